@@ -101,6 +101,36 @@ func (*FilePart) PartType() PartType {
 	return PartTypeFile
 }
 
+// UnmarshalJSON implements [json.Unmarshaler].
+//
+// Besides the nested "file" object emitted by [FilePart] and the other A2A implementations,
+// it accepts the legacy flat layout with "fileBytes", "fileUri" and "mimeType" members next
+// to "type". The nested object wins when both are present.
+func (p *FilePart) UnmarshalJSON(data []byte) error {
+	type Alias FilePart
+	tmp := &struct {
+		*Alias
+		FileBytes string `json:"fileBytes"`
+		FileURI   string `json:"fileUri"`
+		MIMEType  string `json:"mimeType"`
+	}{
+		Alias: (*Alias)(p),
+	}
+	if err := sonic.ConfigFastest.Unmarshal(data, tmp); err != nil {
+		return fmt.Errorf("FilePart: unmarshal data: %w", err)
+	}
+
+	if p.File == (FileContent{}) {
+		p.File = FileContent{
+			MIMEType: tmp.MIMEType,
+			Bytes:    tmp.FileBytes,
+			URI:      tmp.FileURI,
+		}
+	}
+
+	return nil
+}
+
 // FileContent represents the content of a file, either as base64 encoded bytes or a URI.
 type FileContent struct {
 	Name string `json:"name,omitzero"`
@@ -169,31 +199,58 @@ func (r *Message) UnmarshalJSON(data []byte) error {
 
 	r.Parts = make([]Part, len(tmp.Parts))
 	for i, part := range tmp.Parts {
-		// Try to unmarshal content as TextPart first
-		var textPart TextPart
-		if err := sonic.ConfigFastest.Unmarshal(part, &textPart); err == nil {
-			r.Parts[i] = &textPart
-			continue
+		p, err := unmarshalPart(part)
+		if err != nil {
+			return fmt.Errorf("part %d: %w", i, err)
 		}
+		r.Parts[i] = p
+	}
 
-		// Try to unmarshal content as FilePart
-		var filePart FilePart
-		if err := sonic.ConfigFastest.Unmarshal(part, &filePart); err == nil {
-			r.Parts[i] = &filePart
-			continue
-		}
+	return nil
+}
+
+// unmarshalPart decodes a single part, choosing the concrete type from its "type" field.
+//
+// Parts built without an explicit type are inferred from their fields.
+func unmarshalPart(data []byte) (Part, error) {
+	var probe struct {
+		Type      PartType        `json:"type"`
+		File      json.RawMessage `json:"file"`
+		FileBytes string          `json:"fileBytes"`
+		FileURI   string          `json:"fileUri"`
+		Data      json.RawMessage `json:"data"`
+	}
+	if err := sonic.ConfigFastest.Unmarshal(data, &probe); err != nil {
+		return nil, fmt.Errorf("unmarshal part: %w", err)
+	}
 
-		// Try to unmarshal content as DataPart
-		var dataPart DataPart
-		if err := sonic.ConfigFastest.Unmarshal(part, &dataPart); err == nil {
-			r.Parts[i] = &dataPart
-			continue
+	if probe.Type == "" {
+		switch {
+		case len(probe.File) > 0, probe.FileBytes != "", probe.FileURI != "":
+			probe.Type = PartTypeFile
+		case len(probe.Data) > 0:
+			probe.Type = PartTypeData
+		default:
+			probe.Type = PartTypeText
 		}
+	}
 
-		return fmt.Errorf("unknown part type at index: %d", i)
+	var part Part
+	switch probe.Type {
+	case PartTypeText:
+		part = new(TextPart)
+	case PartTypeFile:
+		part = new(FilePart)
+	case PartTypeData:
+		part = new(DataPart)
+	default:
+		return nil, fmt.Errorf("unknown part type %q", probe.Type)
+	}
+	if err := sonic.ConfigFastest.Unmarshal(data, part); err != nil {
+		return nil, fmt.Errorf("unmarshal %s part: %w", probe.Type, err)
 	}
 
-	return nil
+	return part, nil
 }
 
 // TaskStatus represents the current status of a task.
@@ -204,8 +261,24 @@ type TaskStatus struct {
 	// Message is optionally associated with this status.
 	Message *Message `json:"message,omitempty"`
 
+	// Error describes why the task failed, if it did.
+	Error *TaskError `json:"error,omitempty"`
+
+	// Reason optionally explains why the task reached its state, such as the reason it was
+	// canceled for, see [CancelReasonKey].
+	Reason string `json:"reason,omitempty"`
+
 	// Timestamp is the ISO 8601 timestamp of the status update.
 	Timestamp time.Time `json:"timestamp"`
+
+	// Expiry is when the server stops working on the task, if it bounds the task with a timeout.
+	// Clients should not wait for the task past it.
+	Expiry *time.Time `json:"expiry,omitempty"`
+
+	// Delta optionally carries the next fragment of the agent message a streaming task is
+	// producing, in place of Message, see [MessageDelta]. Clients accumulate the deltas of a
+	// stream with a [MessageAccumulator].
+	Delta *MessageDelta `json:"delta,omitempty"`
 }
 
 // Artifact represents output generated by a task.
@@ -230,6 +303,40 @@ type Artifact struct {
 
 	// Metadata contains optional additional artifact metadata.
 	Metadata map[string]any `json:"metadata,omitempty"`
+
+	// Labels are user-defined key/value pairs used to categorize and select artifacts, such
+	// as type=summary. See [Artifact.HasLabel] and [FilterArtifactsByLabel].
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+//
+// Labels holding values other than strings, as artifacts encoded before labels were typed may,
+// do not fail the decoding: the string labels are kept and the others dropped with a warning.
+func (r *Artifact) UnmarshalJSON(data []byte) error {
+	type Alias Artifact
+	tmp := &struct {
+		*Alias
+		Parts  []json.RawMessage `json:"parts"`
+		Labels json.RawMessage   `json:"labels"`
+	}{
+		Alias: (*Alias)(r),
+	}
+	if err := sonic.ConfigFastest.Unmarshal(data, tmp); err != nil {
+		return fmt.Errorf("Artifact: unmarshal data: %w", err)
+	}
+	r.Labels = artifactLabels(r.Name, tmp.Labels)
+
+	r.Parts = make([]Part, len(tmp.Parts))
+	for i, part := range tmp.Parts {
+		p, err := unmarshalPart(part)
+		if err != nil {
+			return fmt.Errorf("part %d: %w", i, err)
+		}
+		r.Parts[i] = p
+	}
+
+	return nil
 }
 
 // Task represents a unit of work processed by an agent.
@@ -249,6 +356,18 @@ type Task struct {
 	// History is the list of messages exchanged during the task.
 	History []Message `json:"history,omitempty"`
 
+	// Labels are user-defined key/value pairs used to organize and filter tasks.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Version is incremented by the server each time the task is updated.
+	Version int `json:"version,omitzero"`
+
+	// CreatedAt is when the task was first stored by the server.
+	CreatedAt time.Time `json:"createdAt,omitzero"`
+
+	// UpdatedAt is when the task was last updated by the server.
+	UpdatedAt time.Time `json:"updatedAt,omitzero"`
+
 	// Metadata contains additional task metadata.
 	Metadata map[string]any `json:"metadata,omitempty"`
 }
@@ -313,8 +432,16 @@ type PushNotificationConfig struct {
 	// Token is an optional token for the agent to include.
 	Token string `json:"token,omitzero"`
 
+	// Secret is an optional key the agent signs each notification with, see
+	// [VerifyWebhookSignature].
+	Secret string `json:"secret,omitzero"`
+
 	// Authentication contains auth details the agent needs to call the URL.
 	Authentication *AuthenticationInfo `json:"authentication,omitempty"`
+
+	// EventTypes optionally limits notifications to the listed event types,
+	// [EventTypeStatus] or [EventTypeArtifact]. Empty means every event.
+	EventTypes []string `json:"eventTypes,omitempty"`
 }
 
 // TaskIDParams represents parameters for methods that require a task ID.
@@ -326,22 +453,124 @@ type TaskIDParams struct {
 	Metadata map[string]any `json:"metadata,omitempty"`
 }
 
+// IDParams returns p. The params of the methods about a task embed TaskIDParams, which they
+// expose through it.
+func (p TaskIDParams) IDParams() TaskIDParams {
+	return p
+}
+
 // TaskQueryParams represents parameters for querying a task.
 type TaskQueryParams struct {
-	// ID is the unique task identifier.
+	TaskIDParams
+
+	// HistoryLength optionally limits the history to its last HistoryLength messages.
+	// Nil includes the whole history, and zero leaves it out.
+	HistoryLength *int `json:"historyLength,omitempty"`
+
+	// IncludeArtifacts optionally tells whether to include the artifacts. Nil includes them.
+	IncludeArtifacts *bool `json:"includeArtifacts,omitempty"`
+
+	// UpdatedSince optionally makes the request conditional: a task whose UpdatedAt is not after
+	// it is answered with a [TaskNotModified] result instead of the task.
+	UpdatedSince time.Time `json:"updatedSince,omitzero"`
+}
+
+// TaskNotModified is the result of a tasks/get request whose task was not updated since
+// [TaskQueryParams.UpdatedSince], which the client already holds.
+type TaskNotModified struct {
+	// ID is the task identifier.
 	ID string `json:"id"`
 
-	// HistoryLength optionally limits the number of historical messages to include.
-	HistoryLength int `json:"historyLength,omitzero"`
+	// UpdatedAt is when the task was last updated by the server.
+	UpdatedAt time.Time `json:"updatedAt"`
 
-	// Metadata contains optional additional metadata.
-	Metadata map[string]any `json:"metadata,omitempty"`
+	// NotModified is always true, telling the result apart from a task.
+	NotModified bool `json:"notModified"`
+}
+
+// StreamEnd is the result of the response ending the stream of a streaming method on a
+// transport carrying each event as a response of its own, with nothing else to tell the end of
+// the stream, such as stdio. It follows the last event.
+type StreamEnd struct {
+	// StreamEnd is always true, telling the result apart from an event.
+	StreamEnd bool `json:"streamEnd"`
+}
+
+// TaskHistoryParams represents parameters for reading one page of a task's history.
+type TaskHistoryParams struct {
+	TaskIDParams
+
+	// Cursor resumes reading after the previous page. It is empty for the first page.
+	Cursor string `json:"cursor,omitempty"`
+
+	// Limit optionally caps the number of messages in the page.
+	Limit int `json:"limit,omitzero"`
+}
+
+// TaskHistoryPage is one page of a task's history, oldest message first.
+type TaskHistoryPage struct {
+	// Messages holds the messages of the page.
+	Messages []Message `json:"messages"`
+
+	// NextCursor resumes reading after this page. It is empty on the last page.
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// TaskListParams represents parameters for listing one page of the tasks of a server.
+type TaskListParams struct {
+	// SessionID optionally selects the tasks of a session.
+	SessionID string `json:"sessionId,omitzero"`
+
+	// State optionally selects the tasks in a state.
+	State TaskState `json:"state,omitzero"`
+
+	// Labels optionally selects the tasks carrying every key/value pair.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Limit optionally caps the number of tasks in the page.
+	Limit int `json:"limit,omitzero"`
+
+	// Cursor resumes listing after the previous page. It is empty for the first page.
+	Cursor string `json:"cursor,omitzero"`
+
+	// IncludeFileBytes keeps the inline content of file parts in the listed tasks, which
+	// servers leave out by default to keep pages small.
+	IncludeFileBytes bool `json:"includeFileBytes,omitzero"`
+}
+
+// TaskListPage is one page of the tasks of a server, oldest task first.
+type TaskListPage struct {
+	// Tasks holds the tasks of the page.
+	Tasks []Task `json:"tasks"`
+
+	// NextCursor resumes listing after this page. It is empty on the last page.
+	NextCursor string `json:"nextCursor,omitzero"`
+}
+
+// TaskArtifactsParams represents parameters for reading a range of a task's artifacts.
+type TaskArtifactsParams struct {
+	TaskIDParams
+
+	// StartIndex is the position of the first artifact to read, in the artifacts of the task
+	// sorted by index. It is the index of that artifact when the indices are contiguous from 0.
+	StartIndex int `json:"startIndex,omitzero"`
+
+	// Count optionally caps the number of artifacts read.
+	Count int `json:"count,omitzero"`
+}
+
+// TaskArtifactsPage is a range of a task's artifacts, sorted by index.
+type TaskArtifactsPage struct {
+	// Artifacts holds the artifacts of the range.
+	Artifacts []Artifact `json:"artifacts"`
+
+	// Total is the number of artifacts of the task.
+	Total int `json:"total"`
 }
 
 // TaskSendParams represents parameters for sending a task.
 type TaskSendParams struct {
-	// ID is the unique task identifier.
-	ID string `json:"id"`
+	TaskIDParams
 
 	// SessionID optionally groups related tasks.
 	SessionID uuid.UUID `json:"sessionId,omitzero"`
@@ -358,8 +587,46 @@ type TaskSendParams struct {
 	// HistoryLength optionally limits the number of historical messages to include.
 	HistoryLength int `json:"historyLength,omitzero"`
 
-	// Metadata contains optional additional metadata.
+	// Labels optionally attaches user-defined key/value pairs to the task.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Metadata contains optional request-specific metadata, such as [DeadlineKey].
 	Metadata map[string]any `json:"metadata,omitempty"`
+
+	// DryRun asks the server to validate a tasks/send request without running it. The server
+	// checks the request as it would before running the task, then answers with a task in the
+	// submitted state that it does not store, or with the error the request would get.
+	// Streaming requests do not support it.
+	DryRun bool `json:"dryRun,omitzero"`
+
+	// EventTypes optionally lists the types of the events a tasks/sendSubscribe stream
+	// delivers, [EventTypeStatus] or [EventTypeArtifact], so that a client only watching the
+	// status of a task is not sent its artifacts. Empty delivers every event. The final status
+	// update ends the stream whatever the types. tasks/send ignores it.
+	EventTypes []string `json:"eventTypes,omitempty"`
+}
+
+// TaskResubscriptionParams represents parameters for resubscribing to the updates of a task.
+type TaskResubscriptionParams struct {
+	TaskIDParams
+
+	// EventTypes optionally lists the types of the events the stream delivers, as
+	// [TaskSendParams.EventTypes] does.
+	EventTypes []string `json:"eventTypes,omitempty"`
+}
+
+// TaskInputParams represents parameters for streaming a chunk of input into the current turn of a task.
+type TaskInputParams struct {
+	TaskIDParams
+
+	// SessionID optionally groups related tasks.
+	SessionID uuid.UUID `json:"sessionId,omitzero"`
+
+	// Message carries the parts to append to the current turn.
+	Message Message `json:"message"`
+
+	// Final indicates this chunk completes the input for the current turn.
+	Final bool `json:"final,omitzero"`
 }
 
 // TaskPushNotificationConfig associates a PushNotificationConfig with a task ID.
@@ -423,6 +690,11 @@ type AgentSkill struct {
 
 	// OutputModes optionally overrides the default output modes for this skill.
 	OutputModes []string `json:"outputModes,omitempty"`
+
+	// Parameters is an optional JSON Schema describing the data parts the skill accepts.
+	//
+	// See [SkillParamsFromStruct] to derive it from a Go struct.
+	Parameters any `json:"parameters,omitempty"`
 }
 
 // AgentCard provides metadata about an agent.
@@ -442,6 +714,9 @@ type AgentCard struct {
 	// Version is the agent/API version.
 	Version string `json:"version"`
 
+	// ProtocolVersion is the version of the A2A protocol the agent implements.
+	ProtocolVersion string `json:"protocolVersion,omitzero"`
+
 	// DocumentationURL is an optional link to documentation.
 	DocumentationURL string `json:"documentationUrl,omitzero"`
 
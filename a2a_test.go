@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/bytedance/sonic"
 	gocmp "github.com/google/go-cmp/cmp"
 	gocmpopts "github.com/google/go-cmp/cmp/cmpopts"
 
@@ -394,14 +395,14 @@ func TestTaskQueryParams(t *testing.T) {
 			ID:       "test-id",
 			Metadata: map[string]any{"key": "value"},
 		},
-		HistoryLength: 10,
+		HistoryLength: ptr(10),
 	}
 
 	if params.ID != "test-id" {
 		t.Errorf("TaskQueryParams.ID = %v, want %v", params.ID, "test-id")
 	}
-	if params.HistoryLength != 10 {
-		t.Errorf("TaskQueryParams.HistoryLength = %v, want %v", params.HistoryLength, 10)
+	if *params.HistoryLength != 10 {
+		t.Errorf("TaskQueryParams.HistoryLength = %v, want %v", *params.HistoryLength, 10)
 	}
 
 	// Check metadata
@@ -515,3 +516,98 @@ func TestAgentCard(t *testing.T) {
 		t.Errorf("AgentCard.Skills[0].ID = %v, want %v", got, want)
 	}
 }
+
+func TestMessage_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	data := `{"role":"user","parts":[` +
+		`{"type":"text","text":"hello"},` +
+		`{"type":"file","file":{"name":"a.txt","uri":"https://example.com/a.txt"}},` +
+		`{"type":"data","data":{"k":"v"}},` +
+		`{"text":"untyped"}]}`
+
+	var got a2a.Message
+	if err := sonic.ConfigFastest.Unmarshal([]byte(data), &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	want := a2a.Message{
+		Role: a2a.RoleUser,
+		Parts: []a2a.Part{
+			&a2a.TextPart{Type: a2a.PartTypeText, Text: "hello"},
+			&a2a.FilePart{Type: a2a.PartTypeFile, File: a2a.FileContent{Name: "a.txt", URI: "https://example.com/a.txt"}},
+			&a2a.DataPart{Type: a2a.PartTypeData, Data: map[string]any{"k": "v"}},
+			&a2a.TextPart{Text: "untyped"},
+		},
+	}
+	if diff := gocmp.Diff(want, got); diff != "" {
+		t.Errorf("Message mismatch (-want +got):\n%s", diff)
+	}
+
+	if err := sonic.ConfigFastest.Unmarshal([]byte(`{"role":"user","parts":[{"type":"video"}]}`), &got); err == nil {
+		t.Error("Unmarshal() error = nil, want error for unknown part type")
+	}
+}
+
+func TestFilePart_JSON(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		data string
+		want a2a.FilePart
+		// wantJSON is the encoding of the decoded part, in the nested layout.
+		wantJSON string
+	}{
+		"nested bytes": {
+			data:     `{"type":"file","file":{"name":"hello.txt","mimeType":"text/plain","bytes":"aGVsbG8="},"metadata":{"source":"upload"}}`,
+			want:     a2a.FilePart{Type: a2a.PartTypeFile, File: a2a.FileContent{Name: "hello.txt", MIMEType: "text/plain", Bytes: "aGVsbG8="}, Metadata: map[string]any{"source": "upload"}},
+			wantJSON: `{"type":"file","file":{"name":"hello.txt","mimeType":"text/plain","bytes":"aGVsbG8="},"metadata":{"source":"upload"}}`,
+		},
+		"nested uri": {
+			data:     `{"type":"file","file":{"mimeType":"image/png","uri":"https://example.com/cat.png"}}`,
+			want:     a2a.FilePart{Type: a2a.PartTypeFile, File: a2a.FileContent{MIMEType: "image/png", URI: "https://example.com/cat.png"}},
+			wantJSON: `{"type":"file","file":{"mimeType":"image/png","uri":"https://example.com/cat.png"}}`,
+		},
+		"legacy flat bytes": {
+			data:     `{"type":"file","mimeType":"text/plain","fileBytes":"aGVsbG8="}`,
+			want:     a2a.FilePart{Type: a2a.PartTypeFile, File: a2a.FileContent{MIMEType: "text/plain", Bytes: "aGVsbG8="}},
+			wantJSON: `{"type":"file","file":{"mimeType":"text/plain","bytes":"aGVsbG8="}}`,
+		},
+		"legacy flat uri": {
+			data:     `{"type":"file","fileUri":"https://example.com/cat.png"}`,
+			want:     a2a.FilePart{Type: a2a.PartTypeFile, File: a2a.FileContent{URI: "https://example.com/cat.png"}},
+			wantJSON: `{"type":"file","file":{"uri":"https://example.com/cat.png"}}`,
+		},
+		"nested wins over flat": {
+			data:     `{"type":"file","file":{"uri":"https://example.com/new.png"},"fileUri":"https://example.com/old.png"}`,
+			want:     a2a.FilePart{Type: a2a.PartTypeFile, File: a2a.FileContent{URI: "https://example.com/new.png"}},
+			wantJSON: `{"type":"file","file":{"uri":"https://example.com/new.png"}}`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var msg a2a.Message
+			if err := sonic.ConfigFastest.UnmarshalFromString(`{"role":"agent","parts":[`+tt.data+`]}`, &msg); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if diff := gocmp.Diff([]a2a.Part{&tt.want}, msg.Parts); diff != "" {
+				t.Errorf("parts mismatch (-want +got):\n%s", diff)
+			}
+
+			got, err := sonic.ConfigFastest.MarshalToString(msg.Parts[0])
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if got != tt.wantJSON {
+				t.Errorf("Marshal() = %s, want %s", got, tt.wantJSON)
+			}
+		})
+	}
+}
+
+// ptr returns a pointer to v.
+func ptr[T any](v T) *T {
+	return &v
+}
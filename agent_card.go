@@ -0,0 +1,197 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// CapabilityRequirement describes what a client needs from an agent.
+type CapabilityRequirement struct {
+	// Streaming requires support for task streaming.
+	Streaming bool
+
+	// PushNotifications requires support for push notifications.
+	PushNotifications bool
+
+	// StateTransitionHistory requires support for state transition history.
+	StateTransitionHistory bool
+
+	// Skills lists the IDs of skills the agent must provide.
+	Skills []string
+
+	// InputModes lists the input modes the agent must accept.
+	InputModes []string
+
+	// OutputModes lists the output modes the agent must produce.
+	OutputModes []string
+}
+
+// Satisfies reports whether the agent card meets every requirement in req.
+//
+// The returned error lists everything that is missing, so tooling choosing among many
+// discovered agents can explain why an agent was rejected.
+func (c AgentCard) Satisfies(req CapabilityRequirement) error {
+	if errs := c.unmet(req); len(errs) > 0 {
+		return fmt.Errorf("agent %q does not satisfy requirements: %w", c.Name, errors.Join(errs...))
+	}
+	return nil
+}
+
+// Supports reports an error naming the first requirement in req that the agent card does
+// not meet, such as "streaming is not supported", letting a client fail early rather than
+// in the middle of a call. Requirements are checked in the order of the fields of req.
+//
+// Use [AgentCard.Satisfies] to list every unmet requirement.
+func (c AgentCard) Supports(req CapabilityRequirement) error {
+	if errs := c.unmet(req); len(errs) > 0 {
+		return fmt.Errorf("agent %q: %w", c.Name, errs[0])
+	}
+	return nil
+}
+
+// HasSkill reports whether the agent card provides the skill with the given ID.
+func (c AgentCard) HasSkill(id string) bool {
+	return slices.ContainsFunc(c.Skills, func(s AgentSkill) bool { return s.ID == id })
+}
+
+// AcceptsInputMode reports whether the agent accepts input in mode, a part kind such as "text"
+// or a media type such as "image/png", listed in its default input modes or in those of any
+// skill. Modes compare case-insensitively and without parameters, and "*/*" or "type/*"
+// wildcards in the card cover the media types they match. A card declaring no input modes
+// accepts anything.
+//
+// A part is accepted when any of its [PartInputModes] is.
+func (c AgentCard) AcceptsInputMode(mode string) bool {
+	declared := len(c.DefaultInputModes) > 0
+	if acceptsMode(c.DefaultInputModes, mode) {
+		return true
+	}
+	for _, skill := range c.Skills {
+		declared = declared || len(skill.InputModes) > 0
+		if acceptsMode(skill.InputModes, mode) {
+			return true
+		}
+	}
+	return !declared
+}
+
+// unmet returns the requirements in req that the agent card does not meet, in the order of
+// the fields of req.
+func (c AgentCard) unmet(req CapabilityRequirement) []error {
+	var errs []error
+
+	if req.Streaming && !c.Capabilities.Streaming {
+		errs = append(errs, errors.New("streaming is not supported"))
+	}
+	if req.PushNotifications && !c.Capabilities.PushNotifications {
+		errs = append(errs, errors.New("push notifications are not supported"))
+	}
+	if req.StateTransitionHistory && !c.Capabilities.StateTransitionHistory {
+		errs = append(errs, errors.New("state transition history is not supported"))
+	}
+
+	for _, id := range req.Skills {
+		if !c.HasSkill(id) {
+			errs = append(errs, fmt.Errorf("skill %q is not provided", id))
+		}
+	}
+
+	for _, mode := range req.InputModes {
+		if !c.supportsMode(mode, c.DefaultInputModes, func(s AgentSkill) []string { return s.InputModes }) {
+			errs = append(errs, fmt.Errorf("input mode %q is not accepted", mode))
+		}
+	}
+	for _, mode := range req.OutputModes {
+		if !c.supportsMode(mode, c.DefaultOutputModes, func(s AgentSkill) []string { return s.OutputModes }) {
+			errs = append(errs, fmt.Errorf("output mode %q is not produced", mode))
+		}
+	}
+	return errs
+}
+
+// supportsMode reports whether mode appears in the default modes or in the modes of any skill.
+func (c AgentCard) supportsMode(mode string, defaults []string, skillModes func(AgentSkill) []string) bool {
+	if containsFold(defaults, mode) {
+		return true
+	}
+	return slices.ContainsFunc(c.Skills, func(s AgentSkill) bool {
+		return containsFold(skillModes(s), mode)
+	})
+}
+
+// containsFold reports whether values contains s, ignoring case.
+func containsFold(values []string, s string) bool {
+	return slices.ContainsFunc(values, func(v string) bool {
+		return strings.EqualFold(v, s)
+	})
+}
+
+// CompatibleWith reports whether the agent speaks a protocol version compatible with clientVersion,
+// along with a human-readable reason.
+//
+// Versions are compared as semantic versions: the major versions must match and, while the
+// protocol is pre-1.0, so must the minor versions. An empty clientVersion means [Version].
+// A card that does not declare a protocol version is assumed to be compatible.
+func (c AgentCard) CompatibleWith(clientVersion string) (bool, string) {
+	if clientVersion == "" {
+		clientVersion = Version
+	}
+	if c.ProtocolVersion == "" {
+		return true, fmt.Sprintf("agent %q (version %s) does not declare a protocol version", c.Name, c.Version)
+	}
+
+	client, err := parseSemver(clientVersion)
+	if err != nil {
+		return false, fmt.Sprintf("invalid client protocol version %q: %v", clientVersion, err)
+	}
+	agent, err := parseSemver(c.ProtocolVersion)
+	if err != nil {
+		return false, fmt.Sprintf("agent %q declares an invalid protocol version %q: %v", c.Name, c.ProtocolVersion, err)
+	}
+
+	switch {
+	case agent[0] != client[0]:
+		return false, fmt.Sprintf("agent %q speaks protocol %s, incompatible major version with client protocol %s", c.Name, c.ProtocolVersion, clientVersion)
+	case agent[0] == 0 && agent[1] != client[1]:
+		return false, fmt.Sprintf("agent %q speaks pre-release protocol %s, incompatible minor version with client protocol %s", c.Name, c.ProtocolVersion, clientVersion)
+	case agent[1] < client[1]:
+		return true, fmt.Sprintf("agent %q speaks older protocol %s; features newer than that may be unavailable", c.Name, c.ProtocolVersion)
+	default:
+		return true, fmt.Sprintf("agent %q speaks compatible protocol %s", c.Name, c.ProtocolVersion)
+	}
+}
+
+// parseSemver parses the major, minor and patch components of a semantic version.
+//
+// A leading "v" and any pre-release or build suffix are ignored; missing components are zero.
+func parseSemver(v string) ([3]int, error) {
+	var parts [3]int
+
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	if v == "" {
+		return parts, errors.New("empty version")
+	}
+
+	fields := strings.Split(v, ".")
+	if len(fields) > 3 {
+		return parts, fmt.Errorf("too many components in %q", v)
+	}
+	for i, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil || n < 0 {
+			return parts, fmt.Errorf("invalid component %q", f)
+		}
+		parts[i] = n
+	}
+
+	return parts, nil
+}
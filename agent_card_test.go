@@ -0,0 +1,304 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"testing"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestAgentCard_Satisfies(t *testing.T) {
+	t.Parallel()
+
+	card := a2a.AgentCard{
+		Name:    "Test Agent",
+		URL:     "https://example.com/agent",
+		Version: "1.0.0",
+		Capabilities: a2a.AgentCapabilities{
+			Streaming: true,
+		},
+		DefaultInputModes:  []string{"text"},
+		DefaultOutputModes: []string{"text"},
+		Skills: []a2a.AgentSkill{
+			{
+				ID:          "translate",
+				Name:        "Translate",
+				InputModes:  []string{"text", "audio/wav"},
+				OutputModes: []string{"application/json"},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		req     a2a.CapabilityRequirement
+		wantErr bool
+	}{
+		"empty": {
+			req:     a2a.CapabilityRequirement{},
+			wantErr: false,
+		},
+		"streaming": {
+			req:     a2a.CapabilityRequirement{Streaming: true},
+			wantErr: false,
+		},
+		"push_notifications": {
+			req:     a2a.CapabilityRequirement{PushNotifications: true},
+			wantErr: true,
+		},
+		"skill": {
+			req:     a2a.CapabilityRequirement{Skills: []string{"translate"}},
+			wantErr: false,
+		},
+		"missing_skill": {
+			req:     a2a.CapabilityRequirement{Skills: []string{"summarize"}},
+			wantErr: true,
+		},
+		"skill_input_mode": {
+			req:     a2a.CapabilityRequirement{InputModes: []string{"Audio/WAV"}},
+			wantErr: false,
+		},
+		"skill_output_mode": {
+			req:     a2a.CapabilityRequirement{OutputModes: []string{"application/json"}},
+			wantErr: false,
+		},
+		"missing_output_mode": {
+			req:     a2a.CapabilityRequirement{OutputModes: []string{"image/png"}},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := card.Satisfies(tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AgentCard.Satisfies() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAgentCard_Satisfies_ListsEverythingMissing(t *testing.T) {
+	t.Parallel()
+
+	card := a2a.AgentCard{Name: "Bare Agent"}
+	err := card.Satisfies(a2a.CapabilityRequirement{
+		Streaming:         true,
+		PushNotifications: true,
+		Skills:            []string{"summarize"},
+	})
+	if err == nil {
+		t.Fatal("AgentCard.Satisfies() error = nil, want error")
+	}
+
+	want := `agent "Bare Agent" does not satisfy requirements: streaming is not supported
+push notifications are not supported
+skill "summarize" is not provided`
+	if got := err.Error(); got != want {
+		t.Errorf("AgentCard.Satisfies() error = %q, want %q", got, want)
+	}
+}
+
+func TestAgentCard_Supports(t *testing.T) {
+	t.Parallel()
+
+	full := a2a.AgentCard{
+		Name: "Full Agent",
+		Capabilities: a2a.AgentCapabilities{
+			Streaming:         true,
+			PushNotifications: true,
+		},
+		DefaultInputModes:  []string{"text"},
+		DefaultOutputModes: []string{"text"},
+		Skills:             []a2a.AgentSkill{{ID: "translate", Name: "Translate"}},
+	}
+	req := a2a.CapabilityRequirement{
+		Streaming:         true,
+		PushNotifications: true,
+		Skills:            []string{"translate"},
+		InputModes:        []string{"text"},
+		OutputModes:       []string{"text"},
+	}
+
+	tests := map[string]struct {
+		modify  func(c *a2a.AgentCard)
+		wantErr string
+	}{
+		"supported": {
+			modify: func(*a2a.AgentCard) {},
+		},
+		"no streaming": {
+			modify:  func(c *a2a.AgentCard) { c.Capabilities.Streaming = false },
+			wantErr: `agent "Full Agent": streaming is not supported`,
+		},
+		"no push notifications": {
+			modify:  func(c *a2a.AgentCard) { c.Capabilities.PushNotifications = false },
+			wantErr: `agent "Full Agent": push notifications are not supported`,
+		},
+		"no skill": {
+			modify:  func(c *a2a.AgentCard) { c.Skills = nil },
+			wantErr: `agent "Full Agent": skill "translate" is not provided`,
+		},
+		"no input mode": {
+			modify:  func(c *a2a.AgentCard) { c.DefaultInputModes = []string{"audio/wav"} },
+			wantErr: `agent "Full Agent": input mode "text" is not accepted`,
+		},
+		"no output mode": {
+			modify:  func(c *a2a.AgentCard) { c.DefaultOutputModes = nil },
+			wantErr: `agent "Full Agent": output mode "text" is not produced`,
+		},
+		"first unmet named": {
+			modify: func(c *a2a.AgentCard) {
+				c.Capabilities = a2a.AgentCapabilities{}
+				c.Skills = nil
+			},
+			wantErr: `agent "Full Agent": streaming is not supported`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			card := full
+			tt.modify(&card)
+			err := card.Supports(req)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("AgentCard.Supports() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("AgentCard.Supports() error = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAgentCard_HasSkill(t *testing.T) {
+	t.Parallel()
+
+	card := a2a.AgentCard{Skills: []a2a.AgentSkill{{ID: "translate"}, {ID: "summarize"}}}
+	tests := map[string]struct {
+		id   string
+		want bool
+	}{
+		"provided":     {id: "summarize", want: true},
+		"not provided": {id: "transcribe"},
+		"name differs": {id: "Translate"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := card.HasSkill(tt.id); got != tt.want {
+				t.Errorf("AgentCard.HasSkill(%q) = %v, want %v", tt.id, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAgentCard_AcceptsInputMode(t *testing.T) {
+	t.Parallel()
+
+	card := a2a.AgentCard{
+		DefaultInputModes: []string{"text", "image/*"},
+		Skills:            []a2a.AgentSkill{{ID: "ocr", InputModes: []string{"application/pdf"}}},
+	}
+	tests := map[string]struct {
+		card a2a.AgentCard
+		mode string
+		want bool
+	}{
+		"default mode":         {card: card, mode: "text", want: true},
+		"case and parameters":  {card: card, mode: "Application/PDF; version=1.7", want: true},
+		"wildcard":             {card: card, mode: "image/png", want: true},
+		"skill mode":           {card: card, mode: "application/pdf", want: true},
+		"not accepted":         {card: card, mode: "audio/mpeg"},
+		"no modes declared":    {mode: "audio/mpeg", want: true},
+		"skill modes declared": {card: a2a.AgentCard{Skills: card.Skills}, mode: "text"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.card.AcceptsInputMode(tt.mode); got != tt.want {
+				t.Errorf("AgentCard.AcceptsInputMode(%q) = %v, want %v", tt.mode, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAgentCard_CompatibleWith(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		protocolVersion string
+		clientVersion   string
+		want            bool
+	}{
+		"undeclared": {
+			protocolVersion: "",
+			clientVersion:   "1.0.0",
+			want:            true,
+		},
+		"same": {
+			protocolVersion: "1.2.0",
+			clientVersion:   "1.2.0",
+			want:            true,
+		},
+		"newer_minor": {
+			protocolVersion: "1.3.0",
+			clientVersion:   "1.2.0",
+			want:            true,
+		},
+		"older_minor": {
+			protocolVersion: "1.1.0",
+			clientVersion:   "1.2.0",
+			want:            true,
+		},
+		"different_major": {
+			protocolVersion: "2.0.0",
+			clientVersion:   "1.2.0",
+			want:            false,
+		},
+		"pre_release_minor": {
+			protocolVersion: "0.2.0",
+			clientVersion:   "0.1.0",
+			want:            false,
+		},
+		"pre_release_patch": {
+			protocolVersion: "v0.1.5-beta",
+			clientVersion:   "0.1.0",
+			want:            true,
+		},
+		"default_client_version": {
+			protocolVersion: a2a.Version,
+			clientVersion:   "",
+			want:            true,
+		},
+		"invalid": {
+			protocolVersion: "latest",
+			clientVersion:   "1.0.0",
+			want:            false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			card := a2a.AgentCard{Name: "Test Agent", Version: "1.0.0", ProtocolVersion: tt.protocolVersion}
+			got, reason := card.CompatibleWith(tt.clientVersion)
+			if got != tt.want {
+				t.Errorf("AgentCard.CompatibleWith() = %v (%s), want %v", got, reason, tt.want)
+			}
+			if reason == "" {
+				t.Error("AgentCard.CompatibleWith() returned an empty reason")
+			}
+		})
+	}
+}
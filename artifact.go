@@ -0,0 +1,99 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ArtifactOutputModeKey is the artifact metadata key holding the output mode an artifact was produced in.
+const ArtifactOutputModeKey = "outputMode"
+
+// DefaultArtifactMode is the key [Task.ArtifactsByMode] uses for artifacts without a declared output mode.
+const DefaultArtifactMode = "default"
+
+// OutputMode returns the declared output mode of the artifact, or an empty string if none is declared.
+func (a Artifact) OutputMode() string {
+	mode, _ := a.Metadata[ArtifactOutputModeKey].(string)
+	return mode
+}
+
+// SetOutputMode declares the output mode of the artifact, such as "text" or "application/json".
+func (a *Artifact) SetOutputMode(mode string) {
+	if a.Metadata == nil {
+		a.Metadata = make(map[string]any)
+	}
+	a.Metadata[ArtifactOutputModeKey] = mode
+}
+
+// ArtifactsByMode groups the task artifacts by their declared output mode.
+//
+// Artifacts without a declared mode are grouped under [DefaultArtifactMode]. Within each
+// group, artifacts keep the order in which they appear in the task.
+func (t Task) ArtifactsByMode() map[string][]Artifact {
+	groups := make(map[string][]Artifact)
+	for _, artifact := range t.Artifacts {
+		mode := artifact.OutputMode()
+		if mode == "" {
+			mode = DefaultArtifactMode
+		}
+		groups[mode] = append(groups[mode], artifact)
+	}
+	return groups
+}
+
+// ErrInvalidArtifactIndex is returned by [ValidateArtifacts] for an artifact with a negative or
+// duplicate index.
+var ErrInvalidArtifactIndex = errors.New("invalid artifact index")
+
+// ArtifactOption represents an option for configuring [ValidateArtifacts].
+type ArtifactOption func(*artifactValidation)
+
+// artifactValidation holds the options of [ValidateArtifacts].
+type artifactValidation struct {
+	allowChunks bool
+}
+
+// AllowArtifactChunks accepts artifacts sharing an index when the later ones set
+// [Artifact.Append], as the chunks of an artifact streamed in chunked-append mode do.
+func AllowArtifactChunks() ArtifactOption {
+	return func(v *artifactValidation) {
+		v.allowChunks = true
+	}
+}
+
+// ValidateArtifacts checks that the artifacts have non-negative and unique indices, so that
+// clients can assemble them by index. It returns an error wrapping [ErrInvalidArtifactIndex]
+// otherwise.
+//
+// By default, artifacts sharing an index are rejected; see [AllowArtifactChunks] for chunks.
+func ValidateArtifacts(arts []Artifact, opts ...ArtifactOption) error {
+	var v artifactValidation
+	for _, opt := range opts {
+		opt(&v)
+	}
+
+	seen := make(map[int]bool, len(arts))
+	for i, artifact := range arts {
+		if artifact.Index < 0 {
+			return fmt.Errorf("%w: artifact %d has index %d", ErrInvalidArtifactIndex, i, artifact.Index)
+		}
+		if seen[artifact.Index] && !(v.allowChunks && artifact.Append) {
+			return fmt.Errorf("%w: artifact %d repeats index %d", ErrInvalidArtifactIndex, i, artifact.Index)
+		}
+		seen[artifact.Index] = true
+	}
+	return nil
+}
+
+// SortArtifacts sorts the artifacts by [Artifact.Index], keeping the order of artifacts
+// sharing an index, such as the chunks of a streamed artifact.
+func SortArtifacts(arts []Artifact) {
+	slices.SortStableFunc(arts, func(a, b Artifact) int {
+		return cmp.Compare(a.Index, b.Index)
+	})
+}
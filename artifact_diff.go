@@ -0,0 +1,146 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+)
+
+// PartEqual reports whether two parts have the same type and content.
+//
+// Text parts compare their text, file parts the name, MIME type, URI and bytes of their file,
+// and data parts their data, deeply. The metadata of the parts is compared deeply too, nil and
+// empty metadata being equal. Two nil parts are equal, and a nil part equals no other part.
+func PartEqual(a, b Part) bool {
+	if isNilPart(a) || isNilPart(b) {
+		return isNilPart(a) && isNilPart(b)
+	}
+
+	switch a := a.(type) {
+	case *TextPart:
+		b, ok := b.(*TextPart)
+		return ok && a.Text == b.Text && equalMetadata(a.Metadata, b.Metadata)
+	case *FilePart:
+		b, ok := b.(*FilePart)
+		return ok && a.File == b.File && equalMetadata(a.Metadata, b.Metadata)
+	case *DataPart:
+		b, ok := b.(*DataPart)
+		return ok && reflect.DeepEqual(a.Data, b.Data) && equalMetadata(a.Metadata, b.Metadata)
+	default:
+		return a.PartType() == b.PartType() && reflect.DeepEqual(a, b)
+	}
+}
+
+// equalMetadata reports whether two metadata maps are deeply equal. Nil and empty maps are equal.
+func equalMetadata(a, b map[string]any) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// isNilPart reports whether p is nil or a nil pointer to one of the part types.
+func isNilPart(p Part) bool {
+	switch p := p.(type) {
+	case nil:
+		return true
+	case *TextPart:
+		return p == nil
+	case *FilePart:
+		return p == nil
+	case *DataPart:
+		return p == nil
+	}
+	return false
+}
+
+// ArtifactChangeKind is the kind of an [ArtifactChange].
+type ArtifactChangeKind string
+
+// Artifact change kinds.
+const (
+	// ArtifactAdded marks an artifact only the new list has.
+	ArtifactAdded ArtifactChangeKind = "added"
+
+	// ArtifactRemoved marks an artifact only the old list has.
+	ArtifactRemoved ArtifactChangeKind = "removed"
+
+	// ArtifactModified marks an artifact both lists have, with different content.
+	ArtifactModified ArtifactChangeKind = "modified"
+)
+
+// ArtifactChange describes an artifact that differs between two lists of artifacts.
+type ArtifactChange struct {
+	// Kind is whether the artifact was added, removed or modified.
+	Kind ArtifactChangeKind
+
+	// Index is the [Artifact.Index] of the artifact.
+	Index int
+
+	// Old is the artifact in the old list, or nil if it was added.
+	Old *Artifact
+
+	// New is the artifact in the new list, or nil if it was removed.
+	New *Artifact
+}
+
+// DiffArtifacts reports the artifacts that were added, removed or modified between the old and
+// updated list, matched by [Artifact.Index] and sorted by it.
+//
+// Artifacts sharing an index, such as the chunks of a streamed artifact, are compared as one
+// artifact holding the parts of all of them, in order. An artifact is modified when its name,
+// description, metadata or parts differ, parts being compared with [PartEqual]. The streaming
+// flags Append and LastChunk are not compared.
+func DiffArtifacts(old, updated []Artifact) []ArtifactChange {
+	oldByIndex, newByIndex := artifactsByIndex(old), artifactsByIndex(updated)
+
+	indexes := slices.Collect(maps.Keys(oldByIndex))
+	for index := range newByIndex {
+		if _, ok := oldByIndex[index]; !ok {
+			indexes = append(indexes, index)
+		}
+	}
+	slices.Sort(indexes)
+
+	var changes []ArtifactChange
+	for _, index := range indexes {
+		o, inOld := oldByIndex[index]
+		n, inNew := newByIndex[index]
+		switch {
+		case !inOld:
+			changes = append(changes, ArtifactChange{Kind: ArtifactAdded, Index: index, New: n})
+		case !inNew:
+			changes = append(changes, ArtifactChange{Kind: ArtifactRemoved, Index: index, Old: o})
+		case !equalArtifacts(*o, *n):
+			changes = append(changes, ArtifactChange{Kind: ArtifactModified, Index: index, Old: o, New: n})
+		}
+	}
+	return changes
+}
+
+// artifactsByIndex maps the index of each artifact to the artifact, merging the parts of
+// artifacts sharing an index into a copy of the first of them.
+func artifactsByIndex(artifacts []Artifact) map[int]*Artifact {
+	byIndex := make(map[int]*Artifact, len(artifacts))
+	for _, a := range artifacts {
+		if merged, ok := byIndex[a.Index]; ok {
+			merged.Parts = append(merged.Parts, a.Parts...)
+			merged.LastChunk = a.LastChunk
+			continue
+		}
+		a.Parts = slices.Clone(a.Parts)
+		byIndex[a.Index] = &a
+	}
+	return byIndex
+}
+
+// equalArtifacts reports whether two artifacts have the same name, description, metadata,
+// labels and parts.
+func equalArtifacts(a, b Artifact) bool {
+	return a.Name == b.Name && a.Description == b.Description &&
+		slices.EqualFunc(a.Parts, b.Parts, PartEqual) &&
+		equalMetadata(a.Metadata, b.Metadata) && maps.Equal(a.Labels, b.Labels)
+}
@@ -0,0 +1,148 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestPartEqual(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		a, b a2a.Part
+		want bool
+	}{
+		"same text": {
+			a:    &a2a.TextPart{Type: a2a.PartTypeText, Text: "hi"},
+			b:    &a2a.TextPart{Type: a2a.PartTypeText, Text: "hi"},
+			want: true,
+		},
+		"different text": {
+			a: &a2a.TextPart{Text: "hi"},
+			b: &a2a.TextPart{Text: "bye"},
+		},
+		"nil and empty metadata": {
+			a:    &a2a.TextPart{Text: "hi"},
+			b:    &a2a.TextPart{Text: "hi", Metadata: map[string]any{}},
+			want: true,
+		},
+		"different metadata": {
+			a: &a2a.TextPart{Text: "hi", Metadata: map[string]any{"lang": "en"}},
+			b: &a2a.TextPart{Text: "hi", Metadata: map[string]any{"lang": "fr"}},
+		},
+		"same file": {
+			a:    &a2a.FilePart{File: a2a.FileContent{MIMEType: "image/png", URI: "https://example.com/a.png"}},
+			b:    &a2a.FilePart{File: a2a.FileContent{MIMEType: "image/png", URI: "https://example.com/a.png"}},
+			want: true,
+		},
+		"different file MIME type": {
+			a: &a2a.FilePart{File: a2a.FileContent{MIMEType: "image/png", Bytes: "aGk="}},
+			b: &a2a.FilePart{File: a2a.FileContent{MIMEType: "image/jpeg", Bytes: "aGk="}},
+		},
+		"same data": {
+			a:    &a2a.DataPart{Data: map[string]any{"n": 1.0, "tags": []any{"a"}}},
+			b:    &a2a.DataPart{Data: map[string]any{"n": 1.0, "tags": []any{"a"}}},
+			want: true,
+		},
+		"different data": {
+			a: &a2a.DataPart{Data: map[string]any{"n": 1.0}},
+			b: &a2a.DataPart{Data: map[string]any{"n": 2.0}},
+		},
+		"different types": {
+			a: &a2a.TextPart{Text: "hi"},
+			b: &a2a.DataPart{Data: map[string]any{"text": "hi"}},
+		},
+		"both nil": {
+			a:    (*a2a.TextPart)(nil),
+			want: true,
+		},
+		"nil and part": {
+			a: &a2a.TextPart{Text: "hi"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := a2a.PartEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("PartEqual() = %t, want %t", got, tt.want)
+			}
+			if got := a2a.PartEqual(tt.b, tt.a); got != tt.want {
+				t.Errorf("PartEqual() reversed = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffArtifacts(t *testing.T) {
+	t.Parallel()
+
+	text := func(s string) []a2a.Part {
+		return []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: s}}
+	}
+
+	tests := map[string]struct {
+		old, updated []a2a.Artifact
+		want         []a2a.ArtifactChange
+	}{
+		"unchanged": {
+			old:     []a2a.Artifact{{Index: 0, Parts: text("a")}},
+			updated: []a2a.Artifact{{Index: 0, Parts: text("a")}},
+		},
+		"added removed and modified": {
+			old: []a2a.Artifact{
+				{Index: 0, Parts: text("a")},
+				{Index: 1, Parts: text("b")},
+				{Index: 3, Name: "report", Parts: text("d")},
+			},
+			updated: []a2a.Artifact{
+				{Index: 2, Parts: text("c")},
+				{Index: 0, Parts: text("a")},
+				{Index: 3, Name: "summary", Parts: text("d")},
+			},
+			want: []a2a.ArtifactChange{
+				{Kind: a2a.ArtifactRemoved, Index: 1, Old: &a2a.Artifact{Index: 1, Parts: text("b")}},
+				{Kind: a2a.ArtifactAdded, Index: 2, New: &a2a.Artifact{Index: 2, Parts: text("c")}},
+				{
+					Kind:  a2a.ArtifactModified,
+					Index: 3,
+					Old:   &a2a.Artifact{Index: 3, Name: "report", Parts: text("d")},
+					New:   &a2a.Artifact{Index: 3, Name: "summary", Parts: text("d")},
+				},
+			},
+		},
+		"streamed chunks": {
+			old: []a2a.Artifact{{Index: 0, Parts: text("a")}},
+			updated: []a2a.Artifact{
+				{Index: 0, Parts: text("a")},
+				{Index: 0, Parts: text("b"), Append: true, LastChunk: true},
+			},
+			want: []a2a.ArtifactChange{{
+				Kind:  a2a.ArtifactModified,
+				Index: 0,
+				Old:   &a2a.Artifact{Index: 0, Parts: text("a")},
+				New:   &a2a.Artifact{Index: 0, Parts: append(text("a"), text("b")...), LastChunk: true},
+			}},
+		},
+		"same chunks": {
+			old:     []a2a.Artifact{{Index: 0, Parts: text("a")}, {Index: 0, Parts: text("b"), Append: true}},
+			updated: []a2a.Artifact{{Index: 0, Parts: append(text("a"), text("b")...)}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := a2a.DiffArtifacts(tt.old, tt.updated)
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("DiffArtifacts() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"errors"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestTask_ArtifactsByMode(t *testing.T) {
+	t.Parallel()
+
+	text1 := a2a.Artifact{Name: "text-1"}
+	text1.SetOutputMode("text")
+	json1 := a2a.Artifact{Name: "json-1"}
+	json1.SetOutputMode("application/json")
+	text2 := a2a.Artifact{Name: "text-2"}
+	text2.SetOutputMode("text")
+	untagged := a2a.Artifact{Name: "untagged"}
+
+	task := a2a.Task{
+		ID:        "task-1",
+		Artifacts: []a2a.Artifact{text1, json1, untagged, text2},
+	}
+
+	want := map[string][]a2a.Artifact{
+		"text":                  {text1, text2},
+		"application/json":      {json1},
+		a2a.DefaultArtifactMode: {untagged},
+	}
+	if diff := gocmp.Diff(want, task.ArtifactsByMode()); diff != "" {
+		t.Errorf("Task.ArtifactsByMode() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestValidateArtifacts(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		artifacts []a2a.Artifact
+		opts      []a2a.ArtifactOption
+		wantErr   bool
+	}{
+		"empty": {},
+		"unique": {
+			artifacts: []a2a.Artifact{{Index: 1}, {Index: 0}, {Index: 3}},
+		},
+		"negative index": {
+			artifacts: []a2a.Artifact{{Index: 0}, {Index: -1}},
+			wantErr:   true,
+		},
+		"duplicate index": {
+			artifacts: []a2a.Artifact{{Index: 0}, {Index: 1}, {Index: 0}},
+			wantErr:   true,
+		},
+		"chunks": {
+			artifacts: []a2a.Artifact{{Index: 0}, {Index: 0, Append: true}},
+			wantErr:   true,
+		},
+		"chunks allowed": {
+			artifacts: []a2a.Artifact{{Index: 0}, {Index: 1}, {Index: 0, Append: true, LastChunk: true}},
+			opts:      []a2a.ArtifactOption{a2a.AllowArtifactChunks()},
+		},
+		"duplicate without append": {
+			artifacts: []a2a.Artifact{{Index: 0}, {Index: 0}},
+			opts:      []a2a.ArtifactOption{a2a.AllowArtifactChunks()},
+			wantErr:   true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := a2a.ValidateArtifacts(tt.artifacts, tt.opts...)
+			if tt.wantErr != errors.Is(err, a2a.ErrInvalidArtifactIndex) {
+				t.Errorf("ValidateArtifacts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSortArtifacts(t *testing.T) {
+	t.Parallel()
+
+	artifacts := []a2a.Artifact{
+		{Name: "c", Index: 2},
+		{Name: "a1", Index: 0},
+		{Name: "b", Index: 1},
+		{Name: "a2", Index: 0, Append: true},
+	}
+	a2a.SortArtifacts(artifacts)
+
+	var got []string
+	for _, artifact := range artifacts {
+		got = append(got, artifact.Name)
+	}
+	if diff := gocmp.Diff([]string{"a1", "a2", "b", "c"}, got); diff != "" {
+		t.Errorf("SortArtifacts() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// CanonicalJSON returns the canonical JSON encoding of v: object keys are sorted, there is no
+// whitespace between tokens, and strings and numbers are written as v encodes them, without
+// escaping HTML characters. Encoding the same value always yields the same bytes, whatever the
+// iteration order of its maps, so the result can be hashed or signed.
+//
+// v is first encoded with [DefaultCodec], honoring its [json.Marshaler] methods.
+func CanonicalJSON(v any) ([]byte, error) {
+	data, err := DefaultCodec.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("marshal: %w", err)
+	}
+
+	// Decoding into maps and re-encoding them sorts the keys; json.Number keeps the numbers
+	// as written.
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return nil, fmt.Errorf("decode: %w", err)
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(value); err != nil {
+		return nil, fmt.Errorf("encode: %w", err)
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte{'\n'}), nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		v    any
+		want string
+	}{
+		"sorted keys": {
+			v:    map[string]any{"b": 1, "a": map[string]any{"d": true, "c": nil}},
+			want: `{"a":{"c":null,"d":true},"b":1}`,
+		},
+		"struct fields": {
+			v:    a2a.TextPart{Type: a2a.PartTypeText, Text: "<hi> & bye", Metadata: map[string]any{"z": 1.5, "y": "x"}},
+			want: `{"metadata":{"y":"x","z":1.5},"text":"<hi> & bye","type":"text"}`,
+		},
+		"large number": {
+			v:    map[string]any{"n": int64(1) << 60},
+			want: `{"n":1152921504606846976}`,
+		},
+		"array order kept": {
+			v:    []any{3, "b", map[string]int{"y": 2, "x": 1}},
+			want: `[3,"b",{"x":1,"y":2}]`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := a2a.CanonicalJSON(tt.v)
+			if err != nil {
+				t.Fatalf("CanonicalJSON() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("CanonicalJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanonicalJSON_Stable(t *testing.T) {
+	t.Parallel()
+
+	properties := make(map[string]any)
+	for i := range 50 {
+		properties["field"+strconv.Itoa(i)] = map[string]any{"type": "string", "description": strconv.Itoa(i)}
+	}
+	card := &a2a.AgentCard{
+		Name: "test", URL: "http://example.com", Version: "1.0.0",
+		Skills: []a2a.AgentSkill{{
+			ID: "skill", Name: "skill",
+			Parameters: map[string]any{"type": "object", "properties": properties},
+		}},
+	}
+
+	want, err := a2a.CanonicalJSON(card)
+	if err != nil {
+		t.Fatalf("CanonicalJSON() error = %v", err)
+	}
+	// Map iteration order is randomized, so differing encodings would show within a few runs.
+	for range 100 {
+		got, err := a2a.CanonicalJSON(card)
+		if err != nil {
+			t.Fatalf("CanonicalJSON() error = %v", err)
+		}
+		if string(got) != string(want) {
+			t.Fatalf("CanonicalJSON() = %s, want %s", got, want)
+		}
+	}
+}
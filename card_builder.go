@@ -0,0 +1,162 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// CardBuilder assembles an [AgentCard] through chained calls, keeping card definitions declarative.
+//
+// The zero value is not usable; create builders with [NewCardBuilder].
+type CardBuilder struct {
+	card AgentCard
+}
+
+// NewCardBuilder returns a builder for an agent card with no skills.
+func NewCardBuilder() *CardBuilder {
+	return &CardBuilder{
+		card: AgentCard{Skills: []AgentSkill{}},
+	}
+}
+
+// SetName sets the human-readable name of the agent.
+func (b *CardBuilder) SetName(name string) *CardBuilder {
+	b.card.Name = name
+	return b
+}
+
+// SetDescription sets the description of the agent.
+func (b *CardBuilder) SetDescription(description string) *CardBuilder {
+	b.card.Description = description
+	return b
+}
+
+// SetURL sets the URL of the agent endpoint.
+func (b *CardBuilder) SetURL(url string) *CardBuilder {
+	b.card.URL = url
+	return b
+}
+
+// SetVersion sets the version of the agent.
+func (b *CardBuilder) SetVersion(version string) *CardBuilder {
+	b.card.Version = version
+	return b
+}
+
+// SetDocumentationURL sets the URL of the agent documentation.
+func (b *CardBuilder) SetDocumentationURL(url string) *CardBuilder {
+	b.card.DocumentationURL = url
+	return b
+}
+
+// SetProvider sets the organization providing the agent and its optional website.
+func (b *CardBuilder) SetProvider(organization, website string) *CardBuilder {
+	b.card.Provider = &AgentProvider{Organization: organization, URL: website}
+	return b
+}
+
+// EnableStreaming declares that the agent supports task streaming.
+func (b *CardBuilder) EnableStreaming() *CardBuilder {
+	b.card.Capabilities.Streaming = true
+	return b
+}
+
+// EnablePushNotifications declares that the agent supports push notifications.
+func (b *CardBuilder) EnablePushNotifications() *CardBuilder {
+	b.card.Capabilities.PushNotifications = true
+	return b
+}
+
+// EnableStateTransitionHistory declares that the agent supports state transition history.
+func (b *CardBuilder) EnableStateTransitionHistory() *CardBuilder {
+	b.card.Capabilities.StateTransitionHistory = true
+	return b
+}
+
+// RequireAuth adds authentication schemes the agent requires. Schemes already added are skipped.
+func (b *CardBuilder) RequireAuth(schemes ...string) *CardBuilder {
+	if b.card.Authentication == nil {
+		b.card.Authentication = &AgentAuthentication{}
+	}
+	for _, scheme := range schemes {
+		if !slices.Contains(b.card.Authentication.Schemes, scheme) {
+			b.card.Authentication.Schemes = append(b.card.Authentication.Schemes, scheme)
+		}
+	}
+	return b
+}
+
+// SetDefaultInputModes sets the input modes the agent accepts unless a skill overrides them.
+func (b *CardBuilder) SetDefaultInputModes(modes ...string) *CardBuilder {
+	b.card.DefaultInputModes = modes
+	return b
+}
+
+// SetDefaultOutputModes sets the output modes the agent produces unless a skill overrides them.
+func (b *CardBuilder) SetDefaultOutputModes(modes ...string) *CardBuilder {
+	b.card.DefaultOutputModes = modes
+	return b
+}
+
+// AddSkill adds a skill to the card.
+func (b *CardBuilder) AddSkill(skill AgentSkill) *CardBuilder {
+	b.card.Skills = append(b.card.Skills, skill)
+	return b
+}
+
+// AddCustomSkill adds a skill whose parameters are described by params, a Go struct
+// converted with [SkillParamsFromStruct].
+func (b *CardBuilder) AddCustomSkill(id, name string, params any) *CardBuilder {
+	return b.AddSkill(AgentSkill{
+		ID:         id,
+		Name:       name,
+		Parameters: SkillParamsFromStruct(params),
+	})
+}
+
+// Build returns the card, or an error listing every problem with it: a missing name,
+// URL or version, and skills without an ID or name or sharing an ID.
+//
+// The returned card does not share its skills with the builder.
+func (b *CardBuilder) Build() (AgentCard, error) {
+	card := b.card
+
+	var errs []error
+	if card.Name == "" {
+		errs = append(errs, errors.New("missing name"))
+	}
+	if card.URL == "" {
+		errs = append(errs, errors.New("missing URL"))
+	}
+	if card.Version == "" {
+		errs = append(errs, errors.New("missing version"))
+	}
+	for i, skill := range card.Skills {
+		switch {
+		case skill.ID == "":
+			errs = append(errs, fmt.Errorf("skill %d: missing ID", i))
+		case slices.ContainsFunc(card.Skills[:i], func(s AgentSkill) bool { return s.ID == skill.ID }):
+			errs = append(errs, fmt.Errorf("skill %q: duplicate ID", skill.ID))
+		}
+		if skill.Name == "" {
+			errs = append(errs, fmt.Errorf("skill %d: missing name", i))
+		}
+	}
+	if len(errs) > 0 {
+		return AgentCard{}, fmt.Errorf("invalid agent card: %w", errors.Join(errs...))
+	}
+
+	card.Skills = slices.Clone(card.Skills)
+	if auth := card.Authentication; auth != nil {
+		card.Authentication = &AgentAuthentication{Schemes: slices.Clone(auth.Schemes), Credentials: auth.Credentials}
+	}
+	if p := card.Provider; p != nil {
+		provider := *p
+		card.Provider = &provider
+	}
+	return card, nil
+}
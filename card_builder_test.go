@@ -0,0 +1,101 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"strings"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestCardBuilder(t *testing.T) {
+	t.Parallel()
+
+	type translateParams struct {
+		Text string `json:"text"`
+	}
+
+	tests := map[string]struct {
+		build    func() *a2a.CardBuilder
+		want     a2a.AgentCard
+		wantErrs []string
+	}{
+		"full card": {
+			build: func() *a2a.CardBuilder {
+				return a2a.NewCardBuilder().
+					SetName("translator").
+					SetURL("https://agent.example.com").
+					SetVersion("1.2.0").
+					SetProvider("Example", "https://example.com").
+					EnableStreaming().
+					RequireAuth("bearer", "apiKey", "bearer").
+					SetDefaultInputModes("text/plain").
+					AddSkill(a2a.AgentSkill{ID: "detect", Name: "Detect language"}).
+					AddCustomSkill("translate", "Translate", translateParams{})
+			},
+			want: a2a.AgentCard{
+				Name:              "translator",
+				URL:               "https://agent.example.com",
+				Version:           "1.2.0",
+				Provider:          &a2a.AgentProvider{Organization: "Example", URL: "https://example.com"},
+				Capabilities:      a2a.AgentCapabilities{Streaming: true},
+				Authentication:    &a2a.AgentAuthentication{Schemes: []string{"bearer", "apiKey"}},
+				DefaultInputModes: []string{"text/plain"},
+				Skills: []a2a.AgentSkill{
+					{ID: "detect", Name: "Detect language"},
+					{ID: "translate", Name: "Translate", Parameters: a2a.SkillParamsFromStruct(translateParams{})},
+				},
+			},
+		},
+		"no skills": {
+			build: func() *a2a.CardBuilder {
+				return a2a.NewCardBuilder().SetName("echo").SetURL("http://localhost").SetVersion("0.1.0")
+			},
+			want: a2a.AgentCard{Name: "echo", URL: "http://localhost", Version: "0.1.0", Skills: []a2a.AgentSkill{}},
+		},
+		"missing fields": {
+			build: func() *a2a.CardBuilder {
+				return a2a.NewCardBuilder().
+					AddSkill(a2a.AgentSkill{ID: "a", Name: "A"}).
+					AddSkill(a2a.AgentSkill{ID: "a", Name: "Again"}).
+					AddSkill(a2a.AgentSkill{})
+			},
+			wantErrs: []string{
+				"missing name",
+				"missing URL",
+				"missing version",
+				`skill "a": duplicate ID`,
+				"skill 2: missing ID",
+				"skill 2: missing name",
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.build().Build()
+			if len(tt.wantErrs) > 0 {
+				if err == nil {
+					t.Fatal("Build() error = nil, want an error")
+				}
+				for _, want := range tt.wantErrs {
+					if !strings.Contains(err.Error(), want) {
+						t.Errorf("Build() error = %v, want it to contain %q", err, want)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Build() error = %v", err)
+			}
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Build() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
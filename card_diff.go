@@ -0,0 +1,139 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// CardDiff describes what changed between two versions of an [AgentCard].
+//
+// Fields are empty or nil when the corresponding part of the card is unchanged.
+type CardDiff struct {
+	// AddedSkills are the skills of the new card whose IDs the old card lacks.
+	AddedSkills []AgentSkill
+
+	// RemovedSkills are the skills of the old card whose IDs the new card lacks.
+	RemovedSkills []AgentSkill
+
+	// ChangedSkills are the skills, as in the new card, whose IDs both cards share but whose details differ.
+	ChangedSkills []AgentSkill
+
+	// Capabilities lists each capability that was turned on or off.
+	Capabilities []CapabilityChange
+
+	// Version is set when the agent version changed.
+	Version *VersionChange
+
+	// ProtocolVersion is set when the declared protocol version changed.
+	ProtocolVersion *VersionChange
+
+	// AddedAuthSchemes are the authentication schemes the new card adds.
+	AddedAuthSchemes []string
+
+	// RemovedAuthSchemes are the authentication schemes the new card drops.
+	RemovedAuthSchemes []string
+}
+
+// CapabilityChange describes a capability of [AgentCapabilities] that was turned on or off.
+type CapabilityChange struct {
+	// Name is the JSON name of the capability, such as "streaming".
+	Name string
+
+	// Old is whether the old card declared the capability.
+	Old bool
+
+	// New is whether the new card declares the capability.
+	New bool
+}
+
+// VersionChange describes a changed version string.
+type VersionChange struct {
+	// Old is the version in the old card.
+	Old string
+
+	// New is the version in the new card.
+	New string
+}
+
+// IsEmpty reports whether the diff records no changes.
+func (d CardDiff) IsEmpty() bool {
+	return len(d.AddedSkills) == 0 && len(d.RemovedSkills) == 0 && len(d.ChangedSkills) == 0 &&
+		len(d.Capabilities) == 0 && d.Version == nil && d.ProtocolVersion == nil &&
+		len(d.AddedAuthSchemes) == 0 && len(d.RemovedAuthSchemes) == 0
+}
+
+// DiffCards reports the skills, capabilities, versions and authentication schemes that differ
+// between the old and updated card.
+//
+// Skills are matched by ID and listed in card order. Authentication schemes are compared
+// case-insensitively. Other fields, such as the name or description, are not compared.
+func DiffCards(old, updated AgentCard) CardDiff {
+	var diff CardDiff
+
+	for _, skill := range updated.Skills {
+		i := slices.IndexFunc(old.Skills, func(s AgentSkill) bool { return s.ID == skill.ID })
+		switch {
+		case i < 0:
+			diff.AddedSkills = append(diff.AddedSkills, skill)
+		case !equalSkills(old.Skills[i], skill):
+			diff.ChangedSkills = append(diff.ChangedSkills, skill)
+		}
+	}
+	for _, skill := range old.Skills {
+		if !slices.ContainsFunc(updated.Skills, func(s AgentSkill) bool { return s.ID == skill.ID }) {
+			diff.RemovedSkills = append(diff.RemovedSkills, skill)
+		}
+	}
+
+	for _, c := range []CapabilityChange{
+		{Name: "streaming", Old: old.Capabilities.Streaming, New: updated.Capabilities.Streaming},
+		{Name: "pushNotifications", Old: old.Capabilities.PushNotifications, New: updated.Capabilities.PushNotifications},
+		{Name: "stateTransitionHistory", Old: old.Capabilities.StateTransitionHistory, New: updated.Capabilities.StateTransitionHistory},
+	} {
+		if c.Old != c.New {
+			diff.Capabilities = append(diff.Capabilities, c)
+		}
+	}
+
+	if old.Version != updated.Version {
+		diff.Version = &VersionChange{Old: old.Version, New: updated.Version}
+	}
+	if old.ProtocolVersion != updated.ProtocolVersion {
+		diff.ProtocolVersion = &VersionChange{Old: old.ProtocolVersion, New: updated.ProtocolVersion}
+	}
+
+	oldSchemes, newSchemes := authSchemes(old.Authentication), authSchemes(updated.Authentication)
+	for _, scheme := range newSchemes {
+		if !containsFold(oldSchemes, scheme) {
+			diff.AddedAuthSchemes = append(diff.AddedAuthSchemes, scheme)
+		}
+	}
+	for _, scheme := range oldSchemes {
+		if !containsFold(newSchemes, scheme) {
+			diff.RemovedAuthSchemes = append(diff.RemovedAuthSchemes, scheme)
+		}
+	}
+
+	return diff
+}
+
+// equalSkills reports whether two skills have the same details. Nil and empty lists are equal.
+func equalSkills(a, b AgentSkill) bool {
+	return a.ID == b.ID && a.Name == b.Name && a.Description == b.Description &&
+		slices.Equal(a.Tags, b.Tags) && slices.Equal(a.Examples, b.Examples) &&
+		slices.EqualFunc(a.InputModes, b.InputModes, strings.EqualFold) &&
+		slices.EqualFunc(a.OutputModes, b.OutputModes, strings.EqualFold) &&
+		reflect.DeepEqual(a.Parameters, b.Parameters)
+}
+
+// authSchemes returns the schemes of auth, which may be nil.
+func authSchemes(auth *AgentAuthentication) []string {
+	if auth == nil {
+		return nil
+	}
+	return auth.Schemes
+}
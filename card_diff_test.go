@@ -0,0 +1,109 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestDiffCards(t *testing.T) {
+	t.Parallel()
+
+	base := a2a.AgentCard{
+		Name:            "Test Agent",
+		URL:             "https://example.com/agent",
+		Version:         "1.0.0",
+		ProtocolVersion: "0.1.0",
+		Capabilities:    a2a.AgentCapabilities{Streaming: true},
+		Authentication:  &a2a.AgentAuthentication{Schemes: []string{"Bearer"}},
+		Skills: []a2a.AgentSkill{
+			{ID: "translate", Name: "Translate", OutputModes: []string{"text/plain"}},
+			{ID: "summarize", Name: "Summarize"},
+		},
+	}
+
+	tests := map[string]struct {
+		update func(c *a2a.AgentCard)
+		want   a2a.CardDiff
+	}{
+		"unchanged": {
+			update: func(c *a2a.AgentCard) {
+				c.Description = "descriptions are not compared"
+				c.Authentication = &a2a.AgentAuthentication{Schemes: []string{"bearer"}}
+			},
+		},
+		"skills": {
+			update: func(c *a2a.AgentCard) {
+				c.Skills = []a2a.AgentSkill{
+					{ID: "translate", Name: "Translate", OutputModes: []string{"text/plain", "audio/wav"}},
+					{ID: "chart", Name: "Chart"},
+				}
+			},
+			want: a2a.CardDiff{
+				AddedSkills:   []a2a.AgentSkill{{ID: "chart", Name: "Chart"}},
+				RemovedSkills: []a2a.AgentSkill{{ID: "summarize", Name: "Summarize"}},
+				ChangedSkills: []a2a.AgentSkill{{ID: "translate", Name: "Translate", OutputModes: []string{"text/plain", "audio/wav"}}},
+			},
+		},
+		"capabilities": {
+			update: func(c *a2a.AgentCard) {
+				c.Capabilities = a2a.AgentCapabilities{PushNotifications: true}
+			},
+			want: a2a.CardDiff{
+				Capabilities: []a2a.CapabilityChange{
+					{Name: "streaming", Old: true, New: false},
+					{Name: "pushNotifications", Old: false, New: true},
+				},
+			},
+		},
+		"versions": {
+			update: func(c *a2a.AgentCard) {
+				c.Version = "2.0.0"
+				c.ProtocolVersion = "0.2.0"
+			},
+			want: a2a.CardDiff{
+				Version:         &a2a.VersionChange{Old: "1.0.0", New: "2.0.0"},
+				ProtocolVersion: &a2a.VersionChange{Old: "0.1.0", New: "0.2.0"},
+			},
+		},
+		"auth schemes": {
+			update: func(c *a2a.AgentCard) {
+				c.Authentication = &a2a.AgentAuthentication{Schemes: []string{"OAuth2"}}
+			},
+			want: a2a.CardDiff{
+				AddedAuthSchemes:   []string{"OAuth2"},
+				RemovedAuthSchemes: []string{"Bearer"},
+			},
+		},
+		"auth removed": {
+			update: func(c *a2a.AgentCard) {
+				c.Authentication = nil
+			},
+			want: a2a.CardDiff{
+				RemovedAuthSchemes: []string{"Bearer"},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			updated := base
+			updated.Skills = append([]a2a.AgentSkill(nil), base.Skills...)
+			tt.update(&updated)
+
+			got := a2a.DiffCards(base, updated)
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("DiffCards() mismatch (-want +got):\n%s", diff)
+			}
+			if got.IsEmpty() != gocmp.Equal(tt.want, a2a.CardDiff{}) {
+				t.Errorf("IsEmpty() = %v for diff %+v", got.IsEmpty(), got)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+const (
+	// PartKindKey is the part metadata key labeling the conventional shape of a data part.
+	PartKindKey = "kind"
+
+	// PartKindCitations labels a data part carrying citations under [CitationsKey].
+	PartKindCitations = "citations"
+
+	// CitationsKey is the data key holding the sources of a citation part.
+	CitationsKey = "citations"
+)
+
+// Source is a reference an agent's answer is based on, such as a retrieved document.
+type Source struct {
+	// URL locates the source.
+	URL string `json:"url"`
+
+	// Title is the optional human-readable title of the source.
+	Title string `json:"title,omitempty"`
+
+	// Snippet is the optional excerpt of the source the answer relies on.
+	Snippet string `json:"snippet,omitempty"`
+
+	// Confidence optionally rates how relevant the source is, from 0 to 1.
+	Confidence float64 `json:"confidence,omitzero"`
+}
+
+// NewCitationPart returns a data part listing sources, labeled with [PartKindCitations]
+// so that clients can render references consistently across agents.
+func NewCitationPart(sources []Source) Part {
+	items := make([]any, 0, len(sources))
+	for _, src := range sources {
+		item := map[string]any{"url": src.URL}
+		if src.Title != "" {
+			item["title"] = src.Title
+		}
+		if src.Snippet != "" {
+			item["snippet"] = src.Snippet
+		}
+		if src.Confidence != 0 {
+			item["confidence"] = src.Confidence
+		}
+		items = append(items, item)
+	}
+
+	return &DataPart{
+		Type:     PartTypeData,
+		Data:     map[string]any{CitationsKey: items},
+		Metadata: map[string]any{PartKindKey: PartKindCitations},
+	}
+}
+
+// Citations collects the sources of every citation part across the task artifacts, in order.
+//
+// Data parts not labeled with [PartKindCitations] are ignored, as are entries without a URL.
+func (t Task) Citations() []Source {
+	var sources []Source
+	for _, artifact := range t.Artifacts {
+		for _, part := range artifact.Parts {
+			dp, ok := part.(*DataPart)
+			if !ok || dp.Metadata[PartKindKey] != PartKindCitations {
+				continue
+			}
+			items, _ := dp.Data[CitationsKey].([]any)
+			for _, item := range items {
+				if src, ok := parseSource(item); ok {
+					sources = append(sources, src)
+				}
+			}
+		}
+	}
+	return sources
+}
+
+// parseSource reads a citation entry as decoded from JSON.
+func parseSource(item any) (Source, bool) {
+	m, ok := item.(map[string]any)
+	if !ok {
+		return Source{}, false
+	}
+	var src Source
+	src.URL, _ = m["url"].(string)
+	src.Title, _ = m["title"].(string)
+	src.Snippet, _ = m["snippet"].(string)
+	src.Confidence, _ = m["confidence"].(float64)
+	return src, src.URL != ""
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"testing"
+
+	"github.com/bytedance/sonic"
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestTask_Citations(t *testing.T) {
+	t.Parallel()
+
+	first := []a2a.Source{
+		{URL: "https://example.com/a", Title: "A", Snippet: "alpha", Confidence: 0.9},
+		{URL: "https://example.com/b"},
+	}
+	second := []a2a.Source{{URL: "https://example.com/c", Title: "C"}}
+
+	task := a2a.Task{
+		ID: "task-1",
+		Artifacts: []a2a.Artifact{
+			{Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "answer"}, a2a.NewCitationPart(first)}},
+			{Parts: []a2a.Part{
+				// Unlabeled data parts are not citations, even with the same shape.
+				&a2a.DataPart{Type: a2a.PartTypeData, Data: map[string]any{"citations": []any{map[string]any{"url": "https://example.com/x"}}}},
+				a2a.NewCitationPart(second),
+			}},
+		},
+	}
+	want := append(append([]a2a.Source{}, first...), second...)
+
+	if diff := gocmp.Diff(want, task.Citations()); diff != "" {
+		t.Errorf("Task.Citations() mismatch (-want +got):\n%s", diff)
+	}
+
+	// Citations survive a round trip through JSON.
+	data, err := sonic.ConfigFastest.Marshal(task)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var decoded a2a.Task
+	if err := sonic.ConfigFastest.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if diff := gocmp.Diff(want, decoded.Citations()); diff != "" {
+		t.Errorf("decoded Task.Citations() mismatch (-want +got):\n%s", diff)
+	}
+
+	if got := (a2a.Task{}).Citations(); got != nil {
+		t.Errorf("Task.Citations() without artifacts = %v, want nil", got)
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/go-a2a/a2a"
+)
+
+// ErrArtifactChunk is reported for an artifact chunk that does not continue a streamed artifact.
+var ErrArtifactChunk = errors.New("unexpected artifact chunk")
+
+// ArtifactAssembler accumulates the chunks of artifacts streamed with [a2a.Artifact.Append]
+// and [a2a.Artifact.LastChunk] into complete artifacts.
+//
+// Chunks of different artifacts may be interleaved; each artifact is tracked by its
+// [a2a.Artifact.Index]. The zero value is ready to use.
+type ArtifactAssembler struct {
+	// pending holds the artifacts that have not received their last chunk yet.
+	pending map[int]*a2a.Artifact
+	// order records the order in which pending artifacts were first seen.
+	order []int
+	// done holds the indexes of the artifacts that received their last chunk.
+	done map[int]bool
+}
+
+// Add adds an artifact chunk, and returns the assembled artifact once its last chunk arrives.
+//
+// A chunk without Append starts the artifact at its index, replacing any parts received so
+// far. Add returns an error wrapping [ErrArtifactChunk] for an appending chunk of an artifact
+// that was never started, including a last chunk arriving before any data, and for an
+// appending chunk of an artifact that was already completed.
+func (a *ArtifactAssembler) Add(chunk a2a.Artifact) (a2a.Artifact, bool, error) {
+	if a.done[chunk.Index] {
+		if chunk.Append {
+			return a2a.Artifact{}, false, fmt.Errorf("%w: chunk appends to artifact %d after its last chunk", ErrArtifactChunk, chunk.Index)
+		}
+		delete(a.done, chunk.Index)
+	}
+
+	artifact, ok := a.pending[chunk.Index]
+	switch {
+	case !ok && chunk.Append:
+		return a2a.Artifact{}, false, fmt.Errorf("%w: chunk appends to artifact %d before its first chunk", ErrArtifactChunk, chunk.Index)
+	case !ok:
+		if a.pending == nil {
+			a.pending = make(map[int]*a2a.Artifact)
+		}
+		a.order = append(a.order, chunk.Index)
+		fallthrough
+	case !chunk.Append:
+		artifact = &a2a.Artifact{
+			Name:        chunk.Name,
+			Description: chunk.Description,
+			Index:       chunk.Index,
+			Metadata:    chunk.Metadata,
+			Labels:      chunk.Labels,
+		}
+		a.pending[chunk.Index] = artifact
+	}
+	artifact.Parts = append(artifact.Parts, chunk.Parts...)
+
+	if !chunk.LastChunk {
+		return a2a.Artifact{}, false, nil
+	}
+
+	delete(a.pending, chunk.Index)
+	a.order = slices.DeleteFunc(a.order, func(i int) bool { return i == chunk.Index })
+	if a.done == nil {
+		a.done = make(map[int]bool)
+	}
+	a.done[chunk.Index] = true
+	artifact.LastChunk = true
+	return *artifact, true, nil
+}
+
+// Flush returns the artifacts still waiting for their last chunk, in the order they were first
+// seen, and forgets them.
+func (a *ArtifactAssembler) Flush() []a2a.Artifact {
+	var artifacts []a2a.Artifact
+	for _, index := range a.order {
+		artifacts = append(artifacts, *a.pending[index])
+	}
+	a.order = nil
+	clear(a.pending)
+	return artifacts
+}
+
+// AssembleArtifacts relays the updates of a stream opened by [Client.SendSubscribe] or
+// [Client.Resubscribe], replacing the chunks of each streamed artifact with a single update
+// carrying the complete artifact, delivered when its last chunk arrives.
+//
+// Artifacts still incomplete when the stream ends, including artifacts sent in a single update
+// without [a2a.Artifact.LastChunk], are delivered as they are, without
+// [a2a.Artifact.LastChunk] set, before the final status update. A chunk rejected by
+// [ArtifactAssembler.Add] ends the stream with an error event. The returned channel is
+// closed once updates is.
+func AssembleArtifacts(updates <-chan TaskUpdateEvent) <-chan TaskUpdateEvent {
+	out := make(chan TaskUpdateEvent, cap(updates))
+	go func() {
+		defer close(out)
+		// Keep draining updates after a failure, so the stream is not left blocked.
+		defer func() {
+			for range updates {
+			}
+		}()
+
+		var (
+			assembler ArtifactAssembler
+			taskID    string
+		)
+		flush := func(eventID string) {
+			for _, artifact := range assembler.Flush() {
+				out <- TaskUpdateEvent{
+					Artifact: &a2a.TaskArtifactUpdateEvent{ID: taskID, Artifact: artifact},
+					EventID:  eventID,
+				}
+			}
+		}
+		for update := range updates {
+			switch {
+			case update.Artifact != nil:
+				taskID = update.Artifact.ID
+				artifact, complete, err := assembler.Add(update.Artifact.Artifact)
+				if err != nil {
+					out <- TaskUpdateEvent{Err: err}
+					return
+				}
+				if !complete {
+					continue
+				}
+				event := *update.Artifact
+				event.Artifact = artifact
+				update.Artifact = &event
+
+			case update.Status != nil && update.Status.Final, update.Err != nil && !update.Skipped:
+				flush(update.EventID)
+			}
+			out <- update
+		}
+		flush("")
+	}()
+	return out
+}
+
+// GetArtifacts reads a range of a task's artifacts from an A2A server, with the tasks/artifacts/get
+// method, and returns them along with the number of artifacts of the task.
+//
+// The artifacts of the task are sorted by index, and count artifacts are read from position
+// start; a non-positive count lets the server pick the size of the range. This reads the
+// artifacts of tasks with many of them in pages, while keeping [Client.GetTask] responses small.
+func (c *Client) GetArtifacts(ctx context.Context, taskID string, start, count int) (artifacts []a2a.Artifact, total int, err error) {
+	ctx, span := c.tracer.Start(ctx, "client.GetArtifacts")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("a2a.task_id", taskID),
+		attribute.Int("a2a.start_index", start),
+		attribute.Int("a2a.count", count),
+	)
+
+	params := a2a.TaskArtifactsParams{
+		TaskIDParams: a2a.TaskIDParams{ID: taskID},
+		StartIndex:   start,
+		Count:        max(count, 0),
+	}
+	data, err := c.sendRequest(ctx, a2a.MethodTasksArtifactsGet, taskID, params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get task artifacts: %w", err)
+	}
+
+	var resp a2a.GetTaskArtifactsResponse
+	if err := c.codec.Unmarshal(data, &resp); err != nil {
+		return nil, 0, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if err := handleRPCError(resp.Error); err != nil {
+		return nil, 0, err
+	}
+	if resp.Result == nil {
+		return nil, 0, nil
+	}
+
+	return resp.Result.Artifacts, resp.Result.Total, nil
+}
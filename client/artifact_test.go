@@ -0,0 +1,241 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+	"github.com/go-a2a/a2a/server"
+)
+
+// chunk returns an artifact chunk of the artifact at index holding text.
+func chunk(index int, text string, appendChunk, last bool) a2a.Artifact {
+	return a2a.Artifact{
+		Index:     index,
+		Parts:     []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: text}},
+		Append:    appendChunk,
+		LastChunk: last,
+	}
+}
+
+func TestArtifactAssembler(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		chunks []a2a.Artifact
+		// want holds the text of each artifact completed, in order.
+		want      []string
+		wantFlush []string
+		wantErr   bool
+	}{
+		"single chunk": {
+			chunks: []a2a.Artifact{chunk(0, "all", false, true)},
+			want:   []string{"all"},
+		},
+		"appended chunks": {
+			chunks: []a2a.Artifact{chunk(0, "a", false, false), chunk(0, "b", true, false), chunk(0, "c", true, true)},
+			want:   []string{"abc"},
+		},
+		"interleaved indexes": {
+			chunks: []a2a.Artifact{
+				chunk(1, "x", false, false),
+				chunk(0, "a", false, false),
+				chunk(1, "y", true, false),
+				chunk(0, "b", true, true),
+				chunk(1, "z", true, true),
+			},
+			want: []string{"ab", "xyz"},
+		},
+		"restart replaces parts": {
+			chunks: []a2a.Artifact{chunk(0, "draft", false, false), chunk(0, "final", false, true)},
+			want:   []string{"final"},
+		},
+		"incomplete flushed": {
+			chunks:    []a2a.Artifact{chunk(2, "b", false, false), chunk(1, "a", false, false), chunk(1, "c", true, false)},
+			wantFlush: []string{"b", "ac"},
+		},
+		"last chunk before any data": {
+			chunks:  []a2a.Artifact{chunk(0, "end", true, true)},
+			wantErr: true,
+		},
+		"append after last chunk": {
+			chunks:  []a2a.Artifact{chunk(0, "a", false, true), chunk(0, "b", true, false)},
+			want:    []string{"a"},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				assembler client.ArtifactAssembler
+				got       []string
+				err       error
+			)
+			for _, c := range tt.chunks {
+				var (
+					artifact a2a.Artifact
+					complete bool
+				)
+				artifact, complete, err = assembler.Add(c)
+				if err != nil {
+					break
+				}
+				if complete {
+					if !artifact.LastChunk || artifact.Append {
+						t.Errorf("assembled artifact flags: append %t, last chunk %t", artifact.Append, artifact.LastChunk)
+					}
+					got = append(got, artifact.JoinText(""))
+				}
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Add() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, client.ErrArtifactChunk) {
+				t.Errorf("Add() error = %v, want %v", err, client.ErrArtifactChunk)
+			}
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("assembled artifacts mismatch (-want +got):\n%s", diff)
+			}
+
+			var flushed []string
+			for _, artifact := range assembler.Flush() {
+				flushed = append(flushed, artifact.JoinText(""))
+			}
+			if diff := gocmp.Diff(tt.wantFlush, flushed); diff != "" {
+				t.Errorf("flushed artifacts mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAssembleArtifacts(t *testing.T) {
+	t.Parallel()
+
+	artifact := func(c a2a.Artifact) client.TaskUpdateEvent {
+		return client.TaskUpdateEvent{Artifact: &a2a.TaskArtifactUpdateEvent{ID: "task-1", Artifact: c}}
+	}
+	status := func(state a2a.TaskState, final bool) client.TaskUpdateEvent {
+		return client.TaskUpdateEvent{Status: &a2a.TaskStatusUpdateEvent{ID: "task-1", Status: a2a.TaskStatus{State: state}, Final: final}}
+	}
+
+	tests := map[string]struct {
+		updates []client.TaskUpdateEvent
+		want    []string
+	}{
+		"chunks assembled": {
+			updates: []client.TaskUpdateEvent{
+				status(a2a.TaskStateWorking, false),
+				artifact(chunk(0, "a", false, false)),
+				artifact(chunk(0, "b", true, true)),
+				status(a2a.TaskStateCompleted, true),
+			},
+			want: []string{"status working", "artifact 0 ab", "status completed"},
+		},
+		"incomplete before final status": {
+			updates: []client.TaskUpdateEvent{
+				artifact(chunk(0, "a", false, false)),
+				artifact(chunk(1, "b", false, false)),
+				status(a2a.TaskStateCompleted, true),
+			},
+			want: []string{"artifact 0 a", "artifact 1 b", "status completed"},
+		},
+		"orphan chunk": {
+			updates: []client.TaskUpdateEvent{
+				artifact(chunk(0, "b", true, true)),
+				status(a2a.TaskStateCompleted, true),
+			},
+			want: []string{"error"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			in := make(chan client.TaskUpdateEvent, len(tt.updates))
+			for _, update := range tt.updates {
+				in <- update
+			}
+			close(in)
+
+			var got []string
+			for update := range client.AssembleArtifacts(in) {
+				switch {
+				case update.Err != nil:
+					got = append(got, "error")
+				case update.Status != nil:
+					got = append(got, "status "+string(update.Status.Status.State))
+				case update.Artifact != nil:
+					a := update.Artifact.Artifact
+					if update.Artifact.ID != "task-1" {
+						t.Errorf("artifact update task ID = %q, want %q", update.Artifact.ID, "task-1")
+					}
+					got = append(got, "artifact "+strconv.Itoa(a.Index)+" "+a.JoinText(""))
+				}
+			}
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("updates mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestClient_GetArtifacts(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	tm := server.NewInMemoryTaskManager()
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, tm))
+	t.Cleanup(srv.Close)
+
+	task := &a2a.Task{ID: "task-1"}
+	var want []string
+	for i := range 5 {
+		text := "artifact " + strconv.Itoa(i)
+		want = append(want, text)
+		task.Artifacts = append(task.Artifacts, a2a.Artifact{Index: i, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: text}}})
+	}
+	if _, err := tm.TaskStore().Create(ctx, task); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	c, err := client.NewClient(srv.URL)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	var got []string
+	for start := 0; ; {
+		artifacts, total, err := c.GetArtifacts(ctx, "task-1", start, 2)
+		if err != nil {
+			t.Fatalf("GetArtifacts() error = %v", err)
+		}
+		if total != 5 {
+			t.Errorf("GetArtifacts() total = %d, want 5", total)
+		}
+		for _, artifact := range artifacts {
+			got = append(got, artifact.Text())
+		}
+		start += len(artifacts)
+		if len(artifacts) == 0 || start >= total {
+			break
+		}
+	}
+	if diff := gocmp.Diff(want, got); diff != "" {
+		t.Errorf("artifacts mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, _, err := c.GetArtifacts(ctx, "missing", 0, 2); !errors.Is(err, a2a.ErrTaskNotFound) {
+		t.Errorf("GetArtifacts() for a missing task error = %v, want %v", err, a2a.ErrTaskNotFound)
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/go-a2a/a2a"
+)
+
+// SendBatch sends independent tasks concurrently, running at most concurrency sends at a time.
+//
+// Results are positional: tasks[i] and errs[i] belong to reqs[i], and a failed send leaves
+// its task nil without affecting the others. Once ctx is canceled no further sends are
+// started, and the requests not yet sent fail with the context error. A non-positive
+// concurrency sends one task at a time.
+func (c *Client) SendBatch(ctx context.Context, reqs []a2a.SendTaskRequest, concurrency int) ([]*a2a.Task, []error) {
+	ctx, span := c.tracer.Start(ctx, "client.SendBatch")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("a2a.batch_size", len(reqs)))
+
+	tasks := make([]*a2a.Task, len(reqs))
+	errs := make([]error, len(reqs))
+
+	sem := make(chan struct{}, max(concurrency, 1))
+	var wg sync.WaitGroup
+	for i, req := range reqs {
+		select {
+		case <-ctx.Done():
+		case sem <- struct{}{}:
+		}
+		// A canceled context wins over a free slot.
+		if err := ctx.Err(); err != nil {
+			for j := i; j < len(reqs); j++ {
+				errs[j] = fmt.Errorf("send batch: %w", err)
+			}
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			tasks[i], errs[i] = c.SendTask(ctx, req)
+		}()
+	}
+	wg.Wait()
+
+	return tasks, errs
+}
+
+// methodBatch is the pseudo method name under which JSON-RPC batches are traced and retried.
+const methodBatch = "batch"
+
+// Batch sends reqs as a single JSON-RPC batch and returns the responses.
+//
+// The server answers requests in order and does not answer notifications, that is requests
+// without an ID, so the responses follow the order of the other requests. A failed request
+// only shows in the Error of its response; Batch returns an error when the batch as a whole
+// failed. Requests without a JSONRPC version are sent as version 2.0.
+func (c *Client) Batch(ctx context.Context, reqs ...a2a.JSONRPCRequest) ([]a2a.JSONRPCResponse, error) {
+	ctx, span := c.tracer.Start(ctx, "client.Batch")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("a2a.batch_size", len(reqs)))
+
+	if len(reqs) == 0 {
+		return nil, errors.New("batch: no requests")
+	}
+
+	reqs = slices.Clone(reqs)
+	for i := range reqs {
+		if reqs[i].JSONRPC == "" {
+			reqs[i].JSONRPC = "2.0"
+		}
+	}
+	data, err := c.codec.Marshal(reqs)
+	if err != nil {
+		return nil, fmt.Errorf("batch: marshal requests: %w", err)
+	}
+
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	body, err := c.retry(ctx, methodBatch, func() ([]byte, error) {
+		return c.doBatch(ctx, data)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("batch: %w", err)
+	}
+
+	body = bytes.TrimSpace(body)
+	switch {
+	case len(body) == 0:
+		// Only notifications were sent.
+		return nil, nil
+	case body[0] == '{':
+		// The server rejected the batch as a whole.
+		var resp a2a.JSONRPCResponse
+		if err := c.codec.Unmarshal(body, &resp); err != nil {
+			return nil, fmt.Errorf("batch: parse response: %w", err)
+		}
+		if resp.Error == nil {
+			return nil, errors.New("batch: unexpected single response")
+		}
+		return nil, fmt.Errorf("batch: %w", handleRPCError(resp.Error))
+	}
+
+	var resps []a2a.JSONRPCResponse
+	if err := c.codec.Unmarshal(body, &resps); err != nil {
+		return nil, fmt.Errorf("batch: parse response: %w", err)
+	}
+	for _, resp := range resps {
+		// A nil result may be a null one, which is valid, so only both being set is invalid.
+		if resp.Result != nil && resp.Error != nil {
+			return nil, fmt.Errorf("batch: response %v: %w", resp.ID, resp.Validate())
+		}
+	}
+	return resps, nil
+}
+
+// doBatch posts a single encoded batch to the A2A server.
+//
+// A 204 No Content reply, sent when the batch only holds notifications, yields an empty body.
+func (c *Client) doBatch(ctx context.Context, data []byte) ([]byte, error) {
+	header := make(http.Header)
+	reqBody, err := c.requestBody(header, data)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("create HTTP request: %w", err)
+	}
+	req.Header = header
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	c.injectTraceContext(ctx, req)
+	if err := c.applyCredentials(ctx, req); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(c.httpClient, req)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "send HTTP request", slog.Any("error", err))
+		return nil, fmt.Errorf("send HTTP request: %w", err)
+	}
+	if err := decompressResponse(resp); err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	recordServerTimings(ctx, resp.Header.Values("Server-Timing"))
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNoContent:
+		return nil, nil
+	default:
+		c.logger.ErrorContext(ctx, "HTTP request failed with status", slog.String("status", resp.Status))
+		return nil, newHTTPStatusError(resp)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	return body, nil
+}
@@ -0,0 +1,212 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bytedance/sonic"
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+	"github.com/go-a2a/a2a/server"
+)
+
+func newBatch(n int) []a2a.SendTaskRequest {
+	reqs := make([]a2a.SendTaskRequest, n)
+	for i := range reqs {
+		reqs[i] = a2a.SendTaskRequest{Params: a2a.TaskSendParams{
+			TaskIDParams: a2a.TaskIDParams{ID: fmt.Sprintf("task-%d", i)},
+			Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "hi"}}},
+		}}
+	}
+	return reqs
+}
+
+func TestClient_SendBatch(t *testing.T) {
+	t.Parallel()
+
+	var inFlight, peak atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		var req struct {
+			Params a2a.TaskSendParams `json:"params"`
+		}
+		data, err := io.ReadAll(r.Body)
+		if err == nil {
+			err = sonic.ConfigFastest.Unmarshal(data, &req)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if req.Params.ID == "task-3" {
+			w.Write([]byte(`{"jsonrpc":"2.0","id":"task-3","error":{"code":-32603,"message":"boom"}}`))
+			return
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%q,"result":{"id":%q,"status":{"state":"completed"}}}`, req.Params.ID, req.Params.ID)
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClient(srv.URL)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	tasks, errs := c.SendBatch(t.Context(), newBatch(8), 3)
+
+	for i := range 8 {
+		if i == 3 {
+			if errs[i] == nil || !strings.Contains(errs[i].Error(), "boom") {
+				t.Errorf("errs[3] = %v, want the RPC error", errs[i])
+			}
+			if tasks[i] != nil {
+				t.Errorf("tasks[3] = %+v, want nil", tasks[i])
+			}
+			continue
+		}
+		if errs[i] != nil {
+			t.Errorf("errs[%d] = %v, want nil", i, errs[i])
+			continue
+		}
+		if want := fmt.Sprintf("task-%d", i); tasks[i].ID != want {
+			t.Errorf("tasks[%d].ID = %q, want %q", i, tasks[i].ID, want)
+		}
+	}
+	if got := peak.Load(); got > 3 {
+		t.Errorf("peak concurrency = %d, want at most 3", got)
+	}
+}
+
+func TestClient_SendBatchCanceled(t *testing.T) {
+	t.Parallel()
+
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Write([]byte(`{"jsonrpc":"2.0","id":"x","result":{"id":"x","status":{"state":"completed"}}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClient(srv.URL)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	_, errs := c.SendBatch(ctx, newBatch(4), 2)
+	for i, err := range errs {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("errs[%d] = %v, want %v", i, err, context.Canceled)
+		}
+	}
+	if got := hits.Load(); got != 0 {
+		t.Errorf("server hit %d times after cancellation, want 0", got)
+	}
+}
+
+func TestClient_Batch(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager()))
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClient(srv.URL)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if _, err := c.SendTask(t.Context(), newBatch(1)[0]); err != nil {
+		t.Fatalf("SendTask() error = %v", err)
+	}
+
+	call := func(id, method, params string) a2a.JSONRPCRequest {
+		req := a2a.JSONRPCRequest{Method: method, Params: []byte(params)}
+		if id != "" {
+			req.ID = a2a.NewID(id)
+		}
+		return req
+	}
+
+	tests := map[string]struct {
+		reqs []a2a.JSONRPCRequest
+		// want lists the ID and error code of each response, in order; 0 means success.
+		want    [][2]any
+		wantErr error
+	}{
+		"mixed results keep order": {
+			reqs: []a2a.JSONRPCRequest{
+				call("a", a2a.MethodTasksGet, `{"id":"task-0"}`),
+				call("b", a2a.MethodTasksGet, `{"id":"missing"}`),
+				call("", a2a.MethodTasksGet, `{"id":"task-0"}`),
+				call("c", a2a.MethodTasksResubscribe, `{"id":"task-0"}`),
+				call("d", "tasks/unknown", `{}`),
+			},
+			want: [][2]any{
+				{"a", 0},
+				{"b", a2a.TaskNotFoundErrorCode},
+				{"c", a2a.InvalidRequestErrorCode},
+				{"d", a2a.MethodNotFoundErrorCode},
+			},
+		},
+		"only notifications": {
+			reqs: []a2a.JSONRPCRequest{
+				call("", a2a.MethodTasksGet, `{"id":"task-0"}`),
+				call("", a2a.MethodTasksGet, `{"id":"missing"}`),
+			},
+		},
+		"empty": {
+			wantErr: errors.New("batch: no requests"),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resps, err := c.Batch(t.Context(), tt.reqs...)
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Fatalf("Batch() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Batch() error = %v", err)
+			}
+
+			var got [][2]any
+			for _, resp := range resps {
+				code := 0
+				if resp.Error != nil {
+					code = resp.Error.Code
+				}
+				got = append(got, [2]any{resp.ID.String(), code})
+			}
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Batch() responses mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without contacting the server while the circuit breaker of the
+// [Client] is open for its host, see [WithCircuitBreaker].
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+const (
+	// DefaultFailureThreshold is the number of consecutive failures opening the circuit breaker
+	// when [CircuitBreakerConfig] sets none.
+	DefaultFailureThreshold = 5
+
+	// DefaultCooldown is how long the circuit breaker stays open when [CircuitBreakerConfig]
+	// sets no cooldown.
+	DefaultCooldown = 30 * time.Second
+)
+
+// CircuitBreakerConfig configures the circuit breaker of the [Client], see [WithCircuitBreaker].
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failed requests to a host that open the
+	// breaker. It defaults to [DefaultFailureThreshold].
+	FailureThreshold int
+
+	// Cooldown is how long the breaker stays open before letting a trial request through.
+	// It defaults to [DefaultCooldown].
+	Cooldown time.Duration
+}
+
+// CircuitState is the state of the circuit breaker of a host.
+type CircuitState int
+
+const (
+	// CircuitClosed lets requests through, counting consecutive failures.
+	CircuitClosed CircuitState = iota
+
+	// CircuitOpen fails requests with [ErrCircuitOpen] until the cooldown elapses.
+	CircuitOpen
+
+	// CircuitHalfOpen lets a single trial request through, whose outcome closes the breaker
+	// or opens it again.
+	CircuitHalfOpen
+)
+
+// String implements [fmt.Stringer].
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return fmt.Sprintf("CircuitState(%d)", int(s))
+	}
+}
+
+// circuit is the breaker state of a single host.
+type circuit struct {
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	trial    bool
+}
+
+// circuitBreaker tracks the health of the hosts a [Client] talks to.
+type circuitBreaker struct {
+	config CircuitBreakerConfig
+	logger *slog.Logger
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+func newCircuitBreaker(config CircuitBreakerConfig, logger *slog.Logger) *circuitBreaker {
+	if config.FailureThreshold <= 0 {
+		config.FailureThreshold = DefaultFailureThreshold
+	}
+	if config.Cooldown <= 0 {
+		config.Cooldown = DefaultCooldown
+	}
+	return &circuitBreaker{
+		config:   config,
+		logger:   logger,
+		circuits: make(map[string]*circuit),
+	}
+}
+
+// allow reports an error wrapping [ErrCircuitOpen] if a request to host must not be sent. When
+// the cooldown of an open breaker has elapsed, the request is let through as the trial.
+func (b *circuitBreaker) allow(ctx context.Context, host string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := b.circuits[host]
+	if c == nil {
+		return nil
+	}
+	switch c.state {
+	case CircuitOpen:
+		if time.Since(c.openedAt) < b.config.Cooldown {
+			return fmt.Errorf("%w: %s", ErrCircuitOpen, host)
+		}
+		b.transition(ctx, host, c, CircuitHalfOpen)
+		c.trial = true
+	case CircuitHalfOpen:
+		if c.trial {
+			return fmt.Errorf("%w: %s", ErrCircuitOpen, host)
+		}
+		c.trial = true
+	}
+	return nil
+}
+
+// record records the outcome of a request to host let through by allow. Requests that ended
+// with neither a success nor a failure of the server, such as ones canceled by the caller, only
+// release the trial of a half-open breaker.
+func (b *circuitBreaker) record(ctx context.Context, host string, failed, ignored bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := b.circuits[host]
+	if c == nil {
+		if !failed || ignored {
+			return
+		}
+		c = &circuit{}
+		b.circuits[host] = c
+	}
+	if c.state == CircuitHalfOpen {
+		c.trial = false
+	}
+	switch {
+	case ignored:
+	case !failed:
+		c.failures = 0
+		if c.state != CircuitClosed {
+			b.transition(ctx, host, c, CircuitClosed)
+		}
+	case c.state == CircuitHalfOpen:
+		b.open(ctx, host, c)
+	default:
+		c.failures++
+		if c.state == CircuitClosed && c.failures >= b.config.FailureThreshold {
+			b.open(ctx, host, c)
+		}
+	}
+}
+
+// open opens the breaker of host. The caller must hold mu.
+func (b *circuitBreaker) open(ctx context.Context, host string, c *circuit) {
+	c.openedAt = time.Now()
+	b.transition(ctx, host, c, CircuitOpen)
+}
+
+// transition moves the breaker of host to state. The caller must hold mu.
+func (b *circuitBreaker) transition(ctx context.Context, host string, c *circuit, state CircuitState) {
+	b.logger.WarnContext(ctx, "circuit breaker state changed",
+		slog.String("host", host),
+		slog.String("from", c.state.String()),
+		slog.String("to", state.String()),
+		slog.Int("failures", c.failures),
+	)
+	c.state = state
+}
+
+// do sends req with httpClient through the circuit breaker of the client, if any.
+//
+// Requests failing to get a response, or getting a 5xx one, count as failures. Other responses,
+// JSON-RPC errors included, count as successes, as the server is up to answer them.
+func (c *Client) do(httpClient *http.Client, req *http.Request) (*http.Response, error) {
+	if c.breaker == nil {
+		return httpClient.Do(req)
+	}
+
+	ctx, host := req.Context(), req.URL.Host
+	if err := c.breaker.allow(ctx, host); err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	failed := err != nil || resp.StatusCode >= http.StatusInternalServerError
+	// A request abandoned by the caller says nothing about the server.
+	ignored := err != nil && ctx.Err() != nil
+	c.breaker.record(ctx, host, failed, ignored)
+	return resp, err
+}
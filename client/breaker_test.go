@@ -0,0 +1,108 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+)
+
+// flakyServer answers tasks/get with a completed task, or with 503 Service Unavailable while
+// failing is set, counting the requests it receives.
+type flakyServer struct {
+	failing  atomic.Bool
+	requests atomic.Int32
+}
+
+func (s *flakyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.requests.Add(1)
+	if s.failing.Load() {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	var req a2a.JSONRPCRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(a2a.GetTaskResponse{
+		JSONRPCResponse: a2a.JSONRPCResponse{JSONRPCMessage: a2a.NewJSONRPCMessage(req.ID)},
+		Result:          &a2a.Task{ID: "task-1", Status: a2a.TaskStatus{State: a2a.TaskStateCompleted}},
+	})
+}
+
+func TestWithCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	const cooldown = 50 * time.Millisecond
+	flaky := &flakyServer{}
+	flaky.failing.Store(true)
+	srv := httptest.NewServer(flaky)
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClient(srv.URL, client.WithCircuitBreaker(client.CircuitBreakerConfig{
+		FailureThreshold: 2,
+		Cooldown:         cooldown,
+	}))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	getTask := func() error {
+		_, err := c.GetTask(t.Context(), &a2a.GetTaskRequest{Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}}})
+		return err
+	}
+	// step calls the server, checking whether the call was refused by the breaker and how many
+	// requests the server received so far.
+	step := func(name string, call func() error, wantOpen bool, wantRequests int32) {
+		t.Helper()
+		err := call()
+		if open := errors.Is(err, client.ErrCircuitOpen); open != wantOpen {
+			t.Fatalf("%s: error = %v, want circuit open %v", name, err, wantOpen)
+		}
+		if got := flaky.requests.Load(); got != wantRequests {
+			t.Fatalf("%s: server received %d requests, want %d", name, got, wantRequests)
+		}
+	}
+	stream := func() error {
+		_, err := c.SendSubscribe(t.Context(), a2a.NewSendTaskStreamingRequest(a2a.NewID("1"), a2a.TaskSendParams{
+			TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+			Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "hi"}}},
+		}))
+		return err
+	}
+
+	// Closed: failures are counted until the threshold opens the breaker. The failing stream
+	// connection counts too.
+	step("first failure", getTask, false, 1)
+	step("failing stream", stream, false, 2)
+	step("open", getTask, true, 2)
+	step("open stream", stream, true, 2)
+
+	// Half-open: the failing trial opens the breaker again.
+	time.Sleep(cooldown)
+	step("failing trial", getTask, false, 3)
+	step("reopened", getTask, true, 3)
+
+	// Half-open: the successful trial closes the breaker.
+	flaky.failing.Store(false)
+	time.Sleep(cooldown)
+	step("successful trial", getTask, false, 4)
+	step("closed", getTask, false, 5)
+
+	// Closed again: the failure count restarted.
+	flaky.failing.Store(true)
+	step("failure after close", getTask, false, 6)
+	step("threshold reached", getTask, false, 7)
+	step("open again", getTask, true, 7)
+}
@@ -0,0 +1,137 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-a2a/a2a"
+)
+
+// defaultCacheTTL is how long a non-terminal task is cached by the [Client].
+const defaultCacheTTL = 2 * time.Second
+
+// ResponseCache caches tasks returned by tasks/get, keyed by task ID.
+type ResponseCache interface {
+	// Get returns the cached task for taskID, if present and not expired.
+	Get(taskID string) (*a2a.Task, bool)
+
+	// Set caches task under taskID. A zero ttl means the entry never expires.
+	Set(taskID string, task *a2a.Task, ttl time.Duration)
+
+	// Delete removes any cached task for taskID.
+	Delete(taskID string)
+}
+
+type cacheEntry struct {
+	task      *a2a.Task
+	expiresAt time.Time
+}
+
+// MemoryResponseCache is an in-memory implementation of [ResponseCache].
+//
+// It stores a copy of each task set and hands out a copy on each get, so that neither the
+// caller caching a task nor those getting it can modify the cached task.
+type MemoryResponseCache struct {
+	mu      sync.RWMutex
+	entries map[string]cacheEntry
+}
+
+var _ ResponseCache = (*MemoryResponseCache)(nil)
+
+// NewMemoryResponseCache creates a new [MemoryResponseCache].
+func NewMemoryResponseCache() *MemoryResponseCache {
+	return &MemoryResponseCache{
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+// Get implements [ResponseCache].
+func (c *MemoryResponseCache) Get(taskID string) (*a2a.Task, bool) {
+	c.mu.RLock()
+	entry, ok := c.entries[taskID]
+	c.mu.RUnlock()
+
+	if !ok {
+		return nil, false
+	}
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		c.Delete(taskID)
+		return nil, false
+	}
+
+	return cloneTask(entry.task), true
+}
+
+// Set implements [ResponseCache].
+func (c *MemoryResponseCache) Set(taskID string, task *a2a.Task, ttl time.Duration) {
+	entry := cacheEntry{task: cloneTask(task)}
+	if ttl > 0 {
+		entry.expiresAt = time.Now().Add(ttl)
+	}
+
+	c.mu.Lock()
+	c.entries[taskID] = entry
+	c.mu.Unlock()
+}
+
+// Delete implements [ResponseCache].
+func (c *MemoryResponseCache) Delete(taskID string) {
+	c.mu.Lock()
+	delete(c.entries, taskID)
+	c.mu.Unlock()
+}
+
+// cloneTask returns a copy of task, or nil, sharing no slices or maps with it but its parts,
+// which are not modified once received.
+func cloneTask(task *a2a.Task) *a2a.Task {
+	if task == nil {
+		return nil
+	}
+	clone := *task
+	clone.Artifacts = slices.Clone(task.Artifacts)
+	for i := range clone.Artifacts {
+		clone.Artifacts[i].Parts = slices.Clone(clone.Artifacts[i].Parts)
+		clone.Artifacts[i].Metadata = maps.Clone(clone.Artifacts[i].Metadata)
+		clone.Artifacts[i].Labels = maps.Clone(clone.Artifacts[i].Labels)
+	}
+	clone.History = slices.Clone(task.History)
+	for i := range clone.History {
+		clone.History[i].Parts = slices.Clone(clone.History[i].Parts)
+		clone.History[i].Metadata = maps.Clone(clone.History[i].Metadata)
+	}
+	if task.Status.Message != nil {
+		msg := *task.Status.Message
+		msg.Parts = slices.Clone(msg.Parts)
+		msg.Metadata = maps.Clone(msg.Metadata)
+		clone.Status.Message = &msg
+	}
+	clone.Labels = maps.Clone(task.Labels)
+	clone.Metadata = maps.Clone(task.Metadata)
+	return &clone
+}
+
+// cacheTask stores task in the response cache, keeping terminal tasks indefinitely.
+func (c *Client) cacheTask(task *a2a.Task) {
+	if c.cache == nil || task == nil || task.ID == "" {
+		return
+	}
+
+	ttl := c.cacheTTL
+	if task.Status.State.IsTerminal() {
+		ttl = 0
+	}
+	c.cache.Set(task.ID, task, ttl)
+}
+
+// invalidateTask removes taskID from the response cache.
+func (c *Client) invalidateTask(taskID string) {
+	if c.cache == nil || taskID == "" {
+		return
+	}
+	c.cache.Delete(taskID)
+}
@@ -0,0 +1,183 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+)
+
+// cachingServer answers every call with the completed task-1, counting the tasks/get calls.
+func cachingServer(t *testing.T) (*client.Client, *atomic.Int32) {
+	t.Helper()
+
+	var gets atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("read request: %v", err)
+			return
+		}
+		var req a2a.JSONRPCRequest
+		if err := a2a.DefaultCodec.Unmarshal(body, &req); err != nil {
+			t.Errorf("decode request: %v", err)
+			return
+		}
+		if req.Method == a2a.MethodTasksGet {
+			gets.Add(1)
+		}
+		id, _ := a2a.DefaultCodec.Marshal(req.ID)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":{"id":"task-1","status":{"state":"completed","timestamp":"2025-01-01T00:00:00Z"},"metadata":{"k":"v"}}}`, id)
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClient(srv.URL, client.WithResponseCache(client.NewMemoryResponseCache()))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return c, &gets
+}
+
+func TestClient_ResponseCache(t *testing.T) {
+	t.Parallel()
+
+	getReq := a2a.NewGetTaskRequest(a2a.NewID("task-1"), a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}})
+	tests := map[string]struct {
+		// between is called between two tasks/get calls for task-1.
+		between  func(t *testing.T, c *client.Client)
+		wantGets int32
+	}{
+		"hit": {
+			between:  func(*testing.T, *client.Client) {},
+			wantGets: 1,
+		},
+		"invalidated by cancel": {
+			between: func(t *testing.T, c *client.Client) {
+				if _, err := c.CancelTask(t.Context(), a2a.NewCancelTaskRequest(a2a.NewID("task-1"), a2a.TaskIDParams{ID: "task-1"})); err != nil {
+					t.Fatalf("CancelTask() error = %v", err)
+				}
+			},
+			wantGets: 2,
+		},
+		"invalidated by send": {
+			between: func(t *testing.T, c *client.Client) {
+				if _, err := c.SendTask(t.Context(), *a2a.NewSendTaskRequest(a2a.NewID("task-1"), a2a.TaskSendParams{
+					TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+					Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{a2a.NewTextPart("hi")}},
+				})); err != nil {
+					t.Fatalf("SendTask() error = %v", err)
+				}
+			},
+			wantGets: 2,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c, gets := cachingServer(t)
+			if _, err := c.GetTask(t.Context(), getReq); err != nil {
+				t.Fatalf("GetTask() error = %v", err)
+			}
+			tt.between(t, c)
+			if _, err := c.GetTask(t.Context(), getReq); err != nil {
+				t.Fatalf("GetTask() error = %v", err)
+			}
+			if got := gets.Load(); got != tt.wantGets {
+				t.Errorf("server got %d tasks/get calls, want %d", got, tt.wantGets)
+			}
+		})
+	}
+
+	t.Run("clone isolation", func(t *testing.T) {
+		t.Parallel()
+
+		c, gets := cachingServer(t)
+		first, err := c.GetTask(t.Context(), getReq)
+		if err != nil {
+			t.Fatalf("GetTask() error = %v", err)
+		}
+		first.Status.State = a2a.TaskStateFailed
+		first.Metadata["k"] = "changed"
+
+		second, err := c.GetTask(t.Context(), getReq)
+		if err != nil {
+			t.Fatalf("GetTask() error = %v", err)
+		}
+		if gets.Load() != 1 {
+			t.Fatalf("server got %d tasks/get calls, want the second answered from the cache", gets.Load())
+		}
+		if second.Status.State != a2a.TaskStateCompleted || second.Metadata["k"] != "v" {
+			t.Errorf("cached task = %+v, want it unchanged by the caller of the first call", second)
+		}
+	})
+}
+
+func TestMemoryResponseCache(t *testing.T) {
+	t.Parallel()
+
+	newTask := func() *a2a.Task {
+		return &a2a.Task{
+			ID:       "task-1",
+			Status:   a2a.TaskStatus{State: a2a.TaskStateWorking, Message: &a2a.Message{Role: a2a.RoleAgent, Parts: []a2a.Part{a2a.NewTextPart("working")}}},
+			History:  []a2a.Message{{Role: a2a.RoleUser, Parts: []a2a.Part{a2a.NewTextPart("hi")}}},
+			Labels:   map[string]string{"team": "a"},
+			Metadata: map[string]any{"k": "v"},
+		}
+	}
+
+	t.Run("ttl", func(t *testing.T) {
+		t.Parallel()
+
+		cache := client.NewMemoryResponseCache()
+		cache.Set("task-1", newTask(), 20*time.Millisecond)
+		cache.Set("task-2", newTask(), 0)
+		if _, ok := cache.Get("task-1"); !ok {
+			t.Fatal("Get() before the TTL = miss, want a hit")
+		}
+		time.Sleep(40 * time.Millisecond)
+		if _, ok := cache.Get("task-1"); ok {
+			t.Error("Get() after the TTL = hit, want a miss")
+		}
+		if _, ok := cache.Get("task-2"); !ok {
+			t.Error("Get() of a task cached without TTL = miss, want a hit")
+		}
+		cache.Delete("task-2")
+		if _, ok := cache.Get("task-2"); ok {
+			t.Error("Get() after Delete() = hit, want a miss")
+		}
+	})
+
+	t.Run("clones", func(t *testing.T) {
+		t.Parallel()
+
+		cache := client.NewMemoryResponseCache()
+		set := newTask()
+		cache.Set("task-1", set, 0)
+
+		// Neither the task set nor the tasks got share anything with the cached task.
+		set.Status.Message.Parts[0] = a2a.NewTextPart("changed")
+		set.History[0].Role = a2a.RoleAgent
+		set.Labels["team"] = "b"
+		got, _ := cache.Get("task-1")
+		got.History = append(got.History[:0], a2a.Message{Role: a2a.RoleAgent})
+		got.Metadata["k"] = "changed"
+
+		got, _ = cache.Get("task-1")
+		if diff := gocmp.Diff(newTask(), got); diff != "" {
+			t.Errorf("cached task mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,33 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/go-a2a/a2a"
+)
+
+// autoCancelTimeout bounds the tasks/cancel call sent by [WithAutoCancel].
+const autoCancelTimeout = 5 * time.Second
+
+// cancelAbandoned cancels taskID on the server when auto-cancel is enabled and ctx, the
+// context of the call that started the task, is done.
+//
+// The cancel is best effort: it runs on a fresh short-lived context, and its failure is only
+// logged, so the caller still sees the error of the original call.
+func (c *Client) cancelAbandoned(ctx context.Context, taskID string) {
+	if !c.autoCancel || taskID == "" || ctx.Err() == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), autoCancelTimeout)
+	defer cancel()
+
+	if _, err := c.CancelTask(ctx, &a2a.CancelTaskRequest{Params: a2a.TaskIDParams{ID: taskID}}); err != nil {
+		c.logger.WarnContext(ctx, "auto-cancel abandoned task", slog.String("task_id", taskID), slog.Any("error", err))
+	}
+}
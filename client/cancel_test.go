@@ -0,0 +1,115 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+)
+
+func TestClient_WithAutoCancel(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		autoCancel bool
+		stream     bool
+		wantCancel bool
+	}{
+		"send": {
+			autoCancel: true,
+			wantCancel: true,
+		},
+		"send subscribe": {
+			autoCancel: true,
+			stream:     true,
+			wantCancel: true,
+		},
+		"disabled": {
+			autoCancel: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			canceled := make(chan string, 1)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req struct {
+					ID     string           `json:"id"`
+					Method string           `json:"method"`
+					Params a2a.TaskIDParams `json:"params"`
+				}
+				data, _ := io.ReadAll(r.Body)
+				if err := sonic.ConfigFastest.Unmarshal(data, &req); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				switch req.Method {
+				case a2a.MethodTasksCancel:
+					canceled <- req.Params.ID
+					w.Header().Set("Content-Type", "application/json")
+					w.Write([]byte(`{"jsonrpc":"2.0","id":"` + req.ID + `","result":{"id":"` + req.Params.ID + `","status":{"state":"canceled"}}}`))
+				case a2a.MethodTasksSendSubscribe:
+					w.Header().Set("Content-Type", "text/event-stream")
+					w.WriteHeader(http.StatusOK)
+					w.(http.Flusher).Flush()
+					<-r.Context().Done()
+				default:
+					// Work on the task until the client gives up.
+					<-r.Context().Done()
+				}
+			}))
+			t.Cleanup(srv.Close)
+
+			c, err := client.NewClient(srv.URL, client.WithAutoCancel(tt.autoCancel))
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+
+			ctx, cancel := context.WithCancel(t.Context())
+			params := a2a.TaskSendParams{
+				TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+				Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "hi"}}},
+			}
+			if tt.stream {
+				updates, err := c.SendSubscribe(ctx, &a2a.SendTaskStreamingRequest{Params: params})
+				if err != nil {
+					t.Fatalf("SendSubscribe() error = %v", err)
+				}
+				cancel()
+				for range updates {
+				}
+			} else {
+				time.AfterFunc(50*time.Millisecond, cancel)
+				if _, err := c.SendTask(ctx, a2a.SendTaskRequest{Params: params}); !errors.Is(err, context.Canceled) {
+					t.Errorf("SendTask() error = %v, want %v", err, context.Canceled)
+				}
+			}
+
+			select {
+			case id := <-canceled:
+				if !tt.wantCancel {
+					t.Errorf("task %s canceled, want no cancel", id)
+				} else if id != "task-1" {
+					t.Errorf("canceled task %s, want task-1", id)
+				}
+			case <-time.After(200 * time.Millisecond):
+				if tt.wantCancel {
+					t.Error("task was not canceled")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/go-a2a/a2a"
+)
+
+// agentCardPath is the well-known path agents serve their card at.
+const agentCardPath = "/.well-known/agent.json"
+
+// cardCache holds the last agent card fetched by [Client.GetAgentCard].
+type cardCache struct {
+	mu        sync.Mutex
+	card      *a2a.AgentCard
+	fetchedAt time.Time
+}
+
+// GetAgentCard fetches the agent card from the well-known path of the agent's host.
+//
+// With [WithAgentCardTTL], a card fetched within the TTL is returned without hitting the
+// network. A failed refetch returns the error but leaves the previously cached card in place,
+// so it is retried on the next call rather than replacing a good card.
+func (c *Client) GetAgentCard(ctx context.Context) (*a2a.AgentCard, error) {
+	ctx, span := c.tracer.Start(ctx, "client.GetAgentCard")
+	defer span.End()
+
+	if c.cardTTL > 0 {
+		c.cardCache.mu.Lock()
+		card, fetchedAt := c.cardCache.card, c.cardCache.fetchedAt
+		c.cardCache.mu.Unlock()
+
+		if card != nil && time.Since(fetchedAt) < c.cardTTL {
+			span.SetAttributes(attribute.Bool("a2a.cache_hit", true))
+			return card, nil
+		}
+	}
+
+	fetchCtx, cancel := c.withTimeout(ctx)
+	defer cancel()
+	card, err := c.fetchAgentCard(fetchCtx)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkCompatibility(card); err != nil {
+		return nil, err
+	}
+
+	if c.cardTTL > 0 {
+		c.cardCache.mu.Lock()
+		c.cardCache.card = card
+		c.cardCache.fetchedAt = time.Now()
+		c.cardCache.mu.Unlock()
+	}
+
+	return card, nil
+}
+
+// fetchAgentCard requests the agent card over HTTP.
+func (c *Client) fetchAgentCard(ctx context.Context) (*a2a.AgentCard, error) {
+	u, err := url.Parse(c.url)
+	if err != nil {
+		return nil, fmt.Errorf("parse agent URL: %w", err)
+	}
+	u = u.ResolveReference(&url.URL{Path: agentCardPath})
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("create HTTP request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	c.injectTraceContext(ctx, req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "fetch agent card", slog.Any("error", err))
+		return nil, fmt.Errorf("fetch agent card: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read agent card: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch agent card: unexpected status %s", resp.Status)
+	}
+
+	var card a2a.AgentCard
+	if err := c.codec.Unmarshal(body, &card); err != nil {
+		return nil, fmt.Errorf("parse agent card: %w", err)
+	}
+	return &card, nil
+}
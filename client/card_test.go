@@ -0,0 +1,76 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-a2a/a2a/client"
+)
+
+func TestClient_GetAgentCard(t *testing.T) {
+	t.Parallel()
+
+	var (
+		hits atomic.Int32
+		fail atomic.Bool
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/agent.json" {
+			http.NotFound(w, r)
+			return
+		}
+		hits.Add(1)
+		if fail.Load() {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name":"echo","url":"http://example.com","version":"1.0.0","capabilities":{},"skills":[]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	const ttl = 100 * time.Millisecond
+	c, err := client.NewClient(srv.URL+"/a2a", client.WithAgentCardTTL(ttl))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx := t.Context()
+
+	for range 3 {
+		card, err := c.GetAgentCard(ctx)
+		if err != nil {
+			t.Fatalf("GetAgentCard() error = %v", err)
+		}
+		if card.Name != "echo" {
+			t.Errorf("GetAgentCard() name = %q, want %q", card.Name, "echo")
+		}
+	}
+	if got := hits.Load(); got != 1 {
+		t.Errorf("fetched %d times within the TTL, want 1", got)
+	}
+
+	// Once expired, a failed refetch reports the error without dropping the good card.
+	time.Sleep(ttl)
+	fail.Store(true)
+	if _, err := c.GetAgentCard(ctx); err == nil {
+		t.Error("GetAgentCard() error = nil, want the refetch error")
+	}
+
+	fail.Store(false)
+	card, err := c.GetAgentCard(ctx)
+	if err != nil {
+		t.Fatalf("GetAgentCard() error = %v", err)
+	}
+	if card.Name != "echo" {
+		t.Errorf("GetAgentCard() name = %q, want %q", card.Name, "echo")
+	}
+	if got := hits.Load(); got != 3 {
+		t.Errorf("fetched %d times, want 3", got)
+	}
+}
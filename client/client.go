@@ -5,18 +5,19 @@
 package client
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
+	"net/url"
 	"time"
 
-	"github.com/bytedance/sonic"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/go-a2a/a2a"
@@ -32,32 +33,127 @@ type Client struct {
 	// httpClient is the HTTP client used for requests.
 	httpClient *http.Client
 
+	// transport, if set, replaces the transport of httpClient, see [WithTransport].
+	transport *http.Transport
+
+	// timeout bounds the calls whose context has no deadline, see [WithTimeout].
+	timeout time.Duration
+
 	// url is the url of the A2A server.
 	url string
 
+	// baseURI is the URI relative file URIs are resolved against, see [WithBaseURI].
+	baseURI *url.URL
+
 	// agentCard is the agent card for the client.
 	agentCard *a2a.AgentCard
 
+	// strictVersion refuses agents whose protocol version is incompatible.
+	strictVersion bool
+
+	// cache optionally caches tasks/get responses.
+	cache ResponseCache
+
+	// cacheTTL is how long non-terminal tasks are cached.
+	cacheTTL time.Duration
+
+	// lastSeen holds the tasks last returned by [Client.GetIfModified].
+	lastSeen lastSeenTasks
+
+	// cardTTL is how long a fetched agent card is reused, or zero to always refetch.
+	cardTTL time.Duration
+
+	// cardCache holds the last fetched agent card.
+	cardCache cardCache
+
+	// streamIdleTimeout is how long a stream may go without receiving anything before it is closed.
+	streamIdleTimeout time.Duration
+
+	// onStreamIdle is called when a stream hits its idle timeout.
+	onStreamIdle func(taskID string)
+
+	// streamErrorMode is how streams handle events that cannot be decoded.
+	streamErrorMode StreamErrorMode
+
+	// multipartThreshold is the file size above which task messages are sent as multipart, or zero.
+	multipartThreshold int
+
+	// maxAttempts is how many times a failed request may be attempted, see [WithRetry].
+	maxAttempts int
+
+	// backoff returns how long to wait before each retry.
+	backoff BackoffFunc
+
+	// reconnectAttempts is how many times in a row a dropped stream may be reconnected, and
+	// reconnectBackoff how long to wait before each attempt, see [WithStreamReconnect].
+	reconnectAttempts int
+	reconnectBackoff  BackoffFunc
+
+	// autoCancel cancels tasks whose sending call is abandoned, see [WithAutoCancel].
+	autoCancel bool
+
+	// compressRequests gzips request bodies of at least compressMinSize bytes, see
+	// [WithRequestCompression].
+	compressRequests bool
+	compressMinSize  int
+
+	// breaker, if set, fails requests fast while the server keeps failing. It is built from
+	// breakerConfig once the options are applied, see [WithCircuitBreaker].
+	breaker       *circuitBreaker
+	breakerConfig *CircuitBreakerConfig
+
+	// credentials, if set, authenticate the requests to the A2A server.
+	credentials CredentialProvider
+
+	// codec encodes requests and decodes responses.
+	codec a2a.Codec
+
+	// propagator injects the trace context into outgoing requests.
+	propagator propagation.TextMapPropagator
+
 	// logger for logging operations.
 	logger *slog.Logger
 
 	// tracer for OpenTelemetry tracing.
 	tracer trace.Tracer
+
+	// closer, if set, releases the transport of the client, see [Client.Close].
+	closer io.Closer
+
+	// uniqueRequestIDs gives each call a request ID of its own, rather than the ID of its task,
+	// for transports telling apart the responses to the calls in flight on a connection by ID.
+	uniqueRequestIDs bool
+
+	// recorder, if set, records the calls of the client, see [WithRecorder].
+	recorder Recorder
 }
 
 // NewClient creates a new [Client] with either a direct URL or [*a2a.AgentCard] option.
 func NewClient(url string, opts ...Option) (*Client, error) {
 	c := &Client{
-		httpClient: &http.Client{
-			Timeout: defaultTimeout,
-		},
-		url:    url,
-		logger: slog.Default(),
-		tracer: otel.GetTracerProvider().Tracer("github.com/go-a2a/a2a/client"),
+		httpClient: &http.Client{},
+		timeout:    defaultTimeout,
+		url:        url,
+		cacheTTL:   defaultCacheTTL,
+		codec:      a2a.DefaultCodec,
+		propagator: otel.GetTextMapPropagator(),
+		logger:     slog.Default(),
+		tracer:     otel.GetTracerProvider().Tracer("github.com/go-a2a/a2a/client"),
 	}
 	for _, opt := range opts {
 		opt(c)
 	}
+	if c.transport != nil {
+		httpClient := *c.httpClient
+		httpClient.Transport = c.transport
+		c.httpClient = &httpClient
+	}
+	if c.breakerConfig != nil {
+		c.breaker = newCircuitBreaker(*c.breakerConfig, c.logger)
+	}
+	if c.backoff == nil {
+		c.backoff = DefaultBackoff
+	}
 
 	if c.url == "" && c.agentCard == nil {
 		return nil, errors.New("must provide either agent_card or url")
@@ -65,19 +161,63 @@ func NewClient(url string, opts ...Option) (*Client, error) {
 
 	if c.agentCard != nil {
 		c.url = c.agentCard.URL
+
+		if err := c.checkCompatibility(c.agentCard); err != nil {
+			return nil, err
+		}
 	}
 
 	return c, nil
 }
 
-// sendRequest makes an HTTP request to the A2A server.
-func (c *Client) sendRequest(ctx context.Context, method, id string, payload any) ([]byte, error) {
-	ctx, span := c.tracer.Start(ctx, "client.sendRequest",
-		trace.WithAttributes(
-			attribute.String("a2a.request_id", id),
-			attribute.String("a2a.method", method),
-		))
-	defer span.End()
+// Close releases the resources the client holds beyond its HTTP client: it stops the agent
+// process of a client created with [NewStdioClient]. It does nothing for other clients.
+func (c *Client) Close() error {
+	if c.closer == nil {
+		return nil
+	}
+	return c.closer.Close()
+}
+
+// checkCompatibility verifies the agent speaks a protocol version compatible with the client.
+//
+// Incompatible agents are logged, and rejected when strict version checking is enabled.
+func (c *Client) checkCompatibility(card *a2a.AgentCard) error {
+	ok, reason := card.CompatibleWith(a2a.Version)
+	if ok {
+		return nil
+	}
+	if c.strictVersion {
+		return fmt.Errorf("incompatible agent: %s", reason)
+	}
+
+	c.logger.Warn("agent protocol version may be incompatible", slog.String("reason", reason))
+	return nil
+}
+
+// requestID returns the request ID of a call about the task id, if any. It is id, unless the
+// transport needs a new ID for each call, see [NewStdioClient], or id is empty and the call is
+// recorded, so that the recorded request carries the ID sent.
+func (c *Client) requestID(id string) string {
+	if c.uniqueRequestIDs || (id == "" && c.recorder != nil) {
+		return uuid.NewString()
+	}
+	return id
+}
+
+// newHTTPRequest builds the HTTP request carrying a JSON-RPC call to the A2A server.
+//
+// With [WithMultipartUpload], task messages carrying large files are sent as multipart/form-data.
+func (c *Client) newHTTPRequest(ctx context.Context, method, id string, payload any) (*http.Request, error) {
+	if id == "" {
+		// A request without an ID is a notification, which the server does not answer.
+		id = uuid.NewString()
+	}
+	if params, ok := payload.(a2a.TaskSendParams); ok && c.multipartThreshold > 0 {
+		if params, uploads := splitUploads(params, c.multipartThreshold); len(uploads) > 0 {
+			return c.newMultipartRequest(ctx, method, id, params, uploads)
+		}
+	}
 
 	request := &a2a.JSONRPCRequest{
 		JSONRPCMessage: a2a.NewJSONRPCMessage(a2a.NewID(id)),
@@ -85,7 +225,7 @@ func (c *Client) sendRequest(ctx context.Context, method, id string, payload any
 	}
 
 	// Marshal the payload separately
-	params, err := sonic.ConfigFastest.Marshal(payload)
+	params, err := c.codec.Marshal(payload)
 	if err != nil {
 		c.logger.ErrorContext(ctx, "marshal params", slog.Any("error", err))
 		return nil, fmt.Errorf("marshal params: %w", err)
@@ -93,32 +233,95 @@ func (c *Client) sendRequest(ctx context.Context, method, id string, payload any
 	request.Params = params
 
 	// Marshal the request
-	data, err := sonic.ConfigFastest.Marshal(request)
+	data, err := c.codec.Marshal(request)
 	if err != nil {
 		c.logger.ErrorContext(ctx, "create request", slog.Any("error", err))
 		return nil, fmt.Errorf("create request: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewBuffer(data))
+	header := make(http.Header)
+	reqBody, err := c.requestBody(header, data)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, reqBody)
 	if err != nil {
 		c.logger.ErrorContext(ctx, "create HTTP request", slog.Any("error", err))
 		return nil, fmt.Errorf("create HTTP request: %w", err)
 	}
 
+	req.Header = header
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", userAgent)
+	c.injectTraceContext(ctx, req)
+	if err := c.applyCredentials(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// injectTraceContext adds the trace context of ctx to the headers of req, so the server
+// span handling it joins the trace of the caller.
+func (c *Client) injectTraceContext(ctx context.Context, req *http.Request) {
+	c.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+}
+
+// sendRequest makes an HTTP request to the A2A server.
+func (c *Client) sendRequest(ctx context.Context, method, id string, payload any) (body []byte, err error) {
+	id = c.requestID(id)
+	ctx, span := c.tracer.Start(ctx, "client.sendRequest",
+		trace.WithAttributes(
+			attribute.String("a2a.request_id", id),
+			attribute.String("a2a.method", method),
+		))
+	defer span.End()
+
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	if c.recorder != nil {
+		req := c.recordedRequest(method, id, payload)
+		defer func() { c.record(req, body, err) }()
+	}
+
+	return c.retry(ctx, method, func() ([]byte, error) {
+		return c.doRequest(ctx, method, id, payload)
+	})
+}
+
+// withTimeout returns ctx bounded by the timeout of the client if it has no deadline, see
+// [WithTimeout], and the function releasing it.
+func (c *Client) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || c.timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.timeout)
+}
+
+// doRequest makes a single HTTP request to the A2A server.
+func (c *Client) doRequest(ctx context.Context, method, id string, payload any) ([]byte, error) {
+	req, err := c.newHTTPRequest(ctx, method, id, payload)
+	if err != nil {
+		return nil, err
+	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(c.httpClient, req)
 	if err != nil {
 		c.logger.ErrorContext(ctx, "send HTTP request", slog.Any("error", err))
 		return nil, fmt.Errorf("send HTTP request: %w", err)
 	}
+	if err := decompressResponse(resp); err != nil {
+		return nil, err
+	}
 	defer resp.Body.Close()
 
+	recordServerTimings(ctx, resp.Header.Values("Server-Timing"))
+
 	if resp.StatusCode != http.StatusOK {
 		c.logger.ErrorContext(ctx, "HTTP request failed with status", slog.String("status", resp.Status))
-		return nil, fmt.Errorf("HTTP request failed with status: %s", resp.Status)
+		return nil, newHTTPStatusError(resp)
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -127,18 +330,29 @@ func (c *Client) sendRequest(ctx context.Context, method, id string, payload any
 		return nil, fmt.Errorf("read response body: %w", err)
 	}
 
+	// A body that is not a response is left to the callers decoding it to report.
+	var probe streamResponse
+	if c.codec.Unmarshal(body, &probe) == nil {
+		if err := probe.validate(); err != nil {
+			c.logger.ErrorContext(ctx, "invalid response", slog.Any("error", err))
+			return nil, err
+		}
+	}
+
 	return body, nil
 }
 
 // handleRPCError processes any JSON-RPC error response and returns an appropriate error.
+//
+// The error wraps the matching a2a sentinel error, such as [a2a.ErrTaskNotFound].
 func handleRPCError(jerr *a2a.JSONRPCError) error {
-	if jerr == nil {
-		return nil
-	}
-	return fmt.Errorf("RPC error: [%d] %s", jerr.Code, jerr.Message)
+	return jerr.AsError()
 }
 
 // SendTask sends a task to an A2A server.
+//
+// With [a2a.TaskSendParams.DryRun] set, the server only validates the request, answering
+// with a submitted task it does not run, or the error the request would get.
 func (c *Client) SendTask(ctx context.Context, req a2a.SendTaskRequest) (*a2a.Task, error) {
 	ctx, span := c.tracer.Start(ctx, "client.SendTask")
 	defer span.End()
@@ -146,13 +360,18 @@ func (c *Client) SendTask(ctx context.Context, req a2a.SendTaskRequest) (*a2a.Ta
 	taskID := req.Params.ID
 	span.SetAttributes(attribute.String("a2a.task_id", taskID))
 
+	c.invalidateTask(taskID)
+
 	data, err := c.sendRequest(ctx, a2a.MethodTasksSend, taskID, req.Params)
 	if err != nil {
+		if !req.Params.DryRun {
+			c.cancelAbandoned(ctx, taskID)
+		}
 		return nil, fmt.Errorf("failed to send task: %w", err)
 	}
 
 	var resp a2a.SendTaskResponse
-	if err := sonic.ConfigFastest.Unmarshal(data, &resp); err != nil {
+	if err := c.codec.Unmarshal(data, &resp); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
 
@@ -170,16 +389,23 @@ func (c *Client) GetTask(ctx context.Context, req *a2a.GetTaskRequest) (*a2a.Tas
 
 	span.SetAttributes(attribute.String("a2a.task_id", req.Params.ID))
 
-	params := map[string]string{
-		"id": req.Params.ID,
+	// Only full views of the task are cached, so a trimmed task never shadows the complete one.
+	cacheable := c.cache != nil && req.Params.HistoryLength == nil && req.Params.UpdatedSince.IsZero() &&
+		(req.Params.IncludeArtifacts == nil || *req.Params.IncludeArtifacts)
+	if cacheable {
+		if task, ok := c.cache.Get(req.Params.ID); ok {
+			span.SetAttributes(attribute.Bool("a2a.cache_hit", true))
+			return task, nil
+		}
 	}
-	data, err := c.sendRequest(ctx, a2a.MethodTasksGet, req.Params.ID, params)
+
+	data, err := c.sendRequest(ctx, a2a.MethodTasksGet, req.Params.ID, req.Params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get task: %w", err)
 	}
 
 	var resp a2a.GetTaskResponse
-	if err := sonic.ConfigFastest.Unmarshal(data, &resp); err != nil {
+	if err := c.codec.Unmarshal(data, &resp); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
 
@@ -187,38 +413,11 @@ func (c *Client) GetTask(ctx context.Context, req *a2a.GetTaskRequest) (*a2a.Tas
 		return nil, err
 	}
 
-	return resp.Result, nil
-}
-
-// SendTaskStreaming sends a task and subscribes to streaming updates.
-// It returns a channel that will receive task events as they occur.
-func (c *Client) SendTaskStreaming(ctx context.Context, req *a2a.SendTaskStreamingRequest) (<-chan a2a.TaskEvent, error) {
-	ctx, span := c.tracer.Start(ctx, "client.SendTaskStreaming")
-	defer span.End()
-
-	taskID := req.Params.ID
-	span.SetAttributes(attribute.String("a2a.task_id", taskID))
-
-	// Create the events channel with reasonable buffer size
-	events := make(chan a2a.TaskEvent, 10)
-
-	// Start a separate goroutine to handle the streaming connection
-	go func() {
-		defer close(events)
-
-		// Create a context with a default timeout
-		streamCtx, cancel := context.WithTimeout(ctx, 30*time.Minute)
-		defer cancel()
-
-		// Open a streaming connection (implementation would depend on your SSE client)
-		// This is a placeholder for the actual implementation
-		c.logger.InfoContext(streamCtx, "Starting streaming connection for task", "taskID", taskID)
-
-		// Handle the connection error and report it
-		// TODO: Implement actual SSE client connection logic here
-	}()
+	if cacheable {
+		c.cacheTask(resp.Result)
+	}
 
-	return events, nil
+	return resp.Result, nil
 }
 
 // CancelTask cancels a task on an A2A server.
@@ -228,6 +427,8 @@ func (c *Client) CancelTask(ctx context.Context, req *a2a.CancelTaskRequest) (*a
 
 	span.SetAttributes(attribute.String("a2a.task_id", req.Params.ID))
 
+	c.invalidateTask(req.Params.ID)
+
 	params := map[string]string{
 		"id": req.Params.ID,
 	}
@@ -237,7 +438,7 @@ func (c *Client) CancelTask(ctx context.Context, req *a2a.CancelTaskRequest) (*a
 	}
 
 	var resp a2a.CancelTaskResponse
-	if err := sonic.ConfigFastest.Unmarshal(data, &resp); err != nil {
+	if err := c.codec.Unmarshal(data, &resp); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
 
@@ -265,7 +466,7 @@ func (c *Client) SetTaskPushNotification(ctx context.Context, req *a2a.SetTaskPu
 	}
 
 	var resp a2a.SetTaskPushNotificationResponse
-	if err := sonic.ConfigFastest.Unmarshal(data, &resp); err != nil {
+	if err := c.codec.Unmarshal(data, &resp); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
 
@@ -293,7 +494,7 @@ func (c *Client) GetTaskPushNotification(ctx context.Context, req *a2a.GetTaskPu
 	}
 
 	var resp a2a.GetTaskPushNotificationResponse
-	if err := sonic.ConfigFastest.Unmarshal(data, &resp); err != nil {
+	if err := c.codec.Unmarshal(data, &resp); err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
 
@@ -0,0 +1,317 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestClient_RPCErrors(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager()))
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClient(srv.URL)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	_, err = c.GetTask(t.Context(), &a2a.GetTaskRequest{Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "missing"}}})
+	if !errors.Is(err, a2a.ErrTaskNotFound) {
+		t.Errorf("GetTask() error = %v, want %v", err, a2a.ErrTaskNotFound)
+	}
+
+	_, err = c.SetTaskPushNotification(t.Context(), &a2a.SetTaskPushNotificationRequest{Params: a2a.TaskPushNotificationConfig{
+		ID:                     "missing",
+		PushNotificationConfig: a2a.PushNotificationConfig{URL: "https://example.com/hook"},
+	}})
+	if !errors.Is(err, a2a.ErrPushNotificationNotSupported) {
+		t.Errorf("SetTaskPushNotification() error = %v, want %v", err, a2a.ErrPushNotificationNotSupported)
+	}
+}
+
+func TestClient_InvalidResponse(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body    string
+		wantErr error
+	}{
+		"both set": {
+			body:    `{"jsonrpc":"2.0","id":"1","result":{"id":"task-1","status":{"state":"completed"}},"error":{"code":-32001,"message":"Task not found"}}`,
+			wantErr: a2a.ErrInvalidResponse,
+		},
+		"null result with error": {
+			body:    `{"jsonrpc":"2.0","id":"1","result":null,"error":{"code":-32001,"message":"Task not found"}}`,
+			wantErr: a2a.ErrTaskNotFound,
+		},
+		"result": {
+			body: `{"jsonrpc":"2.0","id":"1","result":{"id":"task-1","status":{"state":"completed"}}}`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				io.WriteString(w, tt.body)
+			}))
+			t.Cleanup(srv.Close)
+
+			c, err := client.NewClient(srv.URL)
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+			task, err := c.GetTask(t.Context(), &a2a.GetTaskRequest{Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}}})
+			if tt.wantErr == nil {
+				if err != nil || task.ID != "task-1" {
+					t.Errorf("GetTask() = %v, %v, want task-1", task, err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("GetTask() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// countingCodec is an [a2a.Codec] counting its calls.
+type countingCodec struct {
+	a2a.StdCodec
+	calls atomic.Int64
+}
+
+func (c *countingCodec) Marshal(v any) ([]byte, error) {
+	c.calls.Add(1)
+	return c.StdCodec.Marshal(v)
+}
+
+func (c *countingCodec) Unmarshal(data []byte, v any) error {
+	c.calls.Add(1)
+	return c.StdCodec.Unmarshal(data, v)
+}
+
+func TestClient_WithCodec(t *testing.T) {
+	t.Parallel()
+
+	var clientCodec, serverCodec countingCodec
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager(), server.WithCodec(&serverCodec)))
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClient(srv.URL, client.WithCodec(&clientCodec))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	task, err := c.SendTask(t.Context(), a2a.SendTaskRequest{Params: a2a.TaskSendParams{
+		TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+		Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "hello"}}},
+	}})
+	if err != nil {
+		t.Fatalf("SendTask() error = %v", err)
+	}
+	if task.ID != "task-1" {
+		t.Errorf("SendTask() task ID = %q, want %q", task.ID, "task-1")
+	}
+	if clientCodec.calls.Load() == 0 {
+		t.Error("client codec was not used")
+	}
+	if serverCodec.calls.Load() == 0 {
+		t.Error("server codec was not used")
+	}
+}
+
+// slowTaskManager answers tasks/get after a delay, or once the request is canceled.
+type slowTaskManager struct {
+	*server.InMemoryTaskManager
+
+	delay time.Duration
+}
+
+func (tm *slowTaskManager) OnGetTask(ctx context.Context, req *a2a.GetTaskRequest) (*a2a.GetTaskResponse, error) {
+	select {
+	case <-time.After(tm.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return tm.InMemoryTaskManager.OnGetTask(ctx, req)
+}
+
+func TestClient_WithTimeout(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	tm := &slowTaskManager{InMemoryTaskManager: server.NewInMemoryTaskManager(), delay: 300 * time.Millisecond}
+	srv := httptest.NewServer(server.NewServer("", "", card, tm))
+	t.Cleanup(srv.Close)
+
+	tests := map[string]struct {
+		timeout  time.Duration
+		deadline time.Duration
+		wantErr  error
+	}{
+		"default timeout": {
+			timeout: 50 * time.Millisecond,
+			wantErr: context.DeadlineExceeded,
+		},
+		"shorter deadline wins": {
+			timeout:  time.Minute,
+			deadline: 50 * time.Millisecond,
+			wantErr:  context.DeadlineExceeded,
+		},
+		"longer deadline wins": {
+			timeout:  50 * time.Millisecond,
+			deadline: time.Minute,
+			wantErr:  a2a.ErrTaskNotFound,
+		},
+		"disabled": {
+			wantErr: a2a.ErrTaskNotFound,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := client.NewClient(srv.URL, client.WithTimeout(tt.timeout))
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+			ctx := t.Context()
+			if tt.deadline > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.deadline)
+				defer cancel()
+			}
+
+			_, err = c.GetTask(ctx, &a2a.GetTaskRequest{Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "missing"}}})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("GetTask() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClient_WithHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	tm := &slowTaskManager{InMemoryTaskManager: server.NewInMemoryTaskManager(), delay: 300 * time.Millisecond}
+	unary := httptest.NewServer(server.NewServer("", "", card, tm))
+	t.Cleanup(unary.Close)
+
+	// The stream goes silent for longer than the timeout of the HTTP client.
+	stream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		io.WriteString(w, statusEvent+"\n\n")
+		w.(http.Flusher).Flush()
+		select {
+		case <-time.After(300 * time.Millisecond):
+		case <-r.Context().Done():
+			return
+		}
+		io.WriteString(w, `data: {"jsonrpc":"2.0","id":"task-1","result":{"id":"task-1","status":{"state":"completed","timestamp":"2025-01-01T00:00:00Z"},"final":true}}`+"\n\n")
+	}))
+	t.Cleanup(stream.Close)
+
+	httpClient := &http.Client{Timeout: 50 * time.Millisecond}
+
+	t.Run("unary calls honor the timeout", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := client.NewClient(unary.URL, client.WithHTTPClient(httpClient), client.WithTimeout(0))
+		if err != nil {
+			t.Fatalf("NewClient() error = %v", err)
+		}
+		_, err = c.GetTask(t.Context(), &a2a.GetTaskRequest{Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "missing"}}})
+		var nerr net.Error
+		if !errors.As(err, &nerr) || !nerr.Timeout() {
+			t.Errorf("GetTask() error = %v, want a timeout", err)
+		}
+	})
+
+	t.Run("streams outlive the timeout", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := client.NewClient(stream.URL, client.WithHTTPClient(httpClient))
+		if err != nil {
+			t.Fatalf("NewClient() error = %v", err)
+		}
+		updates, err := c.SendSubscribe(t.Context(), &a2a.SendTaskStreamingRequest{Params: a2a.TaskSendParams{
+			TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+			Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "hi"}}},
+		}})
+		if err != nil {
+			t.Fatalf("SendSubscribe() error = %v", err)
+		}
+		var states []a2a.TaskState
+		for update := range updates {
+			if update.Err != nil {
+				t.Fatalf("stream error = %v", update.Err)
+			}
+			if update.Status != nil {
+				states = append(states, update.Status.Status.State)
+			}
+		}
+		if want := []a2a.TaskState{a2a.TaskStateWorking, a2a.TaskStateCompleted}; !slices.Equal(states, want) {
+			t.Errorf("states = %v, want %v", states, want)
+		}
+	})
+}
+
+func TestClient_WithTransport(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager()))
+	t.Cleanup(srv.Close)
+
+	var dials atomic.Int32
+	transport := &http.Transport{
+		MaxIdleConnsPerHost: 4,
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			dials.Add(1)
+			return (&net.Dialer{}).DialContext(ctx, network, addr)
+		},
+	}
+	t.Cleanup(transport.CloseIdleConnections)
+	httpClient := &http.Client{}
+
+	// The transport applies whatever the order of the options.
+	c, err := client.NewClient(srv.URL, client.WithTransport(transport), client.WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	for range 3 {
+		_, err := c.GetTask(t.Context(), &a2a.GetTaskRequest{Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "missing"}}})
+		if !errors.Is(err, a2a.ErrTaskNotFound) {
+			t.Fatalf("GetTask() error = %v, want %v", err, a2a.ErrTaskNotFound)
+		}
+	}
+
+	// Connections are pooled by the transport.
+	if got := dials.Load(); got != 1 {
+		t.Errorf("dials = %d, want 1", got)
+	}
+	if httpClient.Transport != nil {
+		t.Error("WithTransport modified the HTTP client set with WithHTTPClient")
+	}
+}
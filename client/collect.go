@@ -0,0 +1,117 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-a2a/a2a"
+)
+
+// ErrStreamIncomplete is returned by [CollectTask] for a stream that ended before the task
+// reached a terminal state.
+var ErrStreamIncomplete = errors.New("stream ended before the task finished")
+
+// TaskFailedError is returned by [CollectTask] for a task that ended in the failed state.
+type TaskFailedError struct {
+	// Task is the failed task. Its status message, if any, tells why it failed.
+	Task *a2a.Task
+}
+
+// Error implements error.
+func (e *TaskFailedError) Error() string {
+	if msg := e.Task.Status.Message; msg != nil {
+		if text := msg.Text(); text != "" {
+			return fmt.Sprintf("task %s failed: %s", e.Task.ID, text)
+		}
+	}
+	return fmt.Sprintf("task %s failed", e.Task.ID)
+}
+
+// CollectTask applies the updates of a stream opened by [Client.SendSubscribe] or
+// [Client.Resubscribe] to a task, and returns the task once the stream is over, for callers
+// wanting the final task rather than its updates.
+//
+// Each status update sets the status of the task and its UpdatedAt, and adds its message to
+// the history of the task with [a2a.AppendUniqueMessage]. The chunks of streamed artifacts
+// are assembled as with [ArtifactAssembler]; artifacts still incomplete when the stream ends
+// are added as they are. The task is returned once the stream is closed in a
+// terminal state. Otherwise CollectTask returns the task as collected so far along with an
+// error: a [*TaskFailedError] if it failed, an [*InputRequiredError] if it waits for input,
+// the error the stream failed with, [ErrStreamIncomplete] if it ended early, or the error of
+// ctx once it is done. The stream is
+// drained in the background when CollectTask returns before it is closed.
+func CollectTask(ctx context.Context, updates <-chan TaskUpdateEvent) (*a2a.Task, error) {
+	var (
+		task      a2a.Task
+		assembler ArtifactAssembler
+		err       error
+	)
+	collected := func() *a2a.Task {
+		task.Artifacts = append(task.Artifacts, assembler.Flush()...)
+		a2a.SortArtifacts(task.Artifacts)
+		return &task
+	}
+
+loop:
+	for {
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+			break loop
+		case update, ok := <-updates:
+			if !ok {
+				break loop
+			}
+			switch {
+			case update.Err != nil:
+				if update.Skipped {
+					continue
+				}
+				err = update.Err
+				break loop
+			case update.Status != nil:
+				task.ID = update.Status.ID
+				task.Status = update.Status.Status
+				if msg := task.Status.Message; msg != nil {
+					task.History = a2a.AppendUniqueMessage(task.History, *msg)
+				}
+				task.UpdatedAt = update.Status.Status.Timestamp
+				if task.UpdatedAt.IsZero() {
+					task.UpdatedAt = time.Now().UTC()
+				}
+			case update.Artifact != nil:
+				task.ID = update.Artifact.ID
+				artifact, complete, aerr := assembler.Add(update.Artifact.Artifact)
+				if aerr != nil {
+					err = aerr
+					break loop
+				}
+				if complete {
+					task.Artifacts = append(task.Artifacts, artifact)
+				}
+			}
+		}
+	}
+
+	if err != nil {
+		go func() {
+			for range updates {
+			}
+		}()
+		return collected(), err
+	}
+	switch state := task.Status.State; {
+	case state == a2a.TaskStateFailed:
+		return collected(), &TaskFailedError{Task: &task}
+	case state == a2a.TaskStateInputRequired:
+		return collected(), &InputRequiredError{Task: &task}
+	case !state.IsTerminal():
+		return collected(), ErrStreamIncomplete
+	}
+	return collected(), nil
+}
@@ -0,0 +1,174 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+)
+
+func TestCollectTask(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	status := func(state a2a.TaskState) client.TaskUpdateEvent {
+		return client.TaskUpdateEvent{Status: &a2a.TaskStatusUpdateEvent{
+			ID:     "task-1",
+			Status: a2a.TaskStatus{State: state, Timestamp: at},
+			Final:  state.IsTerminal(),
+		}}
+	}
+	says := func(state a2a.TaskState, text string) client.TaskUpdateEvent {
+		update := status(state)
+		update.Status.Status.Message = &a2a.Message{
+			Role:  a2a.RoleAgent,
+			Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: text}},
+		}
+		return update
+	}
+	chunk := func(index int, text string, appendChunk, last bool) client.TaskUpdateEvent {
+		return client.TaskUpdateEvent{Artifact: &a2a.TaskArtifactUpdateEvent{
+			ID: "task-1",
+			Artifact: a2a.Artifact{
+				Index:     index,
+				Parts:     []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: text}},
+				Append:    appendChunk,
+				LastChunk: last,
+			},
+		}}
+	}
+	texts := func(task *a2a.Task) []string {
+		var got []string
+		for _, artifact := range task.Artifacts {
+			var text string
+			for _, part := range artifact.Parts {
+				text += part.(*a2a.TextPart).Text
+			}
+			got = append(got, text)
+		}
+		return got
+	}
+
+	tests := map[string]struct {
+		updates     []client.TaskUpdateEvent
+		cancel      bool
+		wantState   a2a.TaskState
+		wantTexts   []string
+		wantHistory []string
+		wantErr     func(error) bool
+	}{
+		"completed": {
+			updates: []client.TaskUpdateEvent{
+				status(a2a.TaskStateWorking),
+				chunk(1, "second", false, true),
+				chunk(0, "hello ", false, false),
+				{Err: client.ErrMalformedEvent, Skipped: true},
+				chunk(0, "world", true, true),
+				status(a2a.TaskStateCompleted),
+			},
+			wantState: a2a.TaskStateCompleted,
+			wantTexts: []string{"hello world", "second"},
+		},
+		"streamed messages": {
+			updates: []client.TaskUpdateEvent{
+				says(a2a.TaskStateWorking, "Look"),
+				says(a2a.TaskStateWorking, "Looking up"),
+				says(a2a.TaskStateWorking, "Looking up"),
+				says(a2a.TaskStateCompleted, "Found it"),
+			},
+			wantState:   a2a.TaskStateCompleted,
+			wantHistory: []string{"Looking up", "Found it"},
+		},
+		"incomplete artifact": {
+			updates: []client.TaskUpdateEvent{
+				chunk(0, "partial", false, false),
+				status(a2a.TaskStateCompleted),
+			},
+			wantState: a2a.TaskStateCompleted,
+			wantTexts: []string{"partial"},
+		},
+		"failed": {
+			updates:   []client.TaskUpdateEvent{status(a2a.TaskStateWorking), status(a2a.TaskStateFailed)},
+			wantState: a2a.TaskStateFailed,
+			wantErr: func(err error) bool {
+				var failed *client.TaskFailedError
+				return errors.As(err, &failed) && failed.Task.ID == "task-1"
+			},
+		},
+		"input required": {
+			updates:   []client.TaskUpdateEvent{status(a2a.TaskStateInputRequired)},
+			wantState: a2a.TaskStateInputRequired,
+			wantErr: func(err error) bool {
+				var inputRequired *client.InputRequiredError
+				return errors.As(err, &inputRequired)
+			},
+		},
+		"ended early": {
+			updates:   []client.TaskUpdateEvent{status(a2a.TaskStateWorking)},
+			wantState: a2a.TaskStateWorking,
+			wantErr:   func(err error) bool { return errors.Is(err, client.ErrStreamIncomplete) },
+		},
+		"stream error": {
+			updates:   []client.TaskUpdateEvent{status(a2a.TaskStateWorking), {Err: client.ErrStreamIdle}},
+			wantState: a2a.TaskStateWorking,
+			wantErr:   func(err error) bool { return errors.Is(err, client.ErrStreamIdle) },
+		},
+		"context done": {
+			updates:   []client.TaskUpdateEvent{status(a2a.TaskStateWorking)},
+			cancel:    true,
+			wantState: a2a.TaskStateWorking,
+			wantErr:   func(err error) bool { return errors.Is(err, context.Canceled) },
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(t.Context())
+			defer cancel()
+
+			updates := make(chan client.TaskUpdateEvent, len(tt.updates))
+			for _, update := range tt.updates {
+				updates <- update
+			}
+			if tt.cancel {
+				// The stream stays open: only the context ends the collection.
+				go func() {
+					time.Sleep(50 * time.Millisecond)
+					cancel()
+				}()
+			} else {
+				close(updates)
+			}
+
+			task, err := client.CollectTask(ctx, updates)
+			if tt.wantErr == nil && err != nil || tt.wantErr != nil && !tt.wantErr(err) {
+				t.Fatalf("CollectTask() error = %v", err)
+			}
+			if task.ID != "task-1" || task.Status.State != tt.wantState {
+				t.Errorf("task = %s in state %s, want task-1 in state %s", task.ID, task.Status.State, tt.wantState)
+			}
+			if !task.UpdatedAt.Equal(at) {
+				t.Errorf("UpdatedAt = %v, want %v", task.UpdatedAt, at)
+			}
+			if diff := gocmp.Diff(tt.wantTexts, texts(task)); diff != "" {
+				t.Errorf("artifacts mismatch (-want +got):\n%s", diff)
+			}
+			var history []string
+			for _, msg := range task.History {
+				history = append(history, msg.Text())
+			}
+			if diff := gocmp.Diff(tt.wantHistory, history); diff != "" {
+				t.Errorf("history mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// WithRequestCompression gzips the bodies of requests of at least minSize bytes, setting their
+// Content-Encoding header. Only enable it for servers able to decompress requests, such as
+// those of the server package.
+//
+// Responses are decompressed whatever the option: the [Client] always accepts gzip.
+func WithRequestCompression(minSize int) Option {
+	return func(c *Client) {
+		c.compressRequests = true
+		c.compressMinSize = max(minSize, 0)
+	}
+}
+
+// requestBody returns the body of a request carrying data, gzipped when request compression is
+// enabled and data is large enough, and sets the headers of req accordingly.
+func (c *Client) requestBody(h http.Header, data []byte) (io.Reader, error) {
+	h.Set("Accept-Encoding", "gzip")
+	if !c.compressRequests || len(data) < c.compressMinSize {
+		return bytes.NewReader(data), nil
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, fmt.Errorf("compress request body: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("compress request body: %w", err)
+	}
+	h.Set("Content-Encoding", "gzip")
+	return &buf, nil
+}
+
+// decompressResponse replaces the body of resp by its decompressed content if the server
+// gzipped it.
+//
+// Setting Accept-Encoding on requests turns off the decompression of the transport, so the
+// client decompresses responses itself.
+func decompressResponse(resp *http.Response) error {
+	if !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return nil
+	}
+	zr, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		resp.Body.Close()
+		return fmt.Errorf("decompress response body: %w", err)
+	}
+	resp.Body = &gzipBody{Reader: zr, body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
+
+// gzipBody is a response body decompressed by a gzip reader.
+type gzipBody struct {
+	*gzip.Reader
+
+	body io.ReadCloser
+}
+
+// Close implements [io.Closer], closing the compressed body.
+func (b *gzipBody) Close() error {
+	b.Reader.Close()
+	return b.body.Close()
+}
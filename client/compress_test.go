@@ -0,0 +1,88 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestWithRequestCompression(t *testing.T) {
+	t.Parallel()
+
+	text := strings.Repeat("a", 4096)
+
+	tests := map[string]struct {
+		opts            []client.Option
+		wantRequestGzip bool
+	}{
+		"compressed": {
+			opts:            []client.Option{client.WithRequestCompression(0)},
+			wantRequestGzip: true,
+		},
+		"below minimum size": {
+			opts: []client.Option{client.WithRequestCompression(1 << 20)},
+		},
+		"disabled": {},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mu               sync.Mutex
+				requestEncoding  string
+				responseEncoding string
+			)
+			record := func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					encoding := r.Header.Get("Content-Encoding")
+					next.ServeHTTP(w, r)
+
+					mu.Lock()
+					defer mu.Unlock()
+					requestEncoding = encoding
+					responseEncoding = w.Header().Get("Content-Encoding")
+				})
+			}
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager(),
+				server.WithCompression(),
+				server.WithHandlers(record),
+			))
+			t.Cleanup(srv.Close)
+
+			c, err := client.NewClient(srv.URL, tt.opts...)
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+			task, err := c.SendTask(t.Context(), a2a.SendTaskRequest{Params: a2a.TaskSendParams{
+				TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+				Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: text}}},
+			}})
+			if err != nil {
+				t.Fatalf("SendTask() error = %v", err)
+			}
+			if got := task.History[0].Parts[0].(*a2a.TextPart).Text; got != text {
+				t.Errorf("SendTask() history text has %d bytes, want %d", len(got), len(text))
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if got := requestEncoding == "gzip"; got != tt.wantRequestGzip {
+				t.Errorf("request Content-Encoding = %q, want gzip %t", requestEncoding, tt.wantRequestGzip)
+			}
+			if responseEncoding != "gzip" {
+				t.Errorf("response Content-Encoding = %q, want gzip", responseEncoding)
+			}
+		})
+	}
+}
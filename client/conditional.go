@@ -0,0 +1,127 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/go-a2a/a2a"
+)
+
+// maxLastSeenTasks is the number of tasks whose last version [Client.GetIfModified] keeps.
+const maxLastSeenTasks = 1024
+
+// lastSeenTasks holds the last version of each task the client fetched, keyed by task ID. It
+// keeps the most recently seen tasks, forgetting the oldest once there are too many, and holds
+// copies of them, as [MemoryResponseCache] does.
+type lastSeenTasks struct {
+	mu    sync.Mutex
+	tasks map[string]*a2a.Task
+	// order lists the tasks held, oldest first.
+	order []string
+}
+
+// get returns the last seen version of taskID, if any.
+func (l *lastSeenTasks) get(taskID string) (*a2a.Task, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	task, ok := l.tasks[taskID]
+	return cloneTask(task), ok
+}
+
+// set records task as the last seen version of its ID.
+func (l *lastSeenTasks) set(task *a2a.Task) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.tasks == nil {
+		l.tasks = make(map[string]*a2a.Task)
+	}
+	if _, ok := l.tasks[task.ID]; !ok {
+		if len(l.order) == maxLastSeenTasks {
+			delete(l.tasks, l.order[0])
+			l.order = l.order[1:]
+		}
+		l.order = append(l.order, task.ID)
+	}
+	l.tasks[task.ID] = cloneTask(task)
+}
+
+// delete forgets the last seen version of taskID.
+func (l *lastSeenTasks) delete(taskID string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, ok := l.tasks[taskID]; ok {
+		delete(l.tasks, taskID)
+		l.order = slices.DeleteFunc(l.order, func(id string) bool { return id == taskID })
+	}
+}
+
+// GetIfModified retrieves the task id from the A2A server unless it is unchanged since the
+// client last retrieved it with GetIfModified, which makes polling a task cheap.
+//
+// The request carries the UpdatedAt of the task last retrieved, and the server answers with a
+// [a2a.TaskNotModified] result, without the task, if it was not updated since. GetIfModified
+// then returns the task last retrieved and false; otherwise it returns the task as retrieved
+// and true. Servers that do not stamp UpdatedAt always return the whole task.
+//
+// The client keeps its own copy of the last version of the most recently polled tasks,
+// forgetting that of a task the server no longer knows.
+func (c *Client) GetIfModified(ctx context.Context, id string) (*a2a.Task, bool, error) {
+	ctx, span := c.tracer.Start(ctx, "client.GetIfModified")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("a2a.task_id", id))
+
+	params := a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: id}}
+	seen, ok := c.lastSeen.get(id)
+	if ok {
+		params.UpdatedSince = seen.UpdatedAt
+	}
+
+	data, err := c.sendRequest(ctx, a2a.MethodTasksGet, id, params)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	var resp streamResponse
+	if err := c.codec.Unmarshal(data, &resp); err != nil {
+		return nil, false, fmt.Errorf("failed to parse response: %w", err)
+	}
+	if err := handleRPCError(resp.Error); err != nil {
+		if errors.Is(err, a2a.ErrTaskNotFound) {
+			c.lastSeen.delete(id)
+		}
+		return nil, false, err
+	}
+
+	var probe a2a.TaskNotModified
+	if err := c.codec.Unmarshal(resp.Result, &probe); err != nil {
+		return nil, false, fmt.Errorf("failed to parse result: %w", err)
+	}
+	if probe.NotModified {
+		if !ok {
+			return nil, false, fmt.Errorf("task %s reported not modified without a version to compare", id)
+		}
+		span.SetAttributes(attribute.Bool("a2a.not_modified", true))
+		return seen, false, nil
+	}
+
+	var task a2a.Task
+	if err := c.codec.Unmarshal(resp.Result, &task); err != nil {
+		return nil, false, fmt.Errorf("failed to parse result: %w", err)
+	}
+	if !task.UpdatedAt.IsZero() {
+		c.lastSeen.set(&task)
+	}
+	return &task, true, nil
+}
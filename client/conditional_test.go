@@ -0,0 +1,85 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestClient_GetIfModified(t *testing.T) {
+	t.Parallel()
+
+	var notModified atomic.Int32
+	countNotModified := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rec := httptest.NewRecorder()
+			next.ServeHTTP(rec, r)
+			if strings.Contains(rec.Body.String(), `"notModified":true`) {
+				notModified.Add(1)
+			}
+			for k, v := range rec.Header() {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(rec.Code)
+			w.Write(rec.Body.Bytes())
+		})
+	}
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager(), server.WithHandlers(countNotModified)))
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClient(srv.URL)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if _, err := c.SendTask(t.Context(), a2a.SendTaskRequest{Params: a2a.TaskSendParams{
+		TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+		Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "hello"}}},
+	}}); err != nil {
+		t.Fatalf("SendTask() error = %v", err)
+	}
+
+	steps := []struct {
+		name         string
+		cancel       bool
+		wantModified bool
+		wantState    a2a.TaskState
+	}{
+		{name: "first", wantModified: true, wantState: a2a.TaskStateSubmitted},
+		{name: "unchanged", wantState: a2a.TaskStateSubmitted},
+		{name: "canceled", cancel: true, wantModified: true, wantState: a2a.TaskStateCanceled},
+		{name: "unchanged after cancel", wantState: a2a.TaskStateCanceled},
+	}
+	for _, step := range steps {
+		if step.cancel {
+			if _, err := c.CancelTask(t.Context(), &a2a.CancelTaskRequest{Params: a2a.TaskIDParams{ID: "task-1"}}); err != nil {
+				t.Fatalf("%s: CancelTask() error = %v", step.name, err)
+			}
+		}
+		task, modified, err := c.GetIfModified(t.Context(), "task-1")
+		if err != nil {
+			t.Fatalf("%s: GetIfModified() error = %v", step.name, err)
+		}
+		if modified != step.wantModified || task.Status.State != step.wantState {
+			t.Errorf("%s: GetIfModified() = %s, %t, want %s, %t", step.name, task.Status.State, modified, step.wantState, step.wantModified)
+		}
+		// Changing the task returned leaves the version kept by the client as is.
+		task.Status.State = a2a.TaskStateFailed
+	}
+	if got := notModified.Load(); got != 2 {
+		t.Errorf("server answered %d not modified results, want 2", got)
+	}
+
+	if _, _, err := c.GetIfModified(t.Context(), "missing"); err == nil {
+		t.Error("GetIfModified() of an unknown task error = nil, want error")
+	}
+}
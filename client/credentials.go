@@ -0,0 +1,97 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// TokenExpiryLeeway is how long before its expiry a [RefreshingTokenProvider] refreshes a
+// token, so that it does not expire while a request is in flight.
+const TokenExpiryLeeway = 10 * time.Second
+
+// CredentialProvider attaches credentials to the requests of a [Client].
+type CredentialProvider interface {
+	// Apply sets the headers authenticating req.
+	Apply(ctx context.Context, req *http.Request) error
+}
+
+// BearerToken returns a [CredentialProvider] sending token as a bearer token.
+func BearerToken(token string) CredentialProvider {
+	return bearerToken(token)
+}
+
+type bearerToken string
+
+// Apply implements [CredentialProvider].
+func (t bearerToken) Apply(ctx context.Context, req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+string(t))
+	return nil
+}
+
+// Token is an access token with its expiry.
+type Token struct {
+	// AccessToken is the token sent as a bearer token.
+	AccessToken string
+
+	// Expiry is when the token expires. A zero Expiry never expires.
+	Expiry time.Time
+}
+
+// valid reports whether t can still be used, leaving [TokenExpiryLeeway] before its expiry.
+func (t Token) valid() bool {
+	return t.AccessToken != "" && (t.Expiry.IsZero() || time.Now().Add(TokenExpiryLeeway).Before(t.Expiry))
+}
+
+// RefreshingTokenProvider is a [CredentialProvider] sending a bearer token that it obtains
+// from a token endpoint, such as an OAuth2 client credentials grant, and refreshes before
+// each request once it is about to expire.
+type RefreshingTokenProvider struct {
+	fetch func(ctx context.Context) (Token, error)
+
+	mu    sync.Mutex
+	token Token
+}
+
+var _ CredentialProvider = (*RefreshingTokenProvider)(nil)
+
+// NewRefreshingTokenProvider creates a new [RefreshingTokenProvider] getting tokens from fetch.
+func NewRefreshingTokenProvider(fetch func(ctx context.Context) (Token, error)) *RefreshingTokenProvider {
+	return &RefreshingTokenProvider{fetch: fetch}
+}
+
+// Apply implements [CredentialProvider].
+func (p *RefreshingTokenProvider) Apply(ctx context.Context, req *http.Request) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.token.valid() {
+		token, err := p.fetch(ctx)
+		if err != nil {
+			return fmt.Errorf("refresh token: %w", err)
+		}
+		if token.AccessToken == "" {
+			return errors.New("refresh token: empty access token")
+		}
+		p.token = token
+	}
+	req.Header.Set("Authorization", "Bearer "+p.token.AccessToken)
+	return nil
+}
+
+// applyCredentials attaches the credentials of the [CredentialProvider] of the client, if any, to req.
+func (c *Client) applyCredentials(ctx context.Context, req *http.Request) error {
+	if c.credentials == nil {
+		return nil
+	}
+	if err := c.credentials.Apply(ctx, req); err != nil {
+		return fmt.Errorf("apply credentials: %w", err)
+	}
+	return nil
+}
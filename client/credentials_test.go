@@ -0,0 +1,143 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+	"github.com/go-a2a/a2a/server"
+)
+
+// newAuthServer starts a server accepting any bearer token, and returns it with a function
+// reporting the tokens it received so far.
+func newAuthServer(t *testing.T) (*httptest.Server, func() []string) {
+	t.Helper()
+
+	var (
+		mu     sync.Mutex
+		tokens []string
+	)
+	auth := &server.BearerTokenAuthenticator{Verify: func(ctx context.Context, token string) (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		tokens = append(tokens, token)
+		return "caller", nil
+	}}
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	tm := &compressingTaskManager{InMemoryTaskManager: server.NewInMemoryTaskManager()}
+	srv := httptest.NewServer(server.NewServer("", "", card, tm, server.WithAuthenticator(auth)))
+	t.Cleanup(srv.Close)
+
+	return srv, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), tokens...)
+	}
+}
+
+func TestClient_WithCredentials(t *testing.T) {
+	t.Parallel()
+
+	getTask := func(ctx context.Context, c *client.Client) error {
+		_, err := c.GetTask(ctx, &a2a.GetTaskRequest{Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "missing"}}})
+		if !errors.Is(err, a2a.ErrTaskNotFound) {
+			return fmt.Errorf("GetTask() error = %v, want %v", err, a2a.ErrTaskNotFound)
+		}
+		return nil
+	}
+	subscribe := func(ctx context.Context, c *client.Client) error {
+		updates, err := c.SendSubscribe(ctx, &a2a.SendTaskStreamingRequest{Params: a2a.TaskSendParams{
+			TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+			Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "hi"}}},
+		}})
+		if err != nil {
+			return fmt.Errorf("SendSubscribe() error = %v", err)
+		}
+		for range updates {
+		}
+		return nil
+	}
+
+	t.Run("static", func(t *testing.T) {
+		t.Parallel()
+
+		srv, tokens := newAuthServer(t)
+		c, err := client.NewClient(srv.URL, client.WithCredentials(client.BearerToken("secret")))
+		if err != nil {
+			t.Fatalf("NewClient() error = %v", err)
+		}
+		if err := getTask(t.Context(), c); err != nil {
+			t.Fatal(err)
+		}
+		if diff := gocmp.Diff([]string{"secret"}, tokens()); diff != "" {
+			t.Errorf("tokens mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("refreshed after expiry", func(t *testing.T) {
+		t.Parallel()
+
+		srv, tokens := newAuthServer(t)
+		var fetches int
+		provider := client.NewRefreshingTokenProvider(func(ctx context.Context) (client.Token, error) {
+			fetches++
+			return client.Token{
+				AccessToken: fmt.Sprintf("token-%d", fetches),
+				Expiry:      time.Now().Add(client.TokenExpiryLeeway + 300*time.Millisecond),
+			}, nil
+		})
+		c, err := client.NewClient(srv.URL, client.WithCredentials(provider))
+		if err != nil {
+			t.Fatalf("NewClient() error = %v", err)
+		}
+
+		ctx := t.Context()
+		if err := getTask(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+		if err := getTask(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(400 * time.Millisecond)
+		if err := subscribe(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+		if err := getTask(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := gocmp.Diff([]string{"token-1", "token-1", "token-2", "token-2"}, tokens()); diff != "" {
+			t.Errorf("tokens mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("refresh failure", func(t *testing.T) {
+		t.Parallel()
+
+		srv, tokens := newAuthServer(t)
+		provider := client.NewRefreshingTokenProvider(func(ctx context.Context) (client.Token, error) {
+			return client.Token{}, errors.New("token endpoint down")
+		})
+		c, err := client.NewClient(srv.URL, client.WithCredentials(provider))
+		if err != nil {
+			t.Fatalf("NewClient() error = %v", err)
+		}
+		if _, err := c.GetTask(t.Context(), &a2a.GetTaskRequest{Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "missing"}}}); err == nil {
+			t.Error("GetTask() error = nil, want the refresh error")
+		}
+		if got := tokens(); len(got) != 0 {
+			t.Errorf("server received tokens %v, want none", got)
+		}
+	})
+}
@@ -0,0 +1,47 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/url"
+
+	"github.com/go-a2a/a2a"
+)
+
+// BaseURI returns the URI the [Client] resolves relative file URIs against: the one set with
+// [WithBaseURI], or else the URL of the agent. It returns nil if neither is a valid URI.
+func (c *Client) BaseURI() *url.URL {
+	if c.baseURI != nil {
+		base := *c.baseURI
+		return &base
+	}
+	base, err := url.Parse(c.url)
+	if err != nil {
+		return nil
+	}
+	return base
+}
+
+// fileContext returns a copy of ctx carrying the base URI of the client, if any.
+func (c *Client) fileContext(ctx context.Context) context.Context {
+	if base := c.BaseURI(); base != nil {
+		return a2a.ContextWithBaseURI(ctx, base)
+	}
+	return ctx
+}
+
+// OpenFile returns a reader over the content of the file part p, as [a2a.FilePartReader]
+// does, resolving a relative file URI against [Client.BaseURI]. The caller must close the
+// reader.
+func (c *Client) OpenFile(ctx context.Context, p a2a.Part) (io.ReadCloser, error) {
+	return a2a.FilePartReader(c.fileContext(ctx), p)
+}
+
+// WriteFiles writes the content of the file parts of the artifact to files in dir, as
+// [a2a.Artifact.WriteFiles] does, resolving relative file URIs against [Client.BaseURI].
+func (c *Client) WriteFiles(ctx context.Context, artifact a2a.Artifact, dir string) ([]string, error) {
+	return artifact.WriteFiles(c.fileContext(ctx), dir)
+}
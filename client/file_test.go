@@ -0,0 +1,90 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+)
+
+func TestClient_OpenFile(t *testing.T) {
+	t.Parallel()
+
+	const content = "quarterly report"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/a2a/artifacts/report.txt", "/files/report.txt":
+			io.WriteString(w, content)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	files, err := url.Parse(srv.URL + "/files/")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	tests := map[string]struct {
+		opts []client.Option
+		uri  string
+	}{
+		"relative to the agent": {
+			uri: "artifacts/report.txt",
+		},
+		"relative to the base URI": {
+			opts: []client.Option{client.WithBaseURI(files)},
+			uri:  "report.txt",
+		},
+		"absolute": {
+			opts: []client.Option{client.WithBaseURI(files)},
+			uri:  srv.URL + "/a2a/artifacts/report.txt",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := client.NewClient(srv.URL+"/a2a/", tt.opts...)
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+			part := &a2a.FilePart{Type: a2a.PartTypeFile, File: a2a.FileContent{Name: "report.txt", URI: tt.uri}}
+
+			rc, err := c.OpenFile(t.Context(), part)
+			if err != nil {
+				t.Fatalf("OpenFile() error = %v", err)
+			}
+			defer rc.Close()
+			got, err := io.ReadAll(rc)
+			if err != nil {
+				t.Fatalf("ReadAll() error = %v", err)
+			}
+			if string(got) != content {
+				t.Errorf("OpenFile() content = %q, want %q", got, content)
+			}
+
+			paths, err := c.WriteFiles(t.Context(), a2a.Artifact{Parts: []a2a.Part{part}}, t.TempDir())
+			if err != nil {
+				t.Fatalf("WriteFiles() error = %v", err)
+			}
+			written, err := os.ReadFile(paths[0])
+			if err != nil {
+				t.Fatalf("ReadFile() error = %v", err)
+			}
+			if string(written) != content {
+				t.Errorf("written content = %q, want %q", written, content)
+			}
+		})
+	}
+}
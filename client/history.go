@@ -0,0 +1,52 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/go-a2a/a2a"
+)
+
+// GetTaskHistory reads one page of a task's history from an A2A server, oldest message first.
+//
+// Pass an empty cursor for the first page, then the returned nextCursor for each
+// following page; nextCursor is empty once the history is exhausted. A non-positive
+// limit lets the server pick the page size.
+func (c *Client) GetTaskHistory(ctx context.Context, id, cursor string, limit int) (messages []a2a.Message, nextCursor string, err error) {
+	ctx, span := c.tracer.Start(ctx, "client.GetTaskHistory")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("a2a.task_id", id),
+		attribute.Int("a2a.limit", limit),
+	)
+
+	params := a2a.TaskHistoryParams{
+		TaskIDParams: a2a.TaskIDParams{ID: id},
+		Cursor:       cursor,
+		Limit:        max(limit, 0),
+	}
+	data, err := c.sendRequest(ctx, a2a.MethodTasksHistoryGet, id, params)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get task history: %w", err)
+	}
+
+	var resp a2a.GetTaskHistoryResponse
+	if err := c.codec.Unmarshal(data, &resp); err != nil {
+		return nil, "", fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if err := handleRPCError(resp.Error); err != nil {
+		return nil, "", err
+	}
+	if resp.Result == nil {
+		return nil, "", nil
+	}
+
+	return resp.Result.Messages, resp.Result.NextCursor, nil
+}
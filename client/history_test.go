@@ -0,0 +1,96 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestClient_GetTaskHistory(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	tm := server.NewInMemoryTaskManager().WithOmitHistory(true)
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, tm))
+	t.Cleanup(srv.Close)
+
+	var want []string
+	for i := range 5 {
+		text := fmt.Sprintf("message %d", i)
+		want = append(want, text)
+		_, err := tm.OnSendTask(ctx, &a2a.SendTaskRequest{
+			Params: a2a.TaskSendParams{
+				TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+				Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: text}}},
+			},
+		})
+		if err != nil {
+			t.Fatalf("OnSendTask() error = %v", err)
+		}
+	}
+
+	c, err := client.NewClient(srv.URL)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	var (
+		got    []string
+		pages  int
+		cursor string
+	)
+	for {
+		messages, next, err := c.GetTaskHistory(ctx, "task-1", cursor, 2)
+		if err != nil {
+			t.Fatalf("GetTaskHistory() error = %v", err)
+		}
+		pages++
+		for _, msg := range messages {
+			got = append(got, msg.Parts[0].(*a2a.TextPart).Text)
+		}
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+	if diff := gocmp.Diff(want, got); diff != "" {
+		t.Errorf("history mismatch (-want +got):\n%s", diff)
+	}
+	if pages != 3 {
+		t.Errorf("got %d pages, want 3", pages)
+	}
+
+	task, err := c.GetTask(ctx, &a2a.GetTaskRequest{Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}}})
+	if err != nil {
+		t.Fatalf("GetTask() error = %v", err)
+	}
+	if len(task.History) != 0 {
+		t.Errorf("GetTask() history length = %d, want omitted", len(task.History))
+	}
+
+	historyLength := 2
+	task, err = c.GetTask(ctx, &a2a.GetTaskRequest{Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}, HistoryLength: &historyLength}})
+	if err != nil {
+		t.Fatalf("GetTask() error = %v", err)
+	}
+	if len(task.History) != 2 {
+		t.Errorf("GetTask() history length = %d, want 2", len(task.History))
+	}
+
+	if _, _, err := c.GetTaskHistory(ctx, "task-1", "not a cursor", 2); err == nil {
+		t.Error("GetTaskHistory() with a bad cursor error = nil, want error")
+	}
+	if _, _, err := c.GetTaskHistory(ctx, "missing", "", 2); err == nil {
+		t.Error("GetTaskHistory() for a missing task error = nil, want error")
+	}
+}
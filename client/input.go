@@ -0,0 +1,191 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/go-a2a/a2a"
+)
+
+// AppendTaskInput appends a chunk of input to the current turn of a task on an A2A server.
+func (c *Client) AppendTaskInput(ctx context.Context, req *a2a.AppendTaskInputRequest) (*a2a.Task, error) {
+	ctx, span := c.tracer.Start(ctx, "client.AppendTaskInput")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("a2a.task_id", req.Params.ID),
+		attribute.Bool("a2a.final", req.Params.Final),
+	)
+
+	c.invalidateTask(req.Params.ID)
+
+	data, err := c.sendRequest(ctx, a2a.MethodTasksInputAppend, req.Params.ID, req.Params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to append task input: %w", err)
+	}
+
+	var resp a2a.AppendTaskInputResponse
+	if err := c.codec.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if err := handleRPCError(resp.Error); err != nil {
+		return nil, err
+	}
+
+	return resp.Result, nil
+}
+
+// ErrNotInputRequired is returned by [Client.Continue] for a task that is not waiting for input.
+var ErrNotInputRequired = errors.New("task is not waiting for input")
+
+// Continue answers a task in the input-required state with msg, continuing it as the next
+// turn of the same task, and returns the task as the server reports it.
+//
+// Continue fetches the task first, so the message carries the task's session, and returns
+// an error wrapping [ErrNotInputRequired] without sending anything if the task is in
+// another state. A msg without a role is sent as the user's.
+func (c *Client) Continue(ctx context.Context, taskID string, msg a2a.Message) (*a2a.Task, error) {
+	ctx, span := c.tracer.Start(ctx, "client.Continue")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("a2a.task_id", taskID))
+
+	// The state check must observe the server, not a cached task.
+	c.invalidateTask(taskID)
+
+	task, err := c.GetTask(ctx, &a2a.GetTaskRequest{
+		Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: taskID}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("continue task: %w", err)
+	}
+	if task.Status.State != a2a.TaskStateInputRequired {
+		return nil, fmt.Errorf("%w: task %s is %s", ErrNotInputRequired, taskID, task.Status.State)
+	}
+
+	params := a2a.TaskSendParams{
+		TaskIDParams: a2a.TaskIDParams{ID: taskID},
+		Message:      msg,
+	}
+	if task.SessionID != "" {
+		params.SessionID, err = uuid.Parse(task.SessionID)
+		if err != nil {
+			return nil, fmt.Errorf("continue task: invalid session ID %q: %w", task.SessionID, err)
+		}
+	}
+	if params.Message.Role == "" {
+		params.Message.Role = a2a.RoleUser
+	}
+
+	return c.SendTask(ctx, a2a.SendTaskRequest{Params: params})
+}
+
+// InputStream streams the parts of a single input turn to a task incrementally.
+//
+// It is intended for latency-sensitive agents (e.g. voice) that can start working
+// before the whole turn has been sent. Each call to [InputStream.Send] is delivered
+// as its own tasks/input/append request over the client's pooled connection, and the
+// server hands it to the agent working on the task as it arrives, if the agent reads its input. The replies of the
+// agent are received on [InputStream.Updates] for a stream opened by [Client.SubscribeInput].
+type InputStream struct {
+	client    *Client
+	taskID    string
+	sessionID uuid.UUID
+
+	// updates carries the replies of the agent, or is nil.
+	updates <-chan TaskUpdateEvent
+
+	mu     sync.Mutex
+	closed bool
+}
+
+// StreamInput starts streaming an input turn to the task identified by taskID.
+//
+// The input is recorded in the task, and delivered to the agent if it is working on the task
+// and reading its input, such as for a tasks/sendSubscribe call made by another client. Use [Client.SubscribeInput]
+// to receive the replies of the agent too.
+func (c *Client) StreamInput(taskID string, sessionID uuid.UUID) *InputStream {
+	return &InputStream{
+		client:    c,
+		taskID:    taskID,
+		sessionID: sessionID,
+	}
+}
+
+// SubscribeInput starts the task of req with [Client.SendSubscribe], its message opening the
+// input, and returns an [InputStream] streaming the rest of the turn into the task while the
+// agent works on it. The agent receives each chunk as it is sent, and its replies, the task
+// updates it streams, are received on [InputStream.Updates] until the task is done.
+func (c *Client) SubscribeInput(ctx context.Context, req *a2a.SendTaskStreamingRequest) (*InputStream, error) {
+	ctx, span := c.tracer.Start(ctx, "client.SubscribeInput")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("a2a.task_id", req.Params.ID))
+
+	updates, err := c.SendSubscribe(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("subscribe input: %w", err)
+	}
+	return &InputStream{
+		client:    c,
+		taskID:    req.Params.ID,
+		sessionID: req.Params.SessionID,
+		updates:   updates,
+	}, nil
+}
+
+// Updates returns the channel receiving the replies of the agent to the input, closed once the
+// task is done or the stream failed, or nil for a stream started with [Client.StreamInput].
+func (s *InputStream) Updates() <-chan TaskUpdateEvent {
+	return s.updates
+}
+
+// Send appends parts to the current turn.
+func (s *InputStream) Send(ctx context.Context, parts ...a2a.Part) error {
+	_, err := s.send(ctx, parts, false)
+	return err
+}
+
+// Close sends any final parts, marks the turn complete, and returns the resulting task.
+func (s *InputStream) Close(ctx context.Context, parts ...a2a.Part) (*a2a.Task, error) {
+	return s.send(ctx, parts, true)
+}
+
+func (s *InputStream) send(ctx context.Context, parts []a2a.Part, final bool) (*a2a.Task, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil, errors.New("input stream is closed")
+	}
+
+	req := a2a.NewAppendTaskInputRequest(a2a.NewID(s.taskID), a2a.TaskInputParams{
+		TaskIDParams: a2a.TaskIDParams{
+			ID: s.taskID,
+		},
+		SessionID: s.sessionID,
+		Message: a2a.Message{
+			Role:  a2a.RoleUser,
+			Parts: parts,
+		},
+		Final: final,
+	})
+	task, err := s.client.AppendTaskInput(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if final {
+		s.closed = true
+	}
+
+	return task, nil
+}
@@ -0,0 +1,201 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	gocmp "github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+	"github.com/go-a2a/a2a/server"
+)
+
+// recordingTaskManager serves tasks in a fixed state and records the tasks sent to it.
+type recordingTaskManager struct {
+	*server.InMemoryTaskManager
+	state     a2a.TaskState
+	sessionID string
+
+	mu   sync.Mutex
+	sent []a2a.TaskSendParams
+}
+
+func (tm *recordingTaskManager) OnGetTask(ctx context.Context, req *a2a.GetTaskRequest) (*a2a.GetTaskResponse, error) {
+	return &a2a.GetTaskResponse{
+		JSONRPCResponse: a2a.JSONRPCResponse{JSONRPCMessage: a2a.NewJSONRPCMessage(req.ID)},
+		Result:          &a2a.Task{ID: req.Params.ID, SessionID: tm.sessionID, Status: a2a.TaskStatus{State: tm.state}},
+	}, nil
+}
+
+func (tm *recordingTaskManager) OnSendTask(ctx context.Context, req *a2a.SendTaskRequest) (*a2a.SendTaskResponse, error) {
+	tm.mu.Lock()
+	tm.sent = append(tm.sent, req.Params)
+	tm.mu.Unlock()
+
+	return &a2a.SendTaskResponse{
+		JSONRPCResponse: a2a.JSONRPCResponse{JSONRPCMessage: a2a.NewJSONRPCMessage(req.ID)},
+		Result:          &a2a.Task{ID: req.Params.ID, SessionID: req.Params.SessionID.String(), Status: a2a.TaskStatus{State: a2a.TaskStateWorking}},
+	}, nil
+}
+
+func TestClient_Continue(t *testing.T) {
+	t.Parallel()
+
+	sessionID := uuid.MustParse("6f1c3a52-8d8e-4b8e-9c59-1f0f4f6f2a10")
+	reply := a2a.Message{Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "Paris"}}}
+
+	tests := map[string]struct {
+		state    a2a.TaskState
+		wantSent []a2a.TaskSendParams
+		wantErr  error
+	}{
+		"input required": {
+			state: a2a.TaskStateInputRequired,
+			wantSent: []a2a.TaskSendParams{{
+				TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+				SessionID:    sessionID,
+				Message:      a2a.Message{Role: a2a.RoleUser, Parts: reply.Parts},
+			}},
+		},
+		"working": {
+			state:   a2a.TaskStateWorking,
+			wantErr: client.ErrNotInputRequired,
+		},
+		"completed": {
+			state:   a2a.TaskStateCompleted,
+			wantErr: client.ErrNotInputRequired,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			tm := &recordingTaskManager{InMemoryTaskManager: server.NewInMemoryTaskManager(), state: tt.state, sessionID: sessionID.String()}
+			srv := httptest.NewServer(server.NewServer("", "", card, tm))
+			t.Cleanup(srv.Close)
+
+			c, err := client.NewClient(srv.URL)
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+
+			task, err := c.Continue(t.Context(), "task-1", reply)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Continue() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && task.SessionID != sessionID.String() {
+				t.Errorf("Continue() session ID = %q, want %q", task.SessionID, sessionID)
+			}
+			if diff := gocmp.Diff(tt.wantSent, tm.sent); diff != "" {
+				t.Errorf("sent tasks mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// listeningTaskManager replies to each chunk of input appended to its tasks while it works on
+// them, and completes them with the final chunk.
+type listeningTaskManager struct {
+	*server.InMemoryTaskManager
+}
+
+func (tm *listeningTaskManager) OnSendTaskStream(ctx context.Context, req *a2a.SendTaskStreamingRequest, w server.StreamWriter) error {
+	input, ok := server.InputFrom(ctx)
+	if !ok {
+		return errors.New("no input channel")
+	}
+	reply := func(state a2a.TaskState, text string) error {
+		return w.SendStatus(a2a.TaskStatus{
+			State:   state,
+			Message: &a2a.Message{Role: a2a.RoleAgent, Parts: []a2a.Part{a2a.NewTextPart(text)}},
+		})
+	}
+	if err := reply(a2a.TaskStateWorking, "heard "+req.Params.Message.Text()); err != nil {
+		return err
+	}
+	for {
+		select {
+		case chunk := <-input:
+			state := a2a.TaskStateWorking
+			if chunk.Final {
+				state = a2a.TaskStateCompleted
+			}
+			if err := reply(state, "heard "+chunk.Message.Text()); err != nil || chunk.Final {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func TestClient_SubscribeInput(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	tm := &listeningTaskManager{InMemoryTaskManager: server.NewInMemoryTaskManager()}
+	srv := httptest.NewServer(server.NewServer("", "", card, tm))
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClient(srv.URL)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+
+	in, err := c.SubscribeInput(ctx, a2a.NewSendTaskStreamingRequest(a2a.NewID("task-1"), a2a.TaskSendParams{
+		TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+		Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{a2a.NewTextPart("one")}},
+	}))
+	if err != nil {
+		t.Fatalf("SubscribeInput() error = %v", err)
+	}
+	nextReply := func() string {
+		t.Helper()
+		update, ok := <-in.Updates()
+		if !ok {
+			t.Fatal("updates closed early")
+		}
+		if update.Err != nil || update.Status == nil || update.Status.Status.Message == nil {
+			t.Fatalf("update = %+v, want a status update with a message", update)
+		}
+		return update.Status.Status.Message.Text()
+	}
+
+	// Each chunk is answered before the next one is sent.
+	var got []string
+	got = append(got, nextReply())
+	if err := in.Send(ctx, a2a.NewTextPart("two")); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	got = append(got, nextReply())
+	task, err := in.Close(ctx, a2a.NewTextPart("three"))
+	if err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	got = append(got, nextReply())
+	if diff := gocmp.Diff([]string{"heard one", "heard two", "heard three"}, got); diff != "" {
+		t.Errorf("replies mismatch (-want +got):\n%s", diff)
+	}
+	if _, ok := <-in.Updates(); ok {
+		t.Error("updates still open after the final reply")
+	}
+	if task == nil || len(task.History) == 0 {
+		t.Errorf("Close() task = %+v, want the task with the streamed input", task)
+	}
+
+	if err := in.Send(ctx, a2a.NewTextPart("four")); err == nil {
+		t.Error("Send() after Close() error = nil, want an error")
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/go-a2a/a2a"
+)
+
+// GetTaskJournal reads the journal of a task from an A2A server recording one, oldest entry
+// first: the messages the task received, the statuses it moved to, the artifacts it emitted
+// and the errors its calls failed with.
+func (c *Client) GetTaskJournal(ctx context.Context, id string) ([]a2a.JournalEntry, error) {
+	ctx, span := c.tracer.Start(ctx, "client.GetTaskJournal")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("a2a.task_id", id))
+
+	data, err := c.sendRequest(ctx, a2a.MethodTasksJournalGet, id, a2a.TaskIDParams{ID: id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task journal: %w", err)
+	}
+
+	var resp a2a.GetTaskJournalResponse
+	if err := c.codec.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if err := handleRPCError(resp.Error); err != nil {
+		return nil, err
+	}
+	if resp.Result == nil {
+		return nil, nil
+	}
+
+	return resp.Result.Entries, nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestClient_GetTaskJournal(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		opts      []server.Option
+		wantKinds []a2a.JournalEntryKind
+		wantCode  int
+	}{
+		"journaled": {
+			opts:      []server.Option{server.WithEventJournal(server.NewInMemoryEventJournal(0, 0))},
+			wantKinds: []a2a.JournalEntryKind{a2a.JournalMessage, a2a.JournalStatus},
+		},
+		"not journaled": {
+			wantCode: a2a.UnsupportedOperationErrorCode,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager(), tt.opts...))
+			t.Cleanup(srv.Close)
+
+			c, err := client.NewClient(srv.URL)
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+			_, err = c.SendTask(ctx, a2a.SendTaskRequest{Params: a2a.TaskSendParams{
+				TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+				Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "hi"}}},
+			}})
+			if err != nil {
+				t.Fatalf("SendTask() error = %v", err)
+			}
+
+			entries, err := c.GetTaskJournal(ctx, "task-1")
+			if tt.wantCode != 0 {
+				var jerr *a2a.JSONRPCError
+				if !errors.As(err, &jerr) || jerr.Code != tt.wantCode {
+					t.Fatalf("GetTaskJournal() error = %v, want code %d", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetTaskJournal() error = %v", err)
+			}
+
+			var kinds []a2a.JournalEntryKind
+			for _, entry := range entries {
+				kinds = append(kinds, entry.Kind)
+			}
+			if diff := gocmp.Diff(tt.wantKinds, kinds); diff != "" {
+				t.Errorf("journal kinds mismatch (-want +got):\n%s", diff)
+			}
+			if msg := entries[0].Message; msg == nil || msg.Parts[0].(*a2a.TextPart).Text != "hi" {
+				t.Errorf("message entry = %+v, want the message sent", entries[0])
+			}
+		})
+	}
+}
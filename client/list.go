@@ -0,0 +1,52 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/go-a2a/a2a"
+)
+
+// ListTasks lists one page of the tasks of an A2A server, oldest task first, optionally
+// selecting the tasks of a session, in a state, or carrying every label of params.Labels.
+//
+// Pass an empty cursor for the first page, then the returned nextCursor for each following
+// page; nextCursor is empty once the tasks are exhausted. A non-positive limit lets the server
+// pick the page size. The tasks leave out the inline content of file parts unless
+// params.IncludeFileBytes is set.
+func (c *Client) ListTasks(ctx context.Context, params a2a.TaskListParams) (tasks []a2a.Task, nextCursor string, err error) {
+	ctx, span := c.tracer.Start(ctx, "client.ListTasks")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("a2a.session_id", params.SessionID),
+		attribute.String("a2a.state", string(params.State)),
+		attribute.Int("a2a.labels", len(params.Labels)),
+		attribute.Int("a2a.limit", params.Limit),
+	)
+
+	params.Limit = max(params.Limit, 0)
+	data, err := c.sendRequest(ctx, a2a.MethodTasksList, "", params)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	var resp a2a.ListTasksResponse
+	if err := c.codec.Unmarshal(data, &resp); err != nil {
+		return nil, "", fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if err := handleRPCError(resp.Error); err != nil {
+		return nil, "", err
+	}
+	if resp.Result == nil {
+		return nil, "", nil
+	}
+
+	return resp.Result.Tasks, resp.Result.NextCursor, nil
+}
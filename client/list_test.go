@@ -0,0 +1,144 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestClient_ListTasks(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	tm := server.NewInMemoryTaskManager()
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, tm))
+	t.Cleanup(srv.Close)
+
+	var want []string
+	for i := range 5 {
+		state := a2a.TaskStateWorking
+		if i%2 == 0 {
+			state = a2a.TaskStateCompleted
+			want = append(want, "task-"+strconv.Itoa(i))
+		}
+		task := &a2a.Task{ID: "task-" + strconv.Itoa(i), Status: a2a.TaskStatus{State: state}}
+		if _, err := tm.TaskStore().Create(ctx, task); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	c, err := client.NewClient(srv.URL)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	var got []string
+	params := a2a.TaskListParams{State: a2a.TaskStateCompleted, Limit: 2}
+	for {
+		tasks, next, err := c.ListTasks(ctx, params)
+		if err != nil {
+			t.Fatalf("ListTasks() error = %v", err)
+		}
+		for _, task := range tasks {
+			got = append(got, task.ID)
+		}
+		if next == "" {
+			break
+		}
+		params.Cursor = next
+	}
+	if diff := gocmp.Diff(want, got); diff != "" {
+		t.Errorf("tasks mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, _, err := c.ListTasks(ctx, a2a.TaskListParams{Cursor: "bogus"}); !errors.Is(err, a2a.ErrInvalidParams) {
+		t.Errorf("ListTasks() with an invalid cursor error = %v, want %v", err, a2a.ErrInvalidParams)
+	}
+}
+
+func TestClient_ListTasksByLabels(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager()))
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClient(srv.URL)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	// The labels sent with each task are kept on it.
+	labels := []map[string]string{
+		{"team": "search", "env": "prod"},
+		{"team": "search", "env": "dev"},
+		{"team": "billing", "env": "prod"},
+		nil,
+	}
+	for i, l := range labels {
+		id := "task-" + strconv.Itoa(i)
+		if _, err := c.SendTask(ctx, *a2a.NewSendTaskRequest(a2a.NewID(id), a2a.TaskSendParams{
+			TaskIDParams: a2a.TaskIDParams{ID: id},
+			Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{a2a.NewTextPart("hi")}},
+			Labels:       l,
+		})); err != nil {
+			t.Fatalf("SendTask(%s) error = %v", id, err)
+		}
+	}
+
+	tests := map[string]struct {
+		labels map[string]string
+		want   []string
+	}{
+		"one label": {
+			labels: map[string]string{"team": "search"},
+			want:   []string{"task-0", "task-1"},
+		},
+		"every label": {
+			labels: map[string]string{"team": "search", "env": "prod"},
+			want:   []string{"task-0"},
+		},
+		"no match": {
+			labels: map[string]string{"team": "ops"},
+		},
+		"no labels": {
+			want: []string{"task-0", "task-1", "task-2", "task-3"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tasks, _, err := c.ListTasks(t.Context(), a2a.TaskListParams{Labels: tt.labels})
+			if err != nil {
+				t.Fatalf("ListTasks() error = %v", err)
+			}
+			var got []string
+			for _, task := range tasks {
+				got = append(got, task.ID)
+				if !task.MatchLabels(tt.labels) {
+					t.Errorf("task %s labels = %v, want %v", task.ID, task.Labels, tt.labels)
+				}
+			}
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("tasks mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if _, _, err := c.ListTasks(ctx, a2a.TaskListParams{Labels: map[string]string{"": "x"}}); !errors.Is(err, a2a.ErrInvalidParams) {
+		t.Errorf("ListTasks() with an empty label key error = %v, want %v", err, a2a.ErrInvalidParams)
+	}
+}
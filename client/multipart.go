@@ -0,0 +1,129 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-a2a/a2a"
+)
+
+// quoteEscaper escapes the quoted strings of a Content-Disposition header, like [mime/multipart] does.
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// upload is a file part sent as a separate form file of a multipart request.
+type upload struct {
+	contentID string
+	file      a2a.FileContent
+}
+
+// splitUploads returns a copy of params whose file parts larger than threshold bytes
+// reference their content by a cid: URI, along with the uploads carrying that content.
+//
+// It returns no uploads if no part exceeds the threshold.
+func splitUploads(params a2a.TaskSendParams, threshold int) (a2a.TaskSendParams, []upload) {
+	var uploads []upload
+	for i, part := range params.Message.Parts {
+		fp, ok := part.(*a2a.FilePart)
+		if !ok || fp == nil || base64.StdEncoding.DecodedLen(len(fp.File.Bytes)) <= threshold {
+			continue
+		}
+		if uploads == nil {
+			params.Message.Parts = slices.Clone(params.Message.Parts)
+		}
+
+		contentID := "part-" + strconv.Itoa(i)
+		uploads = append(uploads, upload{contentID: contentID, file: fp.File})
+
+		ref := *fp
+		ref.File.Bytes = ""
+		ref.File.URI = a2a.ContentIDScheme + contentID
+		params.Message.Parts[i] = &ref
+	}
+	return params, uploads
+}
+
+// newMultipartRequest builds a multipart/form-data request carrying the JSON-RPC request in
+// the [a2a.MultipartRequestField] field, followed by one form file per upload.
+//
+// The body is streamed: each file is decoded from base64 as it is written, so its content
+// is never held in memory twice.
+func (c *Client) newMultipartRequest(ctx context.Context, method, id string, params a2a.TaskSendParams, uploads []upload) (*http.Request, error) {
+	data, err := c.codec.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("marshal params: %w", err)
+	}
+	request, err := c.codec.Marshal(&a2a.JSONRPCRequest{
+		JSONRPCMessage: a2a.NewJSONRPCMessage(a2a.NewID(id)),
+		Method:         method,
+		Params:         data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		pw.CloseWithError(writeMultipart(mw, request, uploads))
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, pr)
+	if err != nil {
+		pr.Close()
+		return nil, fmt.Errorf("create HTTP request: %w", err)
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	req.Header.Set("Accept-Encoding", "gzip")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	c.injectTraceContext(ctx, req)
+	if err := c.applyCredentials(ctx, req); err != nil {
+		pr.Close()
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// writeMultipart writes the request field and the uploads to mw, then closes it.
+func writeMultipart(mw *multipart.Writer, request []byte, uploads []upload) error {
+	fw, err := mw.CreateFormField(a2a.MultipartRequestField)
+	if err != nil {
+		return err
+	}
+	if _, err := fw.Write(request); err != nil {
+		return err
+	}
+
+	for _, u := range uploads {
+		h := make(textproto.MIMEHeader)
+		h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+			quoteEscaper.Replace(u.contentID), quoteEscaper.Replace(u.file.Name)))
+		contentType := u.file.MIMEType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		h.Set("Content-Type", contentType)
+
+		fw, err := mw.CreatePart(h)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(fw, base64.NewDecoder(base64.StdEncoding, strings.NewReader(u.file.Bytes))); err != nil {
+			return fmt.Errorf("write file %s: %w", u.contentID, err)
+		}
+	}
+
+	return mw.Close()
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestClient_WithMultipartUpload(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu         sync.Mutex
+		mediaTypes []string
+	)
+	recordMediaType := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+			mu.Lock()
+			mediaTypes = append(mediaTypes, mediaType)
+			mu.Unlock()
+			next.ServeHTTP(w, r)
+		})
+	}
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager(), server.WithHandlers(recordMediaType)))
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClient(srv.URL, client.WithMultipartUpload(64))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	large := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte("0123456789"), 100))
+	small := base64.StdEncoding.EncodeToString([]byte("tiny"))
+	parts := []a2a.Part{
+		&a2a.TextPart{Type: a2a.PartTypeText, Text: "summarize these"},
+		&a2a.FilePart{Type: a2a.PartTypeFile, File: a2a.FileContent{Name: "big \"report\".txt", MIMEType: "text/plain", Bytes: large}},
+		&a2a.FilePart{Type: a2a.PartTypeFile, File: a2a.FileContent{Name: "small.txt", MIMEType: "text/plain", Bytes: small}},
+	}
+
+	task, err := c.SendTask(t.Context(), a2a.SendTaskRequest{Params: a2a.TaskSendParams{
+		TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+		Message:      a2a.Message{Role: a2a.RoleUser, Parts: parts},
+	}})
+	if err != nil {
+		t.Fatalf("SendTask() error = %v", err)
+	}
+	if len(task.History) == 0 {
+		t.Fatal("task has no history")
+	}
+	if diff := gocmp.Diff(parts, task.History[0].Parts); diff != "" {
+		t.Errorf("received parts mismatch (-want +got):\n%s", diff)
+	}
+
+	// Messages without large files are still sent as JSON.
+	_, err = c.GetTask(t.Context(), &a2a.GetTaskRequest{Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}}})
+	if err != nil {
+		t.Fatalf("GetTask() error = %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if diff := gocmp.Diff([]string{"multipart/form-data", "application/json"}, mediaTypes); diff != "" {
+		t.Errorf("request media types mismatch (-want +got):\n%s", diff)
+	}
+}
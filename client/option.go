@@ -6,7 +6,10 @@ package client
 import (
 	"log/slog"
 	"net/http"
+	"net/url"
+	"time"
 
+	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/go-a2a/a2a"
@@ -15,13 +18,29 @@ import (
 // Option represents an option for configuring the [Client].
 type Option func(*Client)
 
-// WithHTTPClient sets the [*http.Client] for the [Client].
-func (c *Client) WithHTTPClient(httpClient *http.Client) Option {
+// WithHTTPClient sets the [*http.Client] the [Client] sends its requests with, instead of a
+// zero [http.Client] using [http.DefaultTransport].
+//
+// Its Timeout applies to unary calls, in addition to [WithTimeout], but not to streaming calls,
+// which last as long as their task: they are bound by their context and [WithStreamIdleTimeout]
+// instead. See [WithTransport] to only tune the connections.
+func WithHTTPClient(httpClient *http.Client) Option {
 	return func(c *Client) {
 		c.httpClient = httpClient
 	}
 }
 
+// WithTransport makes the [Client] send its requests over t, letting callers tune connection
+// pooling, such as MaxIdleConnsPerHost, keepalives and TLS settings for high-throughput use.
+//
+// The transport replaces that of the HTTP client set with [WithHTTPClient], whatever the order
+// of the options, without modifying that client.
+func WithTransport(t *http.Transport) Option {
+	return func(c *Client) {
+		c.transport = t
+	}
+}
+
 // WithAgentCard sets the agent card for the [Client].
 func WithAgentCard(agentCard *a2a.AgentCard) Option {
 	return func(c *Client) {
@@ -29,6 +48,190 @@ func WithAgentCard(agentCard *a2a.AgentCard) Option {
 	}
 }
 
+// WithBaseURI sets the URI the [Client] resolves the relative file URIs of the agent against,
+// as when an artifact references "artifacts/report.pdf" on the host of the agent. It defaults
+// to the URL of the agent. See [Client.OpenFile] and [Client.WriteFiles].
+func WithBaseURI(base *url.URL) Option {
+	return func(c *Client) {
+		c.baseURI = base
+	}
+}
+
+// WithStrictVersionCheck makes the [Client] refuse agents whose protocol version is incompatible.
+//
+// By default an incompatible agent is only logged as a warning.
+func WithStrictVersionCheck(strict bool) Option {
+	return func(c *Client) {
+		c.strictVersion = strict
+	}
+}
+
+// WithResponseCache sets the [ResponseCache] used to cache tasks/get responses.
+//
+// Terminal tasks are cached until invalidated, non-terminal tasks only briefly.
+// Any mutating call for a task ID invalidates its cached entry.
+func WithResponseCache(cache ResponseCache) Option {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// WithAgentCardTTL makes [Client.GetAgentCard] reuse a fetched agent card for d before fetching it again.
+//
+// A zero d, the default, fetches the card on every call.
+func WithAgentCardTTL(d time.Duration) Option {
+	return func(c *Client) {
+		c.cardTTL = d
+	}
+}
+
+// WithTimeout bounds each call of the [Client] whose context has no deadline to d, retries
+// included, so that a caller forgetting to set one does not wait forever. It defaults to 30
+// seconds, and a non-positive d disables it.
+//
+// A deadline set on the context of a call always wins, whether it is shorter or longer than d,
+// but the Timeout of an [http.Client] set with [WithHTTPClient] still applies too.
+// Streaming calls are exempt, as streams last as long as their task: bound them with their
+// context, or with [WithStreamIdleTimeout] to close those going silent.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = d
+	}
+}
+
+// WithStreamIdleTimeout closes a stream that receives nothing, not even a keepalive, for longer than d.
+//
+// A stalled connection then ends the event channel instead of blocking the consumer forever.
+// A zero d, the default, disables the watchdog.
+func WithStreamIdleTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		c.streamIdleTimeout = d
+	}
+}
+
+// WithStreamIdleHandler sets a function called with the task ID when a stream hits the idle timeout
+// set by [WithStreamIdleTimeout], for example to reconnect or fail the task.
+func WithStreamIdleHandler(fn func(taskID string)) Option {
+	return func(c *Client) {
+		c.onStreamIdle = fn
+	}
+}
+
+// WithStreamErrorMode sets how streams handle a server-sent event that cannot be decoded:
+// [FailFast], the default, ends the stream, and [SkipBad] reports it and goes on. Either way
+// the data of the event is logged, truncated, to help debug the server producing it.
+func WithStreamErrorMode(mode StreamErrorMode) Option {
+	return func(c *Client) {
+		c.streamErrorMode = mode
+	}
+}
+
+// WithRetry makes the [Client] attempt a request that fails transiently up to maxAttempts times,
+// waiting backoff(n) before the n-th retry, or [DefaultBackoff] if backoff is nil.
+//
+// Idempotent calls such as tasks/get and tasks/cancel are retried on connection errors and on
+// 429, 502, 503 and 504 responses, waiting as long as a Retry-After header asks instead.
+// Other calls, such as tasks/send, are only retried when the connection could not be established.
+// Retrying stops when ctx is done, and the final error reports the number of attempts made.
+// A maxAttempts below 2, the default, disables retries.
+func WithRetry(maxAttempts int, backoff BackoffFunc) Option {
+	return func(c *Client) {
+		c.maxAttempts = maxAttempts
+		c.backoff = backoff
+	}
+}
+
+// WithStreamReconnect sets how many times in a row [Client.StreamResilient] tries to reconnect
+// a dropped stream, [DefaultReconnectAttempts] if maxAttempts is not positive, waiting
+// backoff(n) before the n-th attempt, or [DefaultBackoff] if backoff is nil.
+func WithStreamReconnect(maxAttempts int, backoff BackoffFunc) Option {
+	return func(c *Client) {
+		c.reconnectAttempts = maxAttempts
+		c.reconnectBackoff = backoff
+	}
+}
+
+// WithCircuitBreaker makes the [Client] fail fast while the server keeps failing, instead of
+// sending it more requests. After config.FailureThreshold consecutive requests to a host fail
+// to get a response, or get a 5xx one, the breaker of the host opens: calls fail with
+// [ErrCircuitOpen] without contacting the server until config.Cooldown elapses. The breaker then
+// lets a single trial request through, which closes it on success and opens it again on failure.
+//
+// Every request counts, retries and stream connections included. Responses carrying a JSON-RPC
+// error count as successes.
+func WithCircuitBreaker(config CircuitBreakerConfig) Option {
+	return func(c *Client) {
+		c.breakerConfig = &config
+	}
+}
+
+// WithMultipartUpload makes the [Client] send tasks/send and tasks/sendSubscribe requests as
+// multipart/form-data when their message has a file part of more than threshold bytes.
+//
+// Such files are streamed as separate form files instead of base64 inside the JSON request,
+// and the server reattaches them to their parts, so it must support multipart uploads.
+// A non-positive threshold, the default, always sends JSON.
+func WithMultipartUpload(threshold int) Option {
+	return func(c *Client) {
+		c.multipartThreshold = threshold
+	}
+}
+
+// WithAutoCancel makes the [Client] send tasks/cancel for a task when the context of the
+// tasks/send or tasks/sendSubscribe call that started it is done before the task finished.
+//
+// The cancel is best effort, so the server stops working on a task nobody waits for. It is
+// sent on a fresh short-lived context, and its failure is only logged: the call still
+// returns the context error. Disabled by default.
+func WithAutoCancel(enabled bool) Option {
+	return func(c *Client) {
+		c.autoCancel = enabled
+	}
+}
+
+// WithPropagator sets the [propagation.TextMapPropagator] injecting the trace context, such as
+// the W3C traceparent and tracestate headers, into the requests of the [Client].
+//
+// Defaults to the global propagator, set with otel.SetTextMapPropagator.
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(c *Client) {
+		c.propagator = propagator
+	}
+}
+
+// WithCredentials makes the [Client] authenticate its requests to the A2A server with provider,
+// such as [BearerToken] or a [RefreshingTokenProvider].
+//
+// The provider is consulted for every request, including retries and each stream opened,
+// so a refreshed token is picked up by the next request. The agent card is fetched without credentials.
+func WithCredentials(provider CredentialProvider) Option {
+	return func(c *Client) {
+		c.credentials = provider
+	}
+}
+
+// WithCodec sets the [a2a.Codec] encoding the requests of the [Client] and decoding the
+// responses and streamed events.
+//
+// Defaults to [a2a.DefaultCodec].
+func WithCodec(codec a2a.Codec) Option {
+	return func(c *Client) {
+		c.codec = codec
+	}
+}
+
+// WithRecorder records every JSON-RPC call of the [Client] with r: the request and the response
+// of each unary call, and every event of each stream, see [Recorder]. Use a [FileRecorder] to
+// capture a session that [NewReplayClient] replays offline.
+//
+// Only the JSON-RPC messages are recorded, not the HTTP headers carrying the credentials of the
+// client. Sensitive message content can be removed with [FileRecorder.Redact].
+func WithRecorder(r Recorder) Option {
+	return func(c *Client) {
+		c.recorder = r
+	}
+}
+
 // WithLogger sets the [*slog.Logger] for the [Client].
 func WithLogger(logger *slog.Logger) Option {
 	return func(s *Client) {
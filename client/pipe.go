@@ -0,0 +1,181 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/go-a2a/a2a"
+)
+
+// Pipe streams a task from src and feeds each completed artifact to dst as the input message of a new streaming task.
+//
+// The returned channel carries the updates of both hops, as delivered by [Client.SendSubscribe]; use
+// [a2a.TaskEvent.TaskID] on [TaskUpdateEvent.Event] to tell them apart. Artifacts streamed in chunks
+// are assembled by index before being forwarded. Each destination task runs to completion before the
+// next source event is read, so a slow destination applies backpressure to the source.
+//
+// A failure on either hop, such as a source stream or a destination stream failing, or the
+// destination rejecting a task, tears down both streams and is reported as a final event with Err
+// set, after which the channel is closed. Canceling ctx tears down both streams and closes the channel.
+func Pipe(ctx context.Context, src *Client, srcReq *a2a.SendTaskStreamingRequest, dst *Client) (<-chan TaskUpdateEvent, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	srcUpdates, err := src.SendSubscribe(ctx, srcReq)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("pipe: subscribe to source: %w", err)
+	}
+
+	out := make(chan TaskUpdateEvent)
+	go func() {
+		defer close(out)
+		defer cancel()
+
+		p := &pipe{
+			dst:       dst,
+			sessionID: srcReq.Params.SessionID,
+			out:       out,
+			pending:   make(map[int]*a2a.Artifact),
+		}
+		if err := p.run(ctx, srcUpdates); err != nil && ctx.Err() == nil {
+			dst.logger.ErrorContext(ctx, "pipe failed", slog.String("task_id", srcReq.Params.ID), slog.Any("error", err))
+			p.emit(ctx, TaskUpdateEvent{Err: err})
+		}
+	}()
+
+	return out, nil
+}
+
+// pipe holds the state of a running [Pipe].
+type pipe struct {
+	dst       *Client
+	sessionID uuid.UUID
+	out       chan<- TaskUpdateEvent
+
+	// pending holds chunked artifacts that have not received their last chunk yet.
+	pending map[int]*a2a.Artifact
+	// order records the order in which pending artifacts were first seen.
+	order []int
+}
+
+// run forwards source updates and pipes completed artifacts until the source stream ends.
+func (p *pipe) run(ctx context.Context, srcUpdates <-chan TaskUpdateEvent) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case update, ok := <-srcUpdates:
+			if !ok {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				// Flush whatever the source produced without marking a last chunk.
+				return p.flush(ctx)
+			}
+			if update.Err != nil && !update.Skipped {
+				return fmt.Errorf("pipe: source stream: %w", update.Err)
+			}
+			if err := p.emit(ctx, update); err != nil {
+				return err
+			}
+
+			switch {
+			case update.Artifact != nil:
+				if artifact, done := p.collect(update.Artifact.Artifact); done {
+					if err := p.forward(ctx, artifact); err != nil {
+						return err
+					}
+				}
+			case update.Status != nil && update.Status.Final:
+				return p.flush(ctx)
+			}
+		}
+	}
+}
+
+// collect accumulates an artifact chunk and reports the assembled artifact once its last chunk arrives.
+func (p *pipe) collect(chunk a2a.Artifact) (a2a.Artifact, bool) {
+	artifact, ok := p.pending[chunk.Index]
+	if !ok || !chunk.Append {
+		if !ok {
+			p.order = append(p.order, chunk.Index)
+		}
+		artifact = &a2a.Artifact{
+			Name:        chunk.Name,
+			Description: chunk.Description,
+			Index:       chunk.Index,
+			Metadata:    chunk.Metadata,
+		}
+		p.pending[chunk.Index] = artifact
+	}
+	artifact.Parts = append(artifact.Parts, chunk.Parts...)
+
+	if !chunk.LastChunk {
+		return a2a.Artifact{}, false
+	}
+
+	delete(p.pending, chunk.Index)
+	p.order = slices.DeleteFunc(p.order, func(i int) bool { return i == chunk.Index })
+	return *artifact, true
+}
+
+// flush forwards every artifact still pending, in the order they were first seen.
+func (p *pipe) flush(ctx context.Context) error {
+	for _, index := range p.order {
+		if err := p.forward(ctx, *p.pending[index]); err != nil {
+			return err
+		}
+	}
+	p.order = nil
+	clear(p.pending)
+	return nil
+}
+
+// forward sends artifact to the destination as a new task and relays its updates.
+func (p *pipe) forward(ctx context.Context, artifact a2a.Artifact) error {
+	if len(artifact.Parts) == 0 {
+		return nil
+	}
+
+	req := a2a.NewSendTaskStreamingRequest(a2a.NewID(uuid.NewString()), a2a.TaskSendParams{
+		TaskIDParams: a2a.TaskIDParams{
+			ID: uuid.NewString(),
+		},
+		SessionID: p.sessionID,
+		Message: a2a.Message{
+			Role:  a2a.RoleUser,
+			Parts: artifact.Parts,
+		},
+	})
+	updates, err := p.dst.SendSubscribe(ctx, req)
+	if err != nil {
+		return fmt.Errorf("pipe: subscribe to destination: %w", err)
+	}
+
+	for update := range updates {
+		if update.Err != nil && !update.Skipped {
+			return fmt.Errorf("pipe: destination stream: %w", update.Err)
+		}
+		if err := p.emit(ctx, update); err != nil {
+			return err
+		}
+	}
+	return ctx.Err()
+}
+
+// emit sends update to the output channel, giving up when ctx is done.
+func (p *pipe) emit(ctx context.Context, update TaskUpdateEvent) error {
+	select {
+	case p.out <- update:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+)
+
+// streamingServer answers tasks/sendSubscribe with the events returned by events for the
+// request, and every other call, such as the cancellation of an abandoned task, with an error.
+// An event that is a JSON-RPC error is sent as is, others as the result of a response.
+func streamingServer(t *testing.T, events func(req a2a.SendTaskStreamingRequest) []string) *client.Client {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("read request: %v", err)
+			return
+		}
+		var req a2a.SendTaskStreamingRequest
+		if err := a2a.DefaultCodec.Unmarshal(body, &req); err != nil {
+			t.Errorf("decode request: %v", err)
+			return
+		}
+		id, _ := a2a.DefaultCodec.Marshal(req.ID)
+		if req.Method != a2a.MethodTasksSendSubscribe {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":{"code":%d,"message":"task not found"}}`, id, a2a.TaskNotFoundErrorCode)
+			return
+		}
+
+		evs := events(req)
+		if len(evs) == 1 && strings.HasPrefix(evs[0], `{"code"`) {
+			// Rejected before streaming starts.
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":%s}`, id, evs[0])
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, ev := range evs {
+			if strings.HasPrefix(ev, `{"code"`) {
+				fmt.Fprintf(w, "data: {\"jsonrpc\":\"2.0\",\"id\":%s,\"error\":%s}\n\n", id, ev)
+				continue
+			}
+			fmt.Fprintf(w, "data: {\"jsonrpc\":\"2.0\",\"id\":%s,\"result\":%s}\n\n", id, ev)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClient(srv.URL)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return c
+}
+
+func TestPipe(t *testing.T) {
+	t.Parallel()
+
+	const (
+		working  = `{"id":"src","status":{"state":"working","timestamp":"2025-01-01T00:00:00Z"}}`
+		chunk1   = `{"id":"src","artifact":{"parts":[{"type":"text","text":"hel"}]}}`
+		chunk2   = `{"id":"src","artifact":{"parts":[{"type":"text","text":"lo"}],"append":true,"lastChunk":true}}`
+		complete = `{"id":"src","status":{"state":"completed","timestamp":"2025-01-01T00:00:00Z"},"final":true}`
+		notFound = `{"code":-32001,"message":"Task not found"}`
+	)
+	// echo completes each destination task with the text it was sent.
+	echo := func(req a2a.SendTaskStreamingRequest) []string {
+		id := req.Params.ID
+		return []string{
+			`{"id":"` + id + `","artifact":{"parts":[{"type":"text","text":` + strconv.Quote("echo "+req.Params.Message.Text()) + `}],"lastChunk":true}}`,
+			`{"id":"` + id + `","status":{"state":"completed","timestamp":"2025-01-01T00:00:00Z"},"final":true}`,
+		}
+	}
+
+	tests := map[string]struct {
+		src     []string
+		dst     func(req a2a.SendTaskStreamingRequest) []string
+		want    []string
+		wantErr error
+	}{
+		"success": {
+			src:  []string{working, chunk1, chunk2, complete},
+			dst:  echo,
+			want: []string{"src working", "src artifact hel", "src artifact lo", "dst artifact echo hel\nlo", "dst completed", "src completed"},
+		},
+		"source failure": {
+			src:     []string{working, notFound},
+			dst:     echo,
+			want:    []string{"src working"},
+			wantErr: a2a.ErrTaskNotFound,
+		},
+		"destination rejects the task": {
+			src: []string{working, chunk1, chunk2, complete},
+			dst: func(a2a.SendTaskStreamingRequest) []string {
+				return []string{fmt.Sprintf(`{"code":%d,"message":"no streaming"}`, a2a.UnsupportedOperationErrorCode)}
+			},
+			want:    []string{"src working", "src artifact hel", "src artifact lo"},
+			wantErr: a2a.ErrUnsupportedOperation,
+		},
+		"destination stream failure": {
+			src: []string{working, chunk1, chunk2, complete},
+			dst: func(req a2a.SendTaskStreamingRequest) []string {
+				return []string{`{"id":"` + req.Params.ID + `","status":{"state":"working","timestamp":"2025-01-01T00:00:00Z"}}`, notFound}
+			},
+			want:    []string{"src working", "src artifact hel", "src artifact lo", "dst working"},
+			wantErr: a2a.ErrTaskNotFound,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			src := streamingServer(t, func(a2a.SendTaskStreamingRequest) []string { return tt.src })
+			dst := streamingServer(t, tt.dst)
+
+			updates, err := client.Pipe(t.Context(), src, a2a.NewSendTaskStreamingRequest(a2a.NewID("src"), a2a.TaskSendParams{
+				TaskIDParams: a2a.TaskIDParams{ID: "src"},
+				Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{a2a.NewTextPart("hi")}},
+			}), dst)
+			if err != nil {
+				t.Fatalf("Pipe() error = %v", err)
+			}
+
+			var (
+				got    []string
+				gotErr error
+			)
+			for update := range updates {
+				if gotErr != nil {
+					t.Fatalf("update %+v after the error event", update)
+				}
+				if update.Err != nil {
+					gotErr = update.Err
+					continue
+				}
+				hop := "dst"
+				if update.Event().TaskID() == "src" {
+					hop = "src"
+				}
+				switch {
+				case update.Status != nil:
+					got = append(got, hop+" "+string(update.Status.Status.State))
+				case update.Artifact != nil:
+					got = append(got, hop+" artifact "+update.Artifact.Artifact.Parts[0].(*a2a.TextPart).Text)
+				}
+			}
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("updates mismatch (-want +got):\n%s", diff)
+			}
+			if !errors.Is(gotErr, tt.wantErr) {
+				t.Errorf("error event = %v, want %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,334 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/go-a2a/a2a"
+)
+
+// ErrNotRecorded is returned by the calls of a [NewReplayClient] client that the recording
+// has no response for.
+var ErrNotRecorded = errors.New("call not recorded")
+
+// Recorder records the JSON-RPC calls of a [Client], see [WithRecorder].
+type Recorder interface {
+	// Record is called once a unary call returns, with its request, the response it got, if
+	// any, and the error it failed with, if any, such as a connection error. For a streaming
+	// call, it is called for each event received, with the request that opened the stream.
+	// Record may be called concurrently by the calls of the client.
+	Record(req a2a.JSONRPCRequest, resp a2a.JSONRPCResponse, err error)
+}
+
+// RecordedCall is a line of a recording written by a [FileRecorder]: a unary call, or one
+// event of a streaming call.
+type RecordedCall struct {
+	// Request is the JSON-RPC request of the call.
+	Request a2a.JSONRPCRequest `json:"request"`
+
+	// Response is the JSON-RPC response to the call, or the event received, if any.
+	Response *a2a.JSONRPCResponse `json:"response,omitempty"`
+
+	// Error is the message of the error the call failed with, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// FileRecorder is a [Recorder] writing each call as a line of newline-delimited JSON, a
+// [RecordedCall], such as to a file that [NewReplayClient] replays later.
+type FileRecorder struct {
+	// Redact, if set, is called with each call before it is written, to remove the sensitive
+	// content of its request or response, such as credentials carried in metadata.
+	Redact func(call *RecordedCall)
+
+	mu  sync.Mutex
+	w   io.Writer
+	err error
+}
+
+var _ Recorder = (*FileRecorder)(nil)
+
+// NewFileRecorder returns a [FileRecorder] writing the calls to w.
+func NewFileRecorder(w io.Writer) *FileRecorder {
+	return &FileRecorder{w: w}
+}
+
+// Record implements [Recorder].
+func (r *FileRecorder) Record(req a2a.JSONRPCRequest, resp a2a.JSONRPCResponse, err error) {
+	call := RecordedCall{Request: req}
+	if resp.Result != nil || resp.Error != nil {
+		call.Response = &resp
+	}
+	if err != nil {
+		call.Error = err.Error()
+	}
+	if r.Redact != nil {
+		r.Redact(&call)
+	}
+
+	line, merr := a2a.DefaultCodec.Marshal(call)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return
+	}
+	if merr != nil {
+		r.err = fmt.Errorf("record %s call: %w", req.Method, merr)
+		return
+	}
+	if _, werr := r.w.Write(append(line, '\n')); werr != nil {
+		r.err = fmt.Errorf("record %s call: %w", req.Method, werr)
+	}
+}
+
+// Err returns the first error hit while writing a call, after which no more calls are written.
+func (r *FileRecorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+// recordedRequest returns the request of a call of method with id and payload, as recorded.
+func (c *Client) recordedRequest(method, id string, payload any) a2a.JSONRPCRequest {
+	req := a2a.JSONRPCRequest{
+		JSONRPCMessage: a2a.NewJSONRPCMessage(a2a.NewID(id)),
+		Method:         method,
+	}
+	if params, err := c.codec.Marshal(payload); err == nil {
+		req.Params = params
+	}
+	return req
+}
+
+// record hands the call req, answered with body, to the recorder. A body that is not a
+// response is recorded as the error of the call, unless it failed already.
+func (c *Client) record(req a2a.JSONRPCRequest, body []byte, err error) {
+	var resp a2a.JSONRPCResponse
+	if len(body) > 0 {
+		if uerr := c.codec.Unmarshal(body, &resp); uerr != nil && err == nil {
+			err = fmt.Errorf("failed to parse response: %w", uerr)
+		}
+	}
+	c.recorder.Record(req, resp, err)
+}
+
+// NewReplayClient returns a [Client] answering its calls from a recording written by a
+// [FileRecorder] read from r, instead of the network, so that tests can run offline against
+// captured sessions.
+//
+// A call is answered with the first recorded call not replayed yet of the same method and
+// params, whatever its ID: the recorded response, given the ID of the call, or the events of
+// the recorded stream. Recorded errors are returned as such. Other calls fail with an error
+// wrapping [ErrNotRecorded]. The agent card is not recorded; set it with [WithAgentCard] if
+// needed.
+//
+// It accepts the options of [NewClient], except those about the HTTP client.
+func NewReplayClient(r io.Reader, opts ...Option) (*Client, error) {
+	c, err := NewClient("replay://recording", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &replayTransport{codec: c.codec}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64<<10), maxEventSize)
+	for n := 1; scanner.Scan(); n++ {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var call RecordedCall
+		if err := c.codec.Unmarshal(line, &call); err != nil {
+			return nil, fmt.Errorf("replay: line %d: %w", n, err)
+		}
+		if err := t.add(call); err != nil {
+			return nil, fmt.Errorf("replay: line %d: %w", n, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("replay: read recording: %w", err)
+	}
+
+	c.httpClient = &http.Client{Transport: t}
+	return c, nil
+}
+
+// replayTransport is the [http.RoundTripper] answering the calls of a [Client] from a recording.
+type replayTransport struct {
+	codec a2a.Codec
+
+	mu    sync.Mutex
+	calls []*replayCall
+}
+
+var _ http.RoundTripper = (*replayTransport)(nil)
+
+// replayCall is a recorded call: a unary call, or all the events of a stream.
+type replayCall struct {
+	method string
+	id     a2a.ID
+	// params is the canonical JSON of the params of the call.
+	params   string
+	stream   bool
+	recorded []RecordedCall
+	// ended is set once a stream recorded its final event.
+	ended    bool
+	replayed bool
+}
+
+// add adds a recorded call, appending it to the stream it is an event of.
+func (t *replayTransport) add(call RecordedCall) error {
+	params, err := canonicalParams(call.Request.Params)
+	if err != nil {
+		return err
+	}
+	stream := isStreamingMethod(call.Request.Method)
+	if n := len(t.calls); stream && n > 0 {
+		last := t.calls[n-1]
+		if last.stream && !last.ended && last.method == call.Request.Method &&
+			last.id.Equal(call.Request.ID) && last.params == params {
+			last.recorded = append(last.recorded, call)
+			last.ended = endsStream(call)
+			return nil
+		}
+	}
+	t.calls = append(t.calls, &replayCall{
+		method:   call.Request.Method,
+		id:       call.Request.ID,
+		params:   params,
+		stream:   stream,
+		recorded: []RecordedCall{call},
+		ended:    endsStream(call),
+	})
+	return nil
+}
+
+// isStreamingMethod reports whether method answers with an event stream.
+func isStreamingMethod(method string) bool {
+	return method == a2a.MethodTasksSendSubscribe || method == a2a.MethodTasksResubscribe
+}
+
+// endsStream reports whether the recorded event ends its stream: an error, or a final status update.
+func endsStream(call RecordedCall) bool {
+	if call.Error != "" || call.Response == nil || call.Response.Error != nil {
+		return true
+	}
+	result, _ := call.Response.Result.(map[string]any)
+	final, _ := result["final"].(bool)
+	return final
+}
+
+// canonicalParams returns the canonical JSON of params, so that params equal as JSON compare equal.
+func canonicalParams(params []byte) (string, error) {
+	if len(params) == 0 {
+		return "", nil
+	}
+	data, err := a2a.CanonicalJSON(json.RawMessage(params))
+	if err != nil {
+		return "", fmt.Errorf("params: %w", err)
+	}
+	return string(data), nil
+}
+
+// RoundTrip implements [http.RoundTripper], answering the JSON-RPC call carried by req with its
+// recorded response, or the event stream recorded for it.
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		defer req.Body.Close()
+	}
+	if req.Method != http.MethodPost || req.Body == nil {
+		return nil, fmt.Errorf("replay: only JSON-RPC calls are recorded, not %s %s", req.Method, req.URL.Path)
+	}
+	if mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type")); mediaType != "application/json" {
+		return nil, fmt.Errorf("replay: unsupported request content type %q", mediaType)
+	}
+
+	var body io.Reader = req.Body
+	if strings.EqualFold(req.Header.Get("Content-Encoding"), "gzip") {
+		zr, err := gzip.NewReader(req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("replay: decompress request body: %w", err)
+		}
+		body = zr
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("replay: read request body: %w", err)
+	}
+	var rpcReq a2a.JSONRPCRequest
+	if err := t.codec.Unmarshal(data, &rpcReq); err != nil {
+		return nil, fmt.Errorf("replay: parse request: %w", err)
+	}
+
+	call, err := t.take(rpcReq)
+	if err != nil {
+		return nil, err
+	}
+
+	first := call.recorded[0]
+	if first.Response == nil {
+		return nil, fmt.Errorf("replay: recorded %s call failed: %s", rpcReq.Method, first.Error)
+	}
+	if !call.stream || first.Response.Error != nil {
+		// A streaming call rejected before streaming starts is answered with a plain error.
+		resp, err := t.response(rpcReq.ID, *first.Response)
+		if err != nil {
+			return nil, err
+		}
+		return stdioResponse(req, http.StatusOK, "application/json", io.NopCloser(bytes.NewReader(resp))), nil
+	}
+
+	var events bytes.Buffer
+	for _, event := range call.recorded {
+		if event.Response == nil {
+			// The event could not be decoded when recorded, nor can it be replayed.
+			continue
+		}
+		resp, err := t.response(rpcReq.ID, *event.Response)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&events, "data: %s\n\n", resp)
+	}
+	return stdioResponse(req, http.StatusOK, "text/event-stream", io.NopCloser(&events)), nil
+}
+
+// take returns the first recorded call of the method and params of req not replayed yet, and
+// marks it replayed.
+func (t *replayTransport) take(req a2a.JSONRPCRequest) (*replayCall, error) {
+	params, err := canonicalParams(req.Params)
+	if err != nil {
+		return nil, fmt.Errorf("replay: %w", err)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, call := range t.calls {
+		if !call.replayed && call.method == req.Method && call.params == params {
+			call.replayed = true
+			return call, nil
+		}
+	}
+	return nil, fmt.Errorf("replay: %w: %s %s", ErrNotRecorded, req.Method, params)
+}
+
+// response returns the encoding of resp, answering the request id.
+func (t *replayTransport) response(id a2a.ID, resp a2a.JSONRPCResponse) ([]byte, error) {
+	resp.JSONRPCMessage = a2a.NewJSONRPCMessage(id)
+	data, err := t.codec.Marshal(resp)
+	if err != nil {
+		return nil, fmt.Errorf("replay: marshal response: %w", err)
+	}
+	return data, nil
+}
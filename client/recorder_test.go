@@ -0,0 +1,176 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+)
+
+// recordingServer answers tasks/send with a completed task, streams two events for
+// tasks/sendSubscribe, and fails every other call.
+func recordingServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("read request: %v", err)
+			return
+		}
+		var req a2a.JSONRPCRequest
+		if err := a2a.DefaultCodec.Unmarshal(body, &req); err != nil {
+			t.Errorf("decode request: %v", err)
+			return
+		}
+		id, _ := a2a.DefaultCodec.Marshal(req.ID)
+		switch req.Method {
+		case a2a.MethodTasksSend:
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":{"id":"task-1","status":{"state":"completed","timestamp":"2025-01-01T00:00:00Z"}}}`, id)
+		case a2a.MethodTasksSendSubscribe:
+			w.Header().Set("Content-Type", "text/event-stream")
+			fmt.Fprintf(w, "data: {\"jsonrpc\":\"2.0\",\"id\":%s,\"result\":{\"id\":\"task-1\",\"status\":{\"state\":\"working\",\"timestamp\":\"2025-01-01T00:00:00Z\"}}}\n\n", id)
+			fmt.Fprintf(w, "data: {\"jsonrpc\":\"2.0\",\"id\":%s,\"result\":{\"id\":\"task-1\",\"status\":{\"state\":\"completed\",\"timestamp\":\"2025-01-01T00:00:00Z\"},\"final\":true}}\n\n", id)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":{"code":%d,"message":"task not found"}}`, id, a2a.TaskNotFoundErrorCode)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// session makes the calls recorded and replayed, returning the states of the task seen.
+func session(ctx context.Context, c *client.Client) ([]a2a.TaskState, error) {
+	params := a2a.TaskSendParams{
+		TaskIDParams: a2a.TaskIDParams{ID: "task-1", Metadata: map[string]any{"token": "secret"}},
+		Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{a2a.NewTextPart("hi")}},
+	}
+
+	var states []a2a.TaskState
+	task, err := c.SendTask(ctx, *a2a.NewSendTaskRequest(a2a.NewID("task-1"), params))
+	if err != nil {
+		return nil, fmt.Errorf("SendTask: %w", err)
+	}
+	states = append(states, task.Status.State)
+
+	updates, err := c.SendSubscribe(ctx, a2a.NewSendTaskStreamingRequest(a2a.NewID("task-1"), params))
+	if err != nil {
+		return nil, fmt.Errorf("SendSubscribe: %w", err)
+	}
+	for update := range updates {
+		if update.Err != nil {
+			return nil, fmt.Errorf("SendSubscribe: %w", update.Err)
+		}
+		states = append(states, update.Status.Status.State)
+	}
+
+	_, err = c.GetTask(ctx, a2a.NewGetTaskRequest(a2a.NewID("task-1"), a2a.TaskQueryParams{
+		TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+	}))
+	if !errors.Is(err, a2a.ErrTaskNotFound) {
+		return nil, fmt.Errorf("GetTask error = %v, want %w", err, a2a.ErrTaskNotFound)
+	}
+	return states, nil
+}
+
+func TestRecorder_Replay(t *testing.T) {
+	t.Parallel()
+
+	srv := recordingServer(t)
+
+	var recording bytes.Buffer
+	rec := client.NewFileRecorder(&recording)
+	rec.Redact = func(call *client.RecordedCall) {
+		call.Request.Params = bytes.ReplaceAll(call.Request.Params, []byte("secret"), []byte("REDACTED"))
+	}
+	c, err := client.NewClient(srv.URL, client.WithRecorder(rec))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	want, err := session(t.Context(), c)
+	if err != nil {
+		t.Fatalf("recorded session: %v", err)
+	}
+	if err := rec.Err(); err != nil {
+		t.Fatalf("FileRecorder.Err() = %v", err)
+	}
+	if got := strings.Count(recording.String(), "\n"); got != 4 {
+		t.Errorf("recording has %d lines, want 4: one per unary call and stream event", got)
+	}
+	if strings.Contains(recording.String(), "secret") {
+		t.Errorf("recording = %s, want the token redacted", recording.String())
+	}
+
+	// The replayed calls carry the original params, which the recording has redacted.
+	unredacted := strings.ReplaceAll(recording.String(), "REDACTED", "secret")
+	replay, err := client.NewReplayClient(strings.NewReader(unredacted))
+	if err != nil {
+		t.Fatalf("NewReplayClient() error = %v", err)
+	}
+	got, err := session(t.Context(), replay)
+	if err != nil {
+		t.Fatalf("replayed session: %v", err)
+	}
+	if diff := gocmp.Diff(want, got); diff != "" {
+		t.Errorf("replayed states mismatch (-recorded +replayed):\n%s", diff)
+	}
+
+	t.Run("calls not recorded", func(t *testing.T) {
+		t.Parallel()
+
+		// Every recorded call was replayed already.
+		_, err := replay.GetTask(t.Context(), a2a.NewGetTaskRequest(a2a.NewID("task-1"), a2a.TaskQueryParams{
+			TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+		}))
+		if !errors.Is(err, client.ErrNotRecorded) {
+			t.Errorf("GetTask() error = %v, want %v", err, client.ErrNotRecorded)
+		}
+	})
+}
+
+func TestRecorder_RecordsFailures(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":"task-1","error":{"code":%d,"message":"no streaming"}}`, a2a.UnsupportedOperationErrorCode)
+	}))
+	t.Cleanup(srv.Close)
+
+	var recording bytes.Buffer
+	c, err := client.NewClient(srv.URL, client.WithRecorder(client.NewFileRecorder(&recording)))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	req := a2a.NewSendTaskStreamingRequest(a2a.NewID("task-1"), a2a.TaskSendParams{
+		TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+	})
+	if _, err := c.SendSubscribe(t.Context(), req); !errors.Is(err, a2a.ErrUnsupportedOperation) {
+		t.Fatalf("SendSubscribe() error = %v, want %v", err, a2a.ErrUnsupportedOperation)
+	}
+
+	// The call rejected before streaming started replays as the same error.
+	replay, err := client.NewReplayClient(&recording)
+	if err != nil {
+		t.Fatalf("NewReplayClient() error = %v", err)
+	}
+	if _, err := replay.SendSubscribe(t.Context(), req); !errors.Is(err, a2a.ErrUnsupportedOperation) {
+		t.Errorf("replayed SendSubscribe() error = %v, want %v", err, a2a.ErrUnsupportedOperation)
+	}
+}
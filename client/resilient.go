@@ -0,0 +1,173 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/go-a2a/a2a"
+)
+
+// DefaultReconnectAttempts is how many times in a row [Client.StreamResilient] tries to
+// reconnect a dropped stream, unless [WithStreamReconnect] sets another number.
+const DefaultReconnectAttempts = 5
+
+// StreamReconnect reports that a stream opened by [Client.StreamResilient] dropped and is
+// being reconnected, see [TaskUpdateEvent.Reconnect].
+type StreamReconnect struct {
+	// Attempt is the number of the reconnection attempt, starting at 1 for each drop.
+	Attempt int
+
+	// Wait is how long the client waits before the attempt.
+	Wait time.Duration
+
+	// LastEventID is the ID of the last event received, from which the stream resumes.
+	LastEventID string
+
+	// Err is why the stream dropped, or the previous attempt failed. It is
+	// [ErrStreamIncomplete] for a stream the server ended before the task finished.
+	Err error
+}
+
+// StreamResilient sends a task and subscribes to its updates like [Client.SendSubscribe],
+// reconnecting the stream when it drops before the task reaches a final state.
+//
+// A stream that ends early, fails to read or goes idle is resumed with tasks/resubscribe,
+// sending the [TaskUpdateEvent.EventID] of the last update received so that a server
+// keeping the events of its streams replays the ones missed; see server.WithEventReplay.
+// Every attempt is announced by an update with [TaskUpdateEvent.Reconnect] set, after the
+// wait set by [WithStreamReconnect], and the updates of the new stream follow on the same
+// channel. Once the attempts for a drop run out, the stream ends with an error event. Errors
+// sent by the server, and malformed events unless the client skips them, end the stream as
+// they do for [Client.SendSubscribe].
+func (c *Client) StreamResilient(ctx context.Context, req *a2a.SendTaskStreamingRequest) (<-chan TaskUpdateEvent, error) {
+	ctx, span := c.tracer.Start(ctx, "client.StreamResilient")
+	defer span.End()
+
+	taskID := req.Params.ID
+	span.SetAttributes(attribute.String("a2a.task_id", taskID))
+
+	c.invalidateTask(taskID)
+
+	stream, err := c.openStream(ctx, a2a.MethodTasksSendSubscribe, taskID, req.Params, "")
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(chan TaskUpdateEvent, 10)
+	go func() {
+		defer close(updates)
+		c.relayResilient(ctx, taskID, req.Params.EventTypes, stream, updates)
+	}()
+	return updates, nil
+}
+
+// relayResilient relays the updates of stream to updates, resubscribing to the task whenever
+// the stream drops before its final status, for the same event types.
+func (c *Client) relayResilient(ctx context.Context, taskID string, eventTypes []string, stream <-chan TaskUpdateEvent, updates chan<- TaskUpdateEvent) {
+	send := func(update TaskUpdateEvent) bool {
+		select {
+		case updates <- update:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	maxAttempts, backoff := c.reconnectAttempts, c.reconnectBackoff
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultReconnectAttempts
+	}
+	if backoff == nil {
+		backoff = DefaultBackoff
+	}
+
+	var lastEventID string
+	for attempt := 0; ; {
+		cause := ErrStreamIncomplete
+		for update := range stream {
+			if update.Err != nil && !update.Skipped {
+				cause = update.Err
+				break
+			}
+			if update.EventID != "" {
+				lastEventID = update.EventID
+			}
+			if event := update.Event(); event != nil {
+				// The stream is alive again: further drops get all the attempts.
+				attempt = 0
+				if taskID == "" {
+					taskID = event.TaskID()
+				}
+			}
+			if !send(update) {
+				return
+			}
+			if update.Status != nil && update.Status.Final {
+				return
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if !reconnectable(cause) || taskID == "" {
+			send(TaskUpdateEvent{Err: cause})
+			return
+		}
+
+		for stream = nil; stream == nil; {
+			attempt++
+			if attempt > maxAttempts {
+				send(TaskUpdateEvent{Err: fmt.Errorf("stream dropped, after %d reconnection attempts: %w", maxAttempts, cause)})
+				return
+			}
+
+			wait := backoff(attempt)
+			c.logger.InfoContext(ctx, "reconnecting stream",
+				slog.String("task_id", taskID),
+				slog.Int("attempt", attempt),
+				slog.Duration("wait", wait),
+				slog.Any("error", cause))
+			if !send(TaskUpdateEvent{Reconnect: &StreamReconnect{Attempt: attempt, Wait: wait, LastEventID: lastEventID, Err: cause}}) {
+				return
+			}
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+
+			var err error
+			stream, err = c.Resubscribe(ctx, &a2a.TaskResubscriptionRequest{Params: a2a.TaskResubscriptionParams{
+				TaskIDParams: a2a.TaskIDParams{ID: taskID},
+				EventTypes:   eventTypes,
+			}}, lastEventID)
+			if err != nil {
+				if !reconnectable(err) {
+					send(TaskUpdateEvent{Err: fmt.Errorf("reconnect stream: %w", err)})
+					return
+				}
+				cause = err
+			}
+		}
+	}
+}
+
+// reconnectable reports whether a stream that failed with err may be resumed: it dropped,
+// rather than the server or the events it sent reporting an error.
+func reconnectable(err error) bool {
+	if errors.Is(err, ErrMalformedEvent) || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var jerr *a2a.JSONRPCError
+	return !errors.As(err, &jerr)
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bytedance/sonic"
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+)
+
+func TestClient_StreamResilient(t *testing.T) {
+	t.Parallel()
+
+	event := func(id, state string, final bool) string {
+		return fmt.Sprintf("id: %s\ndata: {\"jsonrpc\":\"2.0\",\"id\":1,\"result\":{\"id\":\"task-1\",\"status\":{\"state\":%q},\"final\":%t}}\n\n", id, state, final)
+	}
+	rpcError := "data: {\"jsonrpc\":\"2.0\",\"id\":1,\"error\":{\"code\":-32603,\"message\":\"Internal error\"}}\n\n"
+
+	type call struct {
+		method      string
+		lastEventID string
+	}
+	tests := map[string]struct {
+		// streams are the bodies of the streams served in turn; an empty one is refused
+		// with 503 Service Unavailable, as is any call past them.
+		streams     []string
+		maxAttempts int
+		want        []string
+		wantCalls   []call
+	}{
+		"resumes after a drop": {
+			streams:   []string{event("1", "working", false), event("2", "working", false) + event("3", "completed", true)},
+			want:      []string{"1 working", "reconnect 1 after 1", "2 working", "3 completed"},
+			wantCalls: []call{{a2a.MethodTasksSendSubscribe, ""}, {a2a.MethodTasksResubscribe, "1"}},
+		},
+		"attempts reset after progress": {
+			streams:     []string{event("1", "working", false), event("2", "working", false), event("3", "completed", true)},
+			maxAttempts: 1,
+			want:        []string{"1 working", "reconnect 1 after 1", "2 working", "reconnect 1 after 2", "3 completed"},
+			wantCalls:   []call{{a2a.MethodTasksSendSubscribe, ""}, {a2a.MethodTasksResubscribe, "1"}, {a2a.MethodTasksResubscribe, "2"}},
+		},
+		"failed attempts": {
+			streams:     []string{event("1", "working", false), "", event("2", "completed", true)},
+			maxAttempts: 2,
+			want:        []string{"1 working", "reconnect 1 after 1", "reconnect 2 after 1", "2 completed"},
+			wantCalls:   []call{{a2a.MethodTasksSendSubscribe, ""}, {a2a.MethodTasksResubscribe, "1"}, {a2a.MethodTasksResubscribe, "1"}},
+		},
+		"attempts run out": {
+			streams:     []string{event("1", "working", false)},
+			maxAttempts: 2,
+			want:        []string{"1 working", "reconnect 1 after 1", "reconnect 2 after 1", "error"},
+			wantCalls:   []call{{a2a.MethodTasksSendSubscribe, ""}, {a2a.MethodTasksResubscribe, "1"}, {a2a.MethodTasksResubscribe, "1"}},
+		},
+		"server error": {
+			streams:   []string{event("1", "working", false) + rpcError},
+			want:      []string{"1 working", "error"},
+			wantCalls: []call{{a2a.MethodTasksSendSubscribe, ""}},
+		},
+		"no drop": {
+			streams:   []string{event("1", "working", false) + event("2", "completed", true)},
+			want:      []string{"1 working", "2 completed"},
+			wantCalls: []call{{a2a.MethodTasksSendSubscribe, ""}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mu    sync.Mutex
+				calls []call
+			)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				var req a2a.JSONRPCRequest
+				if err := sonic.ConfigFastest.Unmarshal(body, &req); err != nil {
+					t.Errorf("Unmarshal() error = %v", err)
+				}
+				mu.Lock()
+				n := len(calls)
+				calls = append(calls, call{req.Method, r.Header.Get("Last-Event-ID")})
+				mu.Unlock()
+
+				if n >= len(tt.streams) || tt.streams[n] == "" {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.Header().Set("Content-Type", "text/event-stream")
+				io.WriteString(w, tt.streams[n])
+			}))
+			t.Cleanup(srv.Close)
+
+			c, err := client.NewClient(srv.URL, client.WithStreamReconnect(tt.maxAttempts, func(int) time.Duration { return time.Millisecond }))
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+			updates, err := c.StreamResilient(t.Context(), &a2a.SendTaskStreamingRequest{Params: a2a.TaskSendParams{
+				TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+				Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "hi"}}},
+			}})
+			if err != nil {
+				t.Fatalf("StreamResilient() error = %v", err)
+			}
+
+			var got []string
+			for update := range updates {
+				switch {
+				case update.Err != nil:
+					got = append(got, "error")
+				case update.Reconnect != nil:
+					got = append(got, fmt.Sprintf("reconnect %d after %s", update.Reconnect.Attempt, update.Reconnect.LastEventID))
+				case update.Status != nil:
+					got = append(got, update.EventID+" "+string(update.Status.Status.State))
+				}
+			}
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("updates mismatch (-want +got):\n%s", diff)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if diff := gocmp.Diff(tt.wantCalls, calls, gocmp.AllowUnexported(call{})); diff != "" {
+				t.Errorf("calls mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-a2a/a2a"
+)
+
+// BackoffFunc returns how long to wait before retry number attempt, starting at 1.
+type BackoffFunc func(attempt int) time.Duration
+
+// ExponentialBackoff returns a [BackoffFunc] waiting base before the first retry and doubling
+// the wait for each further retry, up to limit.
+func ExponentialBackoff(base, limit time.Duration) BackoffFunc {
+	return func(attempt int) time.Duration {
+		d := base
+		for i := 1; i < attempt && d < limit; i++ {
+			d *= 2
+		}
+		return min(d, limit)
+	}
+}
+
+// DefaultBackoff is the [BackoffFunc] used by [WithRetry] when given nil.
+var DefaultBackoff = ExponentialBackoff(200*time.Millisecond, 5*time.Second)
+
+// idempotentMethods are the JSON-RPC methods that can be repeated without changing the outcome.
+var idempotentMethods = map[string]bool{
+	a2a.MethodTasksGet:                 true,
+	a2a.MethodTasksCancel:              true,
+	a2a.MethodTasksPushNotificationGet: true,
+	a2a.MethodTasksHistoryGet:          true,
+	a2a.MethodTasksArtifactsGet:        true,
+	a2a.MethodTasksList:                true,
+	a2a.MethodTasksJournalGet:          true,
+}
+
+// httpStatusError is returned for a response with a status other than 200 OK.
+type httpStatusError struct {
+	// status is the status line of the response.
+	status string
+
+	// code is the status code of the response.
+	code int
+
+	// retryAfter is the delay requested by the Retry-After header, or zero.
+	retryAfter time.Duration
+}
+
+// Error implements error.
+func (e *httpStatusError) Error() string {
+	return "HTTP request failed with status: " + e.status
+}
+
+// newHTTPStatusError returns the error for resp.
+func newHTTPStatusError(resp *http.Response) *httpStatusError {
+	return &httpStatusError{
+		status:     resp.Status,
+		code:       resp.StatusCode,
+		retryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+	}
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return max(time.Duration(secs)*time.Second, 0)
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0)
+	}
+	return 0
+}
+
+// retryable reports whether a call of method that failed with err may be attempted again.
+//
+// Idempotent methods are retried on connection errors and on 429, 502, 503 and 504 responses.
+// Other methods are only retried when the connection could not be established, since the
+// server cannot have seen the request.
+func retryable(method string, err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+	if !idempotentMethods[method] {
+		return false
+	}
+
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) {
+		switch statusErr.code {
+		case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// retry calls fn for method until it succeeds, fails with an error that is not retryable,
+// or the attempts configured by [WithRetry] run out.
+//
+// Once retries were possible, the returned error carries the number of attempts made.
+func (c *Client) retry(ctx context.Context, method string, fn func() ([]byte, error)) ([]byte, error) {
+	if c.maxAttempts <= 1 {
+		return fn()
+	}
+
+	for attempt := 1; ; attempt++ {
+		data, err := fn()
+		if err == nil {
+			return data, nil
+		}
+		if attempt >= c.maxAttempts || !retryable(method, err) {
+			return nil, fmt.Errorf("after %d attempts: %w", attempt, err)
+		}
+
+		wait := c.backoff(attempt)
+		var statusErr *httpStatusError
+		if errors.As(err, &statusErr) && statusErr.retryAfter > 0 {
+			wait = statusErr.retryAfter
+		}
+		c.logger.DebugContext(ctx, "retrying request",
+			slog.String("method", method),
+			slog.Int("attempt", attempt),
+			slog.Duration("wait", wait),
+			slog.Any("error", err))
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("after %d attempts: %w", attempt, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+)
+
+func TestClient_WithRetry(t *testing.T) {
+	t.Parallel()
+
+	const taskResult = `{"jsonrpc":"2.0","id":"task-1","result":{"id":"task-1","status":{"state":"completed"}}}`
+
+	tests := map[string]struct {
+		// failures are the statuses answered before the request succeeds.
+		failures   []int
+		retryAfter string
+		send       bool
+		wantHits   int32
+		wantErr    string
+		minElapsed time.Duration
+	}{
+		"get recovers from unavailable": {
+			failures: []int{http.StatusServiceUnavailable, http.StatusBadGateway},
+			wantHits: 3,
+		},
+		"get gives up after max attempts": {
+			failures: []int{http.StatusGatewayTimeout, http.StatusGatewayTimeout, http.StatusGatewayTimeout, http.StatusGatewayTimeout},
+			wantHits: 3,
+			wantErr:  "after 3 attempts",
+		},
+		"get stops on client error": {
+			failures: []int{http.StatusBadRequest},
+			wantHits: 1,
+			wantErr:  "after 1 attempts",
+		},
+		"get honors Retry-After": {
+			failures:   []int{http.StatusTooManyRequests},
+			retryAfter: "1",
+			wantHits:   2,
+			minElapsed: time.Second,
+		},
+		"send is not retried once delivered": {
+			failures: []int{http.StatusServiceUnavailable},
+			send:     true,
+			wantHits: 1,
+			wantErr:  "after 1 attempts",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var hits atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := int(hits.Add(1))
+				if n <= len(tt.failures) {
+					if tt.retryAfter != "" {
+						w.Header().Set("Retry-After", tt.retryAfter)
+					}
+					http.Error(w, "try again", tt.failures[n-1])
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(taskResult))
+			}))
+			t.Cleanup(srv.Close)
+
+			c, err := client.NewClient(srv.URL, client.WithRetry(3, func(int) time.Duration { return time.Millisecond }))
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+
+			start := time.Now()
+			if tt.send {
+				_, err = c.SendTask(t.Context(), a2a.SendTaskRequest{Params: a2a.TaskSendParams{
+					TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+					Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "hi"}}},
+				}})
+			} else {
+				_, err = c.GetTask(t.Context(), &a2a.GetTaskRequest{Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}}})
+			}
+			elapsed := time.Since(start)
+
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("error = %v, want success", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Errorf("error = %v, want it to mention %q", err, tt.wantErr)
+			}
+			if got := hits.Load(); got != tt.wantHits {
+				t.Errorf("server hit %d times, want %d", got, tt.wantHits)
+			}
+			if elapsed < tt.minElapsed {
+				t.Errorf("took %v, want at least %v", elapsed, tt.minElapsed)
+			}
+		})
+	}
+}
+
+func TestClient_WithRetryDialError(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.NotFoundHandler())
+	url := srv.URL
+	srv.Close()
+
+	c, err := client.NewClient(url, client.WithRetry(3, func(int) time.Duration { return time.Millisecond }))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	// Even a non-idempotent call is retried when the connection could not be established.
+	_, err = c.SendTask(t.Context(), a2a.SendTaskRequest{Params: a2a.TaskSendParams{
+		TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+		Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "hi"}}},
+	}})
+	if err == nil || !strings.Contains(err.Error(), "after 3 attempts") {
+		t.Errorf("SendTask() error = %v, want it to report 3 attempts", err)
+	}
+}
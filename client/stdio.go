@@ -0,0 +1,407 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/go-a2a/a2a"
+)
+
+// ErrStdioClosed is returned by the requests of a [NewStdioClient] client once the agent
+// process closed its stdout or the client was closed.
+var ErrStdioClosed = errors.New("stdio transport closed")
+
+// NewStdioClient starts cmd, an agent serving A2A on its stdin and stdout as with
+// server.Server.ServeStdio, and returns the [Client] talking to it over them.
+//
+// Requests are written to the stdin of the process as newline-delimited JSON-RPC messages, and
+// their responses are read from its stdout. Each request gets an ID of its own, so that calls
+// about a task, such as tasks/cancel, can be made while its stream is open. The events of a streaming method are responses
+// bearing the ID of its request, up to the one whose result is an [a2a.StreamEnd]. The agent
+// card cannot be fetched over stdio; set it with [WithAgentCard] if needed.
+//
+// It accepts the options of [NewClient], except those about the HTTP client. cmd must not have
+// its Stdin or Stdout set, nor be started; its Stderr is left as set. [Client.Close] stops the
+// process.
+func NewStdioClient(cmd *exec.Cmd, opts ...Option) (*Client, error) {
+	c, err := NewClient("stdio://"+filepath.Base(cmd.Path), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("stdio: %w", err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("stdio: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("stdio: start agent: %w", err)
+	}
+
+	t := &stdioTransport{
+		cmd:    cmd,
+		stdin:  stdin,
+		codec:  c.codec,
+		logger: c.logger,
+		calls:  make(map[string]*stdioCall),
+		done:   make(chan struct{}),
+	}
+	go t.readLoop(stdout)
+
+	c.httpClient = &http.Client{Transport: t}
+	c.closer = t
+	// Calls about the same task, such as canceling a task while streaming it, are in flight
+	// together on the connection.
+	c.uniqueRequestIDs = true
+	return c, nil
+}
+
+// stdioTransport is the [http.RoundTripper] carrying the JSON-RPC calls of a [Client] over the
+// stdin and stdout of an agent process.
+type stdioTransport struct {
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	codec  a2a.Codec
+	logger *slog.Logger
+
+	// writeMu serializes the requests written to stdin.
+	writeMu sync.Mutex
+
+	// calls holds the calls waiting for responses, by request ID.
+	mu    sync.Mutex
+	calls map[string]*stdioCall
+
+	// done is closed once stdout is closed, err telling why.
+	done chan struct{}
+	err  error
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+var _ http.RoundTripper = (*stdioTransport)(nil)
+
+// stdioCall is a call waiting for its responses.
+type stdioCall struct {
+	// responses receives the responses to the call, in order.
+	responses chan []byte
+
+	// stream is set for streaming methods, whose events are delivered until the stream ends.
+	stream bool
+
+	// gone is closed once the caller stopped reading the responses.
+	gone     chan struct{}
+	goneOnce sync.Once
+}
+
+// leave marks the call as no longer read.
+func (call *stdioCall) leave() {
+	call.goneOnce.Do(func() { close(call.gone) })
+}
+
+// RoundTrip implements [http.RoundTripper], writing the JSON-RPC request or batch carried by req
+// as a line to the agent, and answering with its response, or the event stream it starts.
+func (t *stdioTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		defer req.Body.Close()
+	}
+	if req.Method != http.MethodPost || req.Body == nil {
+		return nil, fmt.Errorf("stdio: only JSON-RPC calls are supported, not %s %s", req.Method, req.URL.Path)
+	}
+	if mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type")); mediaType != "application/json" {
+		return nil, fmt.Errorf("stdio: unsupported request content type %q", mediaType)
+	}
+
+	var body io.Reader = req.Body
+	if strings.EqualFold(req.Header.Get("Content-Encoding"), "gzip") {
+		zr, err := gzip.NewReader(req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("stdio: decompress request body: %w", err)
+		}
+		body = zr
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("stdio: read request body: %w", err)
+	}
+	line, err := stdioLine(data)
+	if err != nil {
+		return nil, err
+	}
+
+	id, ok, err := t.requestID(data)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		// Notifications get no response.
+		if err := t.write(line); err != nil {
+			return nil, err
+		}
+		return stdioResponse(req, http.StatusNoContent, "", http.NoBody), nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Accept"))
+	call := &stdioCall{
+		responses: make(chan []byte, 1),
+		stream:    mediaType == "text/event-stream",
+		gone:      make(chan struct{}),
+	}
+	select {
+	case <-t.done:
+		// The stream of an earlier call may not have released its ID yet.
+		return nil, t.err
+	default:
+	}
+	t.mu.Lock()
+	if _, dup := t.calls[id]; dup {
+		t.mu.Unlock()
+		return nil, fmt.Errorf("stdio: %w: %s", ErrRequestIDInUse, id)
+	}
+	t.calls[id] = call
+	t.mu.Unlock()
+
+	if err := t.write(line); err != nil {
+		t.forget(id, call)
+		return nil, err
+	}
+
+	var first []byte
+	select {
+	case first = <-call.responses:
+	case <-t.done:
+		t.forget(id, call)
+		return nil, t.err
+	case <-req.Context().Done():
+		t.forget(id, call)
+		return nil, req.Context().Err()
+	}
+
+	if !call.stream || t.isError(first) {
+		// A streaming call rejected before streaming starts is answered with a plain error.
+		t.forget(id, call)
+		return stdioResponse(req, http.StatusOK, "application/json", io.NopCloser(bytes.NewReader(first))), nil
+	}
+
+	pr, pw := io.Pipe()
+	go t.relayStream(req, id, call, first, pw)
+	return stdioResponse(req, http.StatusOK, "text/event-stream", &stdioStreamBody{PipeReader: pr, call: call}), nil
+}
+
+// relayStream writes the events of a streaming call as server-sent events to pw, until the
+// stream ends or the caller stops reading it.
+func (t *stdioTransport) relayStream(req *http.Request, id string, call *stdioCall, first []byte, pw *io.PipeWriter) {
+	defer t.forget(id, call)
+
+	data := first
+	for {
+		if t.isStreamEnd(data) {
+			pw.Close()
+			return
+		}
+		if _, err := fmt.Fprintf(pw, "data: %s\n\n", data); err != nil {
+			return
+		}
+
+		select {
+		case data = <-call.responses:
+		case <-t.done:
+			pw.CloseWithError(t.err)
+			return
+		case <-call.gone:
+			return
+		case <-req.Context().Done():
+			pw.CloseWithError(req.Context().Err())
+			return
+		}
+	}
+}
+
+// stdioStreamBody is the body of an event stream relayed from the agent.
+type stdioStreamBody struct {
+	*io.PipeReader
+
+	call *stdioCall
+}
+
+// Close implements [io.Closer], dropping the events still to come.
+func (b *stdioStreamBody) Close() error {
+	b.call.leave()
+	return b.PipeReader.Close()
+}
+
+// stdioResponse returns the response to req with status, carrying body of contentType.
+func stdioResponse(req *http.Request, status int, contentType string, body io.ReadCloser) *http.Response {
+	resp := &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        make(http.Header),
+		Body:          body,
+		ContentLength: -1,
+		Request:       req,
+	}
+	if contentType != "" {
+		resp.Header.Set("Content-Type", contentType)
+	}
+	return resp
+}
+
+// requestID returns the ID the responses to the request or batch in data carry, the one of its
+// first request expecting a response, and whether there is one.
+func (t *stdioTransport) requestID(data []byte) (string, bool, error) {
+	type request struct {
+		ID *a2a.ID `json:"id"`
+	}
+	var requests []request
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := t.codec.Unmarshal(trimmed, &requests); err != nil {
+			return "", false, fmt.Errorf("stdio: parse batch: %w", err)
+		}
+	} else {
+		var r request
+		if err := t.codec.Unmarshal(data, &r); err != nil {
+			return "", false, fmt.Errorf("stdio: parse request: %w", err)
+		}
+		requests = append(requests, r)
+	}
+	for _, r := range requests {
+		if r.ID != nil && !r.ID.IsNull() {
+			return r.ID.String(), true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// write writes line to the stdin of the agent.
+func (t *stdioTransport) write(line []byte) error {
+	select {
+	case <-t.done:
+		return t.err
+	default:
+	}
+
+	t.writeMu.Lock()
+	defer t.writeMu.Unlock()
+	if _, err := t.stdin.Write(line); err != nil {
+		return fmt.Errorf("stdio: write request: %w", err)
+	}
+	return nil
+}
+
+// forget removes call from the calls waiting for responses.
+func (t *stdioTransport) forget(id string, call *stdioCall) {
+	call.leave()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.calls[id] == call {
+		delete(t.calls, id)
+	}
+}
+
+// readLoop delivers the responses read from the stdout of the agent to their calls, until it
+// is closed.
+func (t *stdioTransport) readLoop(stdout io.Reader) {
+	br := bufio.NewReader(stdout)
+	for {
+		line, err := br.ReadBytes('\n')
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			t.deliver(line)
+		}
+		if err != nil {
+			t.err = ErrStdioClosed
+			if !errors.Is(err, io.EOF) {
+				t.err = fmt.Errorf("%w: read response: %w", ErrStdioClosed, err)
+			}
+			close(t.done)
+			return
+		}
+	}
+}
+
+// deliver hands the response or batch of responses in line to the call it answers.
+func (t *stdioTransport) deliver(line []byte) {
+	id, ok, err := t.requestID(line)
+	if err != nil || !ok {
+		t.logger.Warn("ignore stdio message", slog.Any("error", err), slog.String("id", id))
+		return
+	}
+
+	t.mu.Lock()
+	call := t.calls[id]
+	t.mu.Unlock()
+	if call == nil {
+		t.logger.Debug("ignore stdio response to no pending call", slog.String("id", id))
+		return
+	}
+
+	// Reading stops while a call is slow to take its responses, which keeps them in order.
+	select {
+	case call.responses <- line:
+	case <-call.gone:
+	}
+}
+
+// isError reports whether data is a JSON-RPC response carrying an error.
+func (t *stdioTransport) isError(data []byte) bool {
+	var resp struct {
+		Error *a2a.JSONRPCError `json:"error"`
+	}
+	return t.codec.Unmarshal(data, &resp) == nil && resp.Error != nil
+}
+
+// isStreamEnd reports whether data is the response ending a stream.
+func (t *stdioTransport) isStreamEnd(data []byte) bool {
+	var resp struct {
+		Result *a2a.StreamEnd `json:"result"`
+	}
+	return t.codec.Unmarshal(data, &resp) == nil && resp.Result != nil && resp.Result.StreamEnd
+}
+
+// Close implements [io.Closer], closing the stdin of the agent and waiting for it to exit.
+func (t *stdioTransport) Close() error {
+	t.closeOnce.Do(func() {
+		t.writeMu.Lock()
+		err := t.stdin.Close()
+		t.writeMu.Unlock()
+		<-t.done
+		if werr := t.cmd.Wait(); werr != nil {
+			err = werr
+		}
+		t.closeErr = err
+	})
+	return t.closeErr
+}
+
+// stdioLine returns the JSON-RPC message data as a single line, terminated by a newline.
+func stdioLine(data []byte) ([]byte, error) {
+	data = bytes.TrimSpace(data)
+	if bytes.ContainsAny(data, "\r\n") {
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, data); err != nil {
+			return nil, fmt.Errorf("stdio: compact request: %w", err)
+		}
+		data = buf.Bytes()
+	}
+	return append(data[:len(data):len(data)], '\n'), nil
+}
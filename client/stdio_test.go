@@ -0,0 +1,168 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+	"github.com/go-a2a/a2a/server"
+)
+
+// stdioAgentEnv makes the test binary run as the agent of [TestNewStdioClient] instead of the
+// tests, or as a [waitingTaskManager] agent if set to "waiting".
+const stdioAgentEnv = "A2A_TEST_STDIO_AGENT"
+
+func TestMain(m *testing.M) {
+	if agent := os.Getenv(stdioAgentEnv); agent != "" {
+		card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+		var tm server.TaskManager = &compressingTaskManager{InMemoryTaskManager: server.NewInMemoryTaskManager()}
+		if agent == "waiting" {
+			tm = &waitingTaskManager{InMemoryTaskManager: server.NewInMemoryTaskManager()}
+		}
+		if err := server.NewServer("", "", card, tm).ServeStdio(context.Background(), os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+func TestNewStdioClient(t *testing.T) {
+	t.Parallel()
+
+	cmd := exec.Command(os.Args[0])
+	cmd.Env = append(os.Environ(), stdioAgentEnv+"=1")
+	cmd.Stderr = os.Stderr
+	c, err := client.NewStdioClient(cmd)
+	if err != nil {
+		t.Fatalf("NewStdioClient() error = %v", err)
+	}
+
+	params := a2a.TaskSendParams{
+		TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+		Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "hi"}}},
+	}
+	task, err := c.SendTask(t.Context(), a2a.SendTaskRequest{Params: params})
+	if err != nil {
+		t.Fatalf("SendTask() error = %v", err)
+	}
+	if task.ID != "task-1" {
+		t.Errorf("SendTask() task ID = %q, want task-1", task.ID)
+	}
+
+	_, err = c.GetTask(t.Context(), &a2a.GetTaskRequest{Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "missing"}}})
+	if !errors.Is(err, a2a.ErrTaskNotFound) {
+		t.Errorf("GetTask() error = %v, want %v", err, a2a.ErrTaskNotFound)
+	}
+
+	params.ID = "task-2"
+	updates, err := c.SendSubscribe(t.Context(), a2a.NewSendTaskStreamingRequest(a2a.NewID("task-2"), params))
+	if err != nil {
+		t.Fatalf("SendSubscribe() error = %v", err)
+	}
+	var (
+		text  strings.Builder
+		final bool
+	)
+	for update := range updates {
+		if update.Err != nil {
+			t.Fatalf("update error = %v", update.Err)
+		}
+		if update.Status != nil {
+			final = update.Status.Final
+		}
+		if update.Artifact != nil {
+			for _, part := range update.Artifact.Artifact.Parts {
+				text.WriteString(part.(*a2a.TextPart).Text)
+			}
+		}
+	}
+	if !final {
+		t.Error("stream ended without a final status")
+	}
+	if want := "plain " + strings.Repeat("compressed ", 100); text.String() != want {
+		t.Errorf("artifact text = %q, want %q", text.String(), want)
+	}
+
+	if err := c.Close(); err != nil {
+		t.Errorf("Close() error = %v", err)
+	}
+	if _, err := c.SendTask(t.Context(), a2a.SendTaskRequest{Params: params}); !errors.Is(err, client.ErrStdioClosed) {
+		t.Errorf("SendTask() after Close() error = %v, want %v", err, client.ErrStdioClosed)
+	}
+}
+
+// waitingTaskManager stores its tasks working and streams their status, then waits for them
+// to be canceled.
+type waitingTaskManager struct {
+	*server.InMemoryTaskManager
+}
+
+func (tm *waitingTaskManager) OnSendTaskStream(ctx context.Context, req *a2a.SendTaskStreamingRequest, w server.StreamWriter) error {
+	task := &a2a.Task{ID: req.Params.ID, Status: a2a.TaskStatus{State: a2a.TaskStateWorking}}
+	if _, err := tm.TaskStore().Create(ctx, task); err != nil {
+		return err
+	}
+	if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking}); err != nil {
+		return err
+	}
+	<-ctx.Done()
+	return w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateCanceled})
+}
+
+func TestNewStdioClient_CancelWhileStreaming(t *testing.T) {
+	t.Parallel()
+
+	cmd := exec.Command(os.Args[0])
+	cmd.Env = append(os.Environ(), stdioAgentEnv+"=waiting")
+	cmd.Stderr = os.Stderr
+	c, err := client.NewStdioClient(cmd)
+	if err != nil {
+		t.Fatalf("NewStdioClient() error = %v", err)
+	}
+	t.Cleanup(func() { c.Close() })
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	defer cancel()
+	updates, err := c.SendSubscribe(ctx, a2a.NewSendTaskStreamingRequest(a2a.NewID("task-1"), a2a.TaskSendParams{
+		TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+		Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{a2a.NewTextPart("hi")}},
+	}))
+	if err != nil {
+		t.Fatalf("SendSubscribe() error = %v", err)
+	}
+	if update := <-updates; update.Status == nil || update.Status.Status.State != a2a.TaskStateWorking {
+		t.Fatalf("first update = %+v, want the task working", update)
+	}
+
+	// The stream of the task is still open on the connection.
+	task, err := c.CancelTask(ctx, a2a.NewCancelTaskRequest(a2a.NewID("task-1"), a2a.TaskIDParams{ID: "task-1"}))
+	if err != nil {
+		t.Fatalf("CancelTask() error = %v", err)
+	}
+	if task.Status.State != a2a.TaskStateCanceled {
+		t.Errorf("CancelTask() state = %s, want %s", task.Status.State, a2a.TaskStateCanceled)
+	}
+
+	var last client.TaskUpdateEvent
+	for update := range updates {
+		if update.Err != nil {
+			t.Fatalf("update error = %v", update.Err)
+		}
+		last = update
+	}
+	if last.Status == nil || !last.Status.Final || last.Status.Status.State != a2a.TaskStateCanceled {
+		t.Errorf("last update = %+v, want the final canceled status", last)
+	}
+}
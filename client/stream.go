@@ -0,0 +1,497 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/go-a2a/a2a"
+)
+
+const (
+	// maxEventSize is the largest single server-sent event line the client accepts.
+	maxEventSize = 4 << 20
+
+	// maxLoggedFrame is the length the data of a malformed event is truncated to when logged.
+	maxLoggedFrame = 512
+)
+
+var (
+	// ErrStreamIdle is the cause reported when a stream receives nothing, not even a keepalive,
+	// within the idle timeout set by [WithStreamIdleTimeout].
+	ErrStreamIdle = errors.New("stream idle timeout")
+
+	// ErrMalformedEvent is wrapped by the error of a server-sent event that could not be decoded.
+	ErrMalformedEvent = errors.New("malformed stream event")
+)
+
+// StreamErrorMode is how a stream handles a server-sent event that cannot be decoded, see
+// [WithStreamErrorMode].
+type StreamErrorMode int
+
+const (
+	// FailFast ends the stream with an error event wrapping [ErrMalformedEvent]. It is the default.
+	FailFast StreamErrorMode = iota
+
+	// SkipBad reports the event as an error event wrapping [ErrMalformedEvent], with Skipped
+	// set, and goes on with the stream.
+	SkipBad
+)
+
+// String implements [fmt.Stringer].
+func (m StreamErrorMode) String() string {
+	switch m {
+	case FailFast:
+		return "FailFast"
+	case SkipBad:
+		return "SkipBad"
+	default:
+		return fmt.Sprintf("StreamErrorMode(%d)", int(m))
+	}
+}
+
+// TaskUpdateEvent is a single update delivered by [Client.SendSubscribe].
+//
+// Exactly one of Status, Artifact, Reconnect or Err is set. An event carrying Err is the last
+// one, unless it has Skipped set.
+type TaskUpdateEvent struct {
+	// Status is set for a task status update. For an update carrying an
+	// [a2a.MessageDelta], the client sets the status message to the message streamed so far,
+	// so that consumers get the growing message without accumulating the deltas themselves.
+	Status *a2a.TaskStatusUpdateEvent
+
+	// Artifact is set for a task artifact update.
+	Artifact *a2a.TaskArtifactUpdateEvent
+
+	// Reconnect is set on an informational event announcing that a stream opened by
+	// [Client.StreamResilient] dropped and is being reconnected.
+	Reconnect *StreamReconnect
+
+	// Err is set when the stream failed, for example because an event could not be parsed,
+	// the server returned a JSON-RPC error, or the stream went idle.
+	Err error
+
+	// Skipped is set on an error event reporting a malformed event the stream skipped, in
+	// [SkipBad] mode. The stream goes on after it.
+	Skipped bool
+
+	// EventID is the server-sent event ID of the update, if the server assigned one.
+	// Pass the last one received to [Client.Resubscribe] to resume a dropped stream.
+	EventID string
+}
+
+// Event returns the status or artifact update carried by e, or nil for an error or
+// reconnection event.
+func (e TaskUpdateEvent) Event() a2a.TaskEvent {
+	switch {
+	case e.Status != nil:
+		return e.Status
+	case e.Artifact != nil:
+		return e.Artifact
+	default:
+		return nil
+	}
+}
+
+// SendSubscribe sends a task and subscribes to its updates over server-sent events.
+//
+// Updates are delivered until the task reaches a final state, the server ends the stream,
+// or ctx is canceled, after which the channel is closed. Canceling ctx tears down the
+// underlying connection. A stream failure is reported as a final event with Err set.
+//
+// When req.Params.EventTypes is set, only the status or artifact updates it lists are
+// received, along with the final status update.
+func (c *Client) SendSubscribe(ctx context.Context, req *a2a.SendTaskStreamingRequest) (<-chan TaskUpdateEvent, error) {
+	ctx, span := c.tracer.Start(ctx, "client.SendSubscribe")
+	defer span.End()
+
+	taskID := req.Params.ID
+	span.SetAttributes(attribute.String("a2a.task_id", taskID))
+
+	c.invalidateTask(taskID)
+
+	return c.openStream(ctx, a2a.MethodTasksSendSubscribe, taskID, req.Params, "")
+}
+
+// Resubscribe subscribes again to the updates of a task whose stream was interrupted.
+//
+// lastEventID is the [TaskUpdateEvent.EventID] of the last update received, sent as the
+// Last-Event-ID header so that a server keeping the events of its streams replays the
+// ones missed before following the task; see server.WithEventReplay. An empty lastEventID
+// asks for every update the server still has. Updates are delivered as by [Client.SendSubscribe],
+// filtered by req.Params.EventTypes in the same way.
+func (c *Client) Resubscribe(ctx context.Context, req *a2a.TaskResubscriptionRequest, lastEventID string) (<-chan TaskUpdateEvent, error) {
+	ctx, span := c.tracer.Start(ctx, "client.Resubscribe")
+	defer span.End()
+
+	taskID := req.Params.ID
+	span.SetAttributes(attribute.String("a2a.task_id", taskID))
+
+	return c.openStream(ctx, a2a.MethodTasksResubscribe, taskID, req.Params, lastEventID)
+}
+
+// SendTaskStreaming sends a task and subscribes to streaming updates.
+// It returns a channel that will receive task events as they occur.
+//
+// The channel is closed when the task reaches a final state, the server ends the stream,
+// ctx is canceled, or the stream is idle for longer than the timeout set by [WithStreamIdleTimeout].
+// Stream failures are logged; use [Client.SendSubscribe] to receive them.
+func (c *Client) SendTaskStreaming(ctx context.Context, req *a2a.SendTaskStreamingRequest) (<-chan a2a.TaskEvent, error) {
+	ctx, span := c.tracer.Start(ctx, "client.SendTaskStreaming")
+	defer span.End()
+
+	taskID := req.Params.ID
+	span.SetAttributes(attribute.String("a2a.task_id", taskID))
+
+	c.invalidateTask(taskID)
+
+	updates, err := c.openStream(ctx, a2a.MethodTasksSendSubscribe, taskID, req.Params, "")
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan a2a.TaskEvent, 10)
+	go func() {
+		defer close(events)
+
+		for update := range updates {
+			if update.Err != nil {
+				c.logger.ErrorContext(ctx, "stream failed", slog.String("task_id", taskID), slog.Any("error", update.Err))
+				continue
+			}
+			select {
+			case events <- update.Event():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// openStream issues a streaming JSON-RPC call and relays the server-sent events as task updates.
+//
+// A non-empty lastEventID is sent as the Last-Event-ID header.
+func (c *Client) openStream(ctx context.Context, method, taskID string, payload any, lastEventID string) (<-chan TaskUpdateEvent, error) {
+	streamCtx, cancel := context.WithCancelCause(ctx)
+
+	id := c.requestID(taskID)
+	var rec *a2a.JSONRPCRequest
+	if c.recorder != nil {
+		req := c.recordedRequest(method, id, payload)
+		rec = &req
+	}
+
+	req, err := c.newHTTPRequest(streamCtx, method, id, payload)
+	if err != nil {
+		cancel(err)
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	// The stream outlives any per-request timeout; its lifetime is bound by ctx and the idle watchdog.
+	httpClient := *c.httpClient
+	httpClient.Timeout = 0
+
+	resp, err := c.do(&httpClient, req)
+	if err != nil {
+		cancel(err)
+		if rec != nil {
+			c.record(*rec, nil, err)
+		}
+		c.logger.ErrorContext(ctx, "send HTTP request", slog.Any("error", err))
+		if method == a2a.MethodTasksSendSubscribe {
+			c.cancelAbandoned(ctx, taskID)
+		}
+		return nil, fmt.Errorf("send HTTP request: %w", err)
+	}
+	if err := decompressResponse(resp); err != nil {
+		cancel(err)
+		return nil, err
+	}
+
+	if body, err := c.checkStreamResponse(resp); err != nil {
+		resp.Body.Close()
+		cancel(err)
+		if rec != nil {
+			c.record(*rec, body, err)
+		}
+		c.logger.ErrorContext(ctx, "open stream", slog.Any("error", err))
+		return nil, err
+	}
+
+	updates := make(chan TaskUpdateEvent, 10)
+	go func() {
+		defer close(updates)
+		defer cancel(nil)
+		defer resp.Body.Close()
+
+		final := c.readStream(streamCtx, cancel, taskID, rec, resp.Body, updates)
+		if !final && method == a2a.MethodTasksSendSubscribe {
+			c.cancelAbandoned(ctx, taskID)
+		}
+	}()
+
+	return updates, nil
+}
+
+// checkStreamResponse reports an error unless resp carries an event stream, along with the
+// body read from resp, if any.
+//
+// Servers answer calls rejected before streaming starts with a plain JSON-RPC error response.
+func (c *Client) checkStreamResponse(resp *http.Response) ([]byte, error) {
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP request failed with status: %s", resp.Status)
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType == "text/event-stream" {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	var rpcResp a2a.JSONRPCResponse
+	if err := c.codec.Unmarshal(body, &rpcResp); err != nil {
+		return body, fmt.Errorf("failed to parse response: %w", err)
+	}
+	if err := handleRPCError(rpcResp.Error); err != nil {
+		return body, err
+	}
+	return body, fmt.Errorf("unexpected response content type %q", mediaType)
+}
+
+// readStream parses server-sent events from body and sends the decoded task updates to updates.
+//
+// Every line received, including keepalive comments, resets the idle watchdog.
+// Reading stops after a final status update or the first failure, which is sent as an error event.
+// readStream reports whether it received the final status update. Each event is recorded as a
+// response to rec, if set, see [WithRecorder].
+func (c *Client) readStream(ctx context.Context, cancel context.CancelCauseFunc, taskID string, rec *a2a.JSONRPCRequest, body io.Reader, updates chan<- TaskUpdateEvent) (final bool) {
+	if c.streamIdleTimeout > 0 {
+		idle := time.AfterFunc(c.streamIdleTimeout, func() {
+			c.logger.WarnContext(ctx, "stream idle, closing", slog.String("task_id", taskID), slog.Duration("timeout", c.streamIdleTimeout))
+			if c.onStreamIdle != nil {
+				c.onStreamIdle(taskID)
+			}
+			cancel(ErrStreamIdle)
+		})
+		defer idle.Stop()
+
+		body = &idleResetReader{r: body, reset: func() { idle.Reset(c.streamIdleTimeout) }}
+	}
+
+	send := func(update TaskUpdateEvent) bool {
+		select {
+		case updates <- update:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64<<10), maxEventSize)
+
+	// eventID is the last event ID received, which applies to the following events too.
+	var (
+		data    strings.Builder
+		eventID string
+		deltas  a2a.MessageAccumulator
+	)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		switch {
+		case line == "":
+			if data.Len() == 0 {
+				continue
+			}
+			frame := data.String()
+			data.Reset()
+			if rec != nil {
+				c.record(*rec, []byte(frame), nil)
+			}
+			update, err := c.decodeStreamEvent([]byte(frame))
+			if err == nil {
+				err = accumulateDelta(&deltas, update.Status)
+			}
+			if errors.Is(err, ErrMalformedEvent) {
+				c.logger.WarnContext(ctx, "malformed stream event",
+					slog.String("task_id", taskID),
+					slog.String("event_id", eventID),
+					slog.String("data", truncateFrame(frame)),
+					slog.Any("error", err),
+				)
+				if c.streamErrorMode == SkipBad {
+					if !send(TaskUpdateEvent{Err: err, Skipped: true, EventID: eventID}) {
+						return
+					}
+					continue
+				}
+			}
+			if err != nil {
+				send(TaskUpdateEvent{Err: err})
+				return
+			}
+			update.EventID = eventID
+			if !send(update) {
+				return
+			}
+			if update.Status != nil && update.Status.Final {
+				return true
+			}
+
+		case strings.HasPrefix(line, ":"):
+			// Comment, used by servers as a keepalive.
+
+		case strings.HasPrefix(line, "id:"):
+			eventID = strings.TrimPrefix(strings.TrimPrefix(line, "id:"), " ")
+
+		case strings.HasPrefix(line, "data:"):
+			if data.Len() > 0 {
+				data.WriteByte('\n')
+			}
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+
+	switch cause := context.Cause(ctx); {
+	case errors.Is(cause, ErrStreamIdle):
+		// The context is already canceled, so deliver the error without blocking on it.
+		select {
+		case updates <- TaskUpdateEvent{Err: ErrStreamIdle}:
+		default:
+		}
+	case ctx.Err() != nil:
+		// Canceled by the caller.
+	case scanner.Err() != nil:
+		send(TaskUpdateEvent{Err: fmt.Errorf("read stream: %w", scanner.Err())})
+	}
+	return false
+}
+
+// accumulateDelta adds the message delta carried by the status update, if any, to deltas, and
+// sets the message of the update to the message accumulated so far. A delta that does not
+// continue the message is reported as a malformed event.
+func accumulateDelta(deltas *a2a.MessageAccumulator, update *a2a.TaskStatusUpdateEvent) error {
+	if update == nil || update.Status.Delta == nil {
+		return nil
+	}
+	msg, _, err := deltas.Add(*update.Status.Delta)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrMalformedEvent, err)
+	}
+	update.Status.Message = &msg
+	return nil
+}
+
+// truncateFrame returns the data of an event, truncated for logging.
+func truncateFrame(frame string) string {
+	if len(frame) <= maxLoggedFrame {
+		return frame
+	}
+	n := maxLoggedFrame
+	for n > 0 && !utf8.RuneStart(frame[n]) {
+		n--
+	}
+	return frame[:n] + "…"
+}
+
+// idleResetReader calls reset whenever data is read from r.
+type idleResetReader struct {
+	r     io.Reader
+	reset func()
+}
+
+// Read implements [io.Reader].
+func (r *idleResetReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 {
+		r.reset()
+	}
+	return n, err
+}
+
+// streamResponse is a JSON-RPC response whose result is decoded once its event type is known.
+type streamResponse struct {
+	Result json.RawMessage   `json:"result"`
+	Error  *a2a.JSONRPCError `json:"error"`
+}
+
+// validate returns an error wrapping [a2a.ErrInvalidResponse] for a response setting both a
+// result and an error, which leaves the outcome of the request unknown. A null result next
+// to an error is tolerated, as some servers send one.
+func (r *streamResponse) validate() error {
+	if r.Error != nil && len(r.Result) > 0 && !bytes.Equal(r.Result, []byte("null")) {
+		return fmt.Errorf("%w: both result and error are set", a2a.ErrInvalidResponse)
+	}
+	return nil
+}
+
+// decodeStreamEvent decodes the JSON-RPC response carried by one server-sent event.
+func (c *Client) decodeStreamEvent(data []byte) (TaskUpdateEvent, error) {
+	var resp streamResponse
+	if err := c.codec.Unmarshal(data, &resp); err != nil {
+		return TaskUpdateEvent{}, fmt.Errorf("%w: failed to parse response: %w", ErrMalformedEvent, err)
+	}
+	if err := resp.validate(); err != nil {
+		return TaskUpdateEvent{}, err
+	}
+	if err := handleRPCError(resp.Error); err != nil {
+		return TaskUpdateEvent{}, err
+	}
+	update, err := c.decodeTaskEvent(resp.Result)
+	if err != nil {
+		return TaskUpdateEvent{}, fmt.Errorf("%w: %w", ErrMalformedEvent, err)
+	}
+	return update, nil
+}
+
+// decodeTaskEvent decodes the result of a streaming method into a status or artifact update.
+func (c *Client) decodeTaskEvent(result json.RawMessage) (TaskUpdateEvent, error) {
+	var probe struct {
+		Artifact json.RawMessage `json:"artifact"`
+	}
+	if err := c.codec.Unmarshal(result, &probe); err != nil {
+		return TaskUpdateEvent{}, fmt.Errorf("failed to parse event: %w", err)
+	}
+
+	if len(probe.Artifact) > 0 {
+		var event a2a.TaskArtifactUpdateEvent
+		if err := c.codec.Unmarshal(result, &event); err != nil {
+			return TaskUpdateEvent{}, fmt.Errorf("failed to parse artifact event: %w", err)
+		}
+		if err := event.Decompress(); err != nil {
+			return TaskUpdateEvent{}, fmt.Errorf("failed to decompress artifact event: %w", err)
+		}
+		return TaskUpdateEvent{Artifact: &event}, nil
+	}
+
+	var event a2a.TaskStatusUpdateEvent
+	if err := c.codec.Unmarshal(result, &event); err != nil {
+		return TaskUpdateEvent{}, fmt.Errorf("failed to parse status event: %w", err)
+	}
+	return TaskUpdateEvent{Status: &event}, nil
+}
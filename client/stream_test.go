@@ -0,0 +1,505 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+	"github.com/go-a2a/a2a/server"
+)
+
+const statusEvent = `data: {"jsonrpc":"2.0","id":"task-1","result":{"id":"task-1","status":{"state":"working","timestamp":"2025-01-01T00:00:00Z"}}}`
+
+func TestClient_SendTaskStreamingIdleTimeout(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		// serve writes the stream body after the first event.
+		serve      func(w http.ResponseWriter, r *http.Request, flush func())
+		wantEvents int
+		wantIdle   bool
+	}{
+		"stalled server": {
+			serve: func(w http.ResponseWriter, r *http.Request, flush func()) {
+				<-r.Context().Done()
+			},
+			wantEvents: 1,
+			wantIdle:   true,
+		},
+		"keepalives hold the stream open": {
+			serve: func(w http.ResponseWriter, r *http.Request, flush func()) {
+				for range 10 {
+					time.Sleep(20 * time.Millisecond)
+					fmt.Fprint(w, ": keepalive\n\n")
+					flush()
+				}
+				fmt.Fprintf(w, "%s\n\n", statusEvent)
+				flush()
+			},
+			wantEvents: 2,
+			wantIdle:   false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/event-stream")
+				w.WriteHeader(http.StatusOK)
+				flush := w.(http.Flusher).Flush
+
+				fmt.Fprintf(w, "%s\n\n", statusEvent)
+				flush()
+				tt.serve(w, r, flush)
+			}))
+			t.Cleanup(srv.Close)
+
+			idled := make(chan string, 1)
+			c, err := client.NewClient(srv.URL,
+				client.WithStreamIdleTimeout(100*time.Millisecond),
+				client.WithStreamIdleHandler(func(taskID string) { idled <- taskID }),
+			)
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+
+			req := a2a.NewSendTaskStreamingRequest(a2a.NewID("task-1"), a2a.TaskSendParams{
+				TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+			})
+			events, err := c.SendTaskStreaming(t.Context(), req)
+			if err != nil {
+				t.Fatalf("SendTaskStreaming() error = %v", err)
+			}
+
+			var got int
+			timeout := time.After(5 * time.Second)
+		loop:
+			for {
+				select {
+				case event, ok := <-events:
+					if !ok {
+						break loop
+					}
+					if event.TaskID() != "task-1" {
+						t.Errorf("event.TaskID() = %q, want %q", event.TaskID(), "task-1")
+					}
+					got++
+				case <-timeout:
+					t.Fatal("stream was not closed")
+				}
+			}
+
+			if got != tt.wantEvents {
+				t.Errorf("received %d events, want %d", got, tt.wantEvents)
+			}
+
+			select {
+			case taskID := <-idled:
+				if !tt.wantIdle {
+					t.Errorf("idle handler called for %q, want no call", taskID)
+				}
+			default:
+				if tt.wantIdle {
+					t.Error("idle handler was not called")
+				}
+			}
+		})
+	}
+}
+
+func TestClient_SendSubscribe(t *testing.T) {
+	t.Parallel()
+
+	const (
+		artifactEvent = `data: {"jsonrpc":"2.0","id":"task-1","result":{"id":"task-1","artifact":{"parts":[{"type":"text","text":"done"}],"lastChunk":true}}}`
+		finalEvent    = `data: {"jsonrpc":"2.0","id":"task-1","result":{"id":"task-1","status":{"state":"completed","timestamp":"2025-01-01T00:00:00Z"},"final":true}}`
+		errorEvent    = `data: {"jsonrpc":"2.0","id":"task-1","error":{"code":-32603,"message":"boom"}}`
+	)
+
+	tests := map[string]struct {
+		body    []string
+		want    []string
+		wantErr bool
+	}{
+		"closes after final status": {
+			body: []string{statusEvent, artifactEvent, finalEvent},
+			want: []string{"status", "artifact", "status"},
+		},
+		"parse error ends with error event": {
+			body:    []string{statusEvent, `data: {"jsonrpc":`},
+			want:    []string{"status"},
+			wantErr: true,
+		},
+		"rpc error ends with error event": {
+			body:    []string{errorEvent},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Accept"); got != "text/event-stream" {
+					t.Errorf("Accept = %q, want %q", got, "text/event-stream")
+				}
+				w.Header().Set("Content-Type", "text/event-stream")
+				w.WriteHeader(http.StatusOK)
+				for _, event := range tt.body {
+					fmt.Fprintf(w, "%s\n\n", event)
+				}
+				w.(http.Flusher).Flush()
+
+				// Hold the connection open; the client must stop on its own.
+				<-r.Context().Done()
+			}))
+			t.Cleanup(srv.Close)
+
+			c, err := client.NewClient(srv.URL)
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+
+			req := a2a.NewSendTaskStreamingRequest(a2a.NewID("task-1"), a2a.TaskSendParams{
+				TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+			})
+			updates, err := c.SendSubscribe(t.Context(), req)
+			if err != nil {
+				t.Fatalf("SendSubscribe() error = %v", err)
+			}
+
+			var (
+				got    []string
+				gotErr error
+			)
+			timeout := time.After(5 * time.Second)
+		loop:
+			for {
+				select {
+				case update, ok := <-updates:
+					if !ok {
+						break loop
+					}
+					switch {
+					case update.Err != nil:
+						gotErr = update.Err
+					case update.Status != nil:
+						got = append(got, "status")
+					case update.Artifact != nil:
+						got = append(got, "artifact")
+					}
+				case <-timeout:
+					t.Fatal("stream was not closed")
+				}
+			}
+
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("updates mismatch (-want +got):\n%s", diff)
+			}
+			if (gotErr != nil) != tt.wantErr {
+				t.Errorf("error event = %v, wantErr %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWithStreamErrorMode(t *testing.T) {
+	t.Parallel()
+
+	const finalEvent = `data: {"jsonrpc":"2.0","id":"task-1","result":{"id":"task-1","status":{"state":"completed","timestamp":"2025-01-01T00:00:00Z"},"final":true}}`
+
+	tests := map[string]struct {
+		opts []client.Option
+		body []string
+		want []string
+	}{
+		"fail fast by default": {
+			body: []string{statusEvent, `data: ping`, finalEvent},
+			want: []string{"status", "error"},
+		},
+		"fail fast": {
+			opts: []client.Option{client.WithStreamErrorMode(client.FailFast)},
+			body: []string{statusEvent, `data: {"jsonrpc":`, finalEvent},
+			want: []string{"status", "error"},
+		},
+		"skip bad": {
+			opts: []client.Option{client.WithStreamErrorMode(client.SkipBad)},
+			body: []string{statusEvent, `data: ping`, `data: {"jsonrpc":"2.0","id":"task-1","result":{"status":"bad"}}`, finalEvent},
+			want: []string{"status", "skipped", "skipped", "status"},
+		},
+		"skip bad keeps rpc errors fatal": {
+			opts: []client.Option{client.WithStreamErrorMode(client.SkipBad)},
+			body: []string{statusEvent, `data: {"jsonrpc":"2.0","id":"task-1","error":{"code":-32603,"message":"boom"}}`, finalEvent},
+			want: []string{"status", "error"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/event-stream")
+				w.WriteHeader(http.StatusOK)
+				for _, event := range tt.body {
+					fmt.Fprintf(w, "%s\n\n", event)
+				}
+				w.(http.Flusher).Flush()
+
+				<-r.Context().Done()
+			}))
+			t.Cleanup(srv.Close)
+
+			c, err := client.NewClient(srv.URL, tt.opts...)
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+			req := a2a.NewSendTaskStreamingRequest(a2a.NewID("task-1"), a2a.TaskSendParams{
+				TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+			})
+			updates, err := c.SendSubscribe(t.Context(), req)
+			if err != nil {
+				t.Fatalf("SendSubscribe() error = %v", err)
+			}
+
+			var got []string
+			for update := range updates {
+				switch {
+				case update.Skipped:
+					if !errors.Is(update.Err, client.ErrMalformedEvent) {
+						t.Errorf("skipped event error = %v, want %v", update.Err, client.ErrMalformedEvent)
+					}
+					got = append(got, "skipped")
+				case update.Err != nil:
+					got = append(got, "error")
+				case update.Status != nil:
+					got = append(got, "status")
+				}
+			}
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("updates mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// compressingTaskManager streams one plain and one compressed artifact chunk.
+type compressingTaskManager struct {
+	*server.InMemoryTaskManager
+}
+
+func (tm *compressingTaskManager) OnSendTaskStream(ctx context.Context, req *a2a.SendTaskStreamingRequest, w server.StreamWriter) error {
+	if err := w.SendArtifact(a2a.Artifact{Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "plain "}}}); err != nil {
+		return err
+	}
+	err := w.SendCompressedArtifact(a2a.Artifact{
+		Parts:     []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: strings.Repeat("compressed ", 100)}},
+		Append:    true,
+		LastChunk: true,
+	})
+	if err != nil {
+		return err
+	}
+	return w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateCompleted})
+}
+
+func TestClient_SendSubscribeCompressedArtifact(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	tm := &compressingTaskManager{InMemoryTaskManager: server.NewInMemoryTaskManager()}
+	srv := httptest.NewServer(server.NewServer("", "", card, tm))
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClient(srv.URL)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	req := a2a.NewSendTaskStreamingRequest(a2a.NewID("task-1"), a2a.TaskSendParams{
+		TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+		Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "hi"}}},
+	})
+	updates, err := c.SendSubscribe(t.Context(), req)
+	if err != nil {
+		t.Fatalf("SendSubscribe() error = %v", err)
+	}
+
+	var text strings.Builder
+	for update := range updates {
+		if update.Err != nil {
+			t.Fatalf("update error = %v", update.Err)
+		}
+		if update.Artifact == nil {
+			continue
+		}
+		if update.Artifact.IsCompressed() {
+			t.Error("client delivered a compressed artifact update")
+		}
+		for _, part := range update.Artifact.Artifact.Parts {
+			text.WriteString(part.(*a2a.TextPart).Text)
+		}
+	}
+
+	if want := "plain " + strings.Repeat("compressed ", 100); text.String() != want {
+		t.Errorf("artifact text = %q, want %q", text.String(), want)
+	}
+}
+
+// deltaTaskManager streams an agent message token by token.
+type deltaTaskManager struct {
+	*server.InMemoryTaskManager
+}
+
+func (tm *deltaTaskManager) OnSendTaskStream(ctx context.Context, req *a2a.SendTaskStreamingRequest, w server.StreamWriter) error {
+	deltas := []a2a.MessageDelta{
+		{Text: "Hel"},
+		{Text: "lo"},
+		{PartIndex: 1, Text: "wor"},
+		{PartIndex: 1, Text: "ld", Last: true},
+	}
+	for _, delta := range deltas {
+		if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking, Delta: &delta}); err != nil {
+			return err
+		}
+	}
+	return w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateCompleted})
+}
+
+func TestClient_SendSubscribeMessageDelta(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	tm := &deltaTaskManager{InMemoryTaskManager: server.NewInMemoryTaskManager()}
+	// Coalescing frames must not drop deltas.
+	srv := httptest.NewServer(server.NewServer("", "", card, tm, server.WithMaxFrameRate(20)))
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClient(srv.URL)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	req := a2a.NewSendTaskStreamingRequest(a2a.NewID("task-1"), a2a.TaskSendParams{
+		TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+		Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "hi"}}},
+	})
+	updates, err := c.SendSubscribe(t.Context(), req)
+	if err != nil {
+		t.Fatalf("SendSubscribe() error = %v", err)
+	}
+
+	var got []string
+	for update := range updates {
+		if update.Err != nil {
+			t.Fatalf("update error = %v", update.Err)
+		}
+		if update.Status == nil || update.Status.Status.Delta == nil {
+			continue
+		}
+		var texts []string
+		for _, part := range update.Status.Status.Message.Parts {
+			texts = append(texts, part.(*a2a.TextPart).Text)
+		}
+		got = append(got, strings.Join(texts, "|"))
+	}
+
+	want := []string{"Hel", "Hello", "Hello|wor", "Hello|world"}
+	if diff := gocmp.Diff(want, got); diff != "" {
+		t.Errorf("accumulated messages mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// droppingTaskManager streams two updates, waits for the client to drop the stream, then
+// finishes the task.
+type droppingTaskManager struct {
+	*server.InMemoryTaskManager
+}
+
+func (tm *droppingTaskManager) OnSendTaskStream(ctx context.Context, req *a2a.SendTaskStreamingRequest, w server.StreamWriter) error {
+	chunk := func(text string) a2a.Artifact {
+		return a2a.Artifact{Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: text}}, Append: true}
+	}
+	if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking}); err != nil {
+		return err
+	}
+	if err := w.SendArtifact(chunk("one ")); err != nil {
+		return err
+	}
+	<-ctx.Done()
+
+	// The client is gone, but the events are kept for it to resume.
+	w.SendArtifact(chunk("two"))
+	w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateCompleted})
+	return nil
+}
+
+func TestClient_Resubscribe(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	tm := &droppingTaskManager{InMemoryTaskManager: server.NewInMemoryTaskManager()}
+	srv := httptest.NewServer(server.NewServer("", "", card, tm, server.WithEventReplay(16)))
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClient(srv.URL)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	// describe summarizes an update as its event ID and content.
+	describe := func(update client.TaskUpdateEvent) string {
+		switch {
+		case update.Err != nil:
+			t.Fatalf("update error = %v", update.Err)
+		case update.Status != nil:
+			return update.EventID + " " + string(update.Status.Status.State)
+		}
+		return update.EventID + " " + update.Artifact.Artifact.Parts[0].(*a2a.TextPart).Text
+	}
+
+	ctx, drop := context.WithCancel(t.Context())
+	defer drop()
+	updates, err := c.SendSubscribe(ctx, &a2a.SendTaskStreamingRequest{Params: a2a.TaskSendParams{
+		TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+		Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "hi"}}},
+	}})
+	if err != nil {
+		t.Fatalf("SendSubscribe() error = %v", err)
+	}
+	var got []string
+	var lastEventID string
+	for update := range updates {
+		got = append(got, describe(update))
+		lastEventID = update.EventID
+		if len(got) == 2 {
+			drop()
+			break
+		}
+	}
+
+	resumed, err := c.Resubscribe(t.Context(), &a2a.TaskResubscriptionRequest{Params: a2a.TaskResubscriptionParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}}}, lastEventID)
+	if err != nil {
+		t.Fatalf("Resubscribe() error = %v", err)
+	}
+	for update := range resumed {
+		got = append(got, describe(update))
+	}
+
+	want := []string{"1 working", "2 one ", "3 two", "4 completed"}
+	if diff := gocmp.Diff(want, got); diff != "" {
+		t.Errorf("updates mismatch (-want +got):\n%s", diff)
+	}
+}
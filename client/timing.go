@@ -0,0 +1,98 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ServerTiming is a single metric reported in a Server-Timing response header.
+type ServerTiming struct {
+	// Name is the metric name, such as "handler".
+	Name string
+
+	// Duration is the reported duration.
+	Duration time.Duration
+
+	// Description is the optional human-readable description.
+	Description string
+}
+
+// ServerTimings collects the Server-Timing metrics of responses received with a context returned by [WithServerTimings].
+type ServerTimings struct {
+	mu      sync.Mutex
+	metrics []ServerTiming
+}
+
+// Metrics returns the metrics collected so far, in the order they were received.
+func (t *ServerTimings) Metrics() []ServerTiming {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]ServerTiming(nil), t.metrics...)
+}
+
+func (t *ServerTimings) add(metrics []ServerTiming) {
+	t.mu.Lock()
+	t.metrics = append(t.metrics, metrics...)
+	t.mu.Unlock()
+}
+
+type serverTimingsKey struct{}
+
+// WithServerTimings returns a context that collects the Server-Timing metrics of every response to requests made with it.
+//
+//	ctx, timings := client.WithServerTimings(ctx)
+//	task, err := c.GetTask(ctx, req)
+//	for _, m := range timings.Metrics() { ... }
+func WithServerTimings(ctx context.Context) (context.Context, *ServerTimings) {
+	t := &ServerTimings{}
+	return context.WithValue(ctx, serverTimingsKey{}, t), t
+}
+
+// recordServerTimings parses the Server-Timing header values into the collector carried by ctx, if any.
+func recordServerTimings(ctx context.Context, values []string) {
+	t, ok := ctx.Value(serverTimingsKey{}).(*ServerTimings)
+	if !ok || len(values) == 0 {
+		return
+	}
+	for _, v := range values {
+		t.add(ParseServerTiming(v))
+	}
+}
+
+// ParseServerTiming parses a Server-Timing header value such as `decode;dur=0.2, handler;dur=12.5;desc="LLM"`.
+//
+// Malformed parameters are ignored; durations are interpreted as milliseconds.
+func ParseServerTiming(header string) []ServerTiming {
+	var metrics []ServerTiming
+	for entry := range strings.SplitSeq(header, ",") {
+		params := strings.Split(entry, ";")
+		name := strings.TrimSpace(params[0])
+		if name == "" {
+			continue
+		}
+
+		metric := ServerTiming{Name: name}
+		for _, param := range params[1:] {
+			key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok {
+				continue
+			}
+			switch strings.ToLower(strings.TrimSpace(key)) {
+			case "dur":
+				if ms, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err == nil {
+					metric.Duration = time.Duration(ms * float64(time.Millisecond))
+				}
+			case "desc":
+				metric.Description = strings.Trim(strings.TrimSpace(value), `"`)
+			}
+		}
+		metrics = append(metrics, metric)
+	}
+	return metrics
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestParseServerTiming(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		header string
+		want   []client.ServerTiming
+	}{
+		"metrics": {
+			header: `decode;dur=0.2, handler;dur=12.5;desc="LLM"`,
+			want: []client.ServerTiming{
+				{Name: "decode", Duration: 200 * time.Microsecond},
+				{Name: "handler", Duration: 12500 * time.Microsecond, Description: "LLM"},
+			},
+		},
+		"no duration": {
+			header: `cache;desc=hit`,
+			want:   []client.ServerTiming{{Name: "cache", Description: "hit"}},
+		},
+		"case and spaces": {
+			header: ` store ; DUR = 3 `,
+			want:   []client.ServerTiming{{Name: "store", Duration: 3 * time.Millisecond}},
+		},
+		"malformed parameters": {
+			header: `store;dur=fast;flag, encode;dur=1`,
+			want: []client.ServerTiming{
+				{Name: "store"},
+				{Name: "encode", Duration: time.Millisecond},
+			},
+		},
+		"empty entries": {
+			header: `, ;dur=1,`,
+		},
+		"empty": {},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := gocmp.Diff(tt.want, client.ParseServerTiming(tt.header)); diff != "" {
+				t.Errorf("ParseServerTiming(%q) mismatch (-want +got):\n%s", tt.header, diff)
+			}
+		})
+	}
+}
+
+func TestWithServerTimings(t *testing.T) {
+	t.Parallel()
+
+	tm := server.NewInMemoryTaskManager()
+	if _, err := tm.TaskStore().Create(t.Context(), &a2a.Task{ID: "task-1", Status: a2a.TaskStatus{State: a2a.TaskStateCompleted}}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, tm, server.WithServerTiming(true)))
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClient(srv.URL)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx, timings := client.WithServerTimings(t.Context())
+	if _, err := c.GetTask(ctx, a2a.NewGetTaskRequest(a2a.NewID("task-1"), a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}})); err != nil {
+		t.Fatalf("GetTask() error = %v", err)
+	}
+
+	var names []string
+	for _, m := range timings.Metrics() {
+		names = append(names, m.Name)
+	}
+	want := []string{server.TimingDecode, server.TimingStore, server.TimingHandler, server.TimingEncode}
+	if diff := gocmp.Diff(want, names); diff != "" {
+		t.Errorf("metrics mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/embedded"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+	"github.com/go-a2a/a2a/server"
+)
+
+// idTracer starts spans with real span contexts, children of the span in their context.
+type idTracer struct {
+	embedded.Tracer
+
+	mu    sync.Mutex
+	spans map[string]*idSpan
+}
+
+type idSpan struct {
+	noop.Span
+
+	sc     trace.SpanContext
+	parent trace.SpanContext
+}
+
+func (s *idSpan) SpanContext() trace.SpanContext { return s.sc }
+
+func (t *idTracer) Start(ctx context.Context, name string, _ ...trace.SpanStartOption) (context.Context, trace.Span) {
+	parent := trace.SpanContextFromContext(ctx)
+	cfg := trace.SpanContextConfig{TraceID: parent.TraceID(), TraceFlags: trace.FlagsSampled}
+	if !parent.IsValid() {
+		rand.Read(cfg.TraceID[:])
+	}
+	rand.Read(cfg.SpanID[:])
+	span := &idSpan{sc: trace.NewSpanContext(cfg), parent: parent}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.spans == nil {
+		t.spans = make(map[string]*idSpan)
+	}
+	t.spans[name] = span
+	return trace.ContextWithSpan(ctx, span), span
+}
+
+func (t *idTracer) span(name string) *idSpan {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.spans[name]
+}
+
+func TestClient_WithPropagator(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		call func(ctx context.Context, c *client.Client) error
+		// clientSpan is the client span active when the request is sent.
+		clientSpan string
+		serverSpan string
+	}{
+		"unary": {
+			call: func(ctx context.Context, c *client.Client) error {
+				_, err := c.GetTask(ctx, &a2a.GetTaskRequest{Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "missing"}}})
+				if !errors.Is(err, a2a.ErrTaskNotFound) {
+					return err
+				}
+				return nil
+			},
+			clientSpan: "client.sendRequest",
+			serverSpan: "a2a.tasks/get",
+		},
+		"stream": {
+			call: func(ctx context.Context, c *client.Client) error {
+				updates, err := c.SendSubscribe(ctx, &a2a.SendTaskStreamingRequest{Params: a2a.TaskSendParams{
+					TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+					Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "hi"}}},
+				}})
+				if err != nil {
+					return err
+				}
+				for range updates {
+				}
+				return nil
+			},
+			clientSpan: "client.SendSubscribe",
+			serverSpan: "a2a.tasks/sendSubscribe",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			clientTracer, serverTracer := &idTracer{}, &idTracer{}
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			tm := &compressingTaskManager{InMemoryTaskManager: server.NewInMemoryTaskManager()}
+			srv := httptest.NewServer(server.NewServer("", "", card, tm,
+				server.WithTracer(serverTracer),
+				server.WithPropagator(propagation.TraceContext{}),
+			))
+			t.Cleanup(srv.Close)
+
+			c, err := client.NewClient(srv.URL,
+				client.WithTracer(clientTracer),
+				client.WithPropagator(propagation.TraceContext{}),
+			)
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+			if err := tt.call(t.Context(), c); err != nil {
+				t.Fatalf("call error = %v", err)
+			}
+
+			clientSpan, handlerSpan, rpcSpan := clientTracer.span(tt.clientSpan), serverTracer.span("server.requestHandler"), serverTracer.span(tt.serverSpan)
+			if clientSpan == nil || handlerSpan == nil || rpcSpan == nil {
+				t.Fatalf("missing spans: client %v, handler %v, call %v", clientSpan, handlerSpan, rpcSpan)
+			}
+			if got, want := handlerSpan.parent, clientSpan.sc; !got.Equal(want.WithRemote(true)) {
+				t.Errorf("server span parent = %v, want client span %v", got, want)
+			}
+			if got, want := rpcSpan.sc.TraceID(), clientSpan.sc.TraceID(); got != want {
+				t.Errorf("call span trace ID = %v, want client trace ID %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/go-a2a/a2a"
+)
+
+// defaultPollInterval is how often [Client.WaitForTask] polls when given no interval.
+const defaultPollInterval = time.Second
+
+// ErrTaskExpired is returned when a task passes the expiry advertised in its status without finishing.
+var ErrTaskExpired = errors.New("task expired")
+
+// InputRequiredError is returned by [Client.SendAndWait] when the task stopped in the
+// input-required state, waiting for the caller to send more input.
+type InputRequiredError struct {
+	// Task is the task waiting for input. Its status message, if any, tells what input is needed.
+	Task *a2a.Task
+}
+
+// Error implements error.
+func (e *InputRequiredError) Error() string {
+	if msg := e.Task.Status.Message; msg != nil {
+		if text := msg.Text(); text != "" {
+			return fmt.Sprintf("task %s requires input: %s", e.Task.ID, text)
+		}
+	}
+	return fmt.Sprintf("task %s requires input", e.Task.ID)
+}
+
+// WaitForTask polls the task with tasks/get every interval until it reaches a terminal or
+// input-required state, and returns it.
+//
+// If the task status advertises an [a2a.TaskStatus.Expiry], polling stops there: WaitForTask
+// checks once more at the expiry and, if the task is still running, returns it along with
+// an error wrapping [ErrTaskExpired]. A non-positive interval polls every second.
+func (c *Client) WaitForTask(ctx context.Context, taskID string, interval time.Duration) (*a2a.Task, error) {
+	ctx, span := c.tracer.Start(ctx, "client.WaitForTask")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("a2a.task_id", taskID))
+
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	for {
+		// Polling must observe the server, not a cached non-terminal task.
+		c.invalidateTask(taskID)
+
+		task, err := c.GetTask(ctx, &a2a.GetTaskRequest{
+			Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: taskID}},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("wait for task: %w", err)
+		}
+
+		state := task.Status.State
+		if state.IsTerminal() || state == a2a.TaskStateInputRequired {
+			return task, nil
+		}
+		now := time.Now()
+		if task.Status.Expired(now) {
+			return task, fmt.Errorf("%w: task %s expired at %s", ErrTaskExpired, taskID, task.Status.Expiry.Format(time.RFC3339))
+		}
+
+		wait := interval
+		if expiry := task.Status.Expiry; expiry != nil {
+			wait = min(wait, expiry.Sub(now))
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("wait for task: %w", ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// SendAndWait sends a task and waits until it reaches a terminal state, returning the final task.
+//
+// When the agent card advertises streaming, SendAndWait subscribes to the task updates and
+// builds the task from them, with its status and assembled artifacts, sorted by index, but
+// no history.
+// Otherwise, or if the stream ends early, it polls the task with tasks/get every poll
+// interval as [Client.WaitForTask] does. The card is the one given with [WithAgentCard], or
+// else fetched with [Client.GetAgentCard]; a card that cannot be fetched means polling.
+//
+// A task that stops in the input-required state is returned along with an
+// [*InputRequiredError], so the caller can send the input it asks for. An expired task is
+// returned along with an error wrapping [ErrTaskExpired].
+func (c *Client) SendAndWait(ctx context.Context, req a2a.SendTaskRequest, poll time.Duration) (*a2a.Task, error) {
+	ctx, span := c.tracer.Start(ctx, "client.SendAndWait")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("a2a.task_id", req.Params.ID))
+
+	var (
+		task *a2a.Task
+		err  error
+	)
+	if c.supportsStreaming(ctx) {
+		span.SetAttributes(attribute.Bool("a2a.streaming", true))
+		task, err = c.sendAndFollow(ctx, req)
+	} else {
+		task, err = c.SendTask(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if state := task.Status.State; !state.IsTerminal() && state != a2a.TaskStateInputRequired {
+		task, err = c.WaitForTask(ctx, task.ID, poll)
+		if err != nil {
+			return task, err
+		}
+	}
+	if task.Status.State == a2a.TaskStateInputRequired {
+		return task, &InputRequiredError{Task: task}
+	}
+	return task, nil
+}
+
+// supportsStreaming reports whether the agent card advertises streaming.
+func (c *Client) supportsStreaming(ctx context.Context) bool {
+	card := c.agentCard
+	if card == nil {
+		var err error
+		card, err = c.GetAgentCard(ctx)
+		if err != nil {
+			c.logger.DebugContext(ctx, "fetch agent card, falling back to polling", slog.Any("error", err))
+			return false
+		}
+	}
+	return card.Capabilities.Streaming
+}
+
+// sendAndFollow sends a task with tasks/sendSubscribe and builds the task from its updates.
+//
+// A stream that fails or ends before the final status update is not an error: the task is
+// returned as last seen, for the caller to keep polling.
+func (c *Client) sendAndFollow(ctx context.Context, req a2a.SendTaskRequest) (*a2a.Task, error) {
+	updates, err := c.SendSubscribe(ctx, &a2a.SendTaskStreamingRequest{JSONRPCRequest: req.JSONRPCRequest, Params: req.Params})
+	if err != nil {
+		return nil, err
+	}
+
+	task := &a2a.Task{
+		ID:     req.Params.ID,
+		Status: a2a.TaskStatus{State: a2a.TaskStateSubmitted},
+	}
+	if req.Params.SessionID != uuid.Nil {
+		task.SessionID = req.Params.SessionID.String()
+	}
+	var assembler ArtifactAssembler
+	for update := range updates {
+		switch {
+		case update.Err != nil:
+			c.logger.DebugContext(ctx, "task stream failed, falling back to polling", slog.String("task_id", task.ID), slog.Any("error", update.Err))
+		case update.Status != nil:
+			task.Status = update.Status.Status
+		case update.Artifact != nil:
+			artifact, complete, err := assembler.Add(update.Artifact.Artifact)
+			if err != nil {
+				c.logger.DebugContext(ctx, "skip artifact chunk", slog.String("task_id", task.ID), slog.Any("error", err))
+				continue
+			}
+			if complete {
+				task.Artifacts = append(task.Artifacts, artifact)
+			}
+		}
+	}
+	task.Artifacts = append(task.Artifacts, assembler.Flush()...)
+	a2a.SortArtifacts(task.Artifacts)
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("wait for task: %w", err)
+	}
+	return task, nil
+}
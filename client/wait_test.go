@@ -0,0 +1,204 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestClient_WaitForTask(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		// status returns the status served on poll n, starting at 1.
+		status    func(n int32) a2a.TaskStatus
+		interval  time.Duration
+		wantState a2a.TaskState
+		wantErr   error
+	}{
+		"completes": {
+			status: func(n int32) a2a.TaskStatus {
+				if n < 3 {
+					return a2a.TaskStatus{State: a2a.TaskStateWorking}
+				}
+				return a2a.TaskStatus{State: a2a.TaskStateCompleted}
+			},
+			interval:  10 * time.Millisecond,
+			wantState: a2a.TaskStateCompleted,
+		},
+		"expires": {
+			status: func() func(int32) a2a.TaskStatus {
+				expiry := time.Now().Add(100 * time.Millisecond)
+				return func(int32) a2a.TaskStatus {
+					return a2a.TaskStatus{State: a2a.TaskStateWorking, Expiry: &expiry}
+				}
+			}(),
+			// The interval is far longer than the test: the expiry must cut the wait short.
+			interval:  time.Hour,
+			wantState: a2a.TaskStateWorking,
+			wantErr:   client.ErrTaskExpired,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var polls atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				task := &a2a.Task{ID: "task-1", Status: tt.status(polls.Add(1))}
+				data, err := sonic.ConfigFastest.Marshal(&a2a.GetTaskResponse{
+					JSONRPCResponse: a2a.JSONRPCResponse{JSONRPCMessage: a2a.NewJSONRPCMessage(a2a.NewID("task-1"))},
+					Result:          task,
+				})
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write(data)
+			}))
+			t.Cleanup(srv.Close)
+
+			c, err := client.NewClient(srv.URL)
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			defer cancel()
+
+			task, err := c.WaitForTask(ctx, "task-1", tt.interval)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("WaitForTask() error = %v, want %v", err, tt.wantErr)
+			}
+			if task.Status.State != tt.wantState {
+				t.Errorf("WaitForTask() state = %q, want %q", task.Status.State, tt.wantState)
+			}
+		})
+	}
+}
+
+// scriptedTaskManager finishes every task in its final state, both when streamed and when polled.
+type scriptedTaskManager struct {
+	*server.InMemoryTaskManager
+	final a2a.TaskState
+}
+
+func (tm *scriptedTaskManager) status() a2a.TaskStatus {
+	status := a2a.TaskStatus{State: tm.final}
+	if tm.final == a2a.TaskStateInputRequired {
+		status.Message = &a2a.Message{Role: a2a.RoleAgent, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "which city?"}}}
+	}
+	return status
+}
+
+func (tm *scriptedTaskManager) OnGetTask(ctx context.Context, req *a2a.GetTaskRequest) (*a2a.GetTaskResponse, error) {
+	return &a2a.GetTaskResponse{
+		JSONRPCResponse: a2a.JSONRPCResponse{JSONRPCMessage: a2a.NewJSONRPCMessage(req.ID)},
+		Result: &a2a.Task{
+			ID:        req.Params.ID,
+			Status:    tm.status(),
+			Artifacts: []a2a.Artifact{{Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "polled"}}, LastChunk: true}},
+		},
+	}, nil
+}
+
+func (tm *scriptedTaskManager) OnSendTaskStream(ctx context.Context, req *a2a.SendTaskStreamingRequest, w server.StreamWriter) error {
+	if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking}); err != nil {
+		return err
+	}
+	for _, chunk := range []a2a.Artifact{
+		{Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "stream"}}},
+		{Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "ed"}}, Append: true, LastChunk: true},
+	} {
+		if err := w.SendArtifact(chunk); err != nil {
+			return err
+		}
+	}
+	return w.SendStatus(tm.status())
+}
+
+func TestClient_SendAndWait(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		streaming     bool
+		final         a2a.TaskState
+		wantArtifact  string
+		wantInputText string
+	}{
+		"polls to completion": {
+			final:        a2a.TaskStateCompleted,
+			wantArtifact: "polled",
+		},
+		"streams to completion": {
+			streaming:    true,
+			final:        a2a.TaskStateCompleted,
+			wantArtifact: "streamed",
+		},
+		"polls to input required": {
+			final:         a2a.TaskStateInputRequired,
+			wantArtifact:  "polled",
+			wantInputText: "which city?",
+		},
+		"streams to input required": {
+			streaming:     true,
+			final:         a2a.TaskStateInputRequired,
+			wantArtifact:  "streamed",
+			wantInputText: "which city?",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			tm := &scriptedTaskManager{InMemoryTaskManager: server.NewInMemoryTaskManager(), final: tt.final}
+			caps := server.WithCapabilities(a2a.AgentCapabilities{Streaming: tt.streaming})
+			srv := httptest.NewServer(server.NewServer("", "", card, tm, caps))
+			t.Cleanup(srv.Close)
+
+			c, err := client.NewClient(srv.URL)
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+
+			ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+			defer cancel()
+
+			task, err := c.SendAndWait(ctx, a2a.SendTaskRequest{Params: a2a.TaskSendParams{
+				TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+				Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "weather"}}},
+			}}, 10*time.Millisecond)
+
+			var inputErr *client.InputRequiredError
+			switch {
+			case tt.wantInputText == "" && err != nil:
+				t.Fatalf("SendAndWait() error = %v", err)
+			case tt.wantInputText != "" && !errors.As(err, &inputErr):
+				t.Fatalf("SendAndWait() error = %v, want %T", err, inputErr)
+			case inputErr != nil && inputErr.Task.Status.Message.Text() != tt.wantInputText:
+				t.Errorf("input required prompt = %q, want %q", inputErr.Task.Status.Message.Text(), tt.wantInputText)
+			}
+			if task.Status.State != tt.final {
+				t.Errorf("SendAndWait() state = %q, want %q", task.Status.State, tt.final)
+			}
+			if len(task.Artifacts) != 1 || task.Artifacts[0].JoinText("") != tt.wantArtifact {
+				t.Errorf("SendAndWait() artifacts = %v, want one with %q", task.Artifacts, tt.wantArtifact)
+			}
+		})
+	}
+}
@@ -0,0 +1,385 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/websocket"
+
+	"github.com/go-a2a/a2a"
+)
+
+// ErrWebSocketClosed is returned by [WSClient.Send] once the connection is closed.
+var ErrWebSocketClosed = errors.New("websocket closed")
+
+// ErrRequestIDInUse is returned by [WSClient.Send], and the requests of a [NewStdioClient]
+// client, for a request with the ID of a request still in flight on the connection, as the
+// responses to both could not be told apart.
+var ErrRequestIDInUse = errors.New("request ID already in flight")
+
+// NewRequestID returns a new request ID, unique to the request, such as for [WSClient.Send].
+//
+// Requests in flight together on a connection need IDs of their own, so a request about a task
+// should not reuse its task ID: tasks/cancel would then be refused while the task streams.
+func NewRequestID() a2a.ID {
+	return a2a.NewID(uuid.NewString())
+}
+
+// WSClient is a connection to the WebSocket transport of an A2A server, see [DialWebSocket].
+//
+// Requests are sent with [WSClient.Send], and their responses, along with the events of the
+// streaming methods, are received on [WSClient.Receive], in the order the server sends them.
+// Messages are matched to requests by their ID. A WSClient is safe for concurrent use.
+type WSClient struct {
+	// client holds the configuration the connection was dialed with.
+	client *Client
+
+	ws *websocket.Conn
+
+	// closeConn sends the close frame and closes the connection, once.
+	closeConn func() error
+
+	messages chan WSMessage
+
+	// pending holds the IDs of the requests in flight, until their response or the end of
+	// their stream is received, and whether they stream.
+	mu      sync.Mutex
+	pending map[string]bool
+
+	// closing is closed by [WSClient.Close], and done once the connection has ended.
+	closeOnce sync.Once
+	closing   chan struct{}
+	done      chan struct{}
+
+	// err is why the connection ended, set before done is closed.
+	err error
+}
+
+// WSMessage is a JSON-RPC response received on a [WSClient]: the response to a request, or one
+// event of a streaming method.
+type WSMessage struct {
+	// ID is the ID of the request the message answers.
+	ID a2a.ID `json:"id"`
+
+	// Result is the result of the request, nil if it failed.
+	Result json.RawMessage `json:"result,omitempty"`
+
+	// Error is the error the request failed with, if any.
+	Error *a2a.JSONRPCError `json:"error,omitempty"`
+
+	// client decodes the result.
+	client *Client
+}
+
+// Decode decodes the result of the message into v, or returns the error it carries.
+func (m WSMessage) Decode(v any) error {
+	if err := handleRPCError(m.Error); err != nil {
+		return err
+	}
+	if err := m.client.codec.Unmarshal(m.Result, v); err != nil {
+		return fmt.Errorf("failed to parse result: %w", err)
+	}
+	return nil
+}
+
+// Event decodes the message as an event of a streaming method, or returns the error it carries.
+func (m WSMessage) Event() (TaskUpdateEvent, error) {
+	if err := handleRPCError(m.Error); err != nil {
+		return TaskUpdateEvent{}, err
+	}
+	return m.client.decodeTaskEvent(m.Result)
+}
+
+// DialWebSocket connects to the WebSocket transport of the A2A server at url, as served with
+// server.WithWebSocket. An http or https url is dialed as ws or wss.
+//
+// It accepts the options of [NewClient]: the credentials, codec, propagator, logger and tracer
+// apply to the connection, the other options are ignored. The connection answers the pings of
+// the server, and ends with a close frame on [WSClient.Close].
+func DialWebSocket(ctx context.Context, url string, opts ...Option) (*WSClient, error) {
+	c, err := NewClient(url, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, span := c.tracer.Start(ctx, "client.DialWebSocket")
+	defer span.End()
+
+	config, err := c.webSocketConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ws, err := config.DialContext(ctx)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "dial websocket", slog.Any("error", err))
+		return nil, fmt.Errorf("dial websocket: %w", err)
+	}
+
+	wc := &WSClient{
+		client:    c,
+		ws:        ws,
+		closeConn: sync.OnceValue(ws.Close),
+		messages:  make(chan WSMessage),
+		pending:   make(map[string]bool),
+		closing:   make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	go wc.readLoop()
+
+	return wc, nil
+}
+
+// webSocketConfig returns the configuration to dial the server with, carrying the credentials
+// and trace context of the client.
+func (c *Client) webSocketConfig(ctx context.Context) (*websocket.Config, error) {
+	location, err := url.Parse(c.url)
+	if err != nil {
+		return nil, fmt.Errorf("parse websocket URL: %w", err)
+	}
+	origin := &url.URL{Scheme: location.Scheme, Host: location.Host}
+	switch location.Scheme {
+	case "http", "ws":
+		location.Scheme, origin.Scheme = "ws", "http"
+	case "https", "wss":
+		location.Scheme, origin.Scheme = "wss", "https"
+	default:
+		return nil, fmt.Errorf("unsupported websocket URL scheme %q", location.Scheme)
+	}
+
+	// The handshake is an HTTP request, built here so that credential providers can set
+	// its headers as usual.
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, origin.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("create handshake request: %w", err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+	c.injectTraceContext(ctx, req)
+	if c.credentials != nil {
+		if err := c.credentials.Apply(ctx, req); err != nil {
+			return nil, fmt.Errorf("apply credentials: %w", err)
+		}
+	}
+
+	config, err := websocket.NewConfig(location.String(), origin.String())
+	if err != nil {
+		return nil, fmt.Errorf("create websocket config: %w", err)
+	}
+	config.Header = req.Header
+	return config, nil
+}
+
+// Send sends the JSON-RPC request method with params and id to the server. Its response, or
+// the events it streams, are received on [WSClient.Receive] with the same ID. A request with
+// an absent ID is a notification, which gets no response.
+//
+// The ID of a request is in flight until its response, or the final event or error of its
+// stream, is received. Sending another request with it meanwhile fails with [ErrRequestIDInUse],
+// so give each request an ID of its own, such as one returned by [NewRequestID].
+func (wc *WSClient) Send(ctx context.Context, method string, id a2a.ID, params any) error {
+	c := wc.client
+	ctx, span := c.tracer.Start(ctx, "client.WSClient.Send",
+		trace.WithAttributes(
+			attribute.String("a2a.request_id", id.String()),
+			attribute.String("a2a.method", method),
+		))
+	defer span.End()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	select {
+	case <-wc.closing:
+		return ErrWebSocketClosed
+	case <-wc.done:
+		return ErrWebSocketClosed
+	default:
+	}
+
+	request := &a2a.JSONRPCRequest{
+		JSONRPCMessage: a2a.NewJSONRPCMessage(id),
+		Method:         method,
+	}
+	if params != nil {
+		data, err := c.codec.Marshal(params)
+		if err != nil {
+			return fmt.Errorf("marshal params: %w", err)
+		}
+		request.Params = data
+	}
+	data, err := c.codec.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+
+	release, err := wc.claim(id, method == a2a.MethodTasksSendSubscribe || method == a2a.MethodTasksResubscribe)
+	if err != nil {
+		return err
+	}
+	if err := websocket.Message.Send(wc.ws, string(data)); err != nil {
+		release()
+		c.logger.ErrorContext(ctx, "send websocket message", slog.Any("error", err))
+		return fmt.Errorf("send websocket message: %w", err)
+	}
+	return nil
+}
+
+// pendingKey returns the key of id in the requests in flight. Quoting tells the string ID "1"
+// from the number 1.
+func pendingKey(id a2a.ID) string {
+	return fmt.Sprintf("%q", id)
+}
+
+// claim marks the request id in flight, streaming or not, and returns the function releasing
+// it if it is not sent. It fails with [ErrRequestIDInUse] if a request with id is in flight.
+// Notifications and requests with a null ID get no response to tell apart, so they are not tracked.
+func (wc *WSClient) claim(id a2a.ID, stream bool) (release func(), err error) {
+	if id.IsAbsent() || id.IsNull() {
+		return func() {}, nil
+	}
+	key := pendingKey(id)
+
+	wc.mu.Lock()
+	defer wc.mu.Unlock()
+	if _, ok := wc.pending[key]; ok {
+		return nil, fmt.Errorf("%w: %s", ErrRequestIDInUse, id)
+	}
+	wc.pending[key] = stream
+	return func() {
+		wc.mu.Lock()
+		defer wc.mu.Unlock()
+		delete(wc.pending, key)
+	}, nil
+}
+
+// settle releases the ID of the request msg answers, if it ends the request: a response to a
+// request that does not stream, an error, or the final event of a stream.
+func (wc *WSClient) settle(msg WSMessage) {
+	key := pendingKey(msg.ID)
+
+	wc.mu.Lock()
+	defer wc.mu.Unlock()
+	stream, ok := wc.pending[key]
+	if !ok {
+		return
+	}
+	if stream && msg.Error == nil {
+		var event struct {
+			Final bool `json:"final"`
+		}
+		if err := wc.client.codec.Unmarshal(msg.Result, &event); err != nil || !event.Final {
+			return
+		}
+	}
+	delete(wc.pending, key)
+}
+
+// Receive returns the channel the messages of the server are delivered on. It is closed once
+// the connection ends, after which [WSClient.Err] reports why.
+//
+// The connection is only read as fast as messages are received from the channel.
+func (wc *WSClient) Receive() <-chan WSMessage {
+	return wc.messages
+}
+
+// Err returns the error the connection ended with, once the channel returned by
+// [WSClient.Receive] is closed. It returns nil while the connection is open, and if it was
+// closed by either end.
+func (wc *WSClient) Err() error {
+	select {
+	case <-wc.done:
+		return wc.err
+	default:
+		return nil
+	}
+}
+
+// Close sends a close frame to the server and closes the connection. The server stops the
+// streams still running on it.
+func (wc *WSClient) Close() error {
+	wc.closeOnce.Do(func() { close(wc.closing) })
+	err := wc.closeConn()
+	<-wc.done
+	return err
+}
+
+// readLoop delivers the messages received from the server until the connection ends.
+func (wc *WSClient) readLoop() {
+	// The error is set before the receive channel is closed, for Err to report it.
+	defer close(wc.messages)
+	defer close(wc.done)
+
+	var err error
+	for {
+		var data []byte
+		if err = websocket.Message.Receive(wc.ws, &data); err != nil {
+			break
+		}
+		messages, derr := wc.decode(data)
+		if derr != nil {
+			wc.client.logger.Warn("ignore websocket message", slog.Any("error", derr))
+			continue
+		}
+		if !wc.deliver(messages) {
+			return
+		}
+	}
+
+	// Answer the close frame of the server, or release the failed connection.
+	wc.closeConn()
+	select {
+	case <-wc.closing:
+	default:
+		if !errors.Is(err, io.EOF) {
+			wc.err = fmt.Errorf("receive websocket message: %w", err)
+		}
+	}
+}
+
+// deliver sends messages on the receive channel, and reports whether the connection is still
+// open.
+func (wc *WSClient) deliver(messages []WSMessage) bool {
+	for _, msg := range messages {
+		// The ID is released before the message is received, so that it can be reused right away.
+		wc.settle(msg)
+		select {
+		case wc.messages <- msg:
+		case <-wc.closing:
+			return false
+		}
+	}
+	return true
+}
+
+// decode decodes the response, or batch of responses, carried by a message.
+func (wc *WSClient) decode(data []byte) ([]WSMessage, error) {
+	var messages []WSMessage
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := wc.client.codec.Unmarshal(trimmed, &messages); err != nil {
+			return nil, fmt.Errorf("failed to parse batch response: %w", err)
+		}
+	} else {
+		var msg WSMessage
+		if err := wc.client.codec.Unmarshal(data, &msg); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+		messages = append(messages, msg)
+	}
+	for i := range messages {
+		messages[i].client = wc.client
+	}
+	return messages, nil
+}
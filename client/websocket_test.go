@@ -0,0 +1,215 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/client"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestDialWebSocket(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	tm := &compressingTaskManager{InMemoryTaskManager: server.NewInMemoryTaskManager()}
+	srv := httptest.NewServer(server.NewServer("", "", card, tm, server.WithWebSocket()))
+	t.Cleanup(srv.Close)
+
+	wc, err := client.DialWebSocket(t.Context(), srv.URL+server.WebSocketPath)
+	if err != nil {
+		t.Fatalf("DialWebSocket() error = %v", err)
+	}
+
+	err = wc.Send(t.Context(), a2a.MethodTasksSendSubscribe, a2a.NewID("stream"), a2a.TaskSendParams{
+		TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+		Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "hi"}}},
+	})
+	if err != nil {
+		t.Fatalf("Send(tasks/sendSubscribe) error = %v", err)
+	}
+	if err := wc.Send(t.Context(), a2a.MethodTasksGet, a2a.NewID("get"), a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "missing"}}); err != nil {
+		t.Fatalf("Send(tasks/get) error = %v", err)
+	}
+
+	var (
+		text       strings.Builder
+		final, got bool
+	)
+	for msg := range wc.Receive() {
+		switch msg.ID.String() {
+		case "get":
+			var task a2a.Task
+			if err := msg.Decode(&task); !errors.Is(err, a2a.ErrTaskNotFound) {
+				t.Errorf("tasks/get Decode() error = %v, want %v", err, a2a.ErrTaskNotFound)
+			}
+			got = true
+		case "stream":
+			event, err := msg.Event()
+			if err != nil {
+				t.Fatalf("Event() error = %v", err)
+			}
+			if event.Artifact != nil {
+				for _, part := range event.Artifact.Artifact.Parts {
+					text.WriteString(part.(*a2a.TextPart).Text)
+				}
+			}
+			if event.Status != nil && event.Status.Final {
+				final = true
+			}
+		default:
+			t.Fatalf("message for unknown request ID %v", msg.ID)
+		}
+		if final && got {
+			break
+		}
+	}
+
+	if !final || !got {
+		t.Fatalf("connection ended before all responses: final = %t, tasks/get = %t, Err() = %v", final, got, wc.Err())
+	}
+	if want := "plain " + strings.Repeat("compressed ", 100); text.String() != want {
+		t.Errorf("artifact text = %q, want %q", text.String(), want)
+	}
+
+	if err := wc.Close(); err != nil {
+		t.Errorf("Close() error = %v", err)
+	}
+	if _, ok := <-wc.Receive(); ok {
+		t.Error("Receive() channel open after Close()")
+	}
+	if err := wc.Err(); err != nil {
+		t.Errorf("Err() = %v, want nil", err)
+	}
+	if err := wc.Send(t.Context(), a2a.MethodTasksGet, a2a.NewID("late"), a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}}); !errors.Is(err, client.ErrWebSocketClosed) {
+		t.Errorf("Send() after Close() error = %v, want %v", err, client.ErrWebSocketClosed)
+	}
+}
+
+func TestDialWebSocket_NotEnabled(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager()))
+	t.Cleanup(srv.Close)
+
+	if _, err := client.DialWebSocket(t.Context(), srv.URL+server.WebSocketPath); err == nil {
+		t.Error("DialWebSocket() error = nil, want an error")
+	}
+}
+
+func TestWSClient_Send_RequestIDInUse(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	tm := &slowTaskManager{InMemoryTaskManager: server.NewInMemoryTaskManager(), delay: 300 * time.Millisecond}
+	srv := httptest.NewServer(server.NewServer("", "", card, tm, server.WithWebSocket()))
+	t.Cleanup(srv.Close)
+
+	wc, err := client.DialWebSocket(t.Context(), srv.URL+server.WebSocketPath)
+	if err != nil {
+		t.Fatalf("DialWebSocket() error = %v", err)
+	}
+	t.Cleanup(func() { wc.Close() })
+
+	params := a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}}
+	if err := wc.Send(t.Context(), a2a.MethodTasksGet, a2a.NewID("dup"), params); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	// The first request is answered after a delay, so it is still in flight.
+	if err := wc.Send(t.Context(), a2a.MethodTasksGet, a2a.NewID("dup"), params); !errors.Is(err, client.ErrRequestIDInUse) {
+		t.Fatalf("second Send() error = %v, want %v", err, client.ErrRequestIDInUse)
+	}
+
+	msg, ok := <-wc.Receive()
+	if !ok || msg.ID.String() != "dup" {
+		t.Fatalf("Receive() = %v, %t, want the response to dup", msg.ID, ok)
+	}
+	if err := wc.Send(t.Context(), a2a.MethodTasksGet, a2a.NewID("dup"), params); err != nil {
+		t.Errorf("Send() once answered error = %v", err)
+	}
+}
+
+func TestWSClient_CancelWhileStreaming(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	tm := &waitingTaskManager{InMemoryTaskManager: server.NewInMemoryTaskManager()}
+	srv := httptest.NewServer(server.NewServer("", "", card, tm, server.WithWebSocket()))
+	t.Cleanup(srv.Close)
+
+	wc, err := client.DialWebSocket(t.Context(), srv.URL+server.WebSocketPath)
+	if err != nil {
+		t.Fatalf("DialWebSocket() error = %v", err)
+	}
+	t.Cleanup(func() { wc.Close() })
+
+	streamID, cancelID := client.NewRequestID(), client.NewRequestID()
+	err = wc.Send(t.Context(), a2a.MethodTasksSendSubscribe, streamID, a2a.TaskSendParams{
+		TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+		Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{a2a.NewTextPart("hi")}},
+	})
+	if err != nil {
+		t.Fatalf("Send(tasks/sendSubscribe) error = %v", err)
+	}
+
+	var (
+		canceled   *a2a.Task
+		finalState a2a.TaskState
+	)
+	timeout := time.After(10 * time.Second)
+	for canceled == nil || finalState == "" {
+		var msg client.WSMessage
+		select {
+		case m, ok := <-wc.Receive():
+			if !ok {
+				t.Fatalf("connection ended: %v", wc.Err())
+			}
+			msg = m
+		case <-timeout:
+			t.Fatal("timed out waiting for the cancellation")
+		}
+
+		switch {
+		case msg.ID.Equal(cancelID):
+			var task a2a.Task
+			if err := msg.Decode(&task); err != nil {
+				t.Fatalf("tasks/cancel Decode() error = %v", err)
+			}
+			canceled = &task
+		case msg.ID.Equal(streamID):
+			event, err := msg.Event()
+			if err != nil {
+				t.Fatalf("Event() error = %v", err)
+			}
+			if event.Status == nil {
+				continue
+			}
+			if event.Status.Status.State == a2a.TaskStateWorking && canceled == nil {
+				// The stream of the task is open: cancel the task on the same connection.
+				if err := wc.Send(t.Context(), a2a.MethodTasksCancel, cancelID, a2a.TaskIDParams{ID: "task-1"}); err != nil {
+					t.Fatalf("Send(tasks/cancel) error = %v", err)
+				}
+			}
+			if event.Status.Final {
+				finalState = event.Status.Status.State
+			}
+		default:
+			t.Fatalf("message for unknown request ID %v", msg.ID)
+		}
+	}
+
+	if canceled.Status.State != a2a.TaskStateCanceled {
+		t.Errorf("tasks/cancel state = %s, want %s", canceled.Status.State, a2a.TaskStateCanceled)
+	}
+	if finalState != a2a.TaskStateCanceled {
+		t.Errorf("final stream state = %s, want %s", finalState, a2a.TaskStateCanceled)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"encoding/json"
+)
+
+// Codec encodes and decodes the JSON carried by JSON-RPC messages.
+//
+// Implementations must honor the [json.Marshaler] and [json.Unmarshaler] methods of the
+// types in this package, as [StdCodec] and [SonicCodec] do.
+type Codec interface {
+	// Marshal returns the JSON encoding of v.
+	Marshal(v any) ([]byte, error)
+
+	// Unmarshal decodes the JSON data into v.
+	Unmarshal(data []byte, v any) error
+}
+
+// StdCodec is a [Codec] backed by encoding/json.
+type StdCodec struct{}
+
+var _ Codec = StdCodec{}
+
+// Marshal implements [Codec].
+func (StdCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// Unmarshal implements [Codec].
+func (StdCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+// DefaultCodec is the [Codec] used by clients and servers that are not given one, and by
+// [ID] for its own encoding.
+//
+// It is [SonicCodec], unless the module is built with the a2a_stdjson build tag, which
+// selects [StdCodec]. DefaultCodec may be replaced before any message is encoded, but not
+// while messages are being encoded or decoded.
+var DefaultCodec Codec = defaultCodec
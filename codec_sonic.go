@@ -0,0 +1,37 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !a2a_stdjson
+
+package a2a
+
+import (
+	"github.com/bytedance/sonic"
+)
+
+// SonicCodec is a [Codec] backed by github.com/bytedance/sonic.
+//
+// On platforms sonic does not accelerate, it falls back to encoding/json by itself.
+type SonicCodec struct{}
+
+var _ Codec = SonicCodec{}
+
+// defaultCodec is the initial value of [DefaultCodec].
+var defaultCodec Codec = SonicCodec{}
+
+// sonicAPI is sonic.ConfigFastest, except that it quotes the output of encoding.TextMarshaler
+// implementations such as the uuid.UUID session IDs, which would otherwise be invalid JSON.
+var sonicAPI = sonic.Config{
+	NoValidateJSONMarshaler: true,
+	NoValidateJSONSkip:      true,
+}.Froze()
+
+// Marshal implements [Codec].
+func (SonicCodec) Marshal(v any) ([]byte, error) {
+	return sonicAPI.Marshal(v)
+}
+
+// Unmarshal implements [Codec].
+func (SonicCodec) Unmarshal(data []byte, v any) error {
+	return sonicAPI.Unmarshal(data, v)
+}
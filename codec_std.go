@@ -0,0 +1,9 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build a2a_stdjson
+
+package a2a
+
+// defaultCodec is the initial value of [DefaultCodec].
+var defaultCodec Codec = StdCodec{}
@@ -0,0 +1,69 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"testing"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestCodec(t *testing.T) {
+	t.Parallel()
+
+	codecs := map[string]a2a.Codec{
+		"std":     a2a.StdCodec{},
+		"default": a2a.DefaultCodec,
+	}
+	tests := map[string]struct {
+		req  a2a.JSONRPCRequest
+		want string
+	}{
+		"string ID": {
+			req: a2a.JSONRPCRequest{
+				JSONRPCMessage: a2a.NewJSONRPCMessage(a2a.NewID("req-\"1\"")),
+				Method:         a2a.MethodTasksGet,
+				Params:         []byte(`{"id":"task-1"}`),
+			},
+			want: `{"jsonrpc":"2.0","id":"req-\"1\"","method":"tasks/get","params":{"id":"task-1"}}`,
+		},
+		"numeric ID": {
+			req: a2a.JSONRPCRequest{
+				JSONRPCMessage: a2a.NewJSONRPCMessage(a2a.NewID[int64](9007199254740993)),
+				Method:         a2a.MethodTasksCancel,
+			},
+			want: `{"jsonrpc":"2.0","id":9007199254740993,"method":"tasks/cancel"}`,
+		},
+		"notification": {
+			req: a2a.JSONRPCRequest{
+				JSONRPCMessage: a2a.NewJSONRPCMessage(a2a.ID{}),
+				Method:         a2a.MethodTasksGet,
+			},
+			want: `{"jsonrpc":"2.0","method":"tasks/get"}`,
+		},
+	}
+	for codecName, codec := range codecs {
+		for name, tt := range tests {
+			t.Run(codecName+"/"+name, func(t *testing.T) {
+				t.Parallel()
+
+				data, err := codec.Marshal(&tt.req)
+				if err != nil {
+					t.Fatalf("Marshal() error = %v", err)
+				}
+				if string(data) != tt.want {
+					t.Errorf("Marshal() = %s, want %s", data, tt.want)
+				}
+
+				var got a2a.JSONRPCRequest
+				if err := codec.Unmarshal(data, &got); err != nil {
+					t.Fatalf("Unmarshal() error = %v", err)
+				}
+				if !got.ID.Equal(tt.req.ID) || got.Method != tt.req.Method {
+					t.Errorf("Unmarshal() = %v %s, want %v %s", got.ID, got.Method, tt.req.ID, tt.req.Method)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"slices"
+	"time"
+)
+
+// CompactedPartsKey is the artifact metadata key recording how many parts [Task.Compact]
+// removed from a summarized artifact.
+const CompactedPartsKey = "compactedParts"
+
+// CompactOptions configures [Task.Compact]. The zero value keeps everything.
+type CompactOptions struct {
+	// MaxHistory keeps only the last MaxHistory messages of the history. Zero keeps them all.
+	MaxHistory int
+
+	// KeepArtifacts keeps the parts of the last KeepArtifacts artifacts only. Older artifacts
+	// are summarized: their name, description, index and metadata are kept, their parts are
+	// removed and counted under [CompactedPartsKey]. Zero keeps every artifact whole.
+	KeepArtifacts int
+
+	// FileURI, if set, returns the URI to reference the inline content of a file part by, for
+	// example after uploading it to object storage. The part keeps its bytes when FileURI
+	// returns "".
+	FileURI func(file FileContent) string
+}
+
+// EstimateSize returns the approximate size in bytes of the task serialized as JSON.
+//
+// It returns 0 if the task cannot be serialized.
+func (t Task) EstimateSize() int {
+	data, err := DefaultCodec.Marshal(&t)
+	if err != nil {
+		return 0
+	}
+	return len(data)
+}
+
+// Compact shrinks the task as configured by opts, and sets its UpdatedAt if anything changed.
+//
+// The status is always kept. Compact does not modify the parts, messages or artifacts it
+// replaces, so they may be shared with other tasks.
+func (t *Task) Compact(opts CompactOptions) {
+	changed := false
+
+	if opts.MaxHistory > 0 && len(t.History) > opts.MaxHistory {
+		t.History = slices.Clone(t.History[len(t.History)-opts.MaxHistory:])
+		changed = true
+	}
+
+	if opts.KeepArtifacts > 0 && len(t.Artifacts) > opts.KeepArtifacts {
+		artifacts := slices.Clone(t.Artifacts)
+		for i := range artifacts[:len(artifacts)-opts.KeepArtifacts] {
+			if summarizeArtifact(&artifacts[i]) {
+				changed = true
+			}
+		}
+		t.Artifacts = artifacts
+	}
+
+	if opts.FileURI != nil {
+		var history, artifacts bool
+		for i := range t.History {
+			parts, ok := referenceFiles(t.History[i].Parts, opts.FileURI)
+			if !ok {
+				continue
+			}
+			if !history {
+				t.History, history = slices.Clone(t.History), true
+			}
+			t.History[i].Parts = parts
+		}
+		for i := range t.Artifacts {
+			parts, ok := referenceFiles(t.Artifacts[i].Parts, opts.FileURI)
+			if !ok {
+				continue
+			}
+			if !artifacts {
+				t.Artifacts, artifacts = slices.Clone(t.Artifacts), true
+			}
+			t.Artifacts[i].Parts = parts
+		}
+		changed = changed || history || artifacts
+	}
+
+	if changed {
+		t.UpdatedAt = time.Now()
+	}
+}
+
+// summarizeArtifact removes the parts of artifact, counting them in its metadata, and reports
+// whether it had any.
+func summarizeArtifact(artifact *Artifact) bool {
+	if len(artifact.Parts) == 0 {
+		return false
+	}
+	metadata := make(map[string]any, len(artifact.Metadata)+1)
+	for k, v := range artifact.Metadata {
+		metadata[k] = v
+	}
+	metadata[CompactedPartsKey] = len(artifact.Parts)
+	artifact.Metadata = metadata
+	artifact.Parts = nil
+	return true
+}
+
+// referenceFiles returns a copy of parts in which the file parts with inline content reference
+// it by the URI returned by fileURI instead, and reports whether any part was replaced.
+func referenceFiles(parts []Part, fileURI func(FileContent) string) ([]Part, bool) {
+	var replaced []Part
+	for i, part := range parts {
+		fp, ok := part.(*FilePart)
+		if !ok || fp == nil || fp.File.Bytes == "" {
+			continue
+		}
+		uri := fileURI(fp.File)
+		if uri == "" {
+			continue
+		}
+		if replaced == nil {
+			replaced = slices.Clone(parts)
+		}
+		ref := *fp
+		ref.File.Bytes = ""
+		ref.File.URI = uri
+		replaced[i] = &ref
+	}
+	return replaced, replaced != nil
+}
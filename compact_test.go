@@ -0,0 +1,126 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestTask_Compact(t *testing.T) {
+	t.Parallel()
+
+	text := func(s string) a2a.Part { return &a2a.TextPart{Type: a2a.PartTypeText, Text: s} }
+	file := func(content string) a2a.Part {
+		return &a2a.FilePart{Type: a2a.PartTypeFile, File: a2a.FileContent{Name: "f.txt", MIMEType: "text/plain", Bytes: content}}
+	}
+	updatedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	newTask := func() a2a.Task {
+		return a2a.Task{
+			ID:     "task-1",
+			Status: a2a.TaskStatus{State: a2a.TaskStateCompleted},
+			History: []a2a.Message{
+				{Role: a2a.RoleUser, Parts: []a2a.Part{text("one"), file("aGVsbG8=")}},
+				{Role: a2a.RoleAgent, Parts: []a2a.Part{text("two")}},
+				{Role: a2a.RoleUser, Parts: []a2a.Part{text("three")}},
+			},
+			Artifacts: []a2a.Artifact{
+				{Name: "draft", Parts: []a2a.Part{text("a"), text("b")}, Metadata: map[string]any{"k": "v"}},
+				{Name: "final", Index: 1, Parts: []a2a.Part{file("d29ybGQ=")}},
+			},
+			UpdatedAt: updatedAt,
+		}
+	}
+
+	tests := map[string]struct {
+		opts a2a.CompactOptions
+		want func(task *a2a.Task)
+	}{
+		"zero options": {},
+		"max history": {
+			opts: a2a.CompactOptions{MaxHistory: 2},
+			want: func(task *a2a.Task) { task.History = task.History[1:] },
+		},
+		"history within limit": {
+			opts: a2a.CompactOptions{MaxHistory: 3},
+		},
+		"keep artifacts": {
+			opts: a2a.CompactOptions{KeepArtifacts: 1},
+			want: func(task *a2a.Task) {
+				task.Artifacts[0].Parts = nil
+				task.Artifacts[0].Metadata = map[string]any{"k": "v", a2a.CompactedPartsKey: 2}
+			},
+		},
+		"file URI": {
+			opts: a2a.CompactOptions{FileURI: func(file a2a.FileContent) string {
+				if file.Bytes == "d29ybGQ=" {
+					return "https://example.com/world.txt"
+				}
+				return ""
+			}},
+			want: func(task *a2a.Task) {
+				task.Artifacts[1].Parts = []a2a.Part{&a2a.FilePart{Type: a2a.PartTypeFile, File: a2a.FileContent{
+					Name: "f.txt", MIMEType: "text/plain", URI: "https://example.com/world.txt",
+				}}}
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			task := newTask()
+			original := newTask()
+			shared := task
+			task.Compact(tt.opts)
+
+			want := newTask()
+			if tt.want != nil {
+				tt.want(&want)
+			}
+			changed := !task.UpdatedAt.Equal(updatedAt)
+			if changed != (tt.want != nil) {
+				t.Errorf("Compact() updated UpdatedAt = %t, want %t", changed, tt.want != nil)
+			}
+			task.UpdatedAt = updatedAt
+			if diff := gocmp.Diff(want, task); diff != "" {
+				t.Errorf("Compact() mismatch (-want +got):\n%s", diff)
+			}
+			if diff := gocmp.Diff(original, shared); diff != "" {
+				t.Errorf("Compact() modified a shallow copy (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTask_EstimateSize(t *testing.T) {
+	t.Parallel()
+
+	task := a2a.Task{
+		ID:     "task-1",
+		Status: a2a.TaskStatus{State: a2a.TaskStateCompleted},
+		History: []a2a.Message{
+			{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: strings.Repeat("x", 1000)}}},
+			{Role: a2a.RoleAgent, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "ok"}}},
+		},
+	}
+	data, err := a2a.DefaultCodec.Marshal(&task)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if got := task.EstimateSize(); got != len(data) {
+		t.Errorf("EstimateSize() = %d, want %d", got, len(data))
+	}
+
+	before := task.EstimateSize()
+	task.Compact(a2a.CompactOptions{MaxHistory: 1})
+	if after := task.EstimateSize(); after >= before-1000 {
+		t.Errorf("EstimateSize() after Compact() = %d, want less than %d", after, before-1000)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/bytedance/sonic"
+)
+
+const (
+	// EventEncodingKey is the event metadata key flagging an artifact update whose parts are compressed.
+	EventEncodingKey = "contentEncoding"
+
+	// EncodingGzip is the [EventEncodingKey] value of gzip-compressed artifact parts.
+	EncodingGzip = "gzip"
+
+	// MaxDecompressedPartsSize caps the decompressed size of a compressed artifact update.
+	MaxDecompressedPartsSize = 64 << 20 // 64MiB
+)
+
+// IsCompressed reports whether the artifact parts of the event are compressed.
+func (e *TaskArtifactUpdateEvent) IsCompressed() bool {
+	return e.Metadata[EventEncodingKey] != nil
+}
+
+// Compress replaces the artifact parts with a single text part holding their JSON encoding,
+// gzip-compressed and base64-encoded, and flags the event with [EventEncodingKey].
+//
+// Compression pays off for large text chunks; [TaskArtifactUpdateEvent.Decompress] restores the parts.
+func (e *TaskArtifactUpdateEvent) Compress() error {
+	if e.IsCompressed() {
+		return errors.New("artifact update is already compressed")
+	}
+
+	data, err := sonic.ConfigFastest.Marshal(e.Artifact.Parts)
+	if err != nil {
+		return fmt.Errorf("marshal parts: %w", err)
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return fmt.Errorf("compress parts: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("compress parts: %w", err)
+	}
+
+	e.Artifact.Parts = []Part{&TextPart{
+		Type: PartTypeText,
+		Text: base64.StdEncoding.EncodeToString(buf.Bytes()),
+	}}
+	if e.Metadata == nil {
+		e.Metadata = make(map[string]any)
+	}
+	e.Metadata[EventEncodingKey] = EncodingGzip
+
+	return nil
+}
+
+// Decompress restores the artifact parts of an event compressed with [TaskArtifactUpdateEvent.Compress]
+// and removes the flag. Events that are not flagged are left as they are.
+func (e *TaskArtifactUpdateEvent) Decompress() error {
+	if !e.IsCompressed() {
+		return nil
+	}
+	if enc := e.Metadata[EventEncodingKey]; enc != EncodingGzip {
+		return fmt.Errorf("unsupported artifact encoding %v", enc)
+	}
+	if len(e.Artifact.Parts) != 1 {
+		return fmt.Errorf("compressed artifact has %d parts, want 1", len(e.Artifact.Parts))
+	}
+	tp, ok := e.Artifact.Parts[0].(*TextPart)
+	if !ok {
+		return fmt.Errorf("compressed artifact holds a %T, want a text part", e.Artifact.Parts[0])
+	}
+
+	compressed, err := base64.StdEncoding.DecodeString(tp.Text)
+	if err != nil {
+		return fmt.Errorf("decode compressed parts: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return fmt.Errorf("decompress parts: %w", err)
+	}
+	data, err := io.ReadAll(io.LimitReader(zr, MaxDecompressedPartsSize+1))
+	if err != nil {
+		return fmt.Errorf("decompress parts: %w", err)
+	}
+	if len(data) > MaxDecompressedPartsSize {
+		return fmt.Errorf("decompressed parts exceed %d bytes", MaxDecompressedPartsSize)
+	}
+
+	// Decode through Artifact so each part gets its concrete type.
+	var artifact Artifact
+	if err := sonic.ConfigFastest.Unmarshal(append(append([]byte(`{"parts":`), data...), '}'), &artifact); err != nil {
+		return fmt.Errorf("unmarshal parts: %w", err)
+	}
+
+	e.Artifact.Parts = artifact.Parts
+	delete(e.Metadata, EventEncodingKey)
+	if len(e.Metadata) == 0 {
+		e.Metadata = nil
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bytedance/sonic"
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestTaskArtifactUpdateEvent_Compress(t *testing.T) {
+	t.Parallel()
+
+	parts := []a2a.Part{
+		&a2a.TextPart{Type: a2a.PartTypeText, Text: strings.Repeat("lorem ipsum ", 1000)},
+		&a2a.DataPart{Type: a2a.PartTypeData, Data: map[string]any{"score": 0.5}},
+	}
+	event := &a2a.TaskArtifactUpdateEvent{
+		ID:       "task-1",
+		Artifact: a2a.Artifact{Index: 2, Append: true, Parts: parts},
+		Metadata: map[string]any{"trace": "abc"},
+	}
+
+	if err := event.Compress(); err != nil {
+		t.Fatalf("Compress() error = %v", err)
+	}
+	if !event.IsCompressed() {
+		t.Fatal("compressed event is not flagged")
+	}
+	if err := event.Compress(); err == nil {
+		t.Error("Compress() twice error = nil, want error")
+	}
+
+	// The compressed event travels as JSON like any other.
+	data, err := sonic.ConfigFastest.Marshal(event)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if len(data) > 1000 {
+		t.Errorf("compressed event is %d bytes, want it much smaller than the text", len(data))
+	}
+	var got a2a.TaskArtifactUpdateEvent
+	if err := sonic.ConfigFastest.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if err := got.Decompress(); err != nil {
+		t.Fatalf("Decompress() error = %v", err)
+	}
+	want := a2a.TaskArtifactUpdateEvent{
+		ID:       "task-1",
+		Artifact: a2a.Artifact{Index: 2, Append: true, Parts: parts},
+		Metadata: map[string]any{"trace": "abc"},
+	}
+	if diff := gocmp.Diff(want, got); diff != "" {
+		t.Errorf("decompressed event mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestTaskArtifactUpdateEvent_DecompressUnflagged(t *testing.T) {
+	t.Parallel()
+
+	// A plain chunk that happens to look like base64 is left alone.
+	event := &a2a.TaskArtifactUpdateEvent{
+		Artifact: a2a.Artifact{Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "SGVsbG8="}}},
+	}
+	if err := event.Decompress(); err != nil {
+		t.Fatalf("Decompress() error = %v", err)
+	}
+	if got := event.Artifact.Parts[0].(*a2a.TextPart).Text; got != "SGVsbG8=" {
+		t.Errorf("text = %q, want it unchanged", got)
+	}
+}
@@ -0,0 +1,563 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package conformance checks that a running A2A server complies with the protocol.
+//
+// [Run] exercises the server over HTTP, as any client would, so it applies to servers built
+// with any library:
+//
+//	func TestConformance(t *testing.T) {
+//		srv := httptest.NewServer(newAgent())
+//		defer srv.Close()
+//
+//		conformance.Run(t, srv.URL)
+//	}
+package conformance
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"maps"
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/go-a2a/a2a"
+)
+
+// The checks run by [Run], named after the subtest they run in.
+const (
+	// CheckAgentCard fetches the agent card from its well-known path.
+	CheckAgentCard = "agent card"
+
+	// CheckSendTask sends a task with tasks/send.
+	CheckSendTask = "tasks/send"
+
+	// CheckGetTask gets the sent task with tasks/get.
+	CheckGetTask = "tasks/get"
+
+	// CheckCancelTask cancels the sent task with tasks/cancel.
+	CheckCancelTask = "tasks/cancel"
+
+	// CheckUnknownTask checks the error of the task methods called with an unknown task ID.
+	CheckUnknownTask = "unknown task"
+
+	// CheckMethodNotFound checks the error of a call to an unknown method.
+	CheckMethodNotFound = "method not found"
+
+	// CheckParseError checks the error of a request that is not JSON.
+	CheckParseError = "parse error"
+
+	// CheckContentType checks the content type of responses, and the error of a task accepting
+	// no output mode of the agent, if its card declares output modes.
+	CheckContentType = "content type"
+
+	// CheckStreaming streams a task with tasks/sendSubscribe. It is skipped unless the agent
+	// card advertises streaming.
+	CheckStreaming = "streaming"
+
+	// CheckPushNotifications sets and gets the push notification config of a task. It is
+	// skipped unless the agent card advertises push notifications.
+	CheckPushNotifications = "push notifications"
+)
+
+const (
+	// agentCardPath is the well-known path of the agent card.
+	agentCardPath = "/.well-known/agent.json"
+
+	// unsupportedMode is an output mode no agent produces.
+	unsupportedMode = "application/x-a2a-conformance-unsupported"
+
+	defaultTimeout    = 10 * time.Second
+	defaultWebhookURL = "https://example.com/a2a-conformance"
+)
+
+// ConformanceOption represents an option for configuring [Run].
+type ConformanceOption func(*config)
+
+// config holds the options of [Run].
+type config struct {
+	endpoint   string
+	httpClient *http.Client
+	header     http.Header
+	message    a2a.Message
+	webhookURL string
+	timeout    time.Duration
+	skip       []string
+}
+
+// WithEndpoint sets the URL of the A2A endpoint, which defaults to the base URL.
+func WithEndpoint(url string) ConformanceOption {
+	return func(c *config) {
+		c.endpoint = url
+	}
+}
+
+// WithHTTPClient sets the HTTP client making the requests, which defaults to [http.DefaultClient].
+func WithHTTPClient(client *http.Client) ConformanceOption {
+	return func(c *config) {
+		c.httpClient = client
+	}
+}
+
+// WithHeader adds a header to every request, such as the credentials of the agent.
+func WithHeader(key, value string) ConformanceOption {
+	return func(c *config) {
+		c.header.Add(key, value)
+	}
+}
+
+// WithMessage sets the message of the tasks sent, which defaults to a short text message.
+func WithMessage(msg a2a.Message) ConformanceOption {
+	return func(c *config) {
+		c.message = msg
+	}
+}
+
+// WithWebhookURL sets the webhook URL of the push notification config set by
+// [CheckPushNotifications]. No notification is expected to reach it.
+func WithWebhookURL(url string) ConformanceOption {
+	return func(c *config) {
+		c.webhookURL = url
+	}
+}
+
+// WithTimeout bounds each check to d, 10 seconds by default.
+func WithTimeout(d time.Duration) ConformanceOption {
+	return func(c *config) {
+		c.timeout = d
+	}
+}
+
+// Skip skips the checks, such as [CheckStreaming] for an agent that does not support it yet.
+func Skip(checks ...string) ConformanceOption {
+	return func(c *config) {
+		c.skip = append(c.skip, checks...)
+	}
+}
+
+// Run checks that the A2A server at baseURL complies with the protocol, running each check in
+// a subtest named after it. Checks depending on a failed check, or on a capability the agent
+// card does not advertise, are skipped.
+func Run(t *testing.T, baseURL string, opts ...ConformanceOption) {
+	t.Helper()
+
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	cfg := config{
+		endpoint:   baseURL + "/",
+		httpClient: http.DefaultClient,
+		header:     make(http.Header),
+		message: a2a.Message{
+			Role:  a2a.RoleUser,
+			Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "conformance check"}},
+		},
+		webhookURL: defaultWebhookURL,
+		timeout:    defaultTimeout,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	r := &runner{config: cfg, baseURL: baseURL}
+
+	var (
+		card *a2a.AgentCard
+		task *a2a.Task
+	)
+	r.run(t, CheckAgentCard, "", func(t *testing.T, ctx context.Context) {
+		card = r.checkAgentCard(t, ctx)
+	})
+	r.run(t, CheckSendTask, "", func(t *testing.T, ctx context.Context) {
+		task = r.checkSendTask(t, ctx)
+	})
+	r.run(t, CheckGetTask, needs(task == nil, CheckSendTask), func(t *testing.T, ctx context.Context) {
+		r.checkGetTask(t, ctx, task.ID)
+	})
+	r.run(t, CheckCancelTask, needs(task == nil, CheckSendTask), func(t *testing.T, ctx context.Context) {
+		r.checkCancelTask(t, ctx, task.ID)
+	})
+	r.run(t, CheckUnknownTask, "", r.checkUnknownTask)
+	r.run(t, CheckMethodNotFound, "", r.checkMethodNotFound)
+	r.run(t, CheckParseError, "", r.checkParseError)
+	r.run(t, CheckContentType, "", func(t *testing.T, ctx context.Context) {
+		r.checkContentType(t, ctx, card)
+	})
+	r.run(t, CheckStreaming, advertised(card, card != nil && card.Capabilities.Streaming), r.checkStreaming)
+	r.run(t, CheckPushNotifications, advertised(card, card != nil && card.Capabilities.PushNotifications), r.checkPushNotifications)
+}
+
+// needs returns why a check is skipped when the check it depends on failed.
+func needs(failed bool, check string) string {
+	if failed {
+		return check + " failed"
+	}
+	return ""
+}
+
+// advertised returns why a check is skipped when the capability it checks is not advertised.
+func advertised(card *a2a.AgentCard, ok bool) string {
+	switch {
+	case card == nil:
+		return CheckAgentCard + " failed"
+	case !ok:
+		return "capability not advertised by the agent card"
+	}
+	return ""
+}
+
+// runner runs the checks against a server.
+type runner struct {
+	config
+	baseURL string
+}
+
+// run runs check in a subtest, unless it is skipped by the options or for skipReason.
+func (r *runner) run(t *testing.T, check, skipReason string, fn func(t *testing.T, ctx context.Context)) {
+	t.Helper()
+
+	t.Run(check, func(t *testing.T) {
+		if slices.Contains(r.skip, check) {
+			t.Skip("skipped by option")
+		}
+		if skipReason != "" {
+			t.Skip(skipReason)
+		}
+		ctx, cancel := context.WithTimeout(t.Context(), r.timeout)
+		defer cancel()
+		fn(t, ctx)
+	})
+}
+
+func (r *runner) checkAgentCard(t *testing.T, ctx context.Context) *a2a.AgentCard {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.baseURL+agentCardPath, nil)
+	if err != nil {
+		t.Fatalf("create request: %v", err)
+	}
+	resp := r.do(t, req)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET %s responded %s, want 200 OK", agentCardPath, resp.Status)
+	}
+	checkMediaType(t, resp, "application/json")
+
+	var card a2a.AgentCard
+	if err := decodeBody(resp, &card); err != nil {
+		t.Fatalf("decode agent card: %v", err)
+	}
+	if card.Name == "" || card.URL == "" || card.Version == "" {
+		t.Errorf("agent card has name %q, URL %q and version %q, want all set", card.Name, card.URL, card.Version)
+	}
+	return &card
+}
+
+func (r *runner) checkSendTask(t *testing.T, ctx context.Context) *a2a.Task {
+	params := r.sendParams()
+	task := decodeResult[a2a.Task](t, r.call(t, ctx, a2a.MethodTasksSend, params))
+	checkTask(t, task, params.ID)
+	return task
+}
+
+func (r *runner) checkGetTask(t *testing.T, ctx context.Context, taskID string) {
+	params := a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: taskID}}
+	task := decodeResult[a2a.Task](t, r.call(t, ctx, a2a.MethodTasksGet, params))
+	checkTask(t, task, taskID)
+}
+
+func (r *runner) checkCancelTask(t *testing.T, ctx context.Context, taskID string) {
+	resp := r.call(t, ctx, a2a.MethodTasksCancel, a2a.TaskIDParams{ID: taskID})
+	if resp.Error != nil {
+		// A task that is already done cannot be canceled.
+		if resp.Error.Code != a2a.TaskNotCancelableErrorCode {
+			t.Fatalf("error = %v, want a result or code %d", resp.Error, a2a.TaskNotCancelableErrorCode)
+		}
+		return
+	}
+	task := decodeResult[a2a.Task](t, resp)
+	checkTask(t, task, taskID)
+	if task.Status.State != a2a.TaskStateCanceled {
+		t.Errorf("state = %q, want %q", task.Status.State, a2a.TaskStateCanceled)
+	}
+}
+
+func (r *runner) checkUnknownTask(t *testing.T, ctx context.Context) {
+	taskID := "unknown-" + uuid.NewString()
+	calls := map[string]any{
+		a2a.MethodTasksGet:    a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: taskID}},
+		a2a.MethodTasksCancel: a2a.TaskIDParams{ID: taskID},
+	}
+	for _, method := range slices.Sorted(maps.Keys(calls)) {
+		t.Run(method, func(t *testing.T) {
+			checkError(t, r.call(t, ctx, method, calls[method]), a2a.TaskNotFoundErrorCode)
+		})
+	}
+}
+
+func (r *runner) checkMethodNotFound(t *testing.T, ctx context.Context) {
+	checkError(t, r.call(t, ctx, "conformance/unknown", struct{}{}), a2a.MethodNotFoundErrorCode)
+}
+
+func (r *runner) checkParseError(t *testing.T, ctx context.Context) {
+	resp := r.post(t, ctx, []byte(`{"jsonrpc":"2.0","id":1,"method":`), "application/json")
+	defer resp.Body.Close()
+
+	var rpcResp response
+	if err := decodeBody(resp, &rpcResp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if rpcResp.JSONRPC != "2.0" {
+		t.Errorf("jsonrpc = %q, want %q", rpcResp.JSONRPC, "2.0")
+	}
+	if !rpcResp.ID.IsNull() {
+		t.Errorf("id = %v, want null", rpcResp.ID)
+	}
+	checkError(t, &rpcResp, a2a.JSONParseErrorCode)
+}
+
+func (r *runner) checkContentType(t *testing.T, ctx context.Context, card *a2a.AgentCard) {
+	t.Run("response", func(t *testing.T) {
+		body, err := a2a.DefaultCodec.Marshal(r.request(a2a.MethodTasksGet, a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "unknown-" + uuid.NewString()}}))
+		if err != nil {
+			t.Fatalf("marshal request: %v", err)
+		}
+		resp := r.post(t, ctx, body, "application/json")
+		defer resp.Body.Close()
+		checkMediaType(t, resp, "application/json")
+	})
+
+	t.Run("unsupported output mode", func(t *testing.T) {
+		switch {
+		case card == nil:
+			t.Skip(CheckAgentCard + " failed")
+		case len(card.DefaultOutputModes) == 0 || slices.Contains(card.DefaultOutputModes, "*/*"):
+			t.Skip("agent card declares no restricted output modes")
+		}
+		params := r.sendParams()
+		params.AcceptedOutputModes = []string{unsupportedMode}
+		checkError(t, r.call(t, ctx, a2a.MethodTasksSend, params), a2a.ContentTypeNotSupportedErrorCode)
+	})
+}
+
+func (r *runner) checkStreaming(t *testing.T, ctx context.Context) {
+	params := r.sendParams()
+	body, err := a2a.DefaultCodec.Marshal(r.request(a2a.MethodTasksSendSubscribe, params))
+	if err != nil {
+		t.Fatalf("marshal request: %v", err)
+	}
+	resp := r.post(t, ctx, body, "text/event-stream")
+	defer resp.Body.Close()
+	checkMediaType(t, resp, "text/event-stream")
+
+	events := 0
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data:")
+		if !ok {
+			continue
+		}
+		var rpcResp response
+		if err := a2a.DefaultCodec.Unmarshal([]byte(strings.TrimSpace(data)), &rpcResp); err != nil {
+			t.Fatalf("decode event %d: %v", events, err)
+		}
+		checkEnvelope(t, &rpcResp)
+		event := decodeResult[struct {
+			ID       string          `json:"id"`
+			Status   json.RawMessage `json:"status"`
+			Artifact json.RawMessage `json:"artifact"`
+			Final    bool            `json:"final"`
+		}](t, &rpcResp)
+		events++
+
+		if event.ID != params.ID {
+			t.Errorf("event %d is for task %q, want %q", events, event.ID, params.ID)
+		}
+		if (event.Status == nil) == (event.Artifact == nil) {
+			t.Errorf("event %d has %s, want either a status or an artifact", events, rpcResp.Result)
+		}
+		if event.Final {
+			return
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("read stream after %d events: %v", events, err)
+	}
+	if events == 0 {
+		t.Error("stream ended without events")
+	}
+}
+
+func (r *runner) checkPushNotifications(t *testing.T, ctx context.Context) {
+	params := r.sendParams()
+	task := decodeResult[a2a.Task](t, r.call(t, ctx, a2a.MethodTasksSend, params))
+	checkTask(t, task, params.ID)
+
+	config := a2a.TaskPushNotificationConfig{
+		ID:                     task.ID,
+		PushNotificationConfig: a2a.PushNotificationConfig{URL: r.webhookURL},
+	}
+	set := decodeResult[a2a.TaskPushNotificationConfig](t, r.call(t, ctx, a2a.MethodTasksPushNotificationSet, config))
+	if set.ID != task.ID || set.PushNotificationConfig.URL != r.webhookURL {
+		t.Errorf("set config for task %q with URL %q, want %q and %q", set.ID, set.PushNotificationConfig.URL, task.ID, r.webhookURL)
+	}
+
+	got := decodeResult[a2a.TaskPushNotificationConfig](t, r.call(t, ctx, a2a.MethodTasksPushNotificationGet, a2a.TaskIDParams{ID: task.ID}))
+	if got.ID != task.ID || got.PushNotificationConfig.URL != r.webhookURL {
+		t.Errorf("got config for task %q with URL %q, want %q and %q", got.ID, got.PushNotificationConfig.URL, task.ID, r.webhookURL)
+	}
+}
+
+// response is a JSON-RPC response, keeping its result raw.
+type response struct {
+	a2a.JSONRPCMessage
+	Result json.RawMessage   `json:"result"`
+	Error  *a2a.JSONRPCError `json:"error"`
+}
+
+// sendParams returns the params of a new task, with a unique ID.
+func (r *runner) sendParams() a2a.TaskSendParams {
+	return a2a.TaskSendParams{
+		TaskIDParams: a2a.TaskIDParams{ID: "conformance-" + uuid.NewString()},
+		Message:      r.message,
+	}
+}
+
+// request returns a JSON-RPC request of method with params, identified by a unique ID.
+func (r *runner) request(method string, params any) map[string]any {
+	return map[string]any{
+		"jsonrpc": "2.0",
+		"id":      uuid.NewString(),
+		"method":  method,
+		"params":  params,
+	}
+}
+
+// call calls method with params, checking the envelope of the response.
+func (r *runner) call(t *testing.T, ctx context.Context, method string, params any) *response {
+	t.Helper()
+
+	req := r.request(method, params)
+	body, err := a2a.DefaultCodec.Marshal(req)
+	if err != nil {
+		t.Fatalf("marshal %s request: %v", method, err)
+	}
+	resp := r.post(t, ctx, body, "application/json")
+	defer resp.Body.Close()
+
+	var rpcResp response
+	if err := decodeBody(resp, &rpcResp); err != nil {
+		t.Fatalf("decode %s response: %v", method, err)
+	}
+	checkEnvelope(t, &rpcResp)
+	if want := a2a.NewID(req["id"].(string)); !rpcResp.ID.Equal(want) {
+		t.Errorf("%s response id = %v, want %v", method, rpcResp.ID, want)
+	}
+	return &rpcResp
+}
+
+// post posts body to the endpoint.
+func (r *runner) post(t *testing.T, ctx context.Context, body []byte, accept string) *http.Response {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint, bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", accept)
+	return r.do(t, req)
+}
+
+// do sends req with the configured headers.
+func (r *runner) do(t *testing.T, req *http.Request) *http.Response {
+	t.Helper()
+
+	for key, values := range r.header {
+		req.Header[key] = values
+	}
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", req.Method, req.URL, err)
+	}
+	t.Cleanup(func() {
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	})
+	return resp
+}
+
+// checkEnvelope checks the members of a JSON-RPC response.
+func checkEnvelope(t *testing.T, resp *response) {
+	t.Helper()
+
+	if resp.JSONRPC != "2.0" {
+		t.Errorf("jsonrpc = %q, want %q", resp.JSONRPC, "2.0")
+	}
+	if hasResult := resp.Result != nil && string(resp.Result) != "null"; hasResult == (resp.Error != nil) {
+		t.Errorf("response has result %s and error %v, want exactly one", resp.Result, resp.Error)
+	}
+}
+
+// checkError checks that resp is an error response with code.
+func checkError(t *testing.T, resp *response, code int) {
+	t.Helper()
+
+	if resp.Error == nil {
+		t.Fatalf("result = %s, want error code %d", resp.Result, code)
+	}
+	if resp.Error.Code != code {
+		t.Errorf("error = %v, want code %d", resp.Error, code)
+	}
+}
+
+// checkTask checks that task is a valid task of ID taskID.
+func checkTask(t *testing.T, task *a2a.Task, taskID string) {
+	t.Helper()
+
+	if task.ID != taskID {
+		t.Errorf("task ID = %q, want %q", task.ID, taskID)
+	}
+	if task.Status.State == "" {
+		t.Error("task has no state")
+	}
+}
+
+// checkMediaType checks the media type of the response content type.
+func checkMediaType(t *testing.T, resp *http.Response, want string) {
+	t.Helper()
+
+	got, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil || got != want {
+		t.Errorf("Content-Type = %q, want %q", resp.Header.Get("Content-Type"), want)
+	}
+}
+
+// decodeResult decodes the result of a successful response into a T.
+func decodeResult[T any](t *testing.T, resp *response) *T {
+	t.Helper()
+
+	if resp.Error != nil {
+		t.Fatalf("error = %v, want a result", resp.Error)
+	}
+	var v T
+	if err := a2a.DefaultCodec.Unmarshal(resp.Result, &v); err != nil {
+		t.Fatalf("decode result %s: %v", resp.Result, err)
+	}
+	return &v
+}
+
+// decodeBody decodes the body of resp into v.
+func decodeBody(resp *http.Response, v any) error {
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	return a2a.DefaultCodec.Unmarshal(data, v)
+}
@@ -0,0 +1,48 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/conformance"
+	"github.com/go-a2a/a2a/server"
+)
+
+// echoTaskManager streams a working status, then completes the task.
+type echoTaskManager struct {
+	*server.InMemoryTaskManager
+}
+
+func (tm echoTaskManager) OnSendTaskStream(ctx context.Context, req *a2a.SendTaskStreamingRequest, w server.StreamWriter) error {
+	if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking}); err != nil {
+		return err
+	}
+	if err := w.SendArtifact(a2a.Artifact{Parts: req.Params.Message.Parts}); err != nil {
+		return err
+	}
+	return w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateCompleted})
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{
+		Name:               "conformance",
+		URL:                "http://example.com",
+		Version:            "1.0.0",
+		DefaultInputModes:  []string{"text/plain"},
+		DefaultOutputModes: []string{"text/plain"},
+	}
+	tm := echoTaskManager{server.NewInMemoryTaskManager()}
+	srv := httptest.NewServer(server.NewServer("", "", card, tm,
+		server.WithPushNotifications(server.NewInMemoryPushNotificationStore()),
+	))
+	t.Cleanup(srv.Close)
+
+	conformance.Run(t, srv.URL)
+}
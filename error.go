@@ -0,0 +1,113 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors for the A2A error codes.
+//
+// Handlers can return them, possibly wrapped, and [ToJSONRPCError] turns them into the
+// matching [JSONRPCError]. Conversely, [JSONRPCError.AsError] unwraps to them, so callers
+// can test an error response with [errors.Is].
+var (
+	// ErrTaskNotFound reports an unknown task ID. It maps to [TaskNotFoundErrorCode].
+	ErrTaskNotFound = errors.New("task not found")
+
+	// ErrTaskNotCancelable reports a task that can no longer be canceled. It maps to [TaskNotCancelableErrorCode].
+	ErrTaskNotCancelable = errors.New("task cannot be canceled")
+
+	// ErrPushNotificationNotSupported reports an agent without push notifications. It maps to [PushNotificationNotSupportedErrorCode].
+	ErrPushNotificationNotSupported = errors.New("push notifications not supported")
+
+	// ErrUnsupportedOperation reports an operation the agent does not support. It maps to [UnsupportedOperationErrorCode].
+	ErrUnsupportedOperation = errors.New("operation not supported")
+
+	// ErrContentTypeNotSupported reports incompatible content types. It maps to [ContentTypeNotSupportedErrorCode].
+	ErrContentTypeNotSupported = errors.New("content type not supported")
+
+	// ErrInvalidParams reports invalid method parameters. It maps to [InvalidParamsErrorCode].
+	ErrInvalidParams = errors.New("invalid params")
+)
+
+// errorCodes maps each sentinel error to its JSON-RPC error constructor.
+//
+// [context.DeadlineExceeded] maps to [DeadlineExceededErrorCode], so that a request timing out
+// on the server fails with it on the client too.
+var errorCodes = []struct {
+	err   error
+	newFn func() *JSONRPCError
+}{
+	{ErrTaskNotFound, NewTaskNotFoundError},
+	{ErrTaskNotCancelable, NewTaskNotCancelableError},
+	{ErrPushNotificationNotSupported, NewPushNotificationNotSupportedError},
+	{ErrUnsupportedOperation, NewUnsupportedOperationError},
+	{ErrContentTypeNotSupported, NewContentTypeNotSupportedError},
+	{ErrNoOutputMode, NewContentTypeNotSupportedError},
+	{ErrInvalidParams, NewInvalidParamsError},
+	{context.DeadlineExceeded, NewDeadlineExceededError},
+}
+
+// ToJSONRPCError converts err into the [JSONRPCError] to send to the client.
+//
+// A [*JSONRPCError] in the chain of err is returned as is. Errors wrapping one of the
+// sentinel errors get the matching code and message, and any other error becomes an
+// internal error. Unless err is a bare sentinel, its message is kept in the Data field.
+// ToJSONRPCError returns nil for a nil err.
+func ToJSONRPCError(err error) *JSONRPCError {
+	if err == nil {
+		return nil
+	}
+
+	var jerr *JSONRPCError
+	if errors.As(err, &jerr) {
+		return jerr
+	}
+
+	jerr = NewInternalError()
+	for _, c := range errorCodes {
+		if errors.Is(err, c.err) {
+			if err == c.err {
+				return c.newFn()
+			}
+			jerr = c.newFn()
+			break
+		}
+	}
+	jerr.Data = err.Error()
+	return jerr
+}
+
+// Error implements error.
+func (e *JSONRPCError) Error() string {
+	if e.Data != nil {
+		return fmt.Sprintf("RPC error: [%d] %s: %v", e.Code, e.Message, e.Data)
+	}
+	return fmt.Sprintf("RPC error: [%d] %s", e.Code, e.Message)
+}
+
+// Unwrap returns the sentinel error matching the error code, or nil for other codes.
+func (e *JSONRPCError) Unwrap() error {
+	for _, c := range errorCodes {
+		if c.newFn().Code == e.Code {
+			return c.err
+		}
+	}
+	return nil
+}
+
+// AsError returns e as a Go error, or nil if e is nil.
+//
+// The error wraps the sentinel error matching its code, so callers can test it with
+// [errors.Is], for example errors.Is(err, a2a.ErrTaskNotFound), and recover the
+// [*JSONRPCError] with [errors.As].
+func (e *JSONRPCError) AsError() error {
+	if e == nil {
+		return nil
+	}
+	return e
+}
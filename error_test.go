@@ -0,0 +1,106 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestToJSONRPCError(t *testing.T) {
+	t.Parallel()
+
+	custom := &a2a.JSONRPCError{Code: -32099, Message: "custom"}
+
+	tests := map[string]struct {
+		err  error
+		want *a2a.JSONRPCError
+	}{
+		"nil": {
+			err: nil,
+		},
+		"sentinel": {
+			err:  a2a.ErrTaskNotFound,
+			want: a2a.NewTaskNotFoundError(),
+		},
+		"wrapped sentinel": {
+			err:  fmt.Errorf("cancel task-1: %w", a2a.ErrTaskNotCancelable),
+			want: &a2a.JSONRPCError{Code: a2a.TaskNotCancelableErrorCode, Message: "Task cannot be canceled", Data: "cancel task-1: task cannot be canceled"},
+		},
+		"invalid params": {
+			err:  fmt.Errorf("%w: missing id", a2a.ErrInvalidParams),
+			want: &a2a.JSONRPCError{Code: a2a.InvalidParamsErrorCode, Message: "Invalid parameters", Data: "invalid params: missing id"},
+		},
+		"no output mode": {
+			err:  fmt.Errorf("negotiate: %w", a2a.ErrNoOutputMode),
+			want: &a2a.JSONRPCError{Code: a2a.ContentTypeNotSupportedErrorCode, Message: "Content type not supported", Data: "negotiate: " + a2a.ErrNoOutputMode.Error()},
+		},
+		"deadline exceeded": {
+			err:  fmt.Errorf("process task: %w", context.DeadlineExceeded),
+			want: &a2a.JSONRPCError{Code: a2a.DeadlineExceededErrorCode, Message: "Deadline exceeded", Data: "process task: context deadline exceeded"},
+		},
+		"unknown": {
+			err:  errors.New("disk full"),
+			want: &a2a.JSONRPCError{Code: a2a.InternalErrorCode, Message: "Internal error", Data: "disk full"},
+		},
+		"already a JSON-RPC error": {
+			err:  fmt.Errorf("upstream: %w", custom),
+			want: custom,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := gocmp.Diff(tt.want, a2a.ToJSONRPCError(tt.err)); diff != "" {
+				t.Errorf("ToJSONRPCError() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestJSONRPCError_AsError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		jerr *a2a.JSONRPCError
+		want error
+	}{
+		"task not found":         {jerr: a2a.NewTaskNotFoundError(), want: a2a.ErrTaskNotFound},
+		"task not cancelable":    {jerr: a2a.NewTaskNotCancelableError(), want: a2a.ErrTaskNotCancelable},
+		"push not supported":     {jerr: a2a.NewPushNotificationNotSupportedError(), want: a2a.ErrPushNotificationNotSupported},
+		"unsupported operation":  {jerr: a2a.NewUnsupportedOperationError(), want: a2a.ErrUnsupportedOperation},
+		"content type":           {jerr: a2a.NewContentTypeNotSupportedError(), want: a2a.ErrContentTypeNotSupported},
+		"invalid params":         {jerr: a2a.NewInvalidParamsError(), want: a2a.ErrInvalidParams},
+		"deadline exceeded":      {jerr: a2a.NewDeadlineExceededError(), want: context.DeadlineExceeded},
+		"round trip of wrapping": {jerr: a2a.ToJSONRPCError(fmt.Errorf("get: %w", a2a.ErrTaskNotFound)), want: a2a.ErrTaskNotFound},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.jerr.AsError()
+			if !errors.Is(err, tt.want) {
+				t.Errorf("AsError() = %v, want it to wrap %v", err, tt.want)
+			}
+			var jerr *a2a.JSONRPCError
+			if !errors.As(err, &jerr) || jerr.Code != tt.jerr.Code {
+				t.Errorf("errors.As() did not recover code %d from %v", tt.jerr.Code, err)
+			}
+		})
+	}
+
+	if err := (*a2a.JSONRPCError)(nil).AsError(); err != nil {
+		t.Errorf("nil AsError() = %v, want nil", err)
+	}
+	if err := a2a.NewInternalError().AsError(); errors.Unwrap(err) != nil {
+		t.Errorf("internal error unwraps to %v, want nil", errors.Unwrap(err))
+	}
+}
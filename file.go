@@ -0,0 +1,337 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// ErrUnsupportedScheme is returned by [FilePartReader] for a file URI it cannot fetch.
+var ErrUnsupportedScheme = errors.New("unsupported file URI scheme")
+
+type baseURIKey struct{}
+
+// ContextWithBaseURI returns a copy of ctx carrying base, the URI that [FilePartReader]
+// resolves relative file URIs against, see [BaseURIFromContext].
+func ContextWithBaseURI(ctx context.Context, base *url.URL) context.Context {
+	return context.WithValue(ctx, baseURIKey{}, base)
+}
+
+// BaseURIFromContext returns the base URI carried by ctx, if any.
+func BaseURIFromContext(ctx context.Context) (*url.URL, bool) {
+	base, ok := ctx.Value(baseURIKey{}).(*url.URL)
+	return base, ok && base != nil
+}
+
+// ResolvedFileURI returns the URI of the file of the part, resolved against base if it is
+// relative, as an agent returning paths such as "artifacts/report.pdf" relative to its own
+// endpoint sends. An absolute URI is returned untouched, and so is a relative one when base
+// is nil.
+//
+// It returns an error for a part without a URI or with a malformed one.
+func (p *FilePart) ResolvedFileURI(base *url.URL) (string, error) {
+	if p.File.URI == "" {
+		return "", errors.New("file part has no URI")
+	}
+	u, err := url.Parse(p.File.URI)
+	if err != nil {
+		return "", fmt.Errorf("invalid file URI: %w", err)
+	}
+	if u.IsAbs() || base == nil {
+		return p.File.URI, nil
+	}
+	return base.ResolveReference(u).String(), nil
+}
+
+// FilePartReader returns a reader over the content of the file part p.
+//
+// Inline bytes are decoded as they are read. A URI is fetched lazily when the part has no
+// inline bytes: http and https URIs are requested with ctx and their body is streamed
+// without buffering, and file URIs are opened from the local file system. Other schemes
+// return an error wrapping [ErrUnsupportedScheme]. A relative URI is first resolved against
+// the base URI carried by ctx, see [ContextWithBaseURI]. The caller must close the reader.
+func FilePartReader(ctx context.Context, p Part) (io.ReadCloser, error) {
+	fp, ok := p.(*FilePart)
+	if !ok || fp == nil {
+		return nil, fmt.Errorf("part of type %T is not a file part", p)
+	}
+
+	if fp.File.Bytes != "" {
+		return io.NopCloser(base64.NewDecoder(base64.StdEncoding, strings.NewReader(fp.File.Bytes))), nil
+	}
+	if fp.File.URI == "" {
+		return nil, errors.New("file part has neither bytes nor URI")
+	}
+
+	base, _ := BaseURIFromContext(ctx)
+	uri, err := fp.ResolvedFileURI(base)
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file URI: %w", err)
+	}
+	switch u.Scheme {
+	case "http", "https":
+		return openHTTPFile(ctx, u)
+	case "file":
+		f, err := os.Open(u.Path)
+		if err != nil {
+			return nil, fmt.Errorf("open file: %w", err)
+		}
+		return f, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedScheme, u.Scheme)
+	}
+}
+
+// openHTTPFile requests u and returns the response body of a successful response.
+func openHTTPFile(ctx context.Context, u *url.URL) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("create HTTP request: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch file: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("fetch file: unexpected status %s", resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// NewFilePartFromReader returns a file part holding the content read from r, with the given
+// MIME type and name.
+//
+// The content is base64-encoded as it is read, so only the encoded form is held in memory.
+func NewFilePartFromReader(r io.Reader, mimeType, name string) (Part, error) {
+	var b strings.Builder
+	enc := base64.NewEncoder(base64.StdEncoding, &b)
+	if _, err := io.Copy(enc, r); err != nil {
+		return nil, fmt.Errorf("read file content: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("encode file content: %w", err)
+	}
+
+	return &FilePart{
+		Type: PartTypeFile,
+		File: FileContent{
+			Name:     name,
+			MIMEType: mimeType,
+			Bytes:    b.String(),
+		},
+	}, nil
+}
+
+// ErrMIMETypeMismatch is returned by [FilePart.CheckMIMEType] for a file whose content is not
+// of the MIME type it declares.
+var ErrMIMETypeMismatch = errors.New("file content does not match its MIME type")
+
+// sniffLen is the number of bytes [http.DetectContentType] considers.
+const sniffLen = 512
+
+// SniffMIMEType returns the MIME type of the inline content of the file part, as detected by
+// [http.DetectContentType], without parameters. It sets the MIME type of the file to it if the
+// part declares none.
+//
+// It returns an error for a file without inline bytes, such as one sent by URI, or whose bytes
+// are not valid base64.
+func (p *FilePart) SniffMIMEType() (string, error) {
+	if p.File.Bytes == "" {
+		return "", errors.New("file part has no inline bytes")
+	}
+
+	// Only the first bytes are decoded: 4 base64 characters hold 3 bytes.
+	encoded := p.File.Bytes
+	if n := (sniffLen + 2) / 3 * 4; len(encoded) > n {
+		encoded = encoded[:n]
+	}
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("decode file bytes: %w", err)
+	}
+
+	sniffed := mediaType(http.DetectContentType(data))
+	if p.File.MIMEType == "" {
+		p.File.MIMEType = sniffed
+	}
+	return sniffed, nil
+}
+
+// CheckMIMEType reports an error wrapping [ErrMIMETypeMismatch] if the inline content of the file
+// part is clearly not of the MIME type it declares, as when a part declared as image/png holds
+// a PDF document. A part declaring no MIME type gets the sniffed one, see
+// [FilePart.SniffMIMEType], and files sent by URI are not checked.
+//
+// Content that is not recognized, such as arbitrary binary data, matches any type. So does
+// recognized content of the same family as the declared type: images, audio, video and text
+// each form one, text also matching textual application types such as JSON, and ZIP archives
+// matching the application types based on them, such as the Office and EPUB formats.
+func (p *FilePart) CheckMIMEType() error {
+	if p.File.Bytes == "" {
+		return nil
+	}
+	declared := mediaType(p.File.MIMEType)
+	sniffed, err := p.SniffMIMEType()
+	if err != nil {
+		return err
+	}
+	if declared == "" || compatibleMIMETypes(declared, sniffed) {
+		return nil
+	}
+	return fmt.Errorf("%w: declared %s, content is %s", ErrMIMETypeMismatch, declared, sniffed)
+}
+
+// compatibleMIMETypes reports whether content sniffed as the sniffed media type may be of the
+// declared one.
+func compatibleMIMETypes(declared, sniffed string) bool {
+	if sniffed == "application/octet-stream" || declared == "application/octet-stream" {
+		return true
+	}
+
+	declaredType, declaredSub, _ := strings.Cut(declared, "/")
+	sniffedType, sniffedSub, _ := strings.Cut(sniffed, "/")
+	declaredSub, sniffedSub = strings.TrimPrefix(declaredSub, "x-"), strings.TrimPrefix(sniffedSub, "x-")
+	switch {
+	case declaredType == sniffedType && declaredSub == sniffedSub:
+		return true
+	case declaredType == sniffedType && declaredType != "application":
+		return true
+	case sniffedType == "text":
+		// Textual formats, such as JSON or SVG, are only sniffed as text.
+		return declaredType == "application" || strings.HasSuffix(declaredSub, "+xml") || strings.HasSuffix(declaredSub, "+json")
+	case sniffed == "application/zip":
+		return declaredType == "application" && (strings.HasPrefix(declaredSub, "vnd.") || strings.HasSuffix(declaredSub, "+zip") || declaredSub == "java-archive")
+	case sniffed == "application/ogg":
+		return declaredType == "audio" || declaredType == "video"
+	default:
+		return false
+	}
+}
+
+// WriteFiles writes the content of the file parts of the artifact to files in dir, creating
+// dir if needed, and returns their paths in the order of the parts. Other parts are skipped.
+//
+// The content is read with [FilePartReader], so inline bytes are decoded and URIs fetched,
+// relative ones resolved against the base URI carried by ctx. Files are named after [FileContent.Name], reduced to a base name without path separators,
+// or after the artifact index and part position when the part has no usable name. Existing
+// files are never overwritten: a colliding name gets a numeric suffix before its extension.
+// On error, the files written so far are removed.
+func (a Artifact) WriteFiles(ctx context.Context, dir string) (paths []string, err error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create directory: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			for _, path := range paths {
+				os.Remove(path)
+			}
+			paths = nil
+		}
+	}()
+
+	for i, part := range a.Parts {
+		fp, ok := part.(*FilePart)
+		if !ok || fp == nil {
+			continue
+		}
+		path, err := writeFilePart(ctx, dir, artifactFileName(a.Index, i, fp.File), fp)
+		if path != "" {
+			paths = append(paths, path)
+		}
+		if err != nil {
+			return paths, fmt.Errorf("part %d: %w", i, err)
+		}
+	}
+	return paths, nil
+}
+
+// maxFileNameAttempts bounds the suffixes [Artifact.WriteFiles] tries for a colliding name.
+const maxFileNameAttempts = 1000
+
+// writeFilePart creates a new file in dir named name, or name with a numeric suffix if it
+// exists, and copies the content of fp into it. It returns the path of the file once created,
+// even if copying failed.
+func writeFilePart(ctx context.Context, dir, name string, fp *FilePart) (string, error) {
+	r, err := FilePartReader(ctx, fp)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	ext := filepath.Ext(name)
+	stem := strings.TrimSuffix(name, ext)
+	var f *os.File
+	for n := 0; f == nil; n++ {
+		if n == maxFileNameAttempts {
+			return "", fmt.Errorf("create file: too many files named %q", name)
+		}
+		candidate := name
+		if n > 0 {
+			candidate = fmt.Sprintf("%s-%d%s", stem, n, ext)
+		}
+		f, err = os.OpenFile(filepath.Join(dir, candidate), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err != nil && !errors.Is(err, fs.ErrExist) {
+			return "", fmt.Errorf("create file: %w", err)
+		}
+	}
+
+	path := f.Name()
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return path, fmt.Errorf("write file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return path, fmt.Errorf("write file: %w", err)
+	}
+	return path, nil
+}
+
+// artifactFileName returns the name to write the file of the part at position i of the
+// artifact at index to, from the name of file if it is usable.
+func artifactFileName(index, i int, file FileContent) string {
+	name := strings.ReplaceAll(file.Name, `\`, "/")
+	name = path.Base(name)
+	name = strings.Map(func(r rune) rune {
+		if r < ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimLeft(strings.TrimSpace(name), ".")
+	if name != "" && name != "/" {
+		return name
+	}
+
+	return fmt.Sprintf("artifact-%d-part-%d", index, i) + fileExtension(file.MIMEType)
+}
+
+// fileExtension returns the extension of files of the MIME type mimeType, or an empty string
+// if it has none. Plain text gets ".txt" whatever the system MIME tables list first.
+func fileExtension(mimeType string) string {
+	mimeType = mediaType(mimeType)
+	if mimeType == "text/plain" {
+		return ".txt"
+	}
+	if exts, _ := mime.ExtensionsByType(mimeType); len(exts) > 0 {
+		return exts[0]
+	}
+	return ""
+}
@@ -0,0 +1,384 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestFilePartReader(t *testing.T) {
+	t.Parallel()
+
+	const content = "hello, file"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/report.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		io.WriteString(w, content)
+	}))
+	t.Cleanup(srv.Close)
+
+	path := filepath.Join(t.TempDir(), "report.txt")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	fileURI := (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String()
+
+	inline, err := a2a.NewFilePartFromReader(strings.NewReader(content), "text/plain", "report.txt")
+	if err != nil {
+		t.Fatalf("NewFilePartFromReader() error = %v", err)
+	}
+
+	uriPart := func(uri string) a2a.Part {
+		return &a2a.FilePart{Type: a2a.PartTypeFile, File: a2a.FileContent{Name: "report.txt", URI: uri}}
+	}
+
+	base, err := url.Parse(srv.URL + "/agent/")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	tests := map[string]struct {
+		part    a2a.Part
+		base    *url.URL
+		wantErr error
+		anyErr  bool
+	}{
+		"inline bytes": {
+			part: inline,
+		},
+		"relative URI": {
+			part: uriPart("../report.txt"),
+			base: base,
+		},
+		"relative URI without base": {
+			part:    uriPart("report.txt"),
+			wantErr: a2a.ErrUnsupportedScheme,
+		},
+		"http URI": {
+			part: uriPart(srv.URL + "/report.txt"),
+		},
+		"file URI": {
+			part: uriPart(fileURI),
+		},
+		"http not found": {
+			part:   uriPart(srv.URL + "/missing.txt"),
+			anyErr: true,
+		},
+		"unsupported scheme": {
+			part:    uriPart("ftp://example.com/report.txt"),
+			wantErr: a2a.ErrUnsupportedScheme,
+		},
+		"not a file part": {
+			part:   &a2a.TextPart{Type: a2a.PartTypeText, Text: content},
+			anyErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			if tt.base != nil {
+				ctx = a2a.ContextWithBaseURI(ctx, tt.base)
+			}
+			rc, err := a2a.FilePartReader(ctx, tt.part)
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("FilePartReader() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			case tt.anyErr:
+				if err == nil {
+					rc.Close()
+					t.Fatal("FilePartReader() error = nil, want an error")
+				}
+				return
+			case err != nil:
+				t.Fatalf("FilePartReader() error = %v", err)
+			}
+			defer rc.Close()
+
+			got, err := io.ReadAll(rc)
+			if err != nil {
+				t.Fatalf("ReadAll() error = %v", err)
+			}
+			if diff := gocmp.Diff(content, string(got)); diff != "" {
+				t.Errorf("content mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFilePart_ResolvedFileURI(t *testing.T) {
+	t.Parallel()
+
+	base, err := url.Parse("https://agent.example.com/a2a/")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	tests := map[string]struct {
+		uri     string
+		base    *url.URL
+		want    string
+		wantErr bool
+	}{
+		"relative path": {
+			uri:  "artifacts/report.pdf",
+			base: base,
+			want: "https://agent.example.com/a2a/artifacts/report.pdf",
+		},
+		"absolute path": {
+			uri:  "/files/report.pdf",
+			base: base,
+			want: "https://agent.example.com/files/report.pdf",
+		},
+		"absolute URI": {
+			uri:  "https://cdn.example.com/report.pdf?sig=abc",
+			base: base,
+			want: "https://cdn.example.com/report.pdf?sig=abc",
+		},
+		"relative without base": {
+			uri:  "artifacts/report.pdf",
+			want: "artifacts/report.pdf",
+		},
+		"malformed": {
+			uri:     "http://[::1",
+			base:    base,
+			wantErr: true,
+		},
+		"no URI": {
+			base:    base,
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &a2a.FilePart{Type: a2a.PartTypeFile, File: a2a.FileContent{URI: tt.uri}}
+			got, err := p.ResolvedFileURI(tt.base)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ResolvedFileURI() error = %v, want error %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ResolvedFileURI() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func filePart(mimeType string, data []byte) *a2a.FilePart {
+	return &a2a.FilePart{Type: a2a.PartTypeFile, File: a2a.FileContent{
+		MIMEType: mimeType,
+		Bytes:    base64.StdEncoding.EncodeToString(data),
+	}}
+}
+
+func TestFilePart_SniffMIMEType(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		part         *a2a.FilePart
+		want         string
+		wantMIMEType string
+		wantErr      bool
+	}{
+		"sets missing type": {
+			part:         filePart("", []byte("%PDF-1.7\n")),
+			want:         "application/pdf",
+			wantMIMEType: "application/pdf",
+		},
+		"keeps declared type": {
+			part:         filePart("image/jpeg", []byte("\x89PNG\r\n\x1a\n")),
+			want:         "image/png",
+			wantMIMEType: "image/jpeg",
+		},
+		"large file": {
+			part:         filePart("", append([]byte("GIF89a"), make([]byte, 4096)...)),
+			want:         "image/gif",
+			wantMIMEType: "image/gif",
+		},
+		"URI": {
+			part:    &a2a.FilePart{Type: a2a.PartTypeFile, File: a2a.FileContent{URI: "https://example.com/a.png"}},
+			wantErr: true,
+		},
+		"invalid base64": {
+			part:    &a2a.FilePart{Type: a2a.PartTypeFile, File: a2a.FileContent{Bytes: "not base64!"}},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.part.SniffMIMEType()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SniffMIMEType() error = %v, want error %t", err, tt.wantErr)
+			}
+			if got != tt.want || tt.part.File.MIMEType != tt.wantMIMEType {
+				t.Errorf("SniffMIMEType() = %q with MIME type %q, want %q with %q", got, tt.part.File.MIMEType, tt.want, tt.wantMIMEType)
+			}
+		})
+	}
+}
+
+func TestFilePart_CheckMIMEType(t *testing.T) {
+	t.Parallel()
+
+	var (
+		png  = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+		pdf  = []byte("%PDF-1.7\n")
+		zip  = []byte("PK\x03\x04\x14\x00\x00\x00")
+		json = []byte(`{"name":"report"}`)
+		svg  = []byte(`<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg"/>`)
+		blob = []byte{0x00, 0x01, 0x02, 0x03, 0xfe, 0xff}
+	)
+	tests := map[string]struct {
+		part    *a2a.FilePart
+		wantErr error
+	}{
+		"matching":            {part: filePart("image/png", png)},
+		"parameters ignored":  {part: filePart("Application/PDF; version=1.7", pdf)},
+		"same family":         {part: filePart("image/jpeg", png)},
+		"textual application": {part: filePart("application/json", json)},
+		"xml suffix":          {part: filePart("image/svg+xml", svg)},
+		"zip based":           {part: filePart("application/vnd.openxmlformats-officedocument.wordprocessingml.document", zip)},
+		"unknown content":     {part: filePart("application/x-custom", blob)},
+		"no declared type":    {part: filePart("", pdf)},
+		"image spoofing pdf":  {part: filePart("image/png", pdf), wantErr: a2a.ErrMIMETypeMismatch},
+		"text spoofing image": {part: filePart("text/plain", png), wantErr: a2a.ErrMIMETypeMismatch},
+		"json spoofing pdf":   {part: filePart("application/json", pdf), wantErr: a2a.ErrMIMETypeMismatch},
+		"image spoofing text": {part: filePart("image/png", json), wantErr: a2a.ErrMIMETypeMismatch},
+		"URI is not checked":  {part: &a2a.FilePart{Type: a2a.PartTypeFile, File: a2a.FileContent{MIMEType: "image/png", URI: "https://example.com/a.pdf"}}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := tt.part.CheckMIMEType(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CheckMIMEType() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestArtifact_WriteFiles(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/remote.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		io.WriteString(w, "remote")
+	}))
+	t.Cleanup(srv.Close)
+
+	named := func(name, content string) a2a.Part {
+		p := filePart("text/plain", []byte(content))
+		p.File.Name = name
+		return p
+	}
+	remote := func(name, uri string) a2a.Part {
+		return &a2a.FilePart{Type: a2a.PartTypeFile, File: a2a.FileContent{Name: name, URI: uri}}
+	}
+	tests := map[string]struct {
+		existing map[string]string
+		parts    []a2a.Part
+		want     map[string]string
+		wantErr  bool
+	}{
+		"named files": {
+			parts: []a2a.Part{named("a.txt", "a"), &a2a.TextPart{Type: a2a.PartTypeText, Text: "skipped"}, named("b.txt", "b")},
+			want:  map[string]string{"a.txt": "a", "b.txt": "b"},
+		},
+		"downloaded": {
+			parts: []a2a.Part{remote("remote.txt", srv.URL+"/remote.txt")},
+			want:  map[string]string{"remote.txt": "remote"},
+		},
+		"sanitized": {
+			parts: []a2a.Part{named("../../etc/passwd", "x"), named(`..\win\evil.txt`, "y"), named(".hidden", "z")},
+			want:  map[string]string{"passwd": "x", "evil.txt": "y", "hidden": "z"},
+		},
+		"unnamed": {
+			parts: []a2a.Part{filePart("image/png", []byte("png")), named("..", "dots"), filePart("", []byte("raw"))},
+			want:  map[string]string{"artifact-2-part-0.png": "png", "artifact-2-part-1.txt": "dots", "artifact-2-part-2": "raw"},
+		},
+		"collisions": {
+			existing: map[string]string{"report.txt": "old"},
+			parts:    []a2a.Part{named("report.txt", "first"), named("report.txt", "second")},
+			want:     map[string]string{"report.txt": "old", "report-1.txt": "first", "report-2.txt": "second"},
+		},
+		"error cleans up": {
+			parts:   []a2a.Part{named("kept.txt", "a"), remote("missing.txt", srv.URL+"/missing.txt")},
+			want:    map[string]string{},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := filepath.Join(t.TempDir(), "out")
+			if len(tt.existing) > 0 {
+				if err := os.MkdirAll(dir, 0o755); err != nil {
+					t.Fatalf("MkdirAll() error = %v", err)
+				}
+				for name, content := range tt.existing {
+					if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+						t.Fatalf("WriteFile() error = %v", err)
+					}
+				}
+			}
+
+			art := a2a.Artifact{Index: 2, Parts: tt.parts}
+			paths, err := art.WriteFiles(t.Context(), dir)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WriteFiles() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			for _, path := range paths {
+				if filepath.Dir(path) != dir {
+					t.Errorf("WriteFiles() wrote %s outside of %s", path, dir)
+				}
+			}
+			if wantPaths := len(tt.want) - len(tt.existing); len(paths) != wantPaths {
+				t.Errorf("WriteFiles() returned %d paths, want %d", len(paths), wantPaths)
+			}
+
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				t.Fatalf("ReadDir() error = %v", err)
+			}
+			got := make(map[string]string)
+			for _, entry := range entries {
+				data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+				if err != nil {
+					t.Fatalf("ReadFile() error = %v", err)
+				}
+				got[entry.Name()] = string(data)
+			}
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("WriteFiles() files mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"reflect"
+	"strings"
+)
+
+// AppendUniqueMessage appends m to history unless it repeats the last message of history, as
+// agents streaming several status updates with the same message do.
+//
+// A message extending the last one, from the same role, replaces it instead: its parts start
+// with the parts of the last message, the last of which may be a text part whose text it
+// extends. This keeps a single turn in history for agents streaming a growing message, one
+// delta per status update. Metadata is not compared.
+//
+// Like append, the result may share its array with history, whose last message it may
+// overwrite.
+func AppendUniqueMessage(history []Message, m Message) []Message {
+	if n := len(history); n > 0 {
+		last := history[n-1]
+		if reflect.DeepEqual(last, m) {
+			return history
+		}
+		if extendsMessage(m, last) {
+			history[n-1] = m
+			return history
+		}
+	}
+	return append(history, m)
+}
+
+// extendsMessage reports whether m is prev grown by streaming: the same role and the same
+// parts, except for text appended to its last part and parts appended after it.
+func extendsMessage(m, prev Message) bool {
+	if m.Role != prev.Role || len(prev.Parts) == 0 || len(m.Parts) < len(prev.Parts) {
+		return false
+	}
+	last := len(prev.Parts) - 1
+	for i, part := range prev.Parts[:last] {
+		if !partEqual(m.Parts[i], part) {
+			return false
+		}
+	}
+	if partEqual(m.Parts[last], prev.Parts[last]) {
+		return true
+	}
+	grown, ok := m.Parts[last].(*TextPart)
+	prevText, prevOK := prev.Parts[last].(*TextPart)
+	return ok && prevOK && grown != nil && prevText != nil && strings.HasPrefix(grown.Text, prevText.Text)
+}
+
+// partEqual reports whether a and b have the same content, ignoring their metadata.
+func partEqual(a, b Part) bool {
+	if at, ok := a.(*TextPart); ok {
+		bt, ok := b.(*TextPart)
+		return ok && at != nil && bt != nil && at.Text == bt.Text
+	}
+	return reflect.DeepEqual(a, b)
+}
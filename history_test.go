@@ -0,0 +1,85 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestAppendUniqueMessage(t *testing.T) {
+	t.Parallel()
+
+	msg := func(role a2a.Role, texts ...string) a2a.Message {
+		m := a2a.Message{Role: role}
+		for _, text := range texts {
+			m.Parts = append(m.Parts, &a2a.TextPart{Type: a2a.PartTypeText, Text: text})
+		}
+		return m
+	}
+	file := &a2a.FilePart{Type: a2a.PartTypeFile, File: a2a.FileContent{URI: "https://example.com/a.png"}}
+	withFile := msg(a2a.RoleAgent, "Here")
+	withFile.Parts = append(withFile.Parts, file)
+	withMeta := msg(a2a.RoleAgent, "Hello")
+	withMeta.Metadata = map[string]any{"step": 2.0}
+
+	tests := map[string]struct {
+		history []a2a.Message
+		m       a2a.Message
+		want    []a2a.Message
+	}{
+		"empty history": {
+			m:    msg(a2a.RoleAgent, "Hello"),
+			want: []a2a.Message{msg(a2a.RoleAgent, "Hello")},
+		},
+		"repeated message": {
+			history: []a2a.Message{msg(a2a.RoleUser, "hi"), msg(a2a.RoleAgent, "Hello")},
+			m:       msg(a2a.RoleAgent, "Hello"),
+			want:    []a2a.Message{msg(a2a.RoleUser, "hi"), msg(a2a.RoleAgent, "Hello")},
+		},
+		"growing text": {
+			history: []a2a.Message{msg(a2a.RoleUser, "hi"), msg(a2a.RoleAgent, "Hel")},
+			m:       msg(a2a.RoleAgent, "Hello"),
+			want:    []a2a.Message{msg(a2a.RoleUser, "hi"), msg(a2a.RoleAgent, "Hello")},
+		},
+		"added part": {
+			history: []a2a.Message{msg(a2a.RoleAgent, "Here")},
+			m:       withFile,
+			want:    []a2a.Message{withFile},
+		},
+		"new metadata": {
+			history: []a2a.Message{msg(a2a.RoleAgent, "Hello")},
+			m:       withMeta,
+			want:    []a2a.Message{withMeta},
+		},
+		"different text": {
+			history: []a2a.Message{msg(a2a.RoleAgent, "Hello")},
+			m:       msg(a2a.RoleAgent, "Goodbye"),
+			want:    []a2a.Message{msg(a2a.RoleAgent, "Hello"), msg(a2a.RoleAgent, "Goodbye")},
+		},
+		"different role": {
+			history: []a2a.Message{msg(a2a.RoleUser, "Hello")},
+			m:       msg(a2a.RoleAgent, "Hello there"),
+			want:    []a2a.Message{msg(a2a.RoleUser, "Hello"), msg(a2a.RoleAgent, "Hello there")},
+		},
+		"earlier message repeated": {
+			history: []a2a.Message{msg(a2a.RoleAgent, "Working"), msg(a2a.RoleUser, "ok")},
+			m:       msg(a2a.RoleAgent, "Working"),
+			want:    []a2a.Message{msg(a2a.RoleAgent, "Working"), msg(a2a.RoleUser, "ok"), msg(a2a.RoleAgent, "Working")},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := a2a.AppendUniqueMessage(tt.history, tt.m)
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("AppendUniqueMessage() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"time"
+)
+
+// JournalEntryKind is the kind of a [JournalEntry].
+type JournalEntryKind string
+
+const (
+	// JournalMessage is the kind of the entries recording a message received for the task.
+	JournalMessage JournalEntryKind = "message"
+
+	// JournalStatus is the kind of the entries recording a status the task moved to.
+	JournalStatus JournalEntryKind = "status"
+
+	// JournalArtifact is the kind of the entries recording an artifact the task emitted.
+	JournalArtifact JournalEntryKind = "artifact"
+
+	// JournalError is the kind of the entries recording an error a call on the task failed with.
+	JournalError JournalEntryKind = "error"
+)
+
+// JournalEntry records one thing that happened to a task, in the journal read with
+// [MethodTasksJournalGet]. Exactly one of Message, Status, Artifact and Error is set,
+// according to Kind.
+type JournalEntry struct {
+	// Time is when the entry was recorded.
+	Time time.Time `json:"time"`
+
+	// Kind is the kind of the entry.
+	Kind JournalEntryKind `json:"kind"`
+
+	// Method is the method of the call the entry was recorded during, if any.
+	Method string `json:"method,omitempty"`
+
+	// Message is the message received, for a [JournalMessage] entry.
+	Message *Message `json:"message,omitempty"`
+
+	// Status is the status the task moved to, for a [JournalStatus] entry.
+	Status *TaskStatus `json:"status,omitempty"`
+
+	// Artifact is the artifact emitted, for a [JournalArtifact] entry.
+	Artifact *Artifact `json:"artifact,omitempty"`
+
+	// Error is the error the call failed with, for a [JournalError] entry.
+	Error *JSONRPCError `json:"error,omitempty"`
+}
+
+// TaskJournal is the journal of a task, oldest entry first.
+type TaskJournal struct {
+	// ID is the ID of the task.
+	ID string `json:"id"`
+
+	// Entries holds the recorded entries. The oldest entries of a long task may have been
+	// dropped by the server.
+	Entries []JournalEntry `json:"entries"`
+}
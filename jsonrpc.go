@@ -4,18 +4,44 @@
 package a2a
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
 
 	"github.com/bytedance/sonic"
 )
 
 // ID represents the unique identifier for JSON-RPC messages.
+//
+// An ID is either a string or a number. Integral numbers are held as int64 so that
+// identifiers beyond the float64 safe-integer range survive a round trip, and any other
+// numeric form is preserved verbatim.
+//
+// The zero ID is absent, as in a notification, which is distinct from an explicit null ID
+// and from the number 0. An absent ID is omitted from a [JSONRPCMessage], while a null ID is
+// encoded as null.
 type ID struct {
+	kind   idKind
 	name   string
-	number int32
+	number int64
+	// raw holds the literal form of numeric IDs that don't fit in an int64.
+	raw string
 }
 
+// idKind tells what an [ID] holds.
+type idKind uint8
+
+const (
+	idAbsent idKind = iota
+	idNull
+	idString
+	idNumber
+)
+
 var (
 	_ fmt.Formatter    = (*ID)(nil)
 	_ json.Marshaler   = (*ID)(nil)
@@ -23,30 +49,53 @@ var (
 )
 
 // NewID returns a new request ID.
-func NewID[T string | int32](v T) ID {
+func NewID[T string | int32 | int64](v T) ID {
 	switch v := any(v).(type) {
 	case string:
-		return ID{name: v}
+		return ID{kind: idString, name: v}
 	case int32:
-		return ID{number: v}
+		return ID{kind: idNumber, number: int64(v)}
+	case int64:
+		return ID{kind: idNumber, number: v}
 	default:
 		panic("unreachable")
 	}
 }
 
+// NullID returns the explicit null ID.
+func NullID() ID {
+	return ID{kind: idNull}
+}
+
+// IsAbsent reports whether the ID is absent, as in a notification.
+func (id ID) IsAbsent() bool {
+	return id.kind == idAbsent
+}
+
+// IsNull reports whether the ID is the explicit null ID.
+func (id ID) IsNull() bool {
+	return id.kind == idNull
+}
+
 // Format writes the ID to the formatter.
 //
 // If the rune is q the representation is non ambiguous,
 // string forms are quoted, number forms are preceded by a #.
+// A null ID is written as null, and an absent ID as nothing.
 func (id ID) Format(f fmt.State, r rune) {
-	numF, strF := `%d`, `%s`
+	numF, strF, rawF := `%d`, `%s`, `%s`
 	if r == 'q' {
-		numF, strF = `#%d`, `%q`
+		numF, strF, rawF = `#%d`, `%q`, `#%s`
 	}
 
 	switch {
-	case id.name != "":
+	case id.kind == idAbsent:
+	case id.kind == idNull:
+		fmt.Fprint(f, "null")
+	case id.kind == idString:
 		fmt.Fprintf(f, strF, id.name)
+	case id.raw != "":
+		fmt.Fprintf(f, rawF, id.raw)
 	default:
 		fmt.Fprintf(f, numF, id.number)
 	}
@@ -57,21 +106,88 @@ func (id ID) String() string {
 	return fmt.Sprint(id)
 }
 
-// MarshalJSON implements json.Marshaler.
-func (id *ID) MarshalJSON() ([]byte, error) {
-	if id.name != "" {
-		return sonic.ConfigFastest.Marshal(id.name)
+// Equal reports whether id and other identify the same request.
+//
+// String IDs are equal when their strings are, and never equal a numeric ID, even one with the
+// same digits. Numeric IDs are compared by value, whether they were created with [NewID] or
+// decoded, so 5 equals 5.0. A null ID only equals a null ID, and an absent ID an absent ID.
+func (id ID) Equal(other ID) bool {
+	if id.kind != other.kind {
+		return false
+	}
+	switch id.kind {
+	case idString:
+		return id.name == other.name
+	case idNumber:
+		if id.raw == "" && other.raw == "" {
+			return id.number == other.number
+		}
+		a, okA := id.rat()
+		b, okB := other.rat()
+		return okA && okB && a.Cmp(b) == 0
+	default:
+		return true
 	}
-	return sonic.ConfigFastest.Marshal(id.number)
 }
 
-// UnmarshalJSON implements json.Unmarshaler.
+// rat returns the value of a numeric ID.
+func (id ID) rat() (*big.Rat, bool) {
+	if id.raw == "" {
+		return new(big.Rat).SetInt64(id.number), true
+	}
+	return new(big.Rat).SetString(id.raw)
+}
+
+// MarshalJSON implements json.Marshaler, encoding string IDs with [DefaultCodec].
+//
+// Both null and absent IDs are encoded as null; a [JSONRPCMessage] omits absent IDs instead.
+func (id ID) MarshalJSON() ([]byte, error) {
+	switch {
+	case id.kind == idAbsent, id.kind == idNull:
+		return []byte("null"), nil
+	case id.kind == idString:
+		return DefaultCodec.Marshal(id.name)
+	case id.raw != "":
+		return []byte(id.raw), nil
+	default:
+		return strconv.AppendInt(nil, id.number, 10), nil
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding with [DefaultCodec].
+//
+// A JSON null decodes to the null ID. An id member missing from the JSON leaves the ID absent,
+// since UnmarshalJSON is then not called.
 func (id *ID) UnmarshalJSON(data []byte) error {
 	*id = ID{}
-	if err := sonic.ConfigFastest.Unmarshal(data, &id.number); err == nil {
+
+	data = bytes.TrimSpace(data)
+	switch {
+	case len(data) == 0:
+		return errors.New("ID: empty input")
+	case bytes.Equal(data, []byte("null")):
+		id.kind = idNull
+		return nil
+	case data[0] == '"':
+		id.kind = idString
+		return DefaultCodec.Unmarshal(data, &id.name)
+	}
+
+	// Decode integral numbers exactly instead of going through float64.
+	if n, err := strconv.ParseInt(string(data), 10, 64); err == nil {
+		id.kind = idNumber
+		id.number = n
 		return nil
 	}
-	return sonic.ConfigFastest.Unmarshal(data, &id.name)
+
+	var num json.Number
+	if err := DefaultCodec.Unmarshal(data, &num); err != nil {
+		return fmt.Errorf("ID: must be a string or number: %w", err)
+	}
+	id.kind = idNumber
+	id.raw = num.String()
+
+	return nil
 }
 
 // JSONRPCMessage is the base structure for all JSON-RPC 2.0 messages.
@@ -80,9 +196,16 @@ type JSONRPCMessage struct {
 	JSONRPC string `json:"jsonrpc"`
 
 	// ID is a unique identifier for the request/response correlation.
+	// It is omitted when absent, see [ID].
 	ID ID `json:"id,omitzero"` // string, number, or null
 }
 
+// IsNotification reports whether the message has no id member, which makes a request
+// a notification that gets no response.
+func (m JSONRPCMessage) IsNotification() bool {
+	return m.ID.IsAbsent()
+}
+
 // NewJSONRPCMessage creates a new [JSONRPCMessage] with the given id.
 func NewJSONRPCMessage(id ID) JSONRPCMessage {
 	return JSONRPCMessage{
@@ -115,6 +238,39 @@ type JSONRPCResponse struct {
 	Error *JSONRPCError `json:"error,omitempty"`
 }
 
+// ErrInvalidResponse is returned by [JSONRPCResponse.Validate] for a response that does not
+// set exactly one of its result and error.
+var ErrInvalidResponse = errors.New("invalid JSON-RPC response")
+
+// Validate reports whether r sets exactly one of Result and Error, as JSON-RPC 2.0 requires of
+// every response; notifications are not answered at all. A nil Result counts as absent, and so
+// does a typed nil such as a nil *[Task], which is encoded as a null result.
+//
+// The error wraps [ErrInvalidResponse].
+func (r JSONRPCResponse) Validate() error {
+	hasResult := !isNil(r.Result)
+	switch {
+	case hasResult && r.Error != nil:
+		return fmt.Errorf("%w: both result and error are set", ErrInvalidResponse)
+	case !hasResult && r.Error == nil:
+		return fmt.Errorf("%w: neither result nor error is set", ErrInvalidResponse)
+	}
+	return nil
+}
+
+// isNil reports whether v is nil, or holds a nil pointer, map, slice, channel, function or
+// interface.
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func, reflect.Interface:
+		return rv.IsNil()
+	}
+	return false
+}
+
 // Standard JSON-RPC 2.0 error codes.
 const (
 	// JSONParseErrorCode indicates invalid JSON payload.
@@ -141,6 +297,9 @@ const (
 	UnsupportedOperationErrorCode = -32004
 	// ContentTypeNotSupportedErrorCode indicates a mismatch in supported content types.
 	ContentTypeNotSupportedErrorCode = -32005
+	// DeadlineExceededErrorCode indicates the request was not done by its deadline. It is
+	// reserved by this implementation, apart from the codes of the A2A specification.
+	DeadlineExceededErrorCode = -32010
 )
 
 // JSONRPCError represents a JSON-RPC 2.0 error.
@@ -234,3 +393,42 @@ func NewContentTypeNotSupportedError() *JSONRPCError {
 		Message: "Content type not supported",
 	}
 }
+
+// NewDeadlineExceededError creates a new DeadlineExceededError.
+func NewDeadlineExceededError() *JSONRPCError {
+	return &JSONRPCError{
+		Code:    DeadlineExceededErrorCode,
+		Message: "Deadline exceeded",
+	}
+}
+
+// ParseRequest decodes a single JSON-RPC request from body and checks its envelope.
+//
+// It returns the [JSONParseErrorCode] error if body is not valid JSON, and the
+// [InvalidRequestErrorCode] error if body is not a request object, its jsonrpc member is not
+// "2.0" or its method is empty. Along with an invalid request error, ParseRequest still returns
+// the decoded request when it could, so the error response can echo its ID. The request is
+// decoded with [DefaultCodec].
+func ParseRequest(body []byte) (*JSONRPCRequest, *JSONRPCError) {
+	if !sonic.Valid(body) {
+		return nil, NewJSONParseError()
+	}
+
+	var req JSONRPCRequest
+	if err := DefaultCodec.Unmarshal(body, &req); err != nil {
+		jerr := NewInvalidRequestError()
+		jerr.Data = "not a request object"
+		return nil, jerr
+	}
+
+	var jerr *JSONRPCError
+	switch {
+	case req.JSONRPC != "2.0":
+		jerr = NewInvalidRequestError()
+		jerr.Data = fmt.Sprintf("unsupported jsonrpc version %q", req.JSONRPC)
+	case req.Method == "":
+		jerr = NewInvalidRequestError()
+		jerr.Data = "missing method"
+	}
+	return &req, jerr
+}
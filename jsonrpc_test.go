@@ -4,8 +4,12 @@
 package a2a_test
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"testing"
 
+	"github.com/bytedance/sonic"
 	gocmp "github.com/google/go-cmp/cmp"
 	gocmpopts "github.com/google/go-cmp/cmp/cmpopts"
 
@@ -30,6 +34,11 @@ func TestID(t *testing.T) {
 			want:  a2a.NewID(int32(3)),
 			wantS: "3",
 		},
+		"int64": {
+			v:     int64(9007199254740993),
+			want:  a2a.NewID(int64(9007199254740993)),
+			wantS: "9007199254740993",
+		},
 	}
 
 	for name, tt := range tests {
@@ -42,6 +51,8 @@ func TestID(t *testing.T) {
 				id = a2a.NewID(v)
 			case int32:
 				id = a2a.NewID(v)
+			case int64:
+				id = a2a.NewID(v)
 			}
 
 			if diff := gocmp.Diff(tt.want, id, gocmpopts.EquateComparable(a2a.ID{})); diff != "" {
@@ -73,6 +84,16 @@ func TestIDMarshaling(t *testing.T) {
 			id:       a2a.NewID(int32(3)),
 			expected: `3`,
 		},
+		{
+			name:     "int64",
+			id:       a2a.NewID(int64(9007199254740993)),
+			expected: `9007199254740993`,
+		},
+		{
+			name:     "max_int64",
+			id:       a2a.NewID(int64(math.MaxInt64)),
+			expected: `9223372036854775807`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -99,3 +120,325 @@ func TestIDMarshaling(t *testing.T) {
 		})
 	}
 }
+
+func TestIDUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		data  string
+		wantS string
+		wantQ string
+	}{
+		"large_integer": {
+			data:  `9007199254740993`,
+			wantS: "9007199254740993",
+			wantQ: "#9007199254740993",
+		},
+		"beyond_int64": {
+			data:  `18446744073709551616`,
+			wantS: "18446744073709551616",
+			wantQ: "#18446744073709551616",
+		},
+		"fractional": {
+			data:  `1.5`,
+			wantS: "1.5",
+			wantQ: "#1.5",
+		},
+		"string": {
+			data:  `"9007199254740993"`,
+			wantS: "9007199254740993",
+			wantQ: `"9007199254740993"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var id a2a.ID
+			if err := id.UnmarshalJSON([]byte(tt.data)); err != nil {
+				t.Fatalf("UnmarshalJSON() error = %v", err)
+			}
+
+			if got := id.String(); got != tt.wantS {
+				t.Errorf("String() = %s, want %s", got, tt.wantS)
+			}
+			if got := fmt.Sprintf("%q", id); got != tt.wantQ {
+				t.Errorf("Sprintf(%%q) = %s, want %s", got, tt.wantQ)
+			}
+
+			data, err := id.MarshalJSON()
+			if err != nil {
+				t.Fatalf("MarshalJSON() error = %v", err)
+			}
+			if diff := gocmp.Diff(tt.data, string(data)); diff != "" {
+				t.Errorf("MarshalJSON(): (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestID_Equal(t *testing.T) {
+	t.Parallel()
+
+	decode := func(data string) a2a.ID {
+		var id a2a.ID
+		if err := id.UnmarshalJSON([]byte(data)); err != nil {
+			t.Fatalf("UnmarshalJSON(%s) error = %v", data, err)
+		}
+		return id
+	}
+
+	tests := map[string]struct {
+		a, b a2a.ID
+		want bool
+	}{
+		"same string": {
+			a:    a2a.NewID("req-1"),
+			b:    decode(`"req-1"`),
+			want: true,
+		},
+		"different strings": {
+			a: a2a.NewID("req-1"),
+			b: a2a.NewID("req-2"),
+		},
+		"int32 and decoded integer": {
+			a:    a2a.NewID(int32(5)),
+			b:    decode(`5`),
+			want: true,
+		},
+		"int32 and int64": {
+			a:    a2a.NewID(int32(5)),
+			b:    a2a.NewID(int64(5)),
+			want: true,
+		},
+		"integer and fractional form": {
+			a:    decode(`5`),
+			b:    decode(`5.0`),
+			want: true,
+		},
+		"different numbers": {
+			a: decode(`5`),
+			b: decode(`5.5`),
+		},
+		"beyond int64": {
+			a:    decode(`18446744073709551616`),
+			b:    decode(`1.8446744073709551616e19`),
+			want: true,
+		},
+		"string and number with same digits": {
+			a: a2a.NewID("5"),
+			b: a2a.NewID(int32(5)),
+		},
+		"null IDs": {
+			a:    decode(`null`),
+			b:    a2a.NullID(),
+			want: true,
+		},
+		"null and absent": {
+			a: a2a.NullID(),
+			b: a2a.ID{},
+		},
+		"absent and zero": {
+			a: a2a.ID{},
+			b: a2a.NewID(int64(0)),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.a.Equal(tt.b); got != tt.want {
+				t.Errorf("%q.Equal(%q) = %t, want %t", tt.a, tt.b, got, tt.want)
+			}
+			if got := tt.b.Equal(tt.a); got != tt.want {
+				t.Errorf("%q.Equal(%q) = %t, want %t", tt.b, tt.a, got, tt.want)
+			}
+		})
+	}
+
+	// A decoded integer ID is echoed back exactly as one created with NewID.
+	created, decoded := a2a.NewID(int32(5)), decode(`5`)
+	want, _ := created.MarshalJSON()
+	got, _ := decoded.MarshalJSON()
+	if string(got) != string(want) {
+		t.Errorf("MarshalJSON() of decoded ID = %s, want %s", got, want)
+	}
+}
+
+func TestJSONRPCMessage_ID(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		data             string
+		wantNotification bool
+	}{
+		"absent": {
+			data:             `{"jsonrpc":"2.0"}`,
+			wantNotification: true,
+		},
+		"null": {
+			data: `{"jsonrpc":"2.0","id":null}`,
+		},
+		"zero": {
+			data: `{"jsonrpc":"2.0","id":0}`,
+		},
+		"string": {
+			data: `{"jsonrpc":"2.0","id":""}`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var msg a2a.JSONRPCMessage
+			if err := sonic.ConfigFastest.UnmarshalFromString(tt.data, &msg); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if got := msg.IsNotification(); got != tt.wantNotification {
+				t.Errorf("IsNotification() = %t, want %t", got, tt.wantNotification)
+			}
+
+			got, err := sonic.ConfigFastest.MarshalToString(msg)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if got != tt.data {
+				t.Errorf("Marshal() = %s, want %s", got, tt.data)
+			}
+		})
+	}
+}
+
+func TestParseRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body       string
+		wantMethod string
+		wantID     string
+		wantCode   int
+		wantNoReq  bool
+		// wantNotification is whether the request has no id member.
+		wantNotification bool
+	}{
+		"valid": {
+			body:       `{"jsonrpc":"2.0","id":"req-1","method":"tasks/get","params":{"id":"task-1"}}`,
+			wantMethod: a2a.MethodTasksGet,
+			wantID:     "req-1",
+		},
+		"notification": {
+			body:             `{"jsonrpc":"2.0","method":"tasks/cancel","params":{"id":"task-1"}}`,
+			wantMethod:       a2a.MethodTasksCancel,
+			wantNotification: true,
+		},
+		"null ID": {
+			body:       `{"jsonrpc":"2.0","id":null,"method":"tasks/cancel","params":{"id":"task-1"}}`,
+			wantMethod: a2a.MethodTasksCancel,
+			wantID:     "null",
+		},
+		"zero ID": {
+			body:       `{"jsonrpc":"2.0","id":0,"method":"tasks/cancel","params":{"id":"task-1"}}`,
+			wantMethod: a2a.MethodTasksCancel,
+			wantID:     "0",
+		},
+		"bad JSON": {
+			body:      `{"jsonrpc":"2.0",`,
+			wantCode:  a2a.JSONParseErrorCode,
+			wantNoReq: true,
+		},
+		"not an object": {
+			body:      `"tasks/get"`,
+			wantCode:  a2a.InvalidRequestErrorCode,
+			wantNoReq: true,
+		},
+		"wrong version": {
+			body:       `{"jsonrpc":"1.0","id":7,"method":"tasks/get"}`,
+			wantMethod: a2a.MethodTasksGet,
+			wantID:     "7",
+			wantCode:   a2a.InvalidRequestErrorCode,
+		},
+		"missing method": {
+			body:     `{"jsonrpc":"2.0","id":"req-1"}`,
+			wantID:   "req-1",
+			wantCode: a2a.InvalidRequestErrorCode,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req, jerr := a2a.ParseRequest([]byte(tt.body))
+
+			var code int
+			if jerr != nil {
+				code = jerr.Code
+			}
+			if code != tt.wantCode {
+				t.Errorf("ParseRequest() error = %v, want code %d", jerr, tt.wantCode)
+			}
+			if tt.wantNoReq {
+				if req != nil {
+					t.Errorf("ParseRequest() request = %+v, want nil", req)
+				}
+				return
+			}
+			if req == nil {
+				t.Fatal("ParseRequest() request = nil")
+			}
+			if req.Method != tt.wantMethod || req.ID.String() != tt.wantID {
+				t.Errorf("ParseRequest() = method %q, ID %v, want method %q, ID %s", req.Method, req.ID, tt.wantMethod, tt.wantID)
+			}
+			if got := req.IsNotification(); got != tt.wantNotification {
+				t.Errorf("IsNotification() = %t, want %t", got, tt.wantNotification)
+			}
+		})
+	}
+}
+
+func TestJSONRPCResponse_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		resp    a2a.JSONRPCResponse
+		wantErr bool
+	}{
+		"result": {
+			resp: a2a.JSONRPCResponse{JSONRPCMessage: a2a.NewJSONRPCMessage(a2a.NewID("req-1")), Result: &a2a.Task{ID: "task-1"}},
+		},
+		"error": {
+			resp: a2a.JSONRPCResponse{JSONRPCMessage: a2a.NewJSONRPCMessage(a2a.NewID("req-1")), Error: a2a.NewInternalError()},
+		},
+		"both set": {
+			resp:    a2a.JSONRPCResponse{JSONRPCMessage: a2a.NewJSONRPCMessage(a2a.NewID("req-1")), Result: &a2a.Task{ID: "task-1"}, Error: a2a.NewInternalError()},
+			wantErr: true,
+		},
+		"typed nil result": {
+			resp:    a2a.JSONRPCResponse{JSONRPCMessage: a2a.NewJSONRPCMessage(a2a.NewID("req-1")), Result: (*a2a.Task)(nil)},
+			wantErr: true,
+		},
+		"typed nil result with error": {
+			resp: a2a.JSONRPCResponse{JSONRPCMessage: a2a.NewJSONRPCMessage(a2a.NewID("req-1")), Result: (*a2a.Task)(nil), Error: a2a.NewInternalError()},
+		},
+		"empty slice result": {
+			resp: a2a.JSONRPCResponse{JSONRPCMessage: a2a.NewJSONRPCMessage(a2a.NewID("req-1")), Result: []a2a.Task{}},
+		},
+		"neither set": {
+			resp:    a2a.JSONRPCResponse{JSONRPCMessage: a2a.NewJSONRPCMessage(a2a.NewID("req-1"))},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.resp.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, a2a.ErrInvalidResponse) {
+				t.Errorf("Validate() error = %v, want %v", err, a2a.ErrInvalidResponse)
+			}
+		})
+	}
+}
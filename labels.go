@@ -0,0 +1,103 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+
+	"github.com/bytedance/sonic"
+)
+
+// Limits applied by [ValidateLabels].
+const (
+	// MaxLabels is the maximum number of labels a task may carry.
+	MaxLabels = 64
+
+	// MaxLabelKeyLength is the maximum length of a label key, in bytes.
+	MaxLabelKeyLength = 63
+
+	// MaxLabelValueLength is the maximum length of a label value, in bytes.
+	MaxLabelValueLength = 255
+)
+
+// ValidateLabels reports an error if labels exceed the size limits or contain an empty key.
+func ValidateLabels(labels map[string]string) error {
+	if len(labels) > MaxLabels {
+		return fmt.Errorf("too many labels: %d exceeds maximum of %d", len(labels), MaxLabels)
+	}
+
+	var errs []error
+	for _, key := range slices.Sorted(maps.Keys(labels)) {
+		switch {
+		case key == "":
+			errs = append(errs, errors.New("label key cannot be empty"))
+		case len(key) > MaxLabelKeyLength:
+			errs = append(errs, fmt.Errorf("label key %q exceeds maximum length of %d", key, MaxLabelKeyLength))
+		}
+		if value := labels[key]; len(value) > MaxLabelValueLength {
+			errs = append(errs, fmt.Errorf("label %q value exceeds maximum length of %d", key, MaxLabelValueLength))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// MatchLabels reports whether the task carries every key/value pair in selector.
+//
+// An empty selector matches every task.
+func (t Task) MatchLabels(selector map[string]string) bool {
+	for key, want := range selector {
+		if got, ok := t.Labels[key]; !ok || got != want {
+			return false
+		}
+	}
+	return true
+}
+
+// HasLabel reports whether the artifact carries the label key with the given value.
+func (a Artifact) HasLabel(key, value string) bool {
+	got, ok := a.Labels[key]
+	return ok && got == value
+}
+
+// FilterArtifactsByLabel returns the artifacts carrying the label key with the given value,
+// in order, such as the summaries of a task with key "type" and value "summary".
+func FilterArtifactsByLabel(arts []Artifact, key, value string) []Artifact {
+	var matched []Artifact
+	for _, artifact := range arts {
+		if artifact.HasLabel(key, value) {
+			matched = append(matched, artifact)
+		}
+	}
+	return matched
+}
+
+// artifactLabels decodes the labels of the artifact name from their JSON. Labels were once
+// any JSON value, so rather than failing, it keeps the string values of an object and drops
+// anything else with a warning.
+func artifactLabels(name string, data json.RawMessage) map[string]string {
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+	var values map[string]any
+	if err := sonic.ConfigFastest.Unmarshal(data, &values); err != nil {
+		slog.Warn("ignore artifact labels that are not an object", slog.String("artifact", name), slog.Any("error", err))
+		return nil
+	}
+	labels := make(map[string]string, len(values))
+	for key, value := range values {
+		s, ok := value.(string)
+		if !ok {
+			slog.Warn("ignore artifact label that is not a string", slog.String("artifact", name), slog.String("key", key), slog.Any("value", value))
+			continue
+		}
+		labels[key] = s
+	}
+	return labels
+}
@@ -0,0 +1,198 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/sonic"
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestValidateLabels(t *testing.T) {
+	t.Parallel()
+
+	tooMany := make(map[string]string, a2a.MaxLabels+1)
+	for i := range a2a.MaxLabels + 1 {
+		tooMany["k"+strconv.Itoa(i)] = "v"
+	}
+
+	tests := map[string]struct {
+		labels  map[string]string
+		wantErr bool
+	}{
+		"nil": {
+			labels: nil,
+		},
+		"valid": {
+			labels: map[string]string{"team": "search", "env": "prod"},
+		},
+		"empty value": {
+			labels: map[string]string{"flag": ""},
+		},
+		"empty key": {
+			labels:  map[string]string{"": "x"},
+			wantErr: true,
+		},
+		"key too long": {
+			labels:  map[string]string{strings.Repeat("k", a2a.MaxLabelKeyLength+1): "x"},
+			wantErr: true,
+		},
+		"value too long": {
+			labels:  map[string]string{"k": strings.Repeat("v", a2a.MaxLabelValueLength+1)},
+			wantErr: true,
+		},
+		"too many": {
+			labels:  tooMany,
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := a2a.ValidateLabels(tt.labels)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateLabels() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTask_MatchLabels(t *testing.T) {
+	t.Parallel()
+
+	task := a2a.Task{ID: "task-1", Labels: map[string]string{"team": "search", "env": "prod"}}
+
+	tests := map[string]struct {
+		selector map[string]string
+		want     bool
+	}{
+		"empty selector": {
+			selector: nil,
+			want:     true,
+		},
+		"single match": {
+			selector: map[string]string{"team": "search"},
+			want:     true,
+		},
+		"all match": {
+			selector: map[string]string{"team": "search", "env": "prod"},
+			want:     true,
+		},
+		"value mismatch": {
+			selector: map[string]string{"env": "dev"},
+			want:     false,
+		},
+		"missing key": {
+			selector: map[string]string{"owner": "alice"},
+			want:     false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := task.MatchLabels(tt.selector); got != tt.want {
+				t.Errorf("Task.MatchLabels(%v) = %v, want %v", tt.selector, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTask_LabelsJSON(t *testing.T) {
+	t.Parallel()
+
+	want := a2a.Task{ID: "task-1", Labels: map[string]string{"team": "search"}}
+
+	data, err := sonic.ConfigFastest.Marshal(want)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"labels":{"team":"search"}`) {
+		t.Errorf("Marshal() = %s, want labels field", data)
+	}
+
+	var got a2a.Task
+	if err := sonic.ConfigFastest.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if diff := gocmp.Diff(want.Labels, got.Labels); diff != "" {
+		t.Errorf("Task.Labels round trip mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestArtifact_LabelsJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		data string
+		want map[string]string
+	}{
+		"string labels": {
+			data: `{"parts":[],"labels":{"type":"summary","lang":"en"}}`,
+			want: map[string]string{"type": "summary", "lang": "en"},
+		},
+		"legacy values": {
+			data: `{"parts":[],"labels":{"type":"summary","score":0.9,"tags":["a"],"draft":null}}`,
+			want: map[string]string{"type": "summary"},
+		},
+		"legacy shape": {
+			data: `{"parts":[],"labels":["summary"]}`,
+		},
+		"no labels": {
+			data: `{"parts":[]}`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got a2a.Artifact
+			if err := sonic.ConfigFastest.Unmarshal([]byte(tt.data), &got); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if diff := gocmp.Diff(tt.want, got.Labels); diff != "" {
+				t.Errorf("Artifact.Labels mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFilterArtifactsByLabel(t *testing.T) {
+	t.Parallel()
+
+	arts := []a2a.Artifact{
+		{Name: "summary", Labels: map[string]string{"type": "summary"}},
+		{Name: "raw", Labels: map[string]string{"type": "raw"}},
+		{Name: "unlabeled"},
+		{Name: "short summary", Labels: map[string]string{"type": "summary", "length": "short"}},
+	}
+
+	tests := map[string]struct {
+		key, value string
+		want       []string
+	}{
+		"matching": {key: "type", value: "summary", want: []string{"summary", "short summary"}},
+		"no match": {key: "type", value: "chart"},
+		"empty value does not match a missing label": {key: "length", value: ""},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, artifact := range a2a.FilterArtifactsByLabel(arts, tt.key, tt.value) {
+				got = append(got, artifact.Name)
+			}
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FilterArtifactsByLabel(%q, %q) mismatch (-want +got):\n%s", tt.key, tt.value, diff)
+			}
+		})
+	}
+}
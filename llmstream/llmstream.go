@@ -0,0 +1,169 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package llmstream bridges streaming LLM responses into A2A task events.
+//
+// Most LLM SDKs stream a response as a sequence of text deltas followed by a usage summary.
+// This package turns that shape into [a2a.TaskEvent] values that a streaming task manager can
+// forward to the client, without depending on any particular SDK.
+package llmstream
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	"github.com/go-a2a/a2a"
+)
+
+// UsageMetadataKey is the artifact metadata key holding the [Usage] of the response.
+const UsageMetadataKey = "usage"
+
+// Usage reports the token usage of an LLM response.
+type Usage struct {
+	// InputTokens is the number of prompt tokens.
+	InputTokens int `json:"inputTokens,omitzero"`
+
+	// OutputTokens is the number of generated tokens.
+	OutputTokens int `json:"outputTokens,omitzero"`
+
+	// TotalTokens is the total number of tokens billed.
+	TotalTokens int `json:"totalTokens,omitzero"`
+}
+
+// Options configures how a response is turned into events.
+type Options struct {
+	// ArtifactName is the name of the artifact the text is streamed into.
+	ArtifactName string
+
+	// ArtifactIndex is the index of the artifact the text is streamed into.
+	ArtifactIndex int
+
+	// BufferSize is the capacity of the returned channel.
+	BufferSize int
+}
+
+// FromSeq streams the text deltas of seq into events for taskID.
+//
+// Each delta becomes an artifact chunk appended to the same artifact. Once seq is exhausted,
+// usage is called and the result is attached to a final, empty chunk marked as the last one,
+// followed by a final completed status. If seq yields an error the task is reported as failed.
+// usage may be nil. The channel is closed when the stream ends or ctx is done.
+func FromSeq(ctx context.Context, taskID string, seq iter.Seq2[string, error], usage func() Usage, opts Options) <-chan a2a.TaskEvent {
+	events := make(chan a2a.TaskEvent, opts.BufferSize)
+
+	go func() {
+		defer close(events)
+
+		s := &streamer{ctx: ctx, taskID: taskID, opts: opts, events: events}
+		if !s.status(a2a.TaskStateWorking, "", false) {
+			return
+		}
+
+		for delta, err := range seq {
+			if err != nil {
+				s.status(a2a.TaskStateFailed, err.Error(), true)
+				return
+			}
+			if delta == "" {
+				continue
+			}
+			if !s.chunk(delta, nil, false) {
+				return
+			}
+		}
+
+		var metadata map[string]any
+		if usage != nil {
+			metadata = map[string]any{UsageMetadataKey: usage()}
+		}
+		if !s.chunk("", metadata, true) {
+			return
+		}
+		s.status(a2a.TaskStateCompleted, "", true)
+	}()
+
+	return events
+}
+
+// FromChannel is like [FromSeq] for SDKs that deliver deltas on a channel.
+//
+// The stream ends when deltas is closed; errc, if not nil, is then read once and a
+// non-nil error reports the task as failed.
+func FromChannel(ctx context.Context, taskID string, deltas <-chan string, errc <-chan error, usage func() Usage, opts Options) <-chan a2a.TaskEvent {
+	seq := func(yield func(string, error) bool) {
+		for {
+			select {
+			case <-ctx.Done():
+				yield("", ctx.Err())
+				return
+			case delta, ok := <-deltas:
+				if !ok {
+					if errc != nil {
+						if err := <-errc; err != nil {
+							yield("", err)
+						}
+					}
+					return
+				}
+				if !yield(delta, nil) {
+					return
+				}
+			}
+		}
+	}
+	return FromSeq(ctx, taskID, seq, usage, opts)
+}
+
+// streamer emits the events of a single response.
+type streamer struct {
+	ctx    context.Context
+	taskID string
+	opts   Options
+	events chan<- a2a.TaskEvent
+
+	// started records whether the first chunk has been sent.
+	started bool
+}
+
+// chunk emits an artifact chunk, reporting false if ctx is done.
+func (s *streamer) chunk(text string, metadata map[string]any, last bool) bool {
+	artifact := a2a.Artifact{
+		Name:      s.opts.ArtifactName,
+		Index:     s.opts.ArtifactIndex,
+		Append:    s.started,
+		LastChunk: last,
+		Metadata:  metadata,
+	}
+	if text != "" {
+		artifact.Parts = []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: text}}
+	}
+	s.started = true
+
+	return s.send(&a2a.TaskArtifactUpdateEvent{ID: s.taskID, Artifact: artifact})
+}
+
+// status emits a status update, reporting false if ctx is done.
+func (s *streamer) status(state a2a.TaskState, text string, final bool) bool {
+	status := a2a.TaskStatus{
+		State:     state,
+		Timestamp: time.Now().UTC(),
+	}
+	if text != "" {
+		status.Message = &a2a.Message{
+			Role:  a2a.RoleAgent,
+			Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: text}},
+		}
+	}
+
+	return s.send(&a2a.TaskStatusUpdateEvent{ID: s.taskID, Status: status, Final: final})
+}
+
+func (s *streamer) send(event a2a.TaskEvent) bool {
+	select {
+	case s.events <- event:
+		return true
+	case <-s.ctx.Done():
+		return false
+	}
+}
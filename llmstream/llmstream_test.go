@@ -0,0 +1,99 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package llmstream_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+	gocmpopts "github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/llmstream"
+)
+
+func collect(events <-chan a2a.TaskEvent) []a2a.TaskEvent {
+	var got []a2a.TaskEvent
+	for event := range events {
+		got = append(got, event)
+	}
+	return got
+}
+
+func TestFromChannel(t *testing.T) {
+	t.Parallel()
+
+	deltas := make(chan string, 2)
+	deltas <- "Hello, "
+	deltas <- "world"
+	close(deltas)
+
+	usage := func() llmstream.Usage { return llmstream.Usage{InputTokens: 3, OutputTokens: 2, TotalTokens: 5} }
+	got := collect(llmstream.FromChannel(t.Context(), "task-1", deltas, nil, usage, llmstream.Options{ArtifactName: "answer"}))
+
+	want := []a2a.TaskEvent{
+		&a2a.TaskStatusUpdateEvent{ID: "task-1", Status: a2a.TaskStatus{State: a2a.TaskStateWorking}},
+		&a2a.TaskArtifactUpdateEvent{ID: "task-1", Artifact: a2a.Artifact{
+			Name:  "answer",
+			Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "Hello, "}},
+		}},
+		&a2a.TaskArtifactUpdateEvent{ID: "task-1", Artifact: a2a.Artifact{
+			Name:   "answer",
+			Parts:  []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "world"}},
+			Append: true,
+		}},
+		&a2a.TaskArtifactUpdateEvent{ID: "task-1", Artifact: a2a.Artifact{
+			Name:      "answer",
+			Append:    true,
+			LastChunk: true,
+			Metadata:  map[string]any{llmstream.UsageMetadataKey: llmstream.Usage{InputTokens: 3, OutputTokens: 2, TotalTokens: 5}},
+		}},
+		&a2a.TaskStatusUpdateEvent{ID: "task-1", Status: a2a.TaskStatus{State: a2a.TaskStateCompleted}, Final: true},
+	}
+	opts := []gocmp.Option{
+		gocmpopts.IgnoreFields(a2a.TaskStatus{}, "Timestamp"),
+		gocmpopts.EquateEmpty(),
+	}
+	if diff := gocmp.Diff(want, got, opts...); diff != "" {
+		t.Errorf("FromChannel() events mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFromSeq_Error(t *testing.T) {
+	t.Parallel()
+
+	seq := func(yield func(string, error) bool) {
+		if !yield("partial", nil) {
+			return
+		}
+		yield("", errors.New("rate limited"))
+	}
+	got := collect(llmstream.FromSeq(t.Context(), "task-1", seq, nil, llmstream.Options{}))
+
+	last, ok := got[len(got)-1].(*a2a.TaskStatusUpdateEvent)
+	if !ok {
+		t.Fatalf("last event is %T, want *a2a.TaskStatusUpdateEvent", got[len(got)-1])
+	}
+	if last.Status.State != a2a.TaskStateFailed || !last.Final {
+		t.Errorf("last status = %v (final %v), want final %v", last.Status.State, last.Final, a2a.TaskStateFailed)
+	}
+}
+
+func TestFromSeq_Canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	infinite := func(yield func(string, error) bool) {
+		for yield("token", nil) {
+		}
+	}
+
+	// The channel must close even though nobody reads the events.
+	for range llmstream.FromSeq(ctx, "task-1", infinite, nil, llmstream.Options{}) {
+	}
+}
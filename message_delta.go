@@ -0,0 +1,69 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrMessageDelta is returned by [MessageAccumulator.Add] for a delta that does not continue
+// the message it accumulates.
+var ErrMessageDelta = errors.New("invalid message delta")
+
+// MessageDelta is a fragment of an agent message streamed token by token, carried by a
+// status update in place of the whole message, see [TaskStatus.Delta].
+//
+// The deltas of a message build its text parts in order. A delta whose PartIndex is the
+// number of parts received so far starts a new text part with its text; a delta with the
+// index of a part already started appends its text to that part. The delta marked Last
+// finalizes the message, and the next delta starts a new message.
+type MessageDelta struct {
+	// PartIndex is the index of the text part, in the message, the text belongs to.
+	PartIndex int `json:"partIndex"`
+
+	// Text is the fragment of text appended to the part.
+	Text string `json:"text,omitempty"`
+
+	// Last marks the final delta of the message.
+	Last bool `json:"last,omitzero"`
+}
+
+// MessageAccumulator accumulates the [MessageDelta] values of a stream into the agent messages
+// they stream. The zero value is ready to use.
+type MessageAccumulator struct {
+	parts []*strings.Builder
+}
+
+// Add applies delta to the message being accumulated and returns the message received so far,
+// along with whether delta finalized it. It returns an error wrapping [ErrMessageDelta] for a
+// delta with a negative index, or one skipping parts, and leaves the message unchanged.
+//
+// The returned messages do not share memory with later ones, so they can be kept.
+func (a *MessageAccumulator) Add(delta MessageDelta) (msg Message, complete bool, err error) {
+	switch n := len(a.parts); {
+	case delta.PartIndex < 0 || delta.PartIndex > n:
+		return Message{}, false, fmt.Errorf("%w: part %d of a message with %d parts", ErrMessageDelta, delta.PartIndex, n)
+	case delta.PartIndex == n:
+		a.parts = append(a.parts, new(strings.Builder))
+	}
+	a.parts[delta.PartIndex].WriteString(delta.Text)
+
+	msg = a.Message()
+	if delta.Last {
+		a.parts = nil
+	}
+	return msg, delta.Last, nil
+}
+
+// Message returns the message received so far, with no parts before the first delta.
+func (a *MessageAccumulator) Message() Message {
+	msg := Message{Role: RoleAgent, Parts: make([]Part, len(a.parts))}
+	for i, b := range a.parts {
+		// The strings of a builder are never overwritten, only appended to.
+		msg.Parts[i] = &TextPart{Type: PartTypeText, Text: b.String()}
+	}
+	return msg
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"errors"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestMessageAccumulator(t *testing.T) {
+	t.Parallel()
+
+	text := func(texts ...string) a2a.Message {
+		msg := a2a.Message{Role: a2a.RoleAgent, Parts: []a2a.Part{}}
+		for _, s := range texts {
+			msg.Parts = append(msg.Parts, &a2a.TextPart{Type: a2a.PartTypeText, Text: s})
+		}
+		return msg
+	}
+
+	tests := map[string]struct {
+		deltas       []a2a.MessageDelta
+		want         a2a.Message
+		wantComplete bool
+		wantErr      error
+	}{
+		"append to part": {
+			deltas: []a2a.MessageDelta{{Text: "Hel"}, {Text: "lo"}},
+			want:   text("Hello"),
+		},
+		"new part": {
+			deltas: []a2a.MessageDelta{{Text: "Hello"}, {PartIndex: 1, Text: "world"}, {Text: "!"}},
+			want:   text("Hello!", "world"),
+		},
+		"last finalizes": {
+			deltas:       []a2a.MessageDelta{{Text: "Hello"}, {Text: ".", Last: true}},
+			want:         text("Hello."),
+			wantComplete: true,
+		},
+		"new message after last": {
+			deltas: []a2a.MessageDelta{{Text: "one", Last: true}, {Text: "two"}},
+			want:   text("two"),
+		},
+		"skipped part": {
+			deltas:  []a2a.MessageDelta{{Text: "Hello"}, {PartIndex: 2, Text: "world"}},
+			wantErr: a2a.ErrMessageDelta,
+		},
+		"negative part": {
+			deltas:  []a2a.MessageDelta{{PartIndex: -1, Text: "Hello"}},
+			wantErr: a2a.ErrMessageDelta,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				acc      a2a.MessageAccumulator
+				got      a2a.Message
+				complete bool
+				err      error
+			)
+			for _, delta := range tt.deltas {
+				if got, complete, err = acc.Add(delta); err != nil {
+					break
+				}
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Add() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if complete != tt.wantComplete {
+				t.Errorf("Add() complete = %v, want %v", complete, tt.wantComplete)
+			}
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Add() message mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
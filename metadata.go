@@ -0,0 +1,99 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// MetadataString returns the string stored under key in the metadata of the task, and whether
+// there is one.
+func (t Task) MetadataString(key string) (string, bool) {
+	s, ok := t.Metadata[key].(string)
+	return s, ok
+}
+
+// MetadataInt returns the integer stored under key in the metadata of the task, and whether
+// there is one.
+//
+// Any Go integer type is accepted, and so are the forms numbers take once the metadata is
+// decoded from JSON: a float64 without a fractional part, or a [json.Number].
+func (t Task) MetadataInt(key string) (int, bool) {
+	switch v := t.Metadata[key].(type) {
+	case int:
+		return v, true
+	case int8:
+		return int(v), true
+	case int16:
+		return int(v), true
+	case int32:
+		return int(v), true
+	case int64:
+		return int(v), v >= math.MinInt && v <= math.MaxInt
+	case uint:
+		return int(v), v <= math.MaxInt
+	case uint8:
+		return int(v), true
+	case uint16:
+		return int(v), true
+	case uint32:
+		return int(v), uint64(v) <= math.MaxInt
+	case uint64:
+		return int(v), v <= math.MaxInt
+	case float32:
+		return floatInt(float64(v))
+	case float64:
+		return floatInt(v)
+	case json.Number:
+		n, err := v.Int64()
+		return int(n), err == nil && n >= math.MinInt && n <= math.MaxInt
+	default:
+		return 0, false
+	}
+}
+
+// floatInt returns f as an int, and whether it is an integer that an int holds.
+func floatInt(f float64) (int, bool) {
+	if f != math.Trunc(f) || f < math.MinInt || f >= math.MaxInt {
+		return 0, false
+	}
+	return int(f), true
+}
+
+// MetadataAs returns the value stored under key in the metadata of the task as a T, and
+// whether there is one.
+//
+// A value that is not a T, such as the map[string]any a struct is decoded to from JSON, is
+// converted to T by encoding it with [DefaultCodec] and decoding the result into a T. It
+// returns an error if that fails.
+func MetadataAs[T any](t Task, key string) (T, bool, error) {
+	var out T
+	v, ok := t.Metadata[key]
+	if !ok || v == nil {
+		return out, false, nil
+	}
+	if typed, ok := v.(T); ok {
+		return typed, true, nil
+	}
+
+	data, err := DefaultCodec.Marshal(v)
+	if err != nil {
+		return out, false, fmt.Errorf("marshal %s metadata: %w", key, err)
+	}
+	if err := DefaultCodec.Unmarshal(data, &out); err != nil {
+		return out, false, fmt.Errorf("unmarshal %s metadata to %T: %w", key, out, err)
+	}
+	return out, true, nil
+}
+
+// SetMetadata stores value under key in the metadata of the task, creating the metadata if
+// the task has none.
+func (t *Task) SetMetadata(key string, value any) {
+	if t.Metadata == nil {
+		t.Metadata = make(map[string]any)
+	}
+	t.Metadata[key] = value
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+)
+
+// decodedTask returns a task whose metadata was decoded from JSON, as clients receive it.
+func decodedTask(t *testing.T) a2a.Task {
+	t.Helper()
+
+	data := `{"id":"task-1","status":{"state":"completed"},"metadata":{` +
+		`"owner":"ops","retries":3,"ratio":1.5,"region":{"name":"eu-west","replicas":2}}}`
+	var task a2a.Task
+	if err := a2a.DefaultCodec.Unmarshal([]byte(data), &task); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	return task
+}
+
+func TestTask_MetadataString(t *testing.T) {
+	t.Parallel()
+
+	task := decodedTask(t)
+	tests := map[string]struct {
+		key    string
+		want   string
+		wantOK bool
+	}{
+		"string":     {key: "owner", want: "ops", wantOK: true},
+		"not string": {key: "retries"},
+		"missing":    {key: "absent"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := task.MetadataString(tt.key)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("MetadataString(%q) = %q, %v, want %q, %v", tt.key, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestTask_MetadataInt(t *testing.T) {
+	t.Parallel()
+
+	task := decodedTask(t)
+	task.SetMetadata("native", int64(7))
+	tests := map[string]struct {
+		key    string
+		want   int
+		wantOK bool
+	}{
+		"decoded number": {key: "retries", want: 3, wantOK: true},
+		"native integer": {key: "native", want: 7, wantOK: true},
+		"fraction":       {key: "ratio"},
+		"not a number":   {key: "owner"},
+		"missing":        {key: "absent"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := task.MetadataInt(tt.key)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("MetadataInt(%q) = %d, %v, want %d, %v", tt.key, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestMetadataAs(t *testing.T) {
+	t.Parallel()
+
+	type region struct {
+		Name     string `json:"name"`
+		Replicas int    `json:"replicas"`
+	}
+
+	task := decodedTask(t)
+	got, ok, err := a2a.MetadataAs[region](task, "region")
+	if err != nil || !ok {
+		t.Fatalf("MetadataAs(region) = %v, %v, want the region", ok, err)
+	}
+	if diff := gocmp.Diff(region{Name: "eu-west", Replicas: 2}, got); diff != "" {
+		t.Errorf("MetadataAs(region) mismatch (-want +got):\n%s", diff)
+	}
+
+	if owner, ok, err := a2a.MetadataAs[string](task, "owner"); err != nil || !ok || owner != "ops" {
+		t.Errorf("MetadataAs(owner) = %q, %v, %v, want ops", owner, ok, err)
+	}
+	if _, ok, err := a2a.MetadataAs[region](task, "absent"); err != nil || ok {
+		t.Errorf("MetadataAs(absent) = %v, %v, want not found", ok, err)
+	}
+	if _, ok, err := a2a.MetadataAs[region](task, "owner"); err == nil || ok {
+		t.Errorf("MetadataAs(owner) as region = %v, %v, want an error", ok, err)
+	}
+}
+
+func TestTask_SetMetadata(t *testing.T) {
+	t.Parallel()
+
+	var task a2a.Task
+	task.SetMetadata("owner", "ops")
+	task.SetMetadata("retries", 2)
+
+	want := map[string]any{"owner": "ops", "retries": 2}
+	if diff := gocmp.Diff(want, task.Metadata); diff != "" {
+		t.Errorf("Metadata mismatch (-want +got):\n%s", diff)
+	}
+}
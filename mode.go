@@ -0,0 +1,98 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrNoOutputMode is returned by [NegotiateOutputMode] when the client accepts none of the available modes.
+var ErrNoOutputMode = errors.New("no acceptable output mode")
+
+// NegotiateOutputMode picks the output mode to produce for a client that accepts the given modes.
+//
+// Accepted modes are tried in order of preference, and the first available mode matching
+// one is returned. Either side may use "*/*" or "type/*" wildcards; the more specific of the
+// two matching modes is returned. Media types compare case-insensitively and any parameters
+// are ignored. An empty accepted list means the client accepts anything, so the first
+// available mode is returned. When nothing matches, the error wraps [ErrNoOutputMode].
+func NegotiateOutputMode(accepted, available []string) (string, error) {
+	if len(accepted) == 0 {
+		if len(available) == 0 {
+			return "", nil
+		}
+		return available[0], nil
+	}
+
+	for _, want := range accepted {
+		for _, have := range available {
+			if mode, ok := matchOutputMode(want, have); ok {
+				return mode, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("%w: accepted %v, available %v", ErrNoOutputMode, accepted, available)
+}
+
+// matchOutputMode reports whether the accepted and available modes overlap, returning the more specific one.
+func matchOutputMode(accepted, available string) (string, bool) {
+	want, have := mediaType(accepted), mediaType(available)
+	switch {
+	case want == have:
+		return available, true
+	case matchWildcard(want, have):
+		return available, true
+	case matchWildcard(have, want):
+		return accepted, true
+	default:
+		return "", false
+	}
+}
+
+// matchWildcard reports whether the wildcard pattern "*/*" or "type/*" covers mode.
+func matchWildcard(pattern, mode string) bool {
+	if pattern == "*/*" {
+		return true
+	}
+	prefix, ok := strings.CutSuffix(pattern, "/*")
+	return ok && strings.HasPrefix(mode, prefix+"/")
+}
+
+// PartInputModes returns the input modes p is sent in: the kind of the part, "text", "file" or
+// "data", followed by its media type: "text/plain" for text, the MIME type a file part declares,
+// if any, and "application/json" for data. See [AgentCard.AcceptsInputMode].
+func PartInputModes(p Part) []string {
+	switch p := p.(type) {
+	case *TextPart:
+		return []string{"text", "text/plain"}
+	case *FilePart:
+		if p.File.MIMEType != "" {
+			return []string{"file", p.File.MIMEType}
+		}
+		return []string{"file"}
+	case *DataPart:
+		return []string{"data", "application/json"}
+	default:
+		return nil
+	}
+}
+
+// acceptsMode reports whether mode is one of modes, or is covered by a "*/*" or "type/*"
+// wildcard among them.
+func acceptsMode(modes []string, mode string) bool {
+	mode = mediaType(mode)
+	return slices.ContainsFunc(modes, func(m string) bool {
+		m = mediaType(m)
+		return m == mode || matchWildcard(m, mode)
+	})
+}
+
+// mediaType returns mode lower-cased and without parameters.
+func mediaType(mode string) string {
+	mode, _, _ = strings.Cut(mode, ";")
+	return strings.ToLower(strings.TrimSpace(mode))
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestNegotiateOutputMode(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		accepted  []string
+		available []string
+		want      string
+		wantErr   bool
+	}{
+		"empty accepted means any": {
+			available: []string{"text/plain", "application/json"},
+			want:      "text/plain",
+		},
+		"nothing on either side": {},
+		"client preference wins": {
+			accepted:  []string{"application/json", "text/plain"},
+			available: []string{"text/plain", "application/json"},
+			want:      "application/json",
+		},
+		"case insensitive": {
+			accepted:  []string{"Application/JSON"},
+			available: []string{"application/json"},
+			want:      "application/json",
+		},
+		"parameters ignored": {
+			accepted:  []string{"text/plain; charset=utf-8"},
+			available: []string{"text/plain"},
+			want:      "text/plain",
+		},
+		"any wildcard": {
+			accepted:  []string{"*/*"},
+			available: []string{"image/png"},
+			want:      "image/png",
+		},
+		"type wildcard": {
+			accepted:  []string{"image/*"},
+			available: []string{"text/plain", "image/png"},
+			want:      "image/png",
+		},
+		"available wildcard": {
+			accepted:  []string{"text/csv"},
+			available: []string{"text/*"},
+			want:      "text/csv",
+		},
+		"no overlap": {
+			accepted:  []string{"image/*", "audio/mpeg"},
+			available: []string{"text/plain", "application/json"},
+			wantErr:   true,
+		},
+		"nothing available": {
+			accepted: []string{"text/plain"},
+			wantErr:  true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := a2a.NegotiateOutputMode(tt.accepted, tt.available)
+			if tt.wantErr {
+				if !errors.Is(err, a2a.ErrNoOutputMode) {
+					t.Fatalf("NegotiateOutputMode() error = %v, want %v", err, a2a.ErrNoOutputMode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NegotiateOutputMode() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("NegotiateOutputMode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+// Multipart uploads.
+//
+// A tasks/send or tasks/sendSubscribe request may be sent as multipart/form-data to keep large
+// files out of the JSON payload. The JSON-RPC request goes in the [MultipartRequestField] field,
+// and each file part moved out of it has its bytes replaced by a URI of the [ContentIDScheme]
+// naming the form file that carries its content.
+const (
+	// MultipartRequestField is the form field holding the JSON-RPC request of a multipart upload.
+	MultipartRequestField = "request"
+
+	// ContentIDScheme prefixes the URI of a file part whose content is a form file of a multipart upload.
+	ContentIDScheme = "cid:"
+)
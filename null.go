@@ -0,0 +1,67 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// NullPolicy tells how the optional fields of a [TaskStatus] are encoded when they are unset.
+type NullPolicy uint8
+
+const (
+	// NullOmit omits unset optional fields, the default.
+	NullOmit NullPolicy = iota
+
+	// NullExplicit encodes unset optional fields as null, for peers telling a field that is
+	// present and null from an absent one, as the Python SDK does for status reasons.
+	NullExplicit
+)
+
+// DefaultNullPolicy is the [NullPolicy] of every [TaskStatus] encoded, including those of the
+// tasks and status update events sent by clients and servers.
+//
+// It applies to the message, error, reason, expiry and delta of the status; an empty reason
+// is unset. Either encoding decodes to the same status. DefaultNullPolicy may be set before any
+// message is encoded, but not while messages are being encoded.
+var DefaultNullPolicy = NullOmit
+
+// taskStatus is a [TaskStatus] without its methods, encoded as the struct tags of TaskStatus
+// describe.
+type taskStatus TaskStatus
+
+// explicitTaskStatus is a [TaskStatus] encoded with every optional field, see [NullExplicit].
+type explicitTaskStatus struct {
+	State     TaskState     `json:"state"`
+	Message   *Message      `json:"message"`
+	Error     *TaskError    `json:"error"`
+	Reason    *string       `json:"reason"`
+	Timestamp time.Time     `json:"timestamp"`
+	Expiry    *time.Time    `json:"expiry"`
+	Delta     *MessageDelta `json:"delta"`
+}
+
+var _ json.Marshaler = TaskStatus{}
+
+// MarshalJSON implements [json.Marshaler], encoding with [DefaultCodec] and following
+// [DefaultNullPolicy].
+func (s TaskStatus) MarshalJSON() ([]byte, error) {
+	if DefaultNullPolicy != NullExplicit {
+		return DefaultCodec.Marshal(taskStatus(s))
+	}
+
+	explicit := explicitTaskStatus{
+		State:     s.State,
+		Message:   s.Message,
+		Error:     s.Error,
+		Timestamp: s.Timestamp,
+		Expiry:    s.Expiry,
+		Delta:     s.Delta,
+	}
+	if s.Reason != "" {
+		explicit.Reason = &s.Reason
+	}
+	return DefaultCodec.Marshal(explicit)
+}
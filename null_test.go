@@ -0,0 +1,127 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+)
+
+// pythonCanceledStatus is a canceled status in the shape the Python SDK encodes it, with its
+// unset optional fields present and null.
+const pythonCanceledStatus = `{"state":"canceled","message":null,"error":null,"reason":"user request",` +
+	`"timestamp":"2025-05-01T12:00:00Z","expiry":null,"delta":null}`
+
+// setNullPolicy sets [a2a.DefaultNullPolicy] for the duration of the test, which must not be
+// parallel.
+func setNullPolicy(t *testing.T, policy a2a.NullPolicy) {
+	t.Helper()
+
+	prev := a2a.DefaultNullPolicy
+	a2a.DefaultNullPolicy = policy
+	t.Cleanup(func() { a2a.DefaultNullPolicy = prev })
+}
+
+// Not parallel: the tests set the package-wide null policy.
+func TestTaskStatus_NullPolicy(t *testing.T) {
+	timestamp := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		policy a2a.NullPolicy
+		status a2a.TaskStatus
+		want   string
+	}{
+		"omit unset fields": {
+			policy: a2a.NullOmit,
+			status: a2a.TaskStatus{State: a2a.TaskStateWorking, Timestamp: timestamp},
+			want:   `{"state":"working","timestamp":"2025-05-01T12:00:00Z"}`,
+		},
+		"omit keeps set fields": {
+			policy: a2a.NullOmit,
+			status: a2a.TaskStatus{
+				State:     a2a.TaskStateFailed,
+				Error:     a2a.NewTaskError(a2a.TaskErrTimeout, "too slow"),
+				Reason:    "deadline",
+				Timestamp: timestamp,
+			},
+			want: `{"state":"failed","error":{"code":"timeout","message":"too slow"},"reason":"deadline","timestamp":"2025-05-01T12:00:00Z"}`,
+		},
+		"explicit nulls": {
+			policy: a2a.NullExplicit,
+			status: a2a.TaskStatus{State: a2a.TaskStateWorking, Timestamp: timestamp},
+			want:   `{"state":"working","message":null,"error":null,"reason":null,"timestamp":"2025-05-01T12:00:00Z","expiry":null,"delta":null}`,
+		},
+		"explicit keeps set fields": {
+			policy: a2a.NullExplicit,
+			status: a2a.TaskStatus{
+				State:     a2a.TaskStateFailed,
+				Error:     a2a.NewTaskError(a2a.TaskErrTimeout, "too slow"),
+				Reason:    "deadline",
+				Timestamp: timestamp,
+			},
+			want: `{"state":"failed","message":null,"error":{"code":"timeout","message":"too slow"},"reason":"deadline","timestamp":"2025-05-01T12:00:00Z","expiry":null,"delta":null}`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			setNullPolicy(t, tt.policy)
+
+			for _, codec := range []a2a.Codec{a2a.StdCodec{}, a2a.DefaultCodec} {
+				data, err := codec.Marshal(tt.status)
+				if err != nil {
+					t.Fatalf("%T.Marshal() error = %v", codec, err)
+				}
+				if got := string(data); got != tt.want {
+					t.Errorf("%T.Marshal() = %s, want %s", codec, got, tt.want)
+				}
+
+				var got a2a.TaskStatus
+				if err := codec.Unmarshal(data, &got); err != nil {
+					t.Fatalf("%T.Unmarshal() error = %v", codec, err)
+				}
+				if diff := gocmp.Diff(tt.status, got); diff != "" {
+					t.Errorf("%T round trip mismatch (-want +got):\n%s", codec, diff)
+				}
+			}
+		})
+	}
+
+	t.Run("python fixture", func(t *testing.T) {
+		var status a2a.TaskStatus
+		if err := a2a.DefaultCodec.Unmarshal([]byte(pythonCanceledStatus), &status); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		want := a2a.TaskStatus{State: a2a.TaskStateCanceled, Reason: "user request", Timestamp: timestamp}
+		if diff := gocmp.Diff(want, status); diff != "" {
+			t.Errorf("decoded status mismatch (-want +got):\n%s", diff)
+		}
+
+		setNullPolicy(t, a2a.NullExplicit)
+		data, err := a2a.DefaultCodec.Marshal(status)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		if got := string(data); got != pythonCanceledStatus {
+			t.Errorf("Marshal() = %s, want the fixture %s", got, pythonCanceledStatus)
+		}
+	})
+
+	t.Run("task", func(t *testing.T) {
+		setNullPolicy(t, a2a.NullExplicit)
+
+		task := a2a.Task{ID: "task-1", Status: a2a.TaskStatus{State: a2a.TaskStateSubmitted, Timestamp: timestamp}}
+		data, err := a2a.DefaultCodec.Marshal(task)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		want := `"status":{"state":"submitted","message":null,"error":null,"reason":null,"timestamp":"2025-05-01T12:00:00Z","expiry":null,"delta":null}`
+		if !strings.Contains(string(data), want) {
+			t.Errorf("Marshal() = %s, want it to hold %s", data, want)
+		}
+	})
+}
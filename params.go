@@ -0,0 +1,60 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"fmt"
+)
+
+// DecodeParams decodes the params member of req into a T, such as [TaskSendParams].
+//
+// It returns the [InvalidParamsErrorCode] error, with the decode error in Data, if the
+// params are missing or do not decode into a T. The params are decoded with [DefaultCodec].
+func DecodeParams[T any](req *JSONRPCRequest) (T, *JSONRPCError) {
+	var params T
+	if len(req.Params) == 0 {
+		return params, ToJSONRPCError(fmt.Errorf("%w: missing params", ErrInvalidParams))
+	}
+	if err := DefaultCodec.Unmarshal(req.Params, &params); err != nil {
+		return params, ToJSONRPCError(fmt.Errorf("%w: %w", ErrInvalidParams, err))
+	}
+	return params, nil
+}
+
+// methodParams maps each A2A method to the decoder of its params type.
+var methodParams = map[string]func(*JSONRPCRequest) (any, *JSONRPCError){
+	MethodTasksSend:                decodeParamsAny[TaskSendParams],
+	MethodTasksGet:                 decodeParamsAny[TaskQueryParams],
+	MethodTasksCancel:              decodeParamsAny[TaskIDParams],
+	MethodTasksPushNotificationSet: decodeParamsAny[TaskPushNotificationConfig],
+	MethodTasksPushNotificationGet: decodeParamsAny[TaskIDParams],
+	MethodTasksSendSubscribe:       decodeParamsAny[TaskSendParams],
+	MethodTasksResubscribe:         decodeParamsAny[TaskResubscriptionParams],
+	MethodTasksInputAppend:         decodeParamsAny[TaskInputParams],
+	MethodTasksHistoryGet:          decodeParamsAny[TaskHistoryParams],
+	MethodTasksArtifactsGet:        decodeParamsAny[TaskArtifactsParams],
+	MethodTasksList:                decodeParamsAny[TaskListParams],
+	MethodTasksJournalGet:          decodeParamsAny[TaskIDParams],
+}
+
+func decodeParamsAny[T any](req *JSONRPCRequest) (any, *JSONRPCError) {
+	params, jerr := DecodeParams[T](req)
+	if jerr != nil {
+		return nil, jerr
+	}
+	return params, nil
+}
+
+// DecodeMethodParams decodes the params of req into the params type of its method, such as
+// [TaskQueryParams] for [MethodTasksGet], and returns them by value.
+//
+// It returns the [MethodNotFoundErrorCode] error for a method that is not an A2A method, and
+// the error of [DecodeParams] for params that do not decode.
+func DecodeMethodParams(req *JSONRPCRequest) (any, *JSONRPCError) {
+	decode, ok := methodParams[req.Method]
+	if !ok {
+		return nil, NewMethodNotFoundError()
+	}
+	return decode(req)
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestDecodeParams(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		params   string
+		want     a2a.TaskQueryParams
+		wantCode int
+	}{
+		"valid": {
+			params: `{"id":"task-1","historyLength":2}`,
+			want:   a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}, HistoryLength: ptr(2)},
+		},
+		"missing": {
+			wantCode: a2a.InvalidParamsErrorCode,
+		},
+		"wrong type": {
+			params:   `{"id":1}`,
+			wantCode: a2a.InvalidParamsErrorCode,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := &a2a.JSONRPCRequest{Method: a2a.MethodTasksGet, Params: []byte(tt.params)}
+			got, jerr := a2a.DecodeParams[a2a.TaskQueryParams](req)
+			if tt.wantCode != 0 {
+				if jerr == nil || jerr.Code != tt.wantCode || jerr.Data == nil {
+					t.Fatalf("DecodeParams() error = %v, want code %d with data", jerr, tt.wantCode)
+				}
+				return
+			}
+			if jerr != nil {
+				t.Fatalf("DecodeParams() error = %v", jerr)
+			}
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("DecodeParams() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDecodeMethodParams(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method   string
+		params   string
+		want     any
+		wantCode int
+	}{
+		"send": {
+			method: a2a.MethodTasksSend,
+			params: `{"id":"task-1","message":{"role":"user","parts":[{"type":"text","text":"hi"}]}}`,
+			want: a2a.TaskSendParams{
+				TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+				Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "hi"}}},
+			},
+		},
+		"cancel": {
+			method: a2a.MethodTasksCancel,
+			params: `{"id":"task-1"}`,
+			want:   a2a.TaskIDParams{ID: "task-1"},
+		},
+		"invalid params": {
+			method:   a2a.MethodTasksCancel,
+			params:   `"task-1"`,
+			wantCode: a2a.InvalidParamsErrorCode,
+		},
+		"unknown method": {
+			method:   "tasks/unknown",
+			params:   `{}`,
+			wantCode: a2a.MethodNotFoundErrorCode,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, jerr := a2a.DecodeMethodParams(&a2a.JSONRPCRequest{Method: tt.method, Params: []byte(tt.params)})
+			if tt.wantCode != 0 {
+				if jerr == nil || jerr.Code != tt.wantCode {
+					t.Fatalf("DecodeMethodParams() error = %v, want code %d", jerr, tt.wantCode)
+				}
+				return
+			}
+			if jerr != nil {
+				t.Fatalf("DecodeMethodParams() error = %v", jerr)
+			}
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("DecodeMethodParams() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bytedance/sonic"
+)
+
+var (
+	// ErrNotDataPart is returned by [DataPartAs] for a part that is not a [DataPart].
+	ErrNotDataPart = errors.New("not a data part")
+
+	// ErrDataMismatch is returned by [DataPartAs] for data that does not match the target type.
+	ErrDataMismatch = errors.New("data does not match the target type")
+)
+
+const (
+	// PartTitleKey is the part metadata key holding the human-readable title of a part.
+	PartTitleKey = "title"
+
+	// PartMIMETypeKey is the part metadata key holding the MIME type of the structured data of a
+	// data part, such as "application/vnd.example+json".
+	PartMIMETypeKey = "mimeType"
+)
+
+// PartOption represents an option for configuring the metadata of a part built by
+// [NewTextPart] or [NewDataPart].
+type PartOption func(metadata map[string]any)
+
+// WithPartTitle sets the [PartTitleKey] metadata of the part.
+func WithPartTitle(title string) PartOption {
+	return func(metadata map[string]any) {
+		metadata[PartTitleKey] = title
+	}
+}
+
+// WithPartMIMEType sets the [PartMIMETypeKey] metadata of the part.
+func WithPartMIMEType(mimeType string) PartOption {
+	return func(metadata map[string]any) {
+		metadata[PartMIMETypeKey] = mimeType
+	}
+}
+
+// WithPartMetadata sets the metadata key of the part to value.
+func WithPartMetadata(key string, value any) PartOption {
+	return func(metadata map[string]any) {
+		metadata[key] = value
+	}
+}
+
+// NewTextPart returns a text part holding text, with the metadata set by opts.
+func NewTextPart(text string, opts ...PartOption) Part {
+	return &TextPart{
+		Type:     PartTypeText,
+		Text:     text,
+		Metadata: newPartMetadata(opts),
+	}
+}
+
+// NewDataPart returns a data part holding data, with the metadata set by opts.
+func NewDataPart(data map[string]any, opts ...PartOption) Part {
+	return &DataPart{
+		Type:     PartTypeData,
+		Data:     data,
+		Metadata: newPartMetadata(opts),
+	}
+}
+
+// NewDataPartWithMeta returns a data part holding data, whose MIME type and title are set in
+// its metadata, under [PartMIMETypeKey] and [PartTitleKey]. Empty values are left out.
+func NewDataPartWithMeta(data map[string]any, mimeType, title string) Part {
+	var opts []PartOption
+	if mimeType != "" {
+		opts = append(opts, WithPartMIMEType(mimeType))
+	}
+	if title != "" {
+		opts = append(opts, WithPartTitle(title))
+	}
+	return NewDataPart(data, opts...)
+}
+
+// newPartMetadata returns the metadata set by opts, or nil if there are none.
+func newPartMetadata(opts []PartOption) map[string]any {
+	if len(opts) == 0 {
+		return nil
+	}
+	metadata := make(map[string]any)
+	for _, opt := range opts {
+		opt(metadata)
+	}
+	return metadata
+}
+
+// DefaultTextSeparator is the separator [Message.Text] and [Artifact.Text] put between text parts.
+const DefaultTextSeparator = "\n"
+
+// Text returns the text of every text part of the message, joined by [DefaultTextSeparator].
+func (m Message) Text() string {
+	return joinText(m.Parts, DefaultTextSeparator)
+}
+
+// JoinText returns the text of every text part of the message, joined by sep.
+func (m Message) JoinText(sep string) string {
+	return joinText(m.Parts, sep)
+}
+
+// DataParts returns the structured data of every data part of the message, in order.
+func (m Message) DataParts() []any {
+	var data []any
+	for _, part := range m.Parts {
+		if dp, ok := part.(*DataPart); ok && dp != nil {
+			data = append(data, dp.Data)
+		}
+	}
+	return data
+}
+
+// DataPartAs decodes the structured data of the data part p into a T, by way of JSON.
+//
+// It returns an error wrapping [ErrNotDataPart] if p is not a [DataPart], or [ErrDataMismatch]
+// if the data does not match T, including fields T does not declare.
+//
+//	order, err := a2a.DataPartAs[Order](part)
+func DataPartAs[T any](p Part) (T, error) {
+	var v T
+
+	dp, ok := p.(*DataPart)
+	if !ok || dp == nil {
+		return v, fmt.Errorf("%w: %T", ErrNotDataPart, p)
+	}
+	if err := decodeData(dp.Data, &v); err != nil {
+		var zero T
+		return zero, fmt.Errorf("%w: %w", ErrDataMismatch, err)
+	}
+	return v, nil
+}
+
+// FirstDataPartAs decodes the first data part of the message into a T, see [DataPartAs]. It
+// reports false if the message has no data part.
+func FirstDataPartAs[T any](m Message) (T, bool, error) {
+	for _, part := range m.Parts {
+		if dp, ok := part.(*DataPart); ok && dp != nil {
+			v, err := DataPartAs[T](dp)
+			return v, true, err
+		}
+	}
+	var zero T
+	return zero, false, nil
+}
+
+// decodeData decodes the structured data of a data part into v, which must be a pointer,
+// rejecting fields v does not declare.
+func decodeData(data map[string]any, v any) error {
+	b, err := sonic.ConfigFastest.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("marshal data: %w", err)
+	}
+	if err := resultDecoder.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("decode data into %T: %w", v, err)
+	}
+	return nil
+}
+
+// FileParts returns every file part of the message, in order.
+func (m Message) FileParts() []Part {
+	var files []Part
+	for _, part := range m.Parts {
+		if fp, ok := part.(*FilePart); ok && fp != nil {
+			files = append(files, fp)
+		}
+	}
+	return files
+}
+
+// Text returns the text of every text part of the artifact, joined by [DefaultTextSeparator].
+func (a Artifact) Text() string {
+	return joinText(a.Parts, DefaultTextSeparator)
+}
+
+// JoinText returns the text of every text part of the artifact, joined by sep.
+func (a Artifact) JoinText(sep string) string {
+	return joinText(a.Parts, sep)
+}
+
+// joinText joins the text of the text parts in parts with sep, skipping nil parts.
+func joinText(parts []Part, sep string) string {
+	var (
+		b     strings.Builder
+		first = true
+	)
+	for _, part := range parts {
+		tp, ok := part.(*TextPart)
+		if !ok || tp == nil {
+			continue
+		}
+		if !first {
+			b.WriteString(sep)
+		}
+		b.WriteString(tp.Text)
+		first = false
+	}
+	return b.String()
+}
@@ -0,0 +1,191 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"errors"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestMessage_Parts(t *testing.T) {
+	t.Parallel()
+
+	file := &a2a.FilePart{Type: a2a.PartTypeFile, File: a2a.FileContent{Name: "report.pdf", URI: "https://example.com/report.pdf"}}
+	msg := a2a.Message{
+		Role: a2a.RoleAgent,
+		Parts: []a2a.Part{
+			&a2a.TextPart{Type: a2a.PartTypeText, Text: "first"},
+			nil,
+			(*a2a.TextPart)(nil),
+			&a2a.DataPart{Type: a2a.PartTypeData, Data: map[string]any{"total": 42.0}},
+			file,
+			&a2a.TextPart{Type: a2a.PartTypeText, Text: "second"},
+		},
+	}
+
+	tests := map[string]struct {
+		got  any
+		want any
+	}{
+		"Text": {
+			got:  msg.Text(),
+			want: "first\nsecond",
+		},
+		"JoinText": {
+			got:  msg.JoinText(" "),
+			want: "first second",
+		},
+		"DataParts": {
+			got:  msg.DataParts(),
+			want: []any{map[string]any{"total": 42.0}},
+		},
+		"FileParts": {
+			got:  msg.FileParts(),
+			want: []a2a.Part{file},
+		},
+		"Artifact.Text": {
+			got:  a2a.Artifact{Parts: msg.Parts}.Text(),
+			want: "first\nsecond",
+		},
+		"Artifact.JoinText": {
+			got:  a2a.Artifact{Parts: msg.Parts}.JoinText(""),
+			want: "firstsecond",
+		},
+		"empty": {
+			got:  a2a.Message{}.Text(),
+			want: "",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := gocmp.Diff(tt.want, tt.got); diff != "" {
+				t.Errorf("%s mismatch (-want +got):\n%s", name, diff)
+			}
+		})
+	}
+}
+
+// order is the structured data of the data parts decoded in tests.
+type order struct {
+	ID    string  `json:"id"`
+	Total float64 `json:"total"`
+}
+
+func TestDataPartAs(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		part    a2a.Part
+		want    order
+		wantErr error
+	}{
+		"data part": {
+			part: &a2a.DataPart{Type: a2a.PartTypeData, Data: map[string]any{"id": "o-1", "total": 42.5}},
+			want: order{ID: "o-1", Total: 42.5},
+		},
+		"text part": {
+			part:    &a2a.TextPart{Type: a2a.PartTypeText, Text: "o-1"},
+			wantErr: a2a.ErrNotDataPart,
+		},
+		"nil data part": {
+			part:    (*a2a.DataPart)(nil),
+			wantErr: a2a.ErrNotDataPart,
+		},
+		"wrong field type": {
+			part:    &a2a.DataPart{Type: a2a.PartTypeData, Data: map[string]any{"id": "o-1", "total": "a lot"}},
+			wantErr: a2a.ErrDataMismatch,
+		},
+		"unknown field": {
+			part:    &a2a.DataPart{Type: a2a.PartTypeData, Data: map[string]any{"id": "o-1", "currency": "EUR"}},
+			wantErr: a2a.ErrDataMismatch,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := a2a.DataPartAs[order](tt.part)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DataPartAs() error = %v, want %v", err, tt.wantErr)
+			}
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("DataPartAs() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFirstDataPartAs(t *testing.T) {
+	t.Parallel()
+
+	msg := a2a.Message{
+		Role: a2a.RoleAgent,
+		Parts: []a2a.Part{
+			&a2a.TextPart{Type: a2a.PartTypeText, Text: "your order"},
+			&a2a.DataPart{Type: a2a.PartTypeData, Data: map[string]any{"id": "o-1", "total": 42.5}},
+			&a2a.DataPart{Type: a2a.PartTypeData, Data: map[string]any{"id": "o-2"}},
+		},
+	}
+	got, ok, err := a2a.FirstDataPartAs[order](msg)
+	if err != nil || !ok {
+		t.Fatalf("FirstDataPartAs() = _, %t, %v, want true, nil", ok, err)
+	}
+	if diff := gocmp.Diff(order{ID: "o-1", Total: 42.5}, got); diff != "" {
+		t.Errorf("FirstDataPartAs() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, ok, err := a2a.FirstDataPartAs[order](a2a.Message{Parts: msg.Parts[:1]}); ok || err != nil {
+		t.Errorf("FirstDataPartAs() without data part = _, %t, %v, want false, nil", ok, err)
+	}
+}
+
+func TestNewPart(t *testing.T) {
+	t.Parallel()
+
+	data := map[string]any{"id": "o-1"}
+	tests := map[string]struct {
+		part a2a.Part
+		want a2a.Part
+	}{
+		"text": {
+			part: a2a.NewTextPart("hello"),
+			want: &a2a.TextPart{Type: a2a.PartTypeText, Text: "hello"},
+		},
+		"text with metadata": {
+			part: a2a.NewTextPart("hello", a2a.WithPartTitle("Greeting"), a2a.WithPartMetadata("lang", "en")),
+			want: &a2a.TextPart{Type: a2a.PartTypeText, Text: "hello", Metadata: map[string]any{"title": "Greeting", "lang": "en"}},
+		},
+		"data": {
+			part: a2a.NewDataPart(data),
+			want: &a2a.DataPart{Type: a2a.PartTypeData, Data: data},
+		},
+		"data with options": {
+			part: a2a.NewDataPart(data, a2a.WithPartMIMEType("application/vnd.example+json")),
+			want: &a2a.DataPart{Type: a2a.PartTypeData, Data: data, Metadata: map[string]any{"mimeType": "application/vnd.example+json"}},
+		},
+		"data with meta": {
+			part: a2a.NewDataPartWithMeta(data, "application/vnd.example+json", "Order"),
+			want: &a2a.DataPart{Type: a2a.PartTypeData, Data: data, Metadata: map[string]any{"mimeType": "application/vnd.example+json", "title": "Order"}},
+		},
+		"data with empty meta": {
+			part: a2a.NewDataPartWithMeta(data, "", ""),
+			want: &a2a.DataPart{Type: a2a.PartTypeData, Data: data},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := gocmp.Diff(tt.want, tt.part); diff != "" {
+				t.Errorf("part mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
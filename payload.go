@@ -31,6 +31,21 @@ const (
 
 	// MethodTasksResubscribe is the method name for resubscribing to task updates.
 	MethodTasksResubscribe = "tasks/resubscribe"
+
+	// MethodTasksInputAppend is the method name for appending incremental input to a task.
+	MethodTasksInputAppend = "tasks/input/append"
+
+	// MethodTasksHistoryGet is the method name for reading a page of task history.
+	MethodTasksHistoryGet = "tasks/history/get"
+
+	// MethodTasksArtifactsGet is the method name for reading a range of task artifacts.
+	MethodTasksArtifactsGet = "tasks/artifacts/get"
+
+	// MethodTasksList is the method name for listing a page of tasks.
+	MethodTasksList = "tasks/list"
+
+	// MethodTasksJournalGet is the method name for reading the journal of a task.
+	MethodTasksJournalGet = "tasks/journal/get"
 )
 
 // SendTaskRequest represents a request to initiate or continue a task.
@@ -368,7 +383,7 @@ type SendTaskStreamingResponse struct {
 type TaskResubscriptionRequest struct {
 	JSONRPCRequest
 
-	Params TaskIDParams `json:"params"`
+	Params TaskResubscriptionParams `json:"params"`
 }
 
 // UnmarshalJSON implements [json.Unmarshaler].
@@ -391,9 +406,9 @@ func (r *TaskResubscriptionRequest) UnmarshalJSON(data []byte) error {
 		return fmt.Errorf("marshal params: %w", err)
 	}
 
-	var rr TaskIDParams
+	var rr TaskResubscriptionParams
 	if err := sonic.ConfigFastest.Unmarshal(paramsData, &rr); err != nil {
-		return fmt.Errorf("unmarshal to TaskSendParams: %w", err)
+		return fmt.Errorf("unmarshal to TaskResubscriptionParams: %w", err)
 	}
 	r.Params = rr
 
@@ -401,7 +416,7 @@ func (r *TaskResubscriptionRequest) UnmarshalJSON(data []byte) error {
 }
 
 // NewTaskResubscriptionRequest creates a new [TaskResubscriptionRequest].
-func NewTaskResubscriptionRequest(id ID, params TaskIDParams) *TaskResubscriptionRequest {
+func NewTaskResubscriptionRequest(id ID, params TaskResubscriptionParams) *TaskResubscriptionRequest {
 	return &TaskResubscriptionRequest{
 		JSONRPCRequest: JSONRPCRequest{
 			JSONRPCMessage: NewJSONRPCMessage(id),
@@ -410,3 +425,278 @@ func NewTaskResubscriptionRequest(id ID, params TaskIDParams) *TaskResubscriptio
 		Params: params,
 	}
 }
+
+// AppendTaskInputRequest represents a request to append a chunk of input to the current turn of a task.
+type AppendTaskInputRequest struct {
+	JSONRPCRequest
+
+	Params TaskInputParams `json:"params"`
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (r *AppendTaskInputRequest) UnmarshalJSON(data []byte) error {
+	var m map[string]any
+	if err := sonic.ConfigFastest.Unmarshal(data, &m); err != nil {
+		return fmt.Errorf("unmarshal to map[string]any: %w", err)
+	}
+
+	r.Method = MethodTasksInputAppend
+	r.JSONRPCMessage = JSONRPCMessage{
+		JSONRPC: "2.0",
+	}
+	if id, ok := m["id"].(string); ok {
+		r.JSONRPCMessage.ID = NewID(id)
+	}
+
+	paramsData, err := sonic.ConfigFastest.Marshal(m["params"])
+	if err != nil {
+		return fmt.Errorf("marshal params: %w", err)
+	}
+
+	var rr TaskInputParams
+	if err := sonic.ConfigFastest.Unmarshal(paramsData, &rr); err != nil {
+		return fmt.Errorf("unmarshal to TaskInputParams: %w", err)
+	}
+	r.Params = rr
+
+	return nil
+}
+
+// NewAppendTaskInputRequest creates a new [AppendTaskInputRequest].
+func NewAppendTaskInputRequest(id ID, params TaskInputParams) *AppendTaskInputRequest {
+	return &AppendTaskInputRequest{
+		JSONRPCRequest: JSONRPCRequest{
+			JSONRPCMessage: NewJSONRPCMessage(id),
+			Method:         MethodTasksInputAppend,
+		},
+		Params: params,
+	}
+}
+
+// AppendTaskInputResponse represents a response to an [AppendTaskInputRequest].
+type AppendTaskInputResponse struct {
+	JSONRPCResponse
+
+	// Result contains the task with the appended input if successful.
+	Result *Task `json:"result,omitempty"`
+}
+
+// GetTaskHistoryRequest represents a request to read a page of a task's history.
+type GetTaskHistoryRequest struct {
+	JSONRPCRequest
+
+	Params TaskHistoryParams `json:"params"`
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (r *GetTaskHistoryRequest) UnmarshalJSON(data []byte) error {
+	var m map[string]any
+	if err := sonic.ConfigFastest.Unmarshal(data, &m); err != nil {
+		return fmt.Errorf("unmarshal to map[string]any: %w", err)
+	}
+
+	r.Method = MethodTasksHistoryGet
+	r.JSONRPCMessage = JSONRPCMessage{
+		JSONRPC: "2.0",
+	}
+	if id, ok := m["id"].(string); ok {
+		r.JSONRPCMessage.ID = NewID(id)
+	}
+
+	paramsData, err := sonic.ConfigFastest.Marshal(m["params"])
+	if err != nil {
+		return fmt.Errorf("marshal params: %w", err)
+	}
+
+	var rr TaskHistoryParams
+	if err := sonic.ConfigFastest.Unmarshal(paramsData, &rr); err != nil {
+		return fmt.Errorf("unmarshal to TaskHistoryParams: %w", err)
+	}
+	r.Params = rr
+
+	return nil
+}
+
+// NewGetTaskHistoryRequest creates a new [GetTaskHistoryRequest].
+func NewGetTaskHistoryRequest(id ID, params TaskHistoryParams) *GetTaskHistoryRequest {
+	return &GetTaskHistoryRequest{
+		JSONRPCRequest: JSONRPCRequest{
+			JSONRPCMessage: NewJSONRPCMessage(id),
+			Method:         MethodTasksHistoryGet,
+		},
+		Params: params,
+	}
+}
+
+// GetTaskHistoryResponse represents a response to a [GetTaskHistoryRequest].
+type GetTaskHistoryResponse struct {
+	JSONRPCResponse
+
+	// Result contains the page of history if successful.
+	Result *TaskHistoryPage `json:"result,omitempty"`
+}
+
+// GetTaskArtifactsRequest represents a request to read a range of a task's artifacts.
+type GetTaskArtifactsRequest struct {
+	JSONRPCRequest
+
+	Params TaskArtifactsParams `json:"params"`
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (r *GetTaskArtifactsRequest) UnmarshalJSON(data []byte) error {
+	var m map[string]any
+	if err := sonic.ConfigFastest.Unmarshal(data, &m); err != nil {
+		return fmt.Errorf("unmarshal to map[string]any: %w", err)
+	}
+
+	r.Method = MethodTasksArtifactsGet
+	r.JSONRPCMessage = JSONRPCMessage{
+		JSONRPC: "2.0",
+	}
+	if id, ok := m["id"].(string); ok {
+		r.JSONRPCMessage.ID = NewID(id)
+	}
+
+	paramsData, err := sonic.ConfigFastest.Marshal(m["params"])
+	if err != nil {
+		return fmt.Errorf("marshal params: %w", err)
+	}
+
+	var rr TaskArtifactsParams
+	if err := sonic.ConfigFastest.Unmarshal(paramsData, &rr); err != nil {
+		return fmt.Errorf("unmarshal to TaskArtifactsParams: %w", err)
+	}
+	r.Params = rr
+
+	return nil
+}
+
+// NewGetTaskArtifactsRequest creates a new [GetTaskArtifactsRequest].
+func NewGetTaskArtifactsRequest(id ID, params TaskArtifactsParams) *GetTaskArtifactsRequest {
+	return &GetTaskArtifactsRequest{
+		JSONRPCRequest: JSONRPCRequest{
+			JSONRPCMessage: NewJSONRPCMessage(id),
+			Method:         MethodTasksArtifactsGet,
+		},
+		Params: params,
+	}
+}
+
+// GetTaskArtifactsResponse represents a response to a [GetTaskArtifactsRequest].
+type GetTaskArtifactsResponse struct {
+	JSONRPCResponse
+
+	// Result contains the range of artifacts if successful.
+	Result *TaskArtifactsPage `json:"result,omitempty"`
+}
+
+// GetTaskJournalRequest represents a request to read the journal of a task.
+type GetTaskJournalRequest struct {
+	JSONRPCRequest
+
+	Params TaskIDParams `json:"params"`
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (r *GetTaskJournalRequest) UnmarshalJSON(data []byte) error {
+	var m map[string]any
+	if err := sonic.ConfigFastest.Unmarshal(data, &m); err != nil {
+		return fmt.Errorf("unmarshal to map[string]any: %w", err)
+	}
+
+	r.Method = MethodTasksJournalGet
+	r.JSONRPCMessage = JSONRPCMessage{
+		JSONRPC: "2.0",
+	}
+	if id, ok := m["id"].(string); ok {
+		r.JSONRPCMessage.ID = NewID(id)
+	}
+
+	paramsData, err := sonic.ConfigFastest.Marshal(m["params"])
+	if err != nil {
+		return fmt.Errorf("marshal params: %w", err)
+	}
+
+	var rr TaskIDParams
+	if err := sonic.ConfigFastest.Unmarshal(paramsData, &rr); err != nil {
+		return fmt.Errorf("unmarshal to TaskIDParams: %w", err)
+	}
+	r.Params = rr
+
+	return nil
+}
+
+// NewGetTaskJournalRequest creates a new [GetTaskJournalRequest].
+func NewGetTaskJournalRequest(id ID, params TaskIDParams) *GetTaskJournalRequest {
+	return &GetTaskJournalRequest{
+		JSONRPCRequest: JSONRPCRequest{
+			JSONRPCMessage: NewJSONRPCMessage(id),
+			Method:         MethodTasksJournalGet,
+		},
+		Params: params,
+	}
+}
+
+// GetTaskJournalResponse represents a response to a [GetTaskJournalRequest].
+type GetTaskJournalResponse struct {
+	JSONRPCResponse
+
+	// Result contains the journal of the task if successful.
+	Result *TaskJournal `json:"result,omitempty"`
+}
+
+// ListTasksRequest represents a request to list a page of tasks.
+type ListTasksRequest struct {
+	JSONRPCRequest
+
+	Params TaskListParams `json:"params"`
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (r *ListTasksRequest) UnmarshalJSON(data []byte) error {
+	var m map[string]any
+	if err := sonic.ConfigFastest.Unmarshal(data, &m); err != nil {
+		return fmt.Errorf("unmarshal to map[string]any: %w", err)
+	}
+
+	r.Method = MethodTasksList
+	r.JSONRPCMessage = JSONRPCMessage{
+		JSONRPC: "2.0",
+	}
+	if id, ok := m["id"].(string); ok {
+		r.JSONRPCMessage.ID = NewID(id)
+	}
+
+	paramsData, err := sonic.ConfigFastest.Marshal(m["params"])
+	if err != nil {
+		return fmt.Errorf("marshal params: %w", err)
+	}
+
+	var rr TaskListParams
+	if err := sonic.ConfigFastest.Unmarshal(paramsData, &rr); err != nil {
+		return fmt.Errorf("unmarshal to TaskListParams: %w", err)
+	}
+	r.Params = rr
+
+	return nil
+}
+
+// NewListTasksRequest creates a new [ListTasksRequest].
+func NewListTasksRequest(id ID, params TaskListParams) *ListTasksRequest {
+	return &ListTasksRequest{
+		JSONRPCRequest: JSONRPCRequest{
+			JSONRPCMessage: NewJSONRPCMessage(id),
+			Method:         MethodTasksList,
+		},
+		Params: params,
+	}
+}
+
+// ListTasksResponse represents a response to a [ListTasksRequest].
+type ListTasksResponse struct {
+	JSONRPCResponse
+
+	// Result contains the page of tasks if successful.
+	Result *TaskListPage `json:"result,omitempty"`
+}
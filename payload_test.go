@@ -128,7 +128,7 @@ func TestGetTaskRequest(t *testing.T) {
 		TaskIDParams: a2a.TaskIDParams{
 			ID: "test-id",
 		},
-		HistoryLength: 10,
+		HistoryLength: ptr(10),
 	}
 
 	req := a2a.NewGetTaskRequest(a2a.NewID("req-id"), params)
@@ -315,8 +315,9 @@ func TestGetTaskPushNotificationRequest(t *testing.T) {
 func TestTaskResubscriptionRequest(t *testing.T) {
 	t.Parallel()
 
-	params := a2a.TaskIDParams{
-		ID: "test-id",
+	params := a2a.TaskResubscriptionParams{
+		TaskIDParams: a2a.TaskIDParams{ID: "test-id"},
+		EventTypes:   []string{a2a.EventTypeStatus},
 	}
 
 	req := a2a.NewTaskResubscriptionRequest(a2a.NewID("req-id"), params)
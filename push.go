@@ -0,0 +1,54 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+)
+
+// Task event types a client can subscribe to.
+const (
+	// EventTypeStatus is the type of [TaskStatusUpdateEvent].
+	EventTypeStatus = "status"
+
+	// EventTypeArtifact is the type of [TaskArtifactUpdateEvent].
+	EventTypeArtifact = "artifact"
+)
+
+// Validate reports whether the push notification config can be used to deliver notifications.
+//
+// The URL must be an absolute https URL and every event type must be known.
+func (c PushNotificationConfig) Validate() error {
+	if c.URL == "" {
+		return errors.New("push notification URL cannot be empty")
+	}
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return fmt.Errorf("invalid push notification URL: %w", err)
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("push notification URL %q must be an absolute https URL", c.URL)
+	}
+
+	return ValidateEventTypes(c.EventTypes)
+}
+
+// ValidateEventTypes reports an error for an event type other than [EventTypeStatus] and
+// [EventTypeArtifact].
+func ValidateEventTypes(types []string) error {
+	for _, typ := range types {
+		if typ != EventTypeStatus && typ != EventTypeArtifact {
+			return fmt.Errorf("unknown event type %q", typ)
+		}
+	}
+	return nil
+}
+
+// Wants reports whether the config subscribes to events of type typ.
+func (c PushNotificationConfig) Wants(typ string) bool {
+	return len(c.EventTypes) == 0 || slices.Contains(c.EventTypes, typ)
+}
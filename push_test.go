@@ -0,0 +1,65 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"testing"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestPushNotificationConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		config  a2a.PushNotificationConfig
+		wantErr bool
+	}{
+		"https": {
+			config: a2a.PushNotificationConfig{URL: "https://example.com/hook", Token: "secret"},
+		},
+		"event types": {
+			config: a2a.PushNotificationConfig{URL: "https://example.com/hook", EventTypes: []string{a2a.EventTypeStatus}},
+		},
+		"empty URL": {
+			config:  a2a.PushNotificationConfig{},
+			wantErr: true,
+		},
+		"http": {
+			config:  a2a.PushNotificationConfig{URL: "http://example.com/hook"},
+			wantErr: true,
+		},
+		"relative": {
+			config:  a2a.PushNotificationConfig{URL: "/hook"},
+			wantErr: true,
+		},
+		"unknown event type": {
+			config:  a2a.PushNotificationConfig{URL: "https://example.com/hook", EventTypes: []string{"chunk"}},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := tt.config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPushNotificationConfig_Wants(t *testing.T) {
+	t.Parallel()
+
+	all := a2a.PushNotificationConfig{}
+	status := a2a.PushNotificationConfig{EventTypes: []string{a2a.EventTypeStatus}}
+
+	if !all.Wants(a2a.EventTypeStatus) || !all.Wants(a2a.EventTypeArtifact) {
+		t.Error("config without event types does not want every event")
+	}
+	if !status.Wants(a2a.EventTypeStatus) || status.Wants(a2a.EventTypeArtifact) {
+		t.Error("status-only config does not want exactly status events")
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bytedance/sonic"
+)
+
+var (
+	// ErrTaskNotCompleted is returned by [GetResult] for a task that has not completed.
+	ErrTaskNotCompleted = errors.New("task not completed")
+
+	// ErrNoResult is returned by [GetResult] for a task without structured output.
+	ErrNoResult = errors.New("task has no structured result")
+)
+
+// resultDecoder decodes structured results, rejecting fields the result type does not declare.
+var resultDecoder = sonic.Config{DisallowUnknownFields: true}.Froze()
+
+// GetResult decodes the structured output of a completed task into a T.
+//
+// The output is the first data part, in artifact order, that is not labeled with a
+// [PartKindKey], so conventional parts such as citations are skipped. GetResult returns
+// an error wrapping [ErrTaskNotCompleted] if the task is not completed, [ErrNoResult] if
+// it has no such part, or a decoding error if the data does not match T, including
+// fields T does not declare.
+//
+//	invoice, err := a2a.GetResult[InvoiceData](task)
+func GetResult[T any](t Task) (T, error) {
+	var result T
+
+	if t.Status.State != TaskStateCompleted {
+		return result, fmt.Errorf("%w: task %s is %s", ErrTaskNotCompleted, t.ID, t.Status.State)
+	}
+
+	dp := t.resultPart()
+	if dp == nil {
+		return result, fmt.Errorf("%w: task %s", ErrNoResult, t.ID)
+	}
+
+	data, err := sonic.ConfigFastest.Marshal(dp.Data)
+	if err != nil {
+		return result, fmt.Errorf("marshal result: %w", err)
+	}
+	if err := resultDecoder.Unmarshal(data, &result); err != nil {
+		return result, fmt.Errorf("decode result into %T: %w", result, err)
+	}
+	return result, nil
+}
+
+// resultPart returns the first unlabeled data part of the task artifacts, or nil.
+func (t Task) resultPart() *DataPart {
+	for _, artifact := range t.Artifacts {
+		for _, part := range artifact.Parts {
+			dp, ok := part.(*DataPart)
+			if !ok || dp == nil {
+				continue
+			}
+			if _, labeled := dp.Metadata[PartKindKey]; labeled {
+				continue
+			}
+			return dp
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"errors"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+)
+
+type invoice struct {
+	Number string  `json:"number"`
+	Total  float64 `json:"total"`
+}
+
+func TestGetResult(t *testing.T) {
+	t.Parallel()
+
+	completed := a2a.TaskStatus{State: a2a.TaskStateCompleted}
+	result := &a2a.DataPart{Type: a2a.PartTypeData, Data: map[string]any{"number": "INV-1", "total": 99.5}}
+
+	tests := map[string]struct {
+		task    a2a.Task
+		want    invoice
+		wantErr error
+		anyErr  bool
+	}{
+		"completed": {
+			task: a2a.Task{
+				ID:     "task-1",
+				Status: completed,
+				Artifacts: []a2a.Artifact{
+					{Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "Here is the invoice."}}},
+					{Parts: []a2a.Part{
+						a2a.NewCitationPart([]a2a.Source{{URL: "https://example.com/inv"}}),
+						result,
+					}},
+				},
+			},
+			want: invoice{Number: "INV-1", Total: 99.5},
+		},
+		"not completed": {
+			task: a2a.Task{
+				ID:        "task-1",
+				Status:    a2a.TaskStatus{State: a2a.TaskStateWorking},
+				Artifacts: []a2a.Artifact{{Parts: []a2a.Part{result}}},
+			},
+			wantErr: a2a.ErrTaskNotCompleted,
+		},
+		"no data part": {
+			task: a2a.Task{
+				ID:        "task-1",
+				Status:    completed,
+				Artifacts: []a2a.Artifact{{Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "done"}}}},
+			},
+			wantErr: a2a.ErrNoResult,
+		},
+		"mismatched type": {
+			task: a2a.Task{
+				ID:     "task-1",
+				Status: completed,
+				Artifacts: []a2a.Artifact{{Parts: []a2a.Part{
+					&a2a.DataPart{Type: a2a.PartTypeData, Data: map[string]any{"number": 1}},
+				}}},
+			},
+			anyErr: true,
+		},
+		"unknown field": {
+			task: a2a.Task{
+				ID:     "task-1",
+				Status: completed,
+				Artifacts: []a2a.Artifact{{Parts: []a2a.Part{
+					&a2a.DataPart{Type: a2a.PartTypeData, Data: map[string]any{"number": "INV-1", "customer": "ACME"}},
+				}}},
+			},
+			anyErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := a2a.GetResult[invoice](tt.task)
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("GetResult() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			case tt.anyErr:
+				if err == nil {
+					t.Fatalf("GetResult() = %+v, want an error", got)
+				}
+				return
+			case err != nil:
+				t.Fatalf("GetResult() error = %v", err)
+			}
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetResult() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,301 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bytedance/sonic"
+)
+
+// SkillIDKey is the task send metadata key naming the skill a request invokes.
+//
+// Servers validate the data parts of such a request against the parameters schema of the skill.
+const SkillIDKey = "skillId"
+
+// ErrInvalidSkillParams is returned by [AgentSkill.ValidateParams] for input that does not match the schema.
+var ErrInvalidSkillParams = errors.New("invalid skill parameters")
+
+// ValidateParams validates input against the JSON Schema held in the skill Parameters.
+//
+// A skill without parameters accepts any input. The returned error wraps [ErrInvalidSkillParams]
+// and describes every mismatch by its JSON pointer, such as "/items/0/name: missing required property".
+//
+// The supported keywords are type, enum, const, properties, required, additionalProperties,
+// items, minItems, maxItems, minLength, maxLength, pattern, minimum and maximum; other
+// keywords are ignored.
+func (s AgentSkill) ValidateParams(input any) error {
+	if s.Parameters == nil {
+		return nil
+	}
+
+	schema, err := toJSONValue(s.Parameters)
+	if err != nil {
+		return fmt.Errorf("skill %q: invalid parameters schema: %w", s.ID, err)
+	}
+	value, err := toJSONValue(input)
+	if err != nil {
+		return fmt.Errorf("skill %q: %w: %w", s.ID, ErrInvalidSkillParams, err)
+	}
+
+	var errs []error
+	validateSchema(schema, value, "", &errs)
+	if len(errs) > 0 {
+		return fmt.Errorf("skill %q: %w: %w", s.ID, ErrInvalidSkillParams, errors.Join(errs...))
+	}
+	return nil
+}
+
+// toJSONValue converts v to its generic JSON form, made of maps, slices, strings, float64, bools and nil.
+func toJSONValue(v any) (any, error) {
+	data, err := sonic.ConfigFastest.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var value any
+	if err := sonic.ConfigFastest.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// validateSchema appends to errs every way value, found at path, does not match schema.
+func validateSchema(schema, value any, path string, errs *[]error) {
+	s, ok := schema.(map[string]any)
+	if !ok {
+		// true, or a non-object schema, accepts anything; false rejects everything.
+		if b, ok := schema.(bool); ok && !b {
+			*errs = append(*errs, schemaError(path, "no value is allowed"))
+		}
+		return
+	}
+
+	if types := schemaTypes(s["type"]); len(types) > 0 {
+		got := jsonType(value)
+		if !slices.Contains(types, got) && !(got == "integer" && slices.Contains(types, "number")) {
+			*errs = append(*errs, schemaError(path, "expected %s, got %s", strings.Join(types, " or "), got))
+			return
+		}
+	}
+	if enum, ok := s["enum"].([]any); ok && !slices.ContainsFunc(enum, func(e any) bool { return reflect.DeepEqual(e, value) }) {
+		*errs = append(*errs, schemaError(path, "value %v is not one of %v", value, enum))
+	}
+	if c, ok := s["const"]; ok && !reflect.DeepEqual(c, value) {
+		*errs = append(*errs, schemaError(path, "value %v is not %v", value, c))
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		validateObject(s, v, path, errs)
+	case []any:
+		if n, ok := s["minItems"].(float64); ok && float64(len(v)) < n {
+			*errs = append(*errs, schemaError(path, "has %d items, want at least %v", len(v), n))
+		}
+		if n, ok := s["maxItems"].(float64); ok && float64(len(v)) > n {
+			*errs = append(*errs, schemaError(path, "has %d items, want at most %v", len(v), n))
+		}
+		if items, ok := s["items"]; ok {
+			for i, item := range v {
+				validateSchema(items, item, path+"/"+strconv.Itoa(i), errs)
+			}
+		}
+	case string:
+		n := float64(utf8.RuneCountInString(v))
+		if m, ok := s["minLength"].(float64); ok && n < m {
+			*errs = append(*errs, schemaError(path, "is shorter than %v characters", m))
+		}
+		if m, ok := s["maxLength"].(float64); ok && n > m {
+			*errs = append(*errs, schemaError(path, "is longer than %v characters", m))
+		}
+		if pattern, ok := s["pattern"].(string); ok {
+			re, err := regexp.Compile(pattern)
+			switch {
+			case err != nil:
+				*errs = append(*errs, schemaError(path, "invalid pattern %q: %v", pattern, err))
+			case !re.MatchString(v):
+				*errs = append(*errs, schemaError(path, "does not match pattern %q", pattern))
+			}
+		}
+	case float64:
+		if m, ok := s["minimum"].(float64); ok && v < m {
+			*errs = append(*errs, schemaError(path, "%v is less than the minimum %v", v, m))
+		}
+		if m, ok := s["maximum"].(float64); ok && v > m {
+			*errs = append(*errs, schemaError(path, "%v is greater than the maximum %v", v, m))
+		}
+	}
+}
+
+// validateObject validates the properties of object v against the object keywords of s.
+func validateObject(s map[string]any, v map[string]any, path string, errs *[]error) {
+	if required, ok := s["required"].([]any); ok {
+		for _, name := range required {
+			if name, ok := name.(string); ok {
+				if _, present := v[name]; !present {
+					*errs = append(*errs, schemaError(path+"/"+name, "missing required property"))
+				}
+			}
+		}
+	}
+
+	properties, _ := s["properties"].(map[string]any)
+	names := make([]string, 0, len(v))
+	for name := range v {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		if prop, ok := properties[name]; ok {
+			validateSchema(prop, v[name], path+"/"+name, errs)
+			continue
+		}
+		if additional, ok := s["additionalProperties"]; ok {
+			if b, ok := additional.(bool); ok && !b {
+				*errs = append(*errs, schemaError(path+"/"+name, "unknown property"))
+				continue
+			}
+			validateSchema(additional, v[name], path+"/"+name, errs)
+		}
+	}
+}
+
+// schemaTypes returns the types allowed by the type keyword, given as a string or a list.
+func schemaTypes(t any) []string {
+	switch t := t.(type) {
+	case string:
+		return []string{t}
+	case []any:
+		var types []string
+		for _, name := range t {
+			if name, ok := name.(string); ok {
+				types = append(types, name)
+			}
+		}
+		return types
+	}
+	return nil
+}
+
+// jsonType returns the JSON Schema type of a generic JSON value.
+func jsonType(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case float64:
+		if v == math.Trunc(v) && !math.IsInf(v, 0) {
+			return "integer"
+		}
+		return "number"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// schemaError returns a validation error for the value at path.
+func schemaError(path, format string, args ...any) error {
+	if path == "" {
+		path = "/"
+	}
+	return fmt.Errorf("%s: %s", path, fmt.Sprintf(format, args...))
+}
+
+// SkillParamsFromStruct returns a JSON Schema describing the JSON encoding of v, a struct or
+// a pointer to one, for use as [AgentSkill.Parameters].
+//
+// Properties are named after their json tags. Fields tagged "-" are skipped, and every field
+// without omitempty or omitzero is required. Nested structs, slices, arrays and maps are
+// described recursively; time.Time is a date-time string, and interfaces accept any value.
+func SkillParamsFromStruct(v any) any {
+	return typeSchema(reflect.TypeOf(v))
+}
+
+var timeType = reflect.TypeFor[time.Time]()
+
+// typeSchema returns the JSON Schema of the JSON encoding of t.
+func typeSchema(t reflect.Type) map[string]any {
+	if t == nil {
+		return map[string]any{}
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == timeType {
+		return map[string]any{"type": "string", "format": "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// Byte slices encode as base64 strings.
+			return map[string]any{"type": "string", "contentEncoding": "base64"}
+		}
+		return map[string]any{"type": "array", "items": typeSchema(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": typeSchema(t.Elem())}
+	case reflect.Struct:
+		return structSchema(t)
+	default:
+		return map[string]any{}
+	}
+}
+
+// structSchema returns the JSON Schema of the JSON encoding of struct type t.
+func structSchema(t reflect.Type) map[string]any {
+	properties := make(map[string]any)
+	var required []any
+
+	for _, f := range reflect.VisibleFields(t) {
+		if !f.IsExported() || f.Anonymous {
+			continue
+		}
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = f.Name
+		}
+
+		properties[name] = typeSchema(f.Type)
+		if !strings.Contains(opts, "omitempty") && !strings.Contains(opts, "omitzero") {
+			required = append(required, name)
+		}
+	}
+
+	schema := map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
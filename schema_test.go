@@ -0,0 +1,165 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+)
+
+type forecastParams struct {
+	City    string            `json:"city"`
+	Days    int               `json:"days"`
+	Units   string            `json:"units,omitempty"`
+	Since   *time.Time        `json:"since,omitempty"`
+	Fields  []string          `json:"fields,omitempty"`
+	Extra   map[string]bool   `json:"extra,omitzero"`
+	Raw     []byte            `json:"raw,omitempty"`
+	Ignored string            `json:"-"`
+	Nested  struct{ X int }   `json:"nested,omitempty"`
+	Tags    map[string]string `json:"-"`
+}
+
+func TestSkillParamsFromStruct(t *testing.T) {
+	t.Parallel()
+
+	want := map[string]any{
+		"type":                 "object",
+		"additionalProperties": false,
+		"required":             []any{"city", "days"},
+		"properties": map[string]any{
+			"city":   map[string]any{"type": "string"},
+			"days":   map[string]any{"type": "integer"},
+			"units":  map[string]any{"type": "string"},
+			"since":  map[string]any{"type": "string", "format": "date-time"},
+			"fields": map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+			"extra":  map[string]any{"type": "object", "additionalProperties": map[string]any{"type": "boolean"}},
+			"raw":    map[string]any{"type": "string", "contentEncoding": "base64"},
+			"nested": map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"required":             []any{"X"},
+				"properties":           map[string]any{"X": map[string]any{"type": "integer"}},
+			},
+		},
+	}
+	if diff := gocmp.Diff(want, a2a.SkillParamsFromStruct(&forecastParams{})); diff != "" {
+		t.Errorf("SkillParamsFromStruct() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestAgentSkill_ValidateParams(t *testing.T) {
+	t.Parallel()
+
+	fromStruct := a2a.AgentSkill{ID: "forecast", Parameters: a2a.SkillParamsFromStruct(forecastParams{})}
+	handWritten := a2a.AgentSkill{ID: "order", Parameters: map[string]any{
+		"type":     "object",
+		"required": []string{"items"},
+		"properties": map[string]any{
+			"items": map[string]any{
+				"type":     "array",
+				"minItems": 1,
+				"items": map[string]any{
+					"type":     "object",
+					"required": []string{"sku", "qty"},
+					"properties": map[string]any{
+						"sku":  map[string]any{"type": "string", "pattern": "^[A-Z]{3}-[0-9]+$"},
+						"qty":  map[string]any{"type": "integer", "minimum": 1, "maximum": 10},
+						"gift": map[string]any{"type": []string{"boolean", "null"}},
+					},
+				},
+			},
+			"priority": map[string]any{"enum": []string{"low", "high"}},
+			"note":     map[string]any{"type": "string", "maxLength": 5},
+		},
+	}}
+
+	tests := map[string]struct {
+		skill a2a.AgentSkill
+		input any
+		// wantErrs are the messages the error must contain; none means the input is valid.
+		wantErrs []string
+	}{
+		"no parameters": {
+			skill: a2a.AgentSkill{ID: "free"},
+			input: map[string]any{"anything": 1},
+		},
+		"struct input": {
+			skill: fromStruct,
+			input: forecastParams{City: "Paris", Days: 3},
+		},
+		"map input": {
+			skill: fromStruct,
+			input: map[string]any{"city": "Paris", "days": 3, "fields": []string{"rain"}},
+		},
+		"missing and mistyped": {
+			skill:    fromStruct,
+			input:    map[string]any{"days": 1.5, "fields": []any{"rain", 2}},
+			wantErrs: []string{"/city: missing required property", "/days: expected integer, got number", "/fields/1: expected string, got integer"},
+		},
+		"unknown property": {
+			skill:    fromStruct,
+			input:    map[string]any{"city": "Paris", "days": 3, "country": "FR"},
+			wantErrs: []string{"/country: unknown property"},
+		},
+		"not an object": {
+			skill:    fromStruct,
+			input:    []string{"Paris"},
+			wantErrs: []string{"/: expected object, got array"},
+		},
+		"nested valid": {
+			skill: handWritten,
+			input: map[string]any{"items": []any{map[string]any{"sku": "ABC-1", "qty": 2, "gift": nil}}, "priority": "high"},
+		},
+		"nested constraints": {
+			skill: handWritten,
+			input: map[string]any{
+				"items":    []any{map[string]any{"sku": "abc", "qty": 11}, map[string]any{"qty": 0, "gift": "yes"}},
+				"priority": "urgent",
+				"note":     "too long",
+			},
+			wantErrs: []string{
+				`/items/0/sku: does not match pattern`,
+				"/items/0/qty: 11 is greater than the maximum 10",
+				"/items/1/sku: missing required property",
+				"/items/1/qty: 0 is less than the minimum 1",
+				"/items/1/gift: expected boolean or null, got string",
+				"/priority: value urgent is not one of [low high]",
+				"/note: is longer than 5 characters",
+			},
+		},
+		"too few items": {
+			skill:    handWritten,
+			input:    map[string]any{"items": []any{}},
+			wantErrs: []string{"/items: has 0 items, want at least 1"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.skill.ValidateParams(tt.input)
+			if len(tt.wantErrs) == 0 {
+				if err != nil {
+					t.Fatalf("ValidateParams() error = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, a2a.ErrInvalidSkillParams) {
+				t.Fatalf("ValidateParams() error = %v, want %v", err, a2a.ErrInvalidSkillParams)
+			}
+			for _, want := range tt.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("ValidateParams() error = %v, want it to contain %q", err, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/go-a2a/a2a"
+)
+
+const (
+	// DefaultArtifactPageSize is the number of artifacts in a range when the request sets no count.
+	DefaultArtifactPageSize = 100
+
+	// MaxArtifactPageSize caps the number of artifacts in a range.
+	MaxArtifactPageSize = 1000
+)
+
+// OnGetTaskArtifacts implements [ArtifactReader].
+//
+// The artifacts of the task are sorted by index, then the range starting at the requested
+// position is returned. A start past the last artifact returns an empty range.
+func (tm *InMemoryTaskManager) OnGetTaskArtifacts(ctx context.Context, req *a2a.GetTaskArtifactsRequest) (*a2a.GetTaskArtifactsResponse, error) {
+	ctx, span := tm.tracer.Start(ctx, "task_manager.OnGetTaskArtifacts",
+		trace.WithAttributes(attribute.String("a2a.task_id", req.Params.ID)))
+	defer span.End()
+
+	taskID := req.Params.ID
+	if taskID == "" {
+		return nil, errors.New("task ID cannot be empty")
+	}
+	if req.Params.StartIndex < 0 {
+		return nil, fmt.Errorf("%w: negative start index %d", a2a.ErrInvalidParams, req.Params.StartIndex)
+	}
+
+	task, err := tm.timedStore().Get(ctx, taskID)
+	if err != nil {
+		tm.logger.InfoContext(ctx, "task not found", slog.String("task_id", taskID))
+		return nil, err
+	}
+
+	count := req.Params.Count
+	if count <= 0 {
+		count = DefaultArtifactPageSize
+	}
+	count = min(count, MaxArtifactPageSize)
+
+	a2a.SortArtifacts(task.Artifacts)
+	start := min(req.Params.StartIndex, len(task.Artifacts))
+	end := min(start+count, len(task.Artifacts))
+	page := &a2a.TaskArtifactsPage{
+		Artifacts: task.Artifacts[start:end],
+		Total:     len(task.Artifacts),
+	}
+
+	tm.logger.InfoContext(ctx, "task artifacts retrieved",
+		slog.String("task_id", taskID),
+		slog.Int("start", start),
+		slog.Int("artifacts", len(page.Artifacts)),
+	)
+
+	return &a2a.GetTaskArtifactsResponse{
+		JSONRPCResponse: a2a.JSONRPCResponse{
+			JSONRPCMessage: a2a.NewJSONRPCMessage(req.ID),
+		},
+		Result: page,
+	}, nil
+}
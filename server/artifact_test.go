@@ -0,0 +1,90 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"errors"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestInMemoryTaskManager_OnGetTaskArtifacts(t *testing.T) {
+	t.Parallel()
+
+	tm := server.NewInMemoryTaskManager()
+	task := &a2a.Task{ID: "task-1"}
+	// The artifacts are stored out of order.
+	for _, index := range []int{3, 0, 4, 1, 2} {
+		task.Artifacts = append(task.Artifacts, a2a.Artifact{Index: index, Parts: []a2a.Part{}})
+	}
+	if _, err := tm.TaskStore().Create(t.Context(), task); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	tests := map[string]struct {
+		params      a2a.TaskArtifactsParams
+		wantIndices []int
+		wantErr     error
+	}{
+		"first range": {
+			params:      a2a.TaskArtifactsParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}, Count: 2},
+			wantIndices: []int{0, 1},
+		},
+		"middle range": {
+			params:      a2a.TaskArtifactsParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}, StartIndex: 2, Count: 2},
+			wantIndices: []int{2, 3},
+		},
+		"range past the end": {
+			params:      a2a.TaskArtifactsParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}, StartIndex: 4, Count: 2},
+			wantIndices: []int{4},
+		},
+		"start past the end": {
+			params:      a2a.TaskArtifactsParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}, StartIndex: 9},
+			wantIndices: []int{},
+		},
+		"default count": {
+			params:      a2a.TaskArtifactsParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}},
+			wantIndices: []int{0, 1, 2, 3, 4},
+		},
+		"negative start": {
+			params:  a2a.TaskArtifactsParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}, StartIndex: -1},
+			wantErr: a2a.ErrInvalidParams,
+		},
+		"unknown task": {
+			params:  a2a.TaskArtifactsParams{TaskIDParams: a2a.TaskIDParams{ID: "missing"}},
+			wantErr: server.ErrTaskNotFound,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := tm.OnGetTaskArtifacts(t.Context(), a2a.NewGetTaskArtifactsRequest(a2a.NewID("req-1"), tt.params))
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("OnGetTaskArtifacts() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("OnGetTaskArtifacts() error = %v", err)
+			}
+
+			indices := []int{}
+			for _, artifact := range resp.Result.Artifacts {
+				indices = append(indices, artifact.Index)
+			}
+			if diff := gocmp.Diff(tt.wantIndices, indices); diff != "" {
+				t.Errorf("artifact indices mismatch (-want +got):\n%s", diff)
+			}
+			if resp.Result.Total != 5 {
+				t.Errorf("Total = %d, want 5", resp.Result.Total)
+			}
+		})
+	}
+}
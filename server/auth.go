@@ -0,0 +1,293 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/go-a2a/a2a"
+)
+
+// Authentication schemes of the authenticators in this package, as declared in
+// [a2a.AgentAuthentication.Schemes].
+const (
+	// AuthSchemeBearer is the scheme of [BearerTokenAuthenticator].
+	AuthSchemeBearer = "bearer"
+
+	// AuthSchemeAPIKey is the scheme of [APIKeyAuthenticator].
+	AuthSchemeAPIKey = "apiKey"
+)
+
+// DefaultAPIKeyHeader is the header [APIKeyAuthenticator] reads the key from when none is set.
+const DefaultAPIKeyHeader = "X-API-Key"
+
+// ErrUnauthenticated is returned by an [Authenticator] for a request without valid credentials.
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// Caller identifies the authenticated sender of a request.
+type Caller struct {
+	// Scheme is the authentication scheme the caller used, such as [AuthSchemeBearer].
+	Scheme string
+
+	// ID identifies the caller, such as the subject of a token or the owner of an API key.
+	ID string
+}
+
+// Authenticator authenticates the requests to the A2A endpoint of a [Server].
+type Authenticator interface {
+	// Scheme returns the authentication scheme implemented, as declared in the agent card.
+	Scheme() string
+
+	// Authenticate returns the caller sending r, or an error wrapping [ErrUnauthenticated]
+	// if r carries no valid credentials.
+	Authenticate(r *http.Request) (Caller, error)
+}
+
+// CredentialVerifier returns the ID of the caller holding credential, or an error if the
+// credential is not valid.
+type CredentialVerifier func(ctx context.Context, credential string) (string, error)
+
+// StaticCredentials returns a [CredentialVerifier] accepting the keys of credentials, each
+// identifying the caller named by its value. Credentials are compared in constant time.
+func StaticCredentials(credentials map[string]string) CredentialVerifier {
+	return func(ctx context.Context, credential string) (string, error) {
+		for known, id := range credentials {
+			if subtle.ConstantTimeCompare([]byte(known), []byte(credential)) == 1 {
+				return id, nil
+			}
+		}
+		return "", errors.New("unknown credential")
+	}
+}
+
+// BearerTokenAuthenticator authenticates requests by the bearer token in their Authorization header.
+type BearerTokenAuthenticator struct {
+	// Verify returns the caller ID of a token.
+	Verify CredentialVerifier
+}
+
+var _ Authenticator = (*BearerTokenAuthenticator)(nil)
+
+// Scheme implements [Authenticator].
+func (a *BearerTokenAuthenticator) Scheme() string { return AuthSchemeBearer }
+
+// Authenticate implements [Authenticator].
+func (a *BearerTokenAuthenticator) Authenticate(r *http.Request) (Caller, error) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return Caller{}, fmt.Errorf("%w: missing bearer token", ErrUnauthenticated)
+	}
+	id, err := a.Verify(r.Context(), token)
+	if err != nil {
+		return Caller{}, fmt.Errorf("%w: %w", ErrUnauthenticated, err)
+	}
+	return Caller{Scheme: AuthSchemeBearer, ID: id}, nil
+}
+
+// APIKeyAuthenticator authenticates requests by the API key in a header.
+type APIKeyAuthenticator struct {
+	// Header is the header carrying the key. Defaults to [DefaultAPIKeyHeader].
+	Header string
+
+	// Verify returns the caller ID of a key.
+	Verify CredentialVerifier
+}
+
+var _ Authenticator = (*APIKeyAuthenticator)(nil)
+
+// Scheme implements [Authenticator].
+func (a *APIKeyAuthenticator) Scheme() string { return AuthSchemeAPIKey }
+
+// Authenticate implements [Authenticator].
+func (a *APIKeyAuthenticator) Authenticate(r *http.Request) (Caller, error) {
+	header := a.Header
+	if header == "" {
+		header = DefaultAPIKeyHeader
+	}
+	key := r.Header.Get(header)
+	if key == "" {
+		return Caller{}, fmt.Errorf("%w: missing %s header", ErrUnauthenticated, header)
+	}
+	id, err := a.Verify(r.Context(), key)
+	if err != nil {
+		return Caller{}, fmt.Errorf("%w: %w", ErrUnauthenticated, err)
+	}
+	return Caller{Scheme: AuthSchemeAPIKey, ID: id}, nil
+}
+
+// AnyAuthenticator returns an [Authenticator] accepting the credentials of any of auths, tried
+// in order, so that callers may authenticate with different schemes, such as an API key for
+// reading tasks and a bearer token for the methods restricted with [Server.RequireScheme].
+//
+// Its scheme lists the schemes of auths, separated by commas.
+func AnyAuthenticator(auths ...Authenticator) Authenticator {
+	return anyAuthenticator(slices.Clone(auths))
+}
+
+// anyAuthenticator is the [Authenticator] returned by [AnyAuthenticator].
+type anyAuthenticator []Authenticator
+
+var _ Authenticator = anyAuthenticator(nil)
+
+// Scheme implements [Authenticator].
+func (a anyAuthenticator) Scheme() string {
+	return strings.Join(authSchemes(a), ", ")
+}
+
+// Authenticate implements [Authenticator].
+func (a anyAuthenticator) Authenticate(r *http.Request) (Caller, error) {
+	errs := make([]error, 0, len(a))
+	for _, auth := range a {
+		caller, err := auth.Authenticate(r)
+		if err == nil {
+			return caller, nil
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		return Caller{}, fmt.Errorf("%w: no authenticator", ErrUnauthenticated)
+	}
+	return Caller{}, errors.Join(errs...)
+}
+
+// authSchemes returns the schemes of auth, those of each authenticator of an [AnyAuthenticator].
+func authSchemes(auth Authenticator) []string {
+	multi, ok := auth.(anyAuthenticator)
+	if !ok {
+		return []string{auth.Scheme()}
+	}
+	var schemes []string
+	for _, auth := range multi {
+		schemes = append(schemes, authSchemes(auth)...)
+	}
+	return schemes
+}
+
+type callerKey struct{}
+
+// CallerFrom returns the caller of the request being served, if the [Server] has an [Authenticator].
+func CallerFrom(ctx context.Context) (Caller, bool) {
+	caller, ok := ctx.Value(callerKey{}).(Caller)
+	return caller, ok
+}
+
+// authenticate authenticates r with the authenticator of the server, if any, and returns r
+// with a context carrying the caller.
+//
+// A request that fails authentication is answered with 401 Unauthorized and a JSON-RPC error,
+// and authenticate returns false.
+func (s *Server) authenticate(w http.ResponseWriter, r *http.Request) (*http.Request, bool) {
+	if s.authenticator == nil {
+		return r, true
+	}
+
+	caller, err := s.authenticator.Authenticate(r)
+	if err != nil {
+		s.logger.InfoContext(r.Context(), "authentication failed",
+			slog.String("scheme", s.authenticator.Scheme()),
+			slog.Any("error", err),
+		)
+
+		w.Header().Set("WWW-Authenticate", s.authenticator.Scheme())
+		s.writeJSONRPCError(&statusWriter{ResponseWriter: w, status: http.StatusUnauthorized}, r, &a2a.JSONRPCError{
+			Code:    a2a.InvalidRequestErrorCode,
+			Message: "Unauthenticated",
+		})
+		return r, false
+	}
+
+	return r.WithContext(context.WithValue(r.Context(), callerKey{}, caller)), true
+}
+
+// checkAuthScheme warns when the agent card declares authentication schemes that do not
+// include the schemes of the authenticator.
+func (s *Server) checkAuthScheme() {
+	if s.authenticator == nil || s.agentCard == nil || s.agentCard.Authentication == nil {
+		return
+	}
+	for _, scheme := range authSchemes(s.authenticator) {
+		if !containsFold(s.agentCard.Authentication.Schemes, scheme) {
+			s.logger.Warn("authenticator scheme not declared in agent card",
+				slog.String("scheme", scheme),
+				slog.Any("declared", s.agentCard.Authentication.Schemes),
+			)
+		}
+	}
+}
+
+// RequireScheme restricts the JSON-RPC method to the callers authenticated with one of
+// schemes, such as [AuthSchemeBearer], so that a method like tasks/cancel can demand stronger
+// credentials than tasks/get. Schemes are compared ignoring case. Requiring schemes of a
+// method again replaces them.
+//
+// A call from a caller authenticated with another scheme is answered with 403 Forbidden and
+// a JSON-RPC error, as are all calls to the method when the server has no [Authenticator].
+// Use [AnyAuthenticator] to accept several schemes. RequireScheme must be called before the
+// server starts serving.
+func (s *Server) RequireScheme(method string, schemes ...string) {
+	if s.requiredSchemes == nil {
+		s.requiredSchemes = make(map[string][]string)
+	}
+	s.requiredSchemes[method] = slices.Clone(schemes)
+}
+
+// authorizeScheme reports whether the caller of r authenticated with a scheme the method
+// requires, see [Server.RequireScheme]. Otherwise it answers with 403 Forbidden and a
+// JSON-RPC error, and returns false.
+func (s *Server) authorizeScheme(w http.ResponseWriter, r *http.Request, method string) bool {
+	schemes, ok := s.requiredSchemes[method]
+	if !ok {
+		return true
+	}
+	caller, _ := CallerFrom(r.Context())
+	if caller.Scheme != "" && containsFold(schemes, caller.Scheme) {
+		return true
+	}
+
+	s.logger.InfoContext(r.Context(), "insufficient authentication scheme",
+		slog.String("method", method),
+		slog.String("scheme", caller.Scheme),
+		slog.Any("required", schemes),
+	)
+	s.writeJSONRPCError(&statusWriter{ResponseWriter: w, status: http.StatusForbidden}, r, &a2a.JSONRPCError{
+		Code:    a2a.InvalidRequestErrorCode,
+		Message: "Forbidden",
+		Data:    fmt.Sprintf("method %s requires authentication with %s", method, strings.Join(schemes, " or ")),
+	})
+	return false
+}
+
+// containsFold reports whether values contains s, ignoring case.
+func containsFold(values []string, s string) bool {
+	return slices.ContainsFunc(values, func(v string) bool { return strings.EqualFold(v, s) })
+}
+
+// statusWriter replaces the status code written to the underlying [http.ResponseWriter].
+type statusWriter struct {
+	http.ResponseWriter
+
+	status      int
+	wroteHeader bool
+}
+
+// WriteHeader implements [http.ResponseWriter].
+func (w *statusWriter) WriteHeader(int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		w.ResponseWriter.WriteHeader(w.status)
+	}
+}
+
+// Write implements [http.ResponseWriter].
+func (w *statusWriter) Write(p []byte) (int, error) {
+	w.WriteHeader(w.status)
+	return w.ResponseWriter.Write(p)
+}
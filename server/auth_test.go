@@ -0,0 +1,212 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+// callerTaskManager reports the caller of every tasks/get request.
+type callerTaskManager struct {
+	*server.InMemoryTaskManager
+
+	callers chan server.Caller
+}
+
+func (tm *callerTaskManager) OnGetTask(ctx context.Context, req *a2a.GetTaskRequest) (*a2a.GetTaskResponse, error) {
+	caller, _ := server.CallerFrom(ctx)
+	tm.callers <- caller
+	return tm.InMemoryTaskManager.OnGetTask(ctx, req)
+}
+
+func TestServer_WithAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	credentials := server.StaticCredentials(map[string]string{"secret": "alice"})
+
+	tests := map[string]struct {
+		auth       server.Authenticator
+		header     http.Header
+		wantStatus int
+		wantCaller server.Caller
+	}{
+		"bearer": {
+			auth:       &server.BearerTokenAuthenticator{Verify: credentials},
+			header:     http.Header{"Authorization": {"Bearer secret"}},
+			wantStatus: http.StatusOK,
+			wantCaller: server.Caller{Scheme: server.AuthSchemeBearer, ID: "alice"},
+		},
+		"bearer wrong token": {
+			auth:       &server.BearerTokenAuthenticator{Verify: credentials},
+			header:     http.Header{"Authorization": {"Bearer guess"}},
+			wantStatus: http.StatusUnauthorized,
+		},
+		"bearer missing": {
+			auth:       &server.BearerTokenAuthenticator{Verify: credentials},
+			wantStatus: http.StatusUnauthorized,
+		},
+		"basic instead of bearer": {
+			auth:       &server.BearerTokenAuthenticator{Verify: credentials},
+			header:     http.Header{"Authorization": {"Basic secret"}},
+			wantStatus: http.StatusUnauthorized,
+		},
+		"api key": {
+			auth:       &server.APIKeyAuthenticator{Verify: credentials},
+			header:     http.Header{server.DefaultAPIKeyHeader: {"secret"}},
+			wantStatus: http.StatusOK,
+			wantCaller: server.Caller{Scheme: server.AuthSchemeAPIKey, ID: "alice"},
+		},
+		"api key custom header": {
+			auth:       &server.APIKeyAuthenticator{Header: "X-Agent-Key", Verify: credentials},
+			header:     http.Header{server.DefaultAPIKeyHeader: {"secret"}},
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tm := &callerTaskManager{InMemoryTaskManager: server.NewInMemoryTaskManager(), callers: make(chan server.Caller, 1)}
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			srv := httptest.NewServer(server.NewServer("", "", card, tm, server.WithAuthenticator(tt.auth)))
+			t.Cleanup(srv.Close)
+
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, srv.URL,
+				strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"tasks/get","params":{"id":"missing"}}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			for key, values := range tt.header {
+				req.Header[key] = values
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusUnauthorized {
+				if got, want := string(body), `{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"Unauthenticated"}}`; got != want {
+					t.Errorf("body = %s, want %s", got, want)
+				}
+				if resp.Header.Get("WWW-Authenticate") != tt.auth.Scheme() {
+					t.Errorf("WWW-Authenticate = %q, want %q", resp.Header.Get("WWW-Authenticate"), tt.auth.Scheme())
+				}
+				return
+			}
+			if got := <-tm.callers; got != tt.wantCaller {
+				t.Errorf("CallerFrom() = %+v, want %+v", got, tt.wantCaller)
+			}
+		})
+	}
+
+	// The agent card stays public.
+	srv := httptest.NewServer(server.NewServer("", "", &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"},
+		server.NewInMemoryTaskManager(), server.WithAuthenticator(&server.BearerTokenAuthenticator{Verify: credentials})))
+	t.Cleanup(srv.Close)
+	resp, err := http.Get(srv.URL + server.AgantPath)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("agent card status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+}
+
+func TestServer_RequireScheme(t *testing.T) {
+	t.Parallel()
+
+	auth := server.AnyAuthenticator(
+		&server.BearerTokenAuthenticator{Verify: server.StaticCredentials(map[string]string{"strong": "alice"})},
+		&server.APIKeyAuthenticator{Verify: server.StaticCredentials(map[string]string{"weak": "bob"})},
+	)
+	bearer := http.Header{"Authorization": {"Bearer strong"}}
+	apiKey := http.Header{server.DefaultAPIKeyHeader: {"weak"}}
+
+	tests := map[string]struct {
+		opts       []server.Option
+		method     string
+		header     http.Header
+		wantStatus int
+	}{
+		"unrestricted method with weaker scheme": {
+			opts:       []server.Option{server.WithAuthenticator(auth)},
+			method:     a2a.MethodTasksGet,
+			header:     apiKey,
+			wantStatus: http.StatusOK,
+		},
+		"restricted method with required scheme": {
+			opts:       []server.Option{server.WithAuthenticator(auth)},
+			method:     a2a.MethodTasksCancel,
+			header:     bearer,
+			wantStatus: http.StatusOK,
+		},
+		"restricted method with weaker scheme": {
+			opts:       []server.Option{server.WithAuthenticator(auth)},
+			method:     a2a.MethodTasksCancel,
+			header:     apiKey,
+			wantStatus: http.StatusForbidden,
+		},
+		"restricted method unauthenticated": {
+			opts:       []server.Option{server.WithAuthenticator(auth)},
+			method:     a2a.MethodTasksCancel,
+			wantStatus: http.StatusUnauthorized,
+		},
+		"restricted method without authenticator": {
+			method:     a2a.MethodTasksCancel,
+			wantStatus: http.StatusForbidden,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			s := server.NewServer("", "", card, server.NewInMemoryTaskManager(), tt.opts...)
+			s.RequireScheme(a2a.MethodTasksCancel, "Bearer")
+			srv := httptest.NewServer(s)
+			t.Cleanup(srv.Close)
+
+			body := `{"jsonrpc":"2.0","id":1,"method":"` + tt.method + `","params":{"id":"missing"}}`
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, srv.URL, strings.NewReader(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			for key, values := range tt.header {
+				req.Header[key] = values
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			got, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusForbidden {
+				want := `{"jsonrpc":"2.0","id":1,"error":{"code":-32600,"message":"Forbidden","data":"method tasks/cancel requires authentication with Bearer"}}`
+				if string(got) != want {
+					t.Errorf("body = %s, want %s", got, want)
+				}
+			}
+		})
+	}
+}
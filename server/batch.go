@@ -0,0 +1,256 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/go-a2a/a2a"
+)
+
+// DefaultBatchConcurrency is how many requests of a batch are served at once, unless
+// [WithBatchLimits] sets another limit.
+const DefaultBatchConcurrency = 8
+
+// BatchErrorData is the data of the error answering a request of a batch, attributing the
+// error to the method called.
+type BatchErrorData struct {
+	// Method is the method of the failed request.
+	Method string `json:"method"`
+
+	// Reason tells why the request failed: the data of the error, or its message if it has no
+	// data.
+	Reason string `json:"reason"`
+}
+
+// batchMethodKey is the context key of the method of the batched request being served.
+type batchMethodKey struct{}
+
+// batchError returns jerr, the error answering a batched request to method, with its data
+// replaced by the [BatchErrorData] attributing it to method. Errors carrying structured data
+// are returned as is, so as not to lose it.
+func batchError(method string, jerr *a2a.JSONRPCError) *a2a.JSONRPCError {
+	reason := jerr.Message
+	switch data := jerr.Data.(type) {
+	case nil:
+	case string:
+		reason = data
+	default:
+		return jerr
+	}
+	annotated := *jerr
+	annotated.Data = BatchErrorData{Method: method, Reason: reason}
+	return &annotated
+}
+
+// batchResult is the response to one request of a batch.
+type batchResult struct {
+	w    bufferWriter
+	done chan struct{}
+	// panic holds the panic the request was served with, if any.
+	panic *handlerPanic
+}
+
+// isBatch reports whether body holds a JSON-RPC batch, that is a JSON array.
+func isBatch(body []byte) bool {
+	body = bytes.TrimLeft(body, " \t\r\n")
+	return len(body) > 0 && body[0] == '['
+}
+
+// serveBatch serves the requests of the JSON-RPC batch in body concurrently, each in its own
+// goroutine, up to the limits set by [WithBatchLimits], and writes their responses as a single
+// array in the order of the requests.
+//
+// A failing request only affects its own response, attributed to it by its ID and by the
+// [BatchErrorData] of the error. A request that panics is answered with an internal error,
+// even without [WithRecovery]. A request not answered by the deadline of the batch is
+// answered with a deadline exceeded error while its siblings are, without waiting for it.
+// Notifications get no response, and a batch of notifications only gets an empty 204 No
+// Content reply.
+func (s *Server) serveBatch(w http.ResponseWriter, r *http.Request, body []byte) {
+	var batch []json.RawMessage
+	if err := s.codec.Unmarshal(body, &batch); err != nil {
+		s.writeJSONRPCError(w, r, a2a.NewJSONParseError())
+		return
+	}
+	if len(batch) == 0 {
+		jerr := a2a.NewInvalidRequestError()
+		jerr.Data = "empty batch"
+		s.writeJSONRPCError(w, r, jerr)
+		return
+	}
+
+	trace.SpanFromContext(r.Context()).SetAttributes(attribute.Int("a2a.batch_size", len(batch)))
+
+	results := s.runBatch(r, batch)
+
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	n := 0
+	for _, resp := range results {
+		resp = bytes.TrimSpace(resp)
+		if len(resp) == 0 {
+			continue
+		}
+		if n > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(resp)
+		n++
+	}
+	buf.WriteByte(']')
+
+	if n == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		s.logger.ErrorContext(r.Context(), "write batch response", slog.Any("error", err))
+	}
+}
+
+// runBatch serves the requests of batch, at most [WithBatchLimits] of them at once, and
+// returns their responses, empty for notifications, in the order of the requests.
+func (s *Server) runBatch(r *http.Request, batch []json.RawMessage) [][]byte {
+	ctx, cancel := r.Context(), context.CancelFunc(func() {})
+	if s.batchTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, s.batchTimeout)
+	}
+	defer cancel()
+	r = r.WithContext(ctx)
+
+	concurrency := s.batchConcurrency
+	if concurrency < 1 {
+		concurrency = DefaultBatchConcurrency
+	}
+	sem := make(chan struct{}, concurrency)
+
+	results := make([]*batchResult, 0, len(batch))
+launch:
+	for _, elem := range batch {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			// The requests left are answered as timed out without being served.
+			break launch
+		}
+		res := &batchResult{done: make(chan struct{})}
+		results = append(results, res)
+		go func() {
+			defer func() { <-sem }()
+			defer close(res.done)
+			// A panic is carried over to the goroutine serving the batch, which answers it
+			// as the response to this request only.
+			defer func() {
+				if v := recover(); v != nil {
+					res.panic = &handlerPanic{value: v, stack: debug.Stack()}
+				}
+			}()
+			s.serveRequest(&res.w, r, elem, true)
+		}()
+	}
+
+	resps := make([][]byte, len(batch))
+	for i, elem := range batch {
+		if i < len(results) {
+			select {
+			case <-results[i].done:
+				resps[i] = s.batchResponse(r, elem, results[i])
+				continue
+			case <-ctx.Done():
+				// The response is written by the request while it finishes, if it does.
+				select {
+				case <-results[i].done:
+					resps[i] = s.batchResponse(r, elem, results[i])
+					continue
+				default:
+				}
+			}
+		}
+		resps[i] = s.batchTimeoutResponse(r, elem, ctx.Err())
+	}
+	return resps
+}
+
+// batchResponse returns the response to the batched request elem, served with res.
+//
+// A request that panicked, whether recovery is disabled or the panic got past it, is answered
+// with an internal error after its stack is logged, so that it does not abort the batch.
+func (s *Server) batchResponse(r *http.Request, elem json.RawMessage, res *batchResult) []byte {
+	p := res.panic
+	if p == nil {
+		return res.w.body.Bytes()
+	}
+
+	s.logger.ErrorContext(r.Context(), "batched request panicked",
+		slog.Any("panic", p.value),
+		slog.String("stack", string(p.stack)),
+	)
+	return s.batchErrorResponse(r, elem, a2a.NewInternalError())
+}
+
+// batchTimeoutResponse returns the response to the batched request elem, not answered before
+// the batch was done with err.
+func (s *Server) batchTimeoutResponse(r *http.Request, elem json.RawMessage, err error) []byte {
+	if err == nil {
+		err = context.DeadlineExceeded
+	}
+	return s.batchErrorResponse(r, elem, a2a.ToJSONRPCError(fmt.Errorf("batch: %w", err)))
+}
+
+// batchErrorResponse returns the response to the batched request elem failing with jerr, or
+// nothing if elem is a notification.
+func (s *Server) batchErrorResponse(r *http.Request, elem json.RawMessage, jerr *a2a.JSONRPCError) []byte {
+	ctx := r.Context()
+	req, perr := a2a.ParseRequest(elem)
+	switch {
+	case perr != nil:
+		jerr = perr
+	case req.IsNotification():
+		return nil
+	default:
+		ctx = withBatchMethod(withRequestID(ctx, req.ID), req.Method)
+	}
+
+	rw := &bufferWriter{}
+	s.writeJSONRPCError(rw, r.WithContext(ctx), jerr)
+	return rw.body.Bytes()
+}
+
+// withBatchMethod returns ctx carrying method, the method of the batched request being served,
+// so that the errors answering it are attributed to it.
+func withBatchMethod(ctx context.Context, method string) context.Context {
+	return context.WithValue(ctx, batchMethodKey{}, method)
+}
+
+// bufferWriter is the [http.ResponseWriter] collecting the response to one request of a batch.
+type bufferWriter struct {
+	header http.Header
+	body   bytes.Buffer
+}
+
+// Header implements [http.ResponseWriter].
+func (w *bufferWriter) Header() http.Header {
+	if w.header == nil {
+		w.header = make(http.Header)
+	}
+	return w.header
+}
+
+// Write implements [http.ResponseWriter].
+func (w *bufferWriter) Write(p []byte) (int, error) { return w.body.Write(p) }
+
+// WriteHeader implements [http.ResponseWriter].
+func (w *bufferWriter) WriteHeader(int) {}
@@ -0,0 +1,154 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+// postBatch posts the batch body to url and returns the response body.
+func postBatch(t *testing.T, url, body string) string {
+	t.Helper()
+
+	resp, err := http.Post(url, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	return string(data)
+}
+
+func TestServer_BatchPartialSuccess(t *testing.T) {
+	t.Parallel()
+
+	const (
+		first = `{"jsonrpc":"2.0","id":1,"method":"agent/echo","params":{"n":1}}`
+		third = `{"jsonrpc":"2.0","id":3,"method":"agent/echo","params":{"n":3}}`
+
+		firstResp = `{"jsonrpc":"2.0","id":1,"result":{"n":1}}`
+		thirdResp = `{"jsonrpc":"2.0","id":3,"result":{"n":3}}`
+	)
+	tests := map[string]struct {
+		second   string
+		opts     []server.Option
+		wantResp string
+	}{
+		"handler error": {
+			second:   `{"jsonrpc":"2.0","id":2,"method":"agent/fail"}`,
+			wantResp: `{"jsonrpc":"2.0","id":2,"error":{"code":-32004,"message":"This operation is not supported","data":{"method":"agent/fail","reason":"This operation is not supported"}}}`,
+		},
+		"invalid request": {
+			second:   `{"jsonrpc":"2.0","id":2,"method":"tasks/sendSubscribe","params":{"id":"task-1"}}`,
+			wantResp: `{"jsonrpc":"2.0","id":2,"error":{"code":-32600,"message":"Request payload validation error","data":{"method":"tasks/sendSubscribe","reason":"streaming method tasks/sendSubscribe cannot be batched"}}}`,
+		},
+		"panic": {
+			second:   `{"jsonrpc":"2.0","id":2,"method":"agent/panic"}`,
+			wantResp: `{"jsonrpc":"2.0","id":2,"error":{"code":-32603,"message":"Internal error","data":{"method":"agent/panic","reason":"Internal error"}}}`,
+		},
+		"panic without recovery": {
+			second:   `{"jsonrpc":"2.0","id":2,"method":"agent/panic"}`,
+			opts:     []server.Option{server.WithRecovery(false)},
+			wantResp: `{"jsonrpc":"2.0","id":2,"error":{"code":-32603,"message":"Internal error","data":{"method":"agent/panic","reason":"Internal error"}}}`,
+		},
+		"aborting panic": {
+			second:   `{"jsonrpc":"2.0","id":2,"method":"agent/abort"}`,
+			wantResp: `{"jsonrpc":"2.0","id":2,"error":{"code":-32603,"message":"Internal error","data":{"method":"agent/abort","reason":"Internal error"}}}`,
+		},
+		"timeout": {
+			second:   `{"jsonrpc":"2.0","id":2,"method":"agent/hang"}`,
+			opts:     []server.Option{server.WithBatchLimits(2, 100*time.Millisecond)},
+			wantResp: `{"jsonrpc":"2.0","id":2,"error":{"code":-32010,"message":"Deadline exceeded","data":{"method":"agent/hang","reason":"batch: context deadline exceeded"}}}`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// hung holds agent/hang, which ignores its context, until the test is over.
+			hung := make(chan struct{})
+			t.Cleanup(func() { close(hung) })
+
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			srv := server.NewServer("", "", card, nil, tt.opts...)
+			srv.Handle("agent/echo", server.HandlerFunc(func(ctx context.Context, params any) (any, error) {
+				return params, nil
+			}))
+			srv.Handle("agent/fail", server.HandlerFunc(func(ctx context.Context, params any) (any, error) {
+				return nil, a2a.ErrUnsupportedOperation
+			}))
+			srv.Handle("agent/panic", server.HandlerFunc(func(ctx context.Context, params any) (any, error) {
+				panic("boom")
+			}))
+			srv.Handle("agent/abort", server.HandlerFunc(func(ctx context.Context, params any) (any, error) {
+				panic(http.ErrAbortHandler)
+			}))
+			srv.Handle("agent/hang", server.HandlerFunc(func(ctx context.Context, params any) (any, error) {
+				<-hung
+				return nil, nil
+			}))
+			ts := httptest.NewServer(srv)
+			t.Cleanup(ts.Close)
+
+			got := postBatch(t, ts.URL, "["+first+","+tt.second+","+third+"]")
+			want := "[" + firstResp + "," + tt.wantResp + "," + thirdResp + "]"
+			if diff := gocmp.Diff(want, got); diff != "" {
+				t.Errorf("batch response mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithBatchLimits(t *testing.T) {
+	t.Parallel()
+
+	const concurrency = 2
+	var active, peak atomic.Int32
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := server.NewServer("", "", card, nil, server.WithBatchLimits(concurrency, 0))
+	srv.Handle("agent/work", server.HandlerFunc(func(ctx context.Context, params any) (any, error) {
+		n := active.Add(1)
+		defer active.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		return params, nil
+	}))
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+
+	var batch, want []string
+	for i := range 10 {
+		batch = append(batch, fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":"agent/work","params":[%d]}`, i, i))
+		want = append(want, fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"result":[%d]}`, i, i))
+	}
+	got := postBatch(t, ts.URL, "["+strings.Join(batch, ",")+"]")
+	if diff := gocmp.Diff("["+strings.Join(want, ",")+"]", got); diff != "" {
+		t.Errorf("batch response mismatch (-want +got):\n%s", diff)
+	}
+	if got := peak.Load(); got > concurrency {
+		t.Errorf("%d requests served at once, want at most %d", got, concurrency)
+	}
+}
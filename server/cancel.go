@@ -0,0 +1,119 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-a2a/a2a"
+)
+
+// CancelError is the cause of the cancellation of the context of a task handler whose task was
+// canceled with tasks/cancel, as returned by [context.Cause]. Handlers can clean up and end
+// the task with a canceled status recording the reason in [a2a.TaskStatus.Reason].
+//
+//	<-ctx.Done()
+//	var cerr *server.CancelError
+//	if errors.As(context.Cause(ctx), &cerr) {
+//		status := a2a.TaskStatus{State: a2a.TaskStateCanceled, Reason: cerr.Reason}
+//		...
+//	}
+type CancelError struct {
+	// Reason is the reason given by the tasks/cancel request, see [a2a.CancelReasonKey], or
+	// an empty string if it gave none.
+	Reason string
+}
+
+// Error implements error.
+func (e *CancelError) Error() string {
+	if e.Reason == "" {
+		return "task canceled"
+	}
+	return "task canceled: " + e.Reason
+}
+
+// Is reports whether target is [context.Canceled], which the cancellation is a kind of.
+func (e *CancelError) Is(target error) bool {
+	return target == context.Canceled
+}
+
+// canceledByClient reports whether ctx, the context of a task handler, was canceled by
+// tasks/cancel, and returns the cancellation.
+func canceledByClient(ctx context.Context) (*CancelError, bool) {
+	var cerr *CancelError
+	ok := errors.As(context.Cause(ctx), &cerr)
+	return cerr, ok
+}
+
+// runningTasks tracks the handlers running for each task, so that tasks/cancel can cancel them
+// and tasks/input/append can deliver them input.
+type runningTasks struct {
+	mu       sync.Mutex
+	handlers map[string]map[*runningHandler]struct{}
+}
+
+// runningHandler is a task handler tracked by [runningTasks].
+type runningHandler struct {
+	cancel context.CancelCauseFunc
+	// input carries the chunks of input streamed into the task, see [InputFrom].
+	input chan a2a.TaskInputParams
+	// consuming is set once the handler asked for its input.
+	consuming atomic.Bool
+}
+
+// start registers a handler of taskID and returns its context, canceled when the task is
+// canceled, and the function to call when the handler returns.
+func (rt *runningTasks) start(ctx context.Context, taskID string) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	h := &runningHandler{
+		cancel: cancel,
+		input:  make(chan a2a.TaskInputParams, inputBuffer),
+	}
+	ctx = context.WithValue(ctx, inputKey{}, h)
+
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	if rt.handlers == nil {
+		rt.handlers = make(map[string]map[*runningHandler]struct{})
+	}
+	if rt.handlers[taskID] == nil {
+		rt.handlers[taskID] = make(map[*runningHandler]struct{})
+	}
+	rt.handlers[taskID][h] = struct{}{}
+
+	return ctx, func() {
+		rt.mu.Lock()
+		delete(rt.handlers[taskID], h)
+		if len(rt.handlers[taskID]) == 0 {
+			delete(rt.handlers, taskID)
+		}
+		rt.mu.Unlock()
+
+		cancel(nil)
+	}
+}
+
+// running returns the handlers running for taskID.
+func (rt *runningTasks) running(taskID string) []*runningHandler {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	return slices.Collect(maps.Keys(rt.handlers[taskID]))
+}
+
+// cancel cancels the contexts of the handlers running for taskID with cause, and returns how
+// many there were.
+func (rt *runningTasks) cancel(taskID string, cause error) int {
+	handlers := rt.running(taskID)
+	for _, h := range handlers {
+		h.cancel(cause)
+	}
+	return len(handlers)
+}
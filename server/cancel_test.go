@@ -0,0 +1,222 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestServer_CancelRunningTask(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		metadata   string
+		wantReason string
+	}{
+		"with reason": {
+			metadata:   `,"metadata":{"reason":"user aborted"}`,
+			wantReason: "user aborted",
+		},
+		"without reason": {},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			started := make(chan struct{})
+			causes := make(chan error, 1)
+			tm := &slowTaskManager{
+				InMemoryTaskManager: server.NewInMemoryTaskManager(),
+				work: func(ctx context.Context, _ *server.InMemoryTaskManager, _ string) error {
+					close(started)
+					<-ctx.Done()
+					causes <- context.Cause(ctx)
+					return nil
+				},
+			}
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			srv := httptest.NewServer(server.NewServer("", "", card, tm))
+			t.Cleanup(srv.Close)
+
+			post := func(body string) *a2a.JSONRPCResponse {
+				t.Helper()
+
+				resp, err := http.Post(srv.URL, "application/json", strings.NewReader(body))
+				if err != nil {
+					t.Errorf("Post() error = %v", err)
+					return nil
+				}
+				defer resp.Body.Close()
+				var rpcResp a2a.JSONRPCResponse
+				if err := sonic.ConfigDefault.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+					t.Errorf("Decode() error = %v", err)
+					return nil
+				}
+				return &rpcResp
+			}
+
+			sent := make(chan *a2a.JSONRPCResponse, 1)
+			go func() {
+				sent <- post(`{"jsonrpc":"2.0","id":1,"method":"tasks/send","params":{"id":"task-1",` +
+					`"message":{"role":"user","parts":[{"type":"text","text":"hi"}]}}}`)
+			}()
+			<-started
+
+			canceled := post(`{"jsonrpc":"2.0","id":2,"method":"tasks/cancel","params":{"id":"task-1"` + tt.metadata + `}}`)
+			if canceled == nil || canceled.Error != nil {
+				t.Fatalf("tasks/cancel response = %+v", canceled)
+			}
+
+			cause := <-causes
+			var cerr *server.CancelError
+			if !errors.As(cause, &cerr) {
+				t.Fatalf("context.Cause() = %v, want a *server.CancelError", cause)
+			}
+			if cerr.Reason != tt.wantReason {
+				t.Errorf("CancelError.Reason = %q, want %q", cerr.Reason, tt.wantReason)
+			}
+			if !errors.Is(cause, context.Canceled) {
+				t.Errorf("context.Cause() = %v, want it to be context.Canceled", cause)
+			}
+
+			if resp := <-sent; resp == nil || resp.Error != nil {
+				t.Fatalf("tasks/send response = %+v", resp)
+			}
+			got, err := tm.OnGetTask(t.Context(), &a2a.GetTaskRequest{Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}}})
+			if err != nil {
+				t.Fatalf("OnGetTask() error = %v", err)
+			}
+			if got.Result.Status.State != a2a.TaskStateCanceled || got.Result.Status.Reason != tt.wantReason {
+				t.Errorf("task status = %s with reason %q, want %s with %q",
+					got.Result.Status.State, got.Result.Status.Reason, a2a.TaskStateCanceled, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestServer_CancelInputRequired(t *testing.T) {
+	t.Parallel()
+
+	// wait moves task-1 to input-required, then waits for input until the task is canceled.
+	wait := func(ctx context.Context, tm *server.InMemoryTaskManager, waiting chan<- struct{}) error {
+		if err := tm.UpdateTaskStatus(ctx, "task-1", a2a.TaskStatus{State: a2a.TaskStateInputRequired}, nil); err != nil {
+			return err
+		}
+		close(waiting)
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	send := `{"jsonrpc":"2.0","id":1,"method":"tasks/send","params":{"id":"task-1",` +
+		`"message":{"role":"user","parts":[{"type":"text","text":"hi"}]}}}`
+
+	tests := map[string]struct {
+		streaming bool
+	}{
+		"send":   {},
+		"stream": {streaming: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			waiting := make(chan struct{})
+			returned := make(chan error, 1)
+			inMemory := server.NewInMemoryTaskManager()
+			var tm server.TaskManager = &slowTaskManager{
+				InMemoryTaskManager: inMemory,
+				work: func(ctx context.Context, tm *server.InMemoryTaskManager, _ string) error {
+					err := wait(ctx, tm, waiting)
+					returned <- err
+					return err
+				},
+			}
+			if tt.streaming {
+				tm = &streamingTaskManager{
+					InMemoryTaskManager: inMemory,
+					stream: func(ctx context.Context, w server.StreamWriter) error {
+						_, err := inMemory.OnSendTask(ctx, &a2a.SendTaskRequest{Params: a2a.TaskSendParams{
+							TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+							Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "hi"}}},
+						}})
+						if err != nil {
+							return err
+						}
+						if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateInputRequired}); err != nil {
+							return err
+						}
+						err = wait(ctx, inMemory, waiting)
+						returned <- err
+						return err
+					},
+				}
+			}
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			srv := httptest.NewServer(server.NewServer("", "", card, tm))
+			t.Cleanup(srv.Close)
+
+			type sendResult struct {
+				body string
+				err  error
+			}
+			sent := make(chan sendResult, 1)
+			go func() {
+				if tt.streaming {
+					resp := postSendSubscribe(t.Context(), t, srv.URL)
+					defer resp.Body.Close()
+					body, err := io.ReadAll(resp.Body)
+					sent <- sendResult{string(body), err}
+					return
+				}
+				resp, err := http.Post(srv.URL, "application/json", strings.NewReader(send))
+				if err != nil {
+					sent <- sendResult{err: err}
+					return
+				}
+				defer resp.Body.Close()
+				body, err := io.ReadAll(resp.Body)
+				sent <- sendResult{string(body), err}
+			}()
+			<-waiting
+
+			canceled := postRPC(t, srv.URL, a2a.MethodTasksCancel, `{"id":"task-1"}`)
+			if canceled.Error != nil {
+				t.Fatalf("tasks/cancel error = %v", canceled.Error)
+			}
+
+			var cerr *server.CancelError
+			if err := <-returned; !errors.As(err, &cerr) && !errors.Is(err, context.Canceled) {
+				t.Errorf("waiting handler returned %v, want the task canceled", err)
+			}
+			result := <-sent
+			if result.err != nil {
+				t.Fatalf("read tasks/send response: %v", result.err)
+			}
+			if strings.Contains(result.body, `"error"`) {
+				t.Errorf("tasks/send response = %s, want no error", result.body)
+			}
+			if !tt.streaming && !strings.Contains(result.body, `"state":"canceled"`) {
+				t.Errorf("tasks/send response = %s, want the canceled task", result.body)
+			}
+
+			got, err := inMemory.OnGetTask(t.Context(), &a2a.GetTaskRequest{Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}}})
+			if err != nil {
+				t.Fatalf("OnGetTask() error = %v", err)
+			}
+			if got.Result.Status.State != a2a.TaskStateCanceled {
+				t.Errorf("task state = %s, want %s", got.Result.Status.State, a2a.TaskStateCanceled)
+			}
+		})
+	}
+}
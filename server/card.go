@@ -0,0 +1,232 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"mime"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/go-a2a/a2a"
+)
+
+// Media types the agent card can be served as.
+const (
+	// MediaTypeJSON is the default media type of the agent card.
+	MediaTypeJSON = "application/json"
+
+	// MediaTypeYAML is the media type of the agent card encoded by [YAMLCardEncoder].
+	MediaTypeYAML = "application/yaml"
+)
+
+// CardEncoder encodes an agent card for a particular media type.
+type CardEncoder func(card *a2a.AgentCard) ([]byte, error)
+
+// JSONCardEncoder encodes the agent card as canonical JSON, see [a2a.CanonicalJSON], so that
+// the served bytes do not change from one request to the next.
+func JSONCardEncoder(card *a2a.AgentCard) ([]byte, error) {
+	return a2a.CanonicalJSON(card)
+}
+
+// YAMLCardEncoder encodes the agent card as YAML, with the same field names as its JSON form.
+//
+// Mapping keys are sorted and strings are always double-quoted, so the output is
+// unambiguous to any YAML 1.2 parser.
+func YAMLCardEncoder(card *a2a.AgentCard) ([]byte, error) {
+	data, err := sonic.ConfigFastest.Marshal(card)
+	if err != nil {
+		return nil, err
+	}
+	var v any
+	if err := sonic.ConfigFastest.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := writeYAML(&buf, v, 0); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeYAML writes the generic JSON value v as a YAML block node indented by indent spaces.
+func writeYAML(buf *bytes.Buffer, v any, indent int) error {
+	pad := strings.Repeat(" ", indent)
+
+	switch v := v.(type) {
+	case map[string]any:
+		if len(v) == 0 {
+			buf.WriteString(pad + "{}\n")
+			return nil
+		}
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			buf.WriteString(pad)
+			if err := writeYAMLScalar(buf, key); err != nil {
+				return err
+			}
+			buf.WriteByte(':')
+			if err := writeYAMLValue(buf, v[key], indent); err != nil {
+				return err
+			}
+		}
+	case []any:
+		if len(v) == 0 {
+			buf.WriteString(pad + "[]\n")
+			return nil
+		}
+		for _, elem := range v {
+			buf.WriteString(pad + "-")
+			if err := writeYAMLValue(buf, elem, indent); err != nil {
+				return err
+			}
+		}
+	default:
+		buf.WriteString(pad)
+		if err := writeYAMLScalar(buf, v); err != nil {
+			return err
+		}
+		buf.WriteByte('\n')
+	}
+	return nil
+}
+
+// writeYAMLValue writes v after a mapping key or sequence dash, nesting collections on the following lines.
+func writeYAMLValue(buf *bytes.Buffer, v any, indent int) error {
+	switch c := v.(type) {
+	case map[string]any:
+		if len(c) > 0 {
+			buf.WriteByte('\n')
+			return writeYAML(buf, c, indent+2)
+		}
+		buf.WriteString(" {}\n")
+		return nil
+	case []any:
+		if len(c) > 0 {
+			buf.WriteByte('\n')
+			return writeYAML(buf, c, indent+2)
+		}
+		buf.WriteString(" []\n")
+		return nil
+	}
+
+	buf.WriteByte(' ')
+	if err := writeYAMLScalar(buf, v); err != nil {
+		return err
+	}
+	buf.WriteByte('\n')
+	return nil
+}
+
+// writeYAMLScalar writes a JSON scalar; JSON strings are valid double-quoted YAML scalars.
+func writeYAMLScalar(buf *bytes.Buffer, v any) error {
+	switch v := v.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case float64:
+		buf.WriteString(strconv.FormatFloat(v, 'g', -1, 64))
+	case string:
+		data, err := sonic.ConfigStd.Marshal(v)
+		if err != nil {
+			return err
+		}
+		buf.Write(data)
+	default:
+		return fmt.Errorf("yaml: unsupported value of type %T", v)
+	}
+	return nil
+}
+
+// negotiateCardEncoder picks the encoder for the most preferred media type in the Accept header.
+//
+// An empty Accept header selects JSON. It reports false if no registered encoder is acceptable.
+func negotiateCardEncoder(accept string, encoders map[string]CardEncoder) (string, CardEncoder, bool) {
+	if strings.TrimSpace(accept) == "" {
+		enc, ok := encoders[MediaTypeJSON]
+		return MediaTypeJSON, enc, ok
+	}
+
+	type candidate struct {
+		mediaType string
+		q         float64
+	}
+	var candidates []candidate
+	for item := range strings.SplitSeq(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(item))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+		}
+		if q > 0 {
+			candidates = append(candidates, candidate{mediaType: mediaType, q: q})
+		}
+	}
+	slices.SortStableFunc(candidates, func(a, b candidate) int { return cmp.Compare(b.q, a.q) })
+
+	// Wildcards prefer JSON, then the remaining encoders in a stable order.
+	available := slices.Sorted(maps.Keys(encoders))
+	if i := slices.Index(available, MediaTypeJSON); i > 0 {
+		available = append([]string{MediaTypeJSON}, slices.Delete(available, i, i+1)...)
+	}
+
+	for _, c := range candidates {
+		for _, mediaType := range available {
+			if matchMediaType(c.mediaType, mediaType) {
+				return mediaType, encoders[mediaType], true
+			}
+		}
+	}
+	return "", nil, false
+}
+
+// matchMediaType reports whether mediaType satisfies the possibly wildcarded pattern from an Accept header.
+func matchMediaType(pattern, mediaType string) bool {
+	if pattern == "*/*" || pattern == mediaType {
+		return true
+	}
+	prefix, ok := strings.CutSuffix(pattern, "/*")
+	return ok && strings.HasPrefix(mediaType, prefix+"/")
+}
+
+// cardETag returns the strong entity tag of the agent card served as mediaType.
+//
+// It hashes the canonical JSON of the card rather than the encoded card, so that it stays the
+// same across requests and restarts even with encoders whose output varies with map ordering.
+func cardETag(card *a2a.AgentCard, mediaType string) (string, error) {
+	data, err := a2a.CanonicalJSON(card)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	h.Write([]byte(mediaType))
+	h.Write([]byte{0})
+	h.Write(data)
+	return `"` + base64.RawURLEncoding.EncodeToString(h.Sum(nil)[:16]) + `"`, nil
+}
+
+// matchETag reports whether the If-None-Match header value matches etag, using weak comparison.
+func matchETag(ifNoneMatch, etag string) bool {
+	for tag := range strings.SplitSeq(ifNoneMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
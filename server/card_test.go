@@ -0,0 +1,254 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestServer_AgentCardNegotiation(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{
+		Name:    "test",
+		URL:     "http://example.com",
+		Version: "1.0.0",
+		Skills:  []a2a.AgentSkill{{ID: "echo", Name: "Echo", Tags: []string{"a: b"}}},
+	}
+	srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager(),
+		server.WithWellKnownPath("/discovery/agent"),
+		server.WithCardEncoder(server.MediaTypeYAML, server.YAMLCardEncoder),
+	))
+	t.Cleanup(srv.Close)
+
+	tests := map[string]struct {
+		path            string
+		accept          string
+		wantStatus      int
+		wantContentType string
+		wantBody        string
+	}{
+		"default is json": {
+			path:            "/discovery/agent",
+			wantStatus:      http.StatusOK,
+			wantContentType: server.MediaTypeJSON,
+			wantBody:        `"name":"test"`,
+		},
+		"wildcard prefers json": {
+			path:            "/discovery/agent",
+			accept:          "*/*",
+			wantStatus:      http.StatusOK,
+			wantContentType: server.MediaTypeJSON,
+			wantBody:        `"name":"test"`,
+		},
+		"yaml": {
+			path:            "/discovery/agent",
+			accept:          "application/yaml",
+			wantStatus:      http.StatusOK,
+			wantContentType: server.MediaTypeYAML,
+			wantBody:        "\"name\": \"test\"\n",
+		},
+		"quality values": {
+			path:            "/discovery/agent",
+			accept:          "application/json;q=0.5, application/yaml",
+			wantStatus:      http.StatusOK,
+			wantContentType: server.MediaTypeYAML,
+			wantBody:        "\"skills\":\n  -\n    \"id\": \"echo\"\n",
+		},
+		"not acceptable": {
+			path:       "/discovery/agent",
+			accept:     "text/html",
+			wantStatus: http.StatusNotAcceptable,
+		},
+		"default path not served": {
+			// Only the POST API endpoint is registered at the root.
+			path:       server.AgantPath,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, srv.URL+tt.path, nil)
+			if err != nil {
+				t.Fatalf("NewRequest() error = %v", err)
+			}
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if got := resp.Header.Get("Content-Type"); got != tt.wantContentType {
+				t.Errorf("Content-Type = %q, want %q", got, tt.wantContentType)
+			}
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("read body: %v", err)
+			}
+			if !strings.Contains(string(body), tt.wantBody) {
+				t.Errorf("body = %s, want it to contain %q", body, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestServer_AgentCardETag(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", nil, server.NewInMemoryTaskManager(), server.WithAgentCard(card)))
+	t.Cleanup(srv.Close)
+
+	get := func(ifNoneMatch string) *http.Response {
+		t.Helper()
+
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, srv.URL+server.AgantPath, nil)
+		if err != nil {
+			t.Fatalf("NewRequest() error = %v", err)
+		}
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Do() error = %v", err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	first := get("")
+	if first.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want %d", first.StatusCode, http.StatusOK)
+	}
+	if got := first.Header.Get("Content-Type"); got != server.MediaTypeJSON {
+		t.Errorf("Content-Type = %q, want %q", got, server.MediaTypeJSON)
+	}
+	etag := first.Header.Get("ETag")
+	if etag == "" {
+		t.Fatal("response has no ETag")
+	}
+
+	tests := map[string]struct {
+		ifNoneMatch string
+		wantStatus  int
+	}{
+		"matching":      {ifNoneMatch: etag, wantStatus: http.StatusNotModified},
+		"weak":          {ifNoneMatch: "W/" + etag, wantStatus: http.StatusNotModified},
+		"in a list":     {ifNoneMatch: `"other", ` + etag, wantStatus: http.StatusNotModified},
+		"wildcard":      {ifNoneMatch: "*", wantStatus: http.StatusNotModified},
+		"stale":         {ifNoneMatch: `"stale"`, wantStatus: http.StatusOK},
+		"unconditional": {wantStatus: http.StatusOK},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := get(tt.ifNoneMatch)
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if got := resp.Header.Get("ETag"); got != etag {
+				t.Errorf("ETag = %q, want %q", got, etag)
+			}
+		})
+	}
+}
+
+func TestServer_AgentCardETagStable(t *testing.T) {
+	t.Parallel()
+
+	properties := make(map[string]any)
+	for i := range 50 {
+		properties["field"+strconv.Itoa(i)] = map[string]any{"type": "string"}
+	}
+	newCard := func() *a2a.AgentCard {
+		return &a2a.AgentCard{
+			Name: "test", URL: "http://example.com", Version: "1.0.0",
+			Skills: []a2a.AgentSkill{{
+				ID: "skill", Name: "skill",
+				Parameters: map[string]any{"type": "object", "properties": maps.Clone(properties)},
+			}},
+		}
+	}
+	get := func(url, accept string) (etag, body string) {
+		t.Helper()
+
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, url+server.AgantPath, nil)
+		if err != nil {
+			t.Fatalf("NewRequest() error = %v", err)
+		}
+		req.Header.Set("Accept", accept)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Do() error = %v", err)
+		}
+		defer resp.Body.Close()
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("ReadAll() error = %v", err)
+		}
+		return resp.Header.Get("ETag"), string(data)
+	}
+
+	// Two servers stand for restarts of the same agent.
+	var urls []string
+	for range 2 {
+		srv := httptest.NewServer(server.NewServer("", "", newCard(), server.NewInMemoryTaskManager(),
+			server.WithCardEncoder(server.MediaTypeYAML, server.YAMLCardEncoder)))
+		t.Cleanup(srv.Close)
+		urls = append(urls, srv.URL)
+	}
+
+	etag, body := get(urls[0], server.MediaTypeJSON)
+	for i := range 20 {
+		gotETag, gotBody := get(urls[i%2], server.MediaTypeJSON)
+		if gotETag != etag {
+			t.Fatalf("ETag = %q, want %q", gotETag, etag)
+		}
+		if gotBody != body {
+			t.Fatalf("body = %s, want %s", gotBody, body)
+		}
+	}
+	if yamlETag, _ := get(urls[0], server.MediaTypeYAML); yamlETag == etag {
+		t.Errorf("YAML card has the ETag of the JSON card %q", etag)
+	}
+}
+
+func TestServer_AgentCardNotConfigured(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(server.NewServer("", "", nil, server.NewInMemoryTaskManager()))
+	t.Cleanup(srv.Close)
+
+	resp, err := http.Get(srv.URL + server.AgantPath)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-a2a/a2a"
+)
+
+// DefaultCompressionMinSize is the size, in bytes, from which responses are compressed unless
+// [WithCompressionMinSize] sets another.
+const DefaultCompressionMinSize = 1024
+
+// CompressionOption represents an option for configuring the compression of responses, see
+// [WithCompression].
+type CompressionOption func(*compression)
+
+// WithCompressionMinSize compresses only the responses of at least n bytes, smaller ones not
+// being worth the overhead.
+func WithCompressionMinSize(n int) CompressionOption {
+	return func(c *compression) {
+		c.minSize = max(n, 0)
+	}
+}
+
+// WithStreamCompression compresses the server-sent event streams too. Each event is flushed
+// through the compressor as it is sent, but intermediaries buffering compressed responses may
+// still hold events back, which is why streams are left uncompressed by default.
+func WithStreamCompression() CompressionOption {
+	return func(c *compression) {
+		c.streams = true
+	}
+}
+
+// compression configures the compression of the responses of the A2A endpoint.
+type compression struct {
+	// minSize is the size from which responses are compressed.
+	minSize int
+
+	// streams compresses the event streams too.
+	streams bool
+}
+
+// wrap returns the writer compressing the response to r written to w, if the client accepts
+// gzip, along with the function completing the response once the handler returns.
+func (c *compression) wrap(w http.ResponseWriter, r *http.Request) (http.ResponseWriter, func()) {
+	w.Header().Add("Vary", "Accept-Encoding")
+	if !acceptsGzip(r.Header.Values("Accept-Encoding")) {
+		return w, func() {}
+	}
+	cw := &compressWriter{ResponseWriter: w, minSize: c.minSize, streams: c.streams}
+	return cw, cw.finish
+}
+
+// acceptsGzip reports whether the Accept-Encoding header values accept gzip.
+func acceptsGzip(values []string) bool {
+	for _, value := range values {
+		for coding := range strings.SplitSeq(value, ",") {
+			name, params, _ := strings.Cut(coding, ";")
+			name = strings.ToLower(strings.TrimSpace(name))
+			if name != "gzip" && name != "*" {
+				continue
+			}
+			if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+				if weight, err := strconv.ParseFloat(q, 64); err == nil && weight == 0 {
+					continue
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// compressWriter gzips the response written through it, once it reaches the minimum size.
+//
+// The status and body are held back until the response is known to be small or large enough,
+// or the handler flushes it.
+type compressWriter struct {
+	http.ResponseWriter
+
+	minSize int
+	streams bool
+
+	// status is the status written by the handler, or zero.
+	status int
+
+	// buf holds the body written before the writer decided whether to compress it.
+	buf []byte
+
+	// decided is set once the writer chose to compress the response, with gz, or not.
+	decided bool
+	gz      *gzip.Writer
+}
+
+var _ http.Flusher = (*compressWriter)(nil)
+
+// WriteHeader implements [http.ResponseWriter].
+func (w *compressWriter) WriteHeader(status int) {
+	if w.status != 0 || w.decided {
+		return
+	}
+	w.status = status
+
+	h := w.Header()
+	switch {
+	case status < http.StatusOK, status == http.StatusNoContent, status == http.StatusNotModified, h.Get("Content-Encoding") != "":
+		w.decide(false)
+	case isEventStream(h.Get("Content-Type")):
+		w.decide(w.streams)
+	}
+}
+
+// Write implements [http.ResponseWriter].
+func (w *compressWriter) Write(p []byte) (int, error) {
+	if w.status == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	if !w.decided {
+		w.buf = append(w.buf, p...)
+		if len(w.buf) < w.minSize {
+			return len(p), nil
+		}
+		if err := w.decide(true); err != nil {
+			return 0, err
+		}
+		return len(p), nil
+	}
+	if w.gz != nil {
+		return w.gz.Write(p)
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+// Flush implements [http.Flusher], sending the response written so far, compressed if it
+// reached the minimum size.
+func (w *compressWriter) Flush() {
+	if !w.decided {
+		w.decide(len(w.buf) >= w.minSize)
+	}
+	if w.gz != nil {
+		w.gz.Flush()
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap returns the underlying writer, for [http.ResponseController].
+func (w *compressWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// decide writes the response headers, compressing the response if compress is set, and the
+// body held back so far.
+func (w *compressWriter) decide(compress bool) error {
+	w.decided = true
+	if compress {
+		h := w.Header()
+		h.Set("Content-Encoding", "gzip")
+		h.Del("Content-Length")
+		w.gz = gzip.NewWriter(w.ResponseWriter)
+	}
+	if w.status != 0 {
+		w.ResponseWriter.WriteHeader(w.status)
+	}
+
+	buf := w.buf
+	w.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	var err error
+	if w.gz != nil {
+		_, err = w.gz.Write(buf)
+	} else {
+		_, err = w.ResponseWriter.Write(buf)
+	}
+	return err
+}
+
+// finish completes the response once the handler returned.
+func (w *compressWriter) finish() {
+	if !w.decided {
+		if w.status == 0 && len(w.buf) == 0 {
+			// Nothing was written, leave it to the server.
+			return
+		}
+		w.decide(false)
+	}
+	if w.gz != nil {
+		w.gz.Close()
+	}
+}
+
+// isEventStream reports whether contentType is the media type of server-sent events.
+func isEventStream(contentType string) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	return mediaType == "text/event-stream"
+}
+
+// decompressRequest replaces the body of r, compressed as its Content-Encoding header says, by
+// its decompressed content. It answers the request with 415 Unsupported Media Type and returns
+// false for an encoding the server cannot decompress.
+func (s *Server) decompressRequest(w http.ResponseWriter, r *http.Request) bool {
+	encoding := strings.ToLower(strings.TrimSpace(r.Header.Get("Content-Encoding")))
+	switch encoding {
+	case "", "identity":
+		return true
+	case "gzip", "x-gzip":
+		zr, err := gzip.NewReader(r.Body)
+		if err == nil {
+			r.Body = &gzipBody{Reader: zr, body: r.Body}
+			r.Header.Del("Content-Encoding")
+			r.ContentLength = -1
+			return true
+		}
+		jerr := a2a.NewInvalidRequestError()
+		jerr.Data = fmt.Sprintf("decompress request body: %v", err)
+		s.writeJSONRPCError(w, r, jerr)
+		return false
+	default:
+		jerr := a2a.NewInvalidRequestError()
+		jerr.Data = fmt.Sprintf("unsupported content encoding %q", encoding)
+		w.Header().Set("Accept-Encoding", "gzip")
+		s.writeJSONRPCError(&statusWriter{ResponseWriter: w, status: http.StatusUnsupportedMediaType}, r, jerr)
+		return false
+	}
+}
+
+// gzipBody is a request body decompressed by a gzip reader.
+type gzipBody struct {
+	*gzip.Reader
+
+	body io.ReadCloser
+}
+
+// Close implements [io.Closer], closing the compressed body.
+func (b *gzipBody) Close() error {
+	b.Reader.Close()
+	return b.body.Close()
+}
@@ -0,0 +1,156 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestWithCompression(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		opts            []server.CompressionOption
+		method          string
+		text            string
+		acceptEncoding  string
+		contentEncoding string
+		wantStatus      int
+		wantEncoding    string
+	}{
+		"large response": {
+			method:         a2a.MethodTasksSend,
+			text:           strings.Repeat("a", 4096),
+			acceptEncoding: "gzip",
+			wantEncoding:   "gzip",
+		},
+		"small response": {
+			method:         a2a.MethodTasksSend,
+			text:           "hi",
+			acceptEncoding: "gzip",
+		},
+		"lower minimum size": {
+			opts:           []server.CompressionOption{server.WithCompressionMinSize(0)},
+			method:         a2a.MethodTasksSend,
+			text:           "hi",
+			acceptEncoding: "gzip, deflate",
+			wantEncoding:   "gzip",
+		},
+		"gzip not accepted": {
+			method: a2a.MethodTasksSend,
+			text:   strings.Repeat("a", 4096),
+		},
+		"gzip refused": {
+			method:         a2a.MethodTasksSend,
+			text:           strings.Repeat("a", 4096),
+			acceptEncoding: "gzip;q=0, identity",
+		},
+		"stream": {
+			method:         a2a.MethodTasksSendSubscribe,
+			text:           strings.Repeat("a", 4096),
+			acceptEncoding: "gzip",
+		},
+		"stream compression": {
+			opts:           []server.CompressionOption{server.WithStreamCompression()},
+			method:         a2a.MethodTasksSendSubscribe,
+			text:           "hi",
+			acceptEncoding: "gzip",
+			wantEncoding:   "gzip",
+		},
+		"gzipped request": {
+			method:          a2a.MethodTasksSend,
+			text:            strings.Repeat("a", 4096),
+			contentEncoding: "gzip",
+		},
+		"unsupported request encoding": {
+			method:          a2a.MethodTasksSend,
+			text:            "hi",
+			contentEncoding: "br",
+			wantStatus:      http.StatusUnsupportedMediaType,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newStreamingServer(t, func(ctx context.Context, w server.StreamWriter) error {
+				return w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateCompleted})
+			}, server.WithCompression(tt.opts...))
+
+			body := []byte(`{"jsonrpc":"2.0","id":1,"method":"` + tt.method + `","params":` +
+				`{"id":"task-1","message":{"role":"user","parts":[{"type":"text","text":"` + tt.text + `"}]}}}`)
+			if tt.contentEncoding == "gzip" {
+				var buf bytes.Buffer
+				zw := gzip.NewWriter(&buf)
+				zw.Write(body)
+				zw.Close()
+				body = buf.Bytes()
+			}
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, srv.URL, bytes.NewReader(body))
+			if err != nil {
+				t.Fatalf("NewRequest() error = %v", err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			if tt.acceptEncoding != "" {
+				req.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			}
+			if tt.contentEncoding != "" {
+				req.Header.Set("Content-Encoding", tt.contentEncoding)
+			}
+
+			// Keep the transport from decompressing responses behind the test's back.
+			client := &http.Client{Transport: &http.Transport{DisableCompression: true}}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			wantStatus := tt.wantStatus
+			if wantStatus == 0 {
+				wantStatus = http.StatusOK
+			}
+			if resp.StatusCode != wantStatus {
+				t.Fatalf("status = %d, want %d", resp.StatusCode, wantStatus)
+			}
+			if got := resp.Header.Get("Content-Encoding"); got != tt.wantEncoding {
+				t.Errorf("Content-Encoding = %q, want %q", got, tt.wantEncoding)
+			}
+			if got := resp.Header.Get("Vary"); !strings.Contains(got, "Accept-Encoding") {
+				t.Errorf("Vary = %q, want Accept-Encoding", got)
+			}
+
+			var r io.Reader = resp.Body
+			if tt.wantEncoding == "gzip" {
+				zr, err := gzip.NewReader(resp.Body)
+				if err != nil {
+					t.Fatalf("gzip.NewReader() error = %v", err)
+				}
+				r = zr
+			}
+			got, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatalf("ReadAll() error = %v", err)
+			}
+			if tt.wantStatus != 0 {
+				return
+			}
+			if !bytes.Contains(got, []byte(`"completed"`)) && !bytes.Contains(got, []byte(`"submitted"`)) {
+				t.Errorf("body = %s, want the task", got)
+			}
+			if bytes.Contains(got, []byte(`"error"`)) {
+				t.Errorf("body = %s, want no error", got)
+			}
+		})
+	}
+}
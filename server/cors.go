@@ -0,0 +1,126 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CORSConfig configures the cross-origin resource sharing of the [Server], letting browser-based
+// clients call the agent from other origins, see [WithCORS].
+type CORSConfig struct {
+	// AllowedOrigins lists the origins allowed to call the server, such as "https://example.com".
+	// "*" allows any origin.
+	AllowedOrigins []string
+
+	// AllowedMethods lists the methods allowed in cross-origin requests. It defaults to GET,
+	// POST and OPTIONS.
+	AllowedMethods []string
+
+	// AllowedHeaders lists the request headers allowed in cross-origin requests. It defaults
+	// to Content-Type, Authorization and Last-Event-ID.
+	AllowedHeaders []string
+
+	// AllowCredentials allows cross-origin requests to carry cookies and authorization headers.
+	// The allowed origin is then echoed back even when any origin is allowed, as browsers
+	// reject credentialed responses allowing "*".
+	AllowCredentials bool
+
+	// MaxAge is how long browsers may cache the result of a preflight request. Zero leaves
+	// it to the browser.
+	MaxAge time.Duration
+}
+
+// Default values of [CORSConfig].
+var (
+	defaultCORSMethods = []string{http.MethodGet, http.MethodPost, http.MethodOptions}
+	defaultCORSHeaders = []string{"Content-Type", "Authorization", "Last-Event-ID"}
+)
+
+// cors applies a [CORSConfig] to the requests of the [Server].
+type cors struct {
+	config  CORSConfig
+	methods string
+	headers string
+}
+
+func newCORS(config CORSConfig) *cors {
+	c := &cors{config: config}
+	methods, headers := config.AllowedMethods, config.AllowedHeaders
+	if len(methods) == 0 {
+		methods = defaultCORSMethods
+	}
+	if len(headers) == 0 {
+		headers = defaultCORSHeaders
+	}
+	c.methods = strings.Join(methods, ", ")
+	c.headers = strings.Join(headers, ", ")
+	return c
+}
+
+// allowOrigin returns the value of the Access-Control-Allow-Origin header answering origin,
+// or the empty string if the origin is not allowed.
+func (c *cors) allowOrigin(origin string) string {
+	if slices.Contains(c.config.AllowedOrigins, origin) {
+		return origin
+	}
+	if !slices.Contains(c.config.AllowedOrigins, "*") {
+		return ""
+	}
+	if c.config.AllowCredentials {
+		return origin
+	}
+	return "*"
+}
+
+// withCORS returns next with the Access-Control headers set on the responses to allowed
+// origins, answering preflight requests itself. The headers are set before next runs, so that
+// they precede the body of event streams.
+func (s *Server) withCORS(next http.Handler) http.Handler {
+	if s.cors == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		h := w.Header()
+		h.Add("Vary", "Origin")
+		preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+		allowed := s.cors.allowOrigin(origin)
+		if allowed == "" {
+			if preflight {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		h.Set("Access-Control-Allow-Origin", allowed)
+		if s.cors.config.AllowCredentials {
+			h.Set("Access-Control-Allow-Credentials", "true")
+		}
+		if !preflight {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		h.Add("Vary", "Access-Control-Request-Method")
+		h.Add("Vary", "Access-Control-Request-Headers")
+		h.Set("Access-Control-Allow-Methods", s.cors.methods)
+		h.Set("Access-Control-Allow-Headers", s.cors.headers)
+		if s.cors.config.MaxAge > 0 {
+			h.Set("Access-Control-Max-Age", strconv.Itoa(int(s.cors.config.MaxAge.Seconds())))
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
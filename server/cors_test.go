@@ -0,0 +1,154 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestWithCORS_Preflight(t *testing.T) {
+	t.Parallel()
+
+	corsHeaders := []string{
+		"Access-Control-Allow-Origin",
+		"Access-Control-Allow-Methods",
+		"Access-Control-Allow-Headers",
+		"Access-Control-Allow-Credentials",
+		"Access-Control-Max-Age",
+	}
+
+	tests := map[string]struct {
+		opts        []server.Option
+		origin      string
+		wantStatus  int
+		wantHeaders map[string]string
+	}{
+		"allowed origin": {
+			opts: []server.Option{server.WithCORS(server.CORSConfig{
+				AllowedOrigins: []string{"https://app.example.com"},
+				MaxAge:         10 * time.Minute,
+			})},
+			origin:     "https://app.example.com",
+			wantStatus: http.StatusNoContent,
+			wantHeaders: map[string]string{
+				"Access-Control-Allow-Origin":  "https://app.example.com",
+				"Access-Control-Allow-Methods": "GET, POST, OPTIONS",
+				"Access-Control-Allow-Headers": "Content-Type, Authorization, Last-Event-ID",
+				"Access-Control-Max-Age":       "600",
+			},
+		},
+		"any origin": {
+			opts: []server.Option{server.WithCORS(server.CORSConfig{
+				AllowedOrigins: []string{"*"},
+				AllowedMethods: []string{http.MethodPost},
+				AllowedHeaders: []string{"Content-Type"},
+			})},
+			origin:     "https://app.example.com",
+			wantStatus: http.StatusNoContent,
+			wantHeaders: map[string]string{
+				"Access-Control-Allow-Origin":  "*",
+				"Access-Control-Allow-Methods": "POST",
+				"Access-Control-Allow-Headers": "Content-Type",
+			},
+		},
+		"any origin with credentials": {
+			opts: []server.Option{server.WithCORS(server.CORSConfig{
+				AllowedOrigins:   []string{"*"},
+				AllowCredentials: true,
+			})},
+			origin:     "https://app.example.com",
+			wantStatus: http.StatusNoContent,
+			wantHeaders: map[string]string{
+				"Access-Control-Allow-Origin":      "https://app.example.com",
+				"Access-Control-Allow-Methods":     "GET, POST, OPTIONS",
+				"Access-Control-Allow-Headers":     "Content-Type, Authorization, Last-Event-ID",
+				"Access-Control-Allow-Credentials": "true",
+			},
+		},
+		"disallowed origin": {
+			opts: []server.Option{server.WithCORS(server.CORSConfig{
+				AllowedOrigins: []string{"https://app.example.com"},
+			})},
+			origin:      "https://evil.example.com",
+			wantStatus:  http.StatusForbidden,
+			wantHeaders: map[string]string{},
+		},
+		"no cors": {
+			origin:      "https://app.example.com",
+			wantStatus:  http.StatusMethodNotAllowed,
+			wantHeaders: map[string]string{},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newStreamingServer(t, func(context.Context, server.StreamWriter) error { return nil }, tt.opts...)
+
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodOptions, srv.URL, nil)
+			if err != nil {
+				t.Fatalf("NewRequest() error = %v", err)
+			}
+			req.Header.Set("Origin", tt.origin)
+			req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+			req.Header.Set("Access-Control-Request-Headers", "content-type")
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			got := make(map[string]string)
+			for _, key := range corsHeaders {
+				if v := resp.Header.Get(key); v != "" {
+					got[key] = v
+				}
+			}
+			if diff := gocmp.Diff(tt.wantHeaders, got); diff != "" {
+				t.Errorf("headers mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithCORS_Stream(t *testing.T) {
+	t.Parallel()
+
+	srv := newStreamingServer(t, func(ctx context.Context, w server.StreamWriter) error {
+		return w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateCompleted})
+	}, server.WithCORS(server.CORSConfig{AllowedOrigins: []string{"https://app.example.com"}}))
+
+	body := `{"jsonrpc":"2.0","id":42,"method":"tasks/sendSubscribe","params":` +
+		`{"id":"task-1","message":{"role":"user","parts":[{"type":"text","text":"hi"}]}}}`
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, srv.URL, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Origin", "https://app.example.com")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if got := resp.Header.Get("Content-Type"); !strings.HasPrefix(got, "text/event-stream") {
+		t.Fatalf("Content-Type = %q, want an event stream", got)
+	}
+	if got, want := resp.Header.Get("Access-Control-Allow-Origin"), "https://app.example.com"; got != want {
+		t.Errorf("Access-Control-Allow-Origin = %q, want %q", got, want)
+	}
+}
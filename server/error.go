@@ -0,0 +1,69 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-a2a/a2a"
+)
+
+// ErrorEncoder renders a JSON-RPC error to the HTTP response.
+//
+// Operators can replace it to match the error envelope expected by an API gateway.
+type ErrorEncoder func(w http.ResponseWriter, r *http.Request, err *a2a.JSONRPCError)
+
+type loggerKey struct{}
+
+// withLogger returns a copy of ctx carrying the logger of the server rendering an error.
+func withLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// loggerFrom returns the logger carried by ctx, or [slog.Default] for an [ErrorEncoder] called
+// apart from a [Server].
+func loggerFrom(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
+
+// errorResponse is a JSON-RPC error response. Unlike [a2a.JSONRPCResponse], it always has an
+// id member, which is null when the request ID could not be determined.
+type errorResponse struct {
+	JSONRPC string            `json:"jsonrpc"`
+	ID      *a2a.ID           `json:"id"`
+	Error   *a2a.JSONRPCError `json:"error"`
+}
+
+// DefaultErrorEncoder is the spec-compliant [ErrorEncoder].
+//
+// It writes the error as a JSON-RPC response with HTTP status 200, since the error is
+// carried by the JSON-RPC response rather than the HTTP status code. The response echoes
+// the request ID, or has a null ID if the request had none or could not be parsed. Failures
+// to write it are logged to the logger of the server, see [WithLogger].
+func DefaultErrorEncoder(w http.ResponseWriter, r *http.Request, err *a2a.JSONRPCError) {
+	resp := &errorResponse{
+		JSONRPC: "2.0",
+		Error:   err,
+	}
+	if id, ok := RequestIDFromContext(r.Context()); ok {
+		resp.ID = &id
+	}
+	data, merr := a2a.DefaultCodec.Marshal(resp)
+	if merr != nil {
+		loggerFrom(r.Context()).ErrorContext(r.Context(), "marshal error response", slog.Any("error", merr))
+		http.Error(w, "Marshal error response", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK) // error is in the JSON-RPC response, not HTTP
+	if _, werr := w.Write(data); werr != nil {
+		loggerFrom(r.Context()).ErrorContext(r.Context(), "write error response", slog.Any("error", werr))
+	}
+}
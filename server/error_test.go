@@ -0,0 +1,148 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/sonic"
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+// gatewayEncoder renders errors in the envelope of an API gateway, with a status of its own.
+func gatewayEncoder(w http.ResponseWriter, r *http.Request, err *a2a.JSONRPCError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadGateway)
+	fmt.Fprintf(w, `{"fault":{"code":%d}}`, err.Code)
+}
+
+func TestServer_WithErrorEncoder(t *testing.T) {
+	t.Parallel()
+
+	const unknownMethod = `{"jsonrpc":"2.0","id":7,"method":"tasks/unknown","params":{}}`
+	bearer := &server.BearerTokenAuthenticator{Verify: server.StaticCredentials(map[string]string{"secret": "alice"})}
+
+	tests := map[string]struct {
+		opts []server.Option
+		// header is sent along with body, a JSON-RPC request.
+		header     http.Header
+		body       string
+		wantStatus int
+		wantBody   map[string]any
+	}{
+		"default": {
+			body:       unknownMethod,
+			wantStatus: http.StatusOK,
+			wantBody: map[string]any{
+				"jsonrpc": "2.0",
+				"id":      float64(7),
+				"error":   map[string]any{"code": float64(a2a.MethodNotFoundErrorCode), "message": "Method not found"},
+			},
+		},
+		"default unknown request ID": {
+			body:       `{"jsonrpc":`,
+			wantStatus: http.StatusOK,
+			wantBody: map[string]any{
+				"jsonrpc": "2.0",
+				"id":      nil,
+				"error":   map[string]any{"code": float64(a2a.JSONParseErrorCode), "message": "Invalid JSON payload"},
+			},
+		},
+		"custom": {
+			opts:       []server.Option{server.WithErrorEncoder(gatewayEncoder)},
+			body:       unknownMethod,
+			wantStatus: http.StatusBadGateway,
+			wantBody:   map[string]any{"fault": map[string]any{"code": float64(a2a.MethodNotFoundErrorCode)}},
+		},
+		"unauthorized": {
+			opts:       []server.Option{server.WithAuthenticator(bearer)},
+			body:       unknownMethod,
+			wantStatus: http.StatusUnauthorized,
+			wantBody: map[string]any{
+				"jsonrpc": "2.0",
+				"id":      nil,
+				"error":   map[string]any{"code": float64(a2a.InvalidRequestErrorCode), "message": "Unauthenticated"},
+			},
+		},
+		"unauthorized custom": {
+			opts:       []server.Option{server.WithAuthenticator(bearer), server.WithErrorEncoder(gatewayEncoder)},
+			body:       unknownMethod,
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   map[string]any{"fault": map[string]any{"code": float64(a2a.InvalidRequestErrorCode)}},
+		},
+		"forbidden custom": {
+			opts:       []server.Option{server.WithErrorEncoder(gatewayEncoder)},
+			body:       `{"jsonrpc":"2.0","id":7,"method":"tasks/cancel","params":{"id":"task-1"}}`,
+			wantStatus: http.StatusForbidden,
+			wantBody:   map[string]any{"fault": map[string]any{"code": float64(a2a.InvalidRequestErrorCode)}},
+		},
+		"too large custom": {
+			opts:       []server.Option{server.WithMaxRequestBytes(16), server.WithErrorEncoder(gatewayEncoder)},
+			body:       unknownMethod,
+			wantStatus: http.StatusRequestEntityTooLarge,
+			wantBody:   map[string]any{"fault": map[string]any{"code": float64(a2a.InvalidRequestErrorCode)}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			s := server.NewServer("", "", card, server.NewInMemoryTaskManager(), tt.opts...)
+			s.RequireScheme(a2a.MethodTasksCancel, server.AuthSchemeBearer)
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			s.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			var got map[string]any
+			if err := sonic.ConfigDefault.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+				t.Fatalf("decode response %q: %v", rec.Body, err)
+			}
+			if diff := gocmp.Diff(tt.wantBody, got); diff != "" {
+				t.Errorf("response mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// failingWriter fails every write of the body of the response.
+type failingWriter struct {
+	*httptest.ResponseRecorder
+}
+
+func (w failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+func TestDefaultErrorEncoder_Logger(t *testing.T) {
+	t.Parallel()
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, nil))
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	s := server.NewServer("", "", card, server.NewInMemoryTaskManager(), server.WithLogger(logger))
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(`{"jsonrpc":`))
+	req.Header.Set("Content-Type", "application/json")
+	s.ServeHTTP(failingWriter{httptest.NewRecorder()}, req)
+
+	if !strings.Contains(logs.String(), "write error response") {
+		t.Errorf("server logs = %q, want the write failure", logs.String())
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/go-a2a/a2a"
+)
+
+// finalTask is the single event of a tasks/sendSubscribe call served by the tasks/send
+// handler: the task, marked final so that it also reads as the final status update of the stream.
+type finalTask struct {
+	*a2a.Task
+
+	Final bool `json:"final"`
+}
+
+// lookupStreamingFallback returns the handler of method on a server that does not serve it,
+// if it is tasks/sendSubscribe, see [WithStreamingFallback].
+func (s *Server) lookupStreamingFallback(method string) (methodHandler, bool) {
+	if method != a2a.MethodTasksSendSubscribe {
+		return nil, false
+	}
+	if h, ok := s.registered[a2a.MethodTasksSend]; ok && s.streamingFallback {
+		return typed(s.streamSentTask(h)), true
+	}
+	return func(w http.ResponseWriter, r *http.Request, req *a2a.JSONRPCRequest, params any) {
+		jerr := a2a.NewUnsupportedOperationError()
+		jerr.Data = "streaming is not supported"
+		s.writeJSONRPCError(w, r, jerr)
+	}, true
+}
+
+// streamSentTask returns the handler serving tasks/sendSubscribe with h, the handler of
+// tasks/send, streaming the task it answers with as a single final event.
+func (s *Server) streamSentTask(h Handler) func(http.ResponseWriter, *http.Request, *a2a.JSONRPCRequest, a2a.TaskSendParams) {
+	return func(w http.ResponseWriter, r *http.Request, rpcReq *a2a.JSONRPCRequest, params a2a.TaskSendParams) {
+		ctx, span := s.tracer.Start(r.Context(), "server.streamSentTask")
+		defer span.End()
+
+		r = r.WithContext(ctx)
+
+		span.SetAttributes(
+			attribute.String("a2a.task_id", params.ID),
+			attribute.Bool("a2a.streaming_fallback", true),
+		)
+
+		// The call is served by the tasks/send handler, so it must be allowed to call tasks/send.
+		if !s.authorizeScheme(w, r, a2a.MethodTasksSend) {
+			return
+		}
+
+		result, err := h.ServeJSONRPC(withMethod(ctx, a2a.MethodTasksSend), params)
+		if err != nil {
+			s.writeJSONRPCError(w, r, a2a.ToJSONRPCError(err))
+			return
+		}
+		task, ok := result.(*a2a.Task)
+		if !ok || task == nil {
+			s.writeError(w, r, a2a.InternalErrorCode, fmt.Sprintf("tasks/send handler answered with %T, not a task", result))
+			return
+		}
+
+		sw, err := newSSEWriter(ctx, w, s.codec, rpcReq.ID, task.ID, 0, nil, nil)
+		if err != nil {
+			s.writeError(w, r, a2a.InternalErrorCode, err.Error())
+			return
+		}
+		defer sw.Close()
+
+		err = sw.write(sseFrame{resp: &a2a.JSONRPCResponse{
+			JSONRPCMessage: a2a.NewJSONRPCMessage(rpcReq.ID),
+			Result:         &finalTask{Task: task, Final: true},
+		}})
+		if err != nil {
+			s.logger.ErrorContext(ctx, "write event", slog.Any("error", err))
+		}
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestServer_StreamingFallback(t *testing.T) {
+	t.Parallel()
+
+	sendTask := server.HandlerFunc(func(ctx context.Context, params any) (any, error) {
+		p := params.(a2a.TaskSendParams)
+		return &a2a.Task{
+			ID:        p.ID,
+			Status:    a2a.TaskStatus{State: a2a.TaskStateCompleted},
+			Artifacts: []a2a.Artifact{{Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "done"}}}},
+		}, nil
+	})
+
+	tests := map[string]struct {
+		opts     []server.Option
+		sendTask bool
+		// wantCode is the error code of the plain JSON-RPC response, or zero for a stream.
+		wantCode int
+	}{
+		"fallback": {
+			sendTask: true,
+		},
+		"strict": {
+			opts:     []server.Option{server.WithStreamingFallback(false)},
+			sendTask: true,
+			wantCode: a2a.UnsupportedOperationErrorCode,
+		},
+		"fallback without tasks/send": {
+			wantCode: a2a.UnsupportedOperationErrorCode,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			s := server.NewServer("", "", card, nil, tt.opts...)
+			if tt.sendTask {
+				s.Handle(a2a.MethodTasksSend, sendTask)
+			}
+			if s.InferCapabilities().Streaming {
+				t.Error("InferCapabilities() advertises streaming")
+			}
+			srv := httptest.NewServer(s)
+			t.Cleanup(srv.Close)
+
+			resp := postSendSubscribe(t.Context(), t, srv.URL)
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("read response: %v", err)
+			}
+
+			if tt.wantCode != 0 {
+				if ct := resp.Header.Get("Content-Type"); ct != "application/json" {
+					t.Errorf("Content-Type = %q, want application/json", ct)
+				}
+				var got a2a.JSONRPCResponse
+				if err := sonic.ConfigDefault.Unmarshal(body, &got); err != nil {
+					t.Fatalf("Unmarshal(%q) error = %v", body, err)
+				}
+				if got.Error == nil || got.Error.Code != tt.wantCode {
+					t.Errorf("error = %v, want code %d", got.Error, tt.wantCode)
+				}
+				return
+			}
+
+			if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/event-stream" {
+				t.Fatalf("status = %d, Content-Type = %q, want an event stream", resp.StatusCode, resp.Header.Get("Content-Type"))
+			}
+			var events []string
+			for line := range strings.Lines(string(body)) {
+				if data, ok := strings.CutPrefix(line, "data: "); ok {
+					events = append(events, data)
+				}
+			}
+			if len(events) != 1 {
+				t.Fatalf("got %d events, want 1:\n%s", len(events), body)
+			}
+			var event struct {
+				ID     int `json:"id"`
+				Result struct {
+					a2a.Task
+					Final bool `json:"final"`
+				} `json:"result"`
+			}
+			if err := sonic.ConfigDefault.Unmarshal([]byte(events[0]), &event); err != nil {
+				t.Fatalf("Unmarshal(%q) error = %v", events[0], err)
+			}
+			got := event.Result
+			if event.ID != 42 || !got.Final || got.ID != "task-1" || got.Status.State != a2a.TaskStateCompleted {
+				t.Errorf("event: id = %d, final = %t, task = %q, state = %s, want 42, true, task-1 and %s", event.ID, got.Final, got.ID, got.Status.State, a2a.TaskStateCompleted)
+			}
+			if len(got.Artifacts) != 1 || got.Artifacts[0].JoinText("") != "done" {
+				t.Errorf("event artifacts = %v, want the task artifact", got.Artifacts)
+			}
+		})
+	}
+}
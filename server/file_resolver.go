@@ -0,0 +1,277 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/go-a2a/a2a"
+)
+
+const (
+	// DefaultMaxFetchBytes is the largest file a [HTTPFileResolver] downloads unless
+	// [WithMaxFetchBytes] sets another limit.
+	DefaultMaxFetchBytes = 10 << 20 // 10MiB
+
+	// DefaultFetchTimeout is how long a [HTTPFileResolver] waits for a download unless
+	// [WithFetchTimeout] sets another timeout.
+	DefaultFetchTimeout = 30 * time.Second
+
+	// maxFetchRedirects is the number of redirects a [HTTPFileResolver] follows.
+	maxFetchRedirects = 5
+)
+
+var (
+	// ErrFileNotAllowed is returned by a [FileResolver] for a file URI its policy forbids
+	// fetching, such as one of a scheme or host that is not allowed, or resolving to a private
+	// address. The server answers it with the content-type-not-supported error.
+	ErrFileNotAllowed = errors.New("file URI not allowed")
+
+	// ErrFileTooLarge is returned by a [FileResolver] for a file exceeding its size limit. The
+	// server answers it with the content-type-not-supported error. It is [a2a.ErrFileTooLarge].
+	ErrFileTooLarge = a2a.ErrFileTooLarge
+)
+
+// FileResolver fetches the content of the files that clients send by URI, see [WithFileResolver].
+// Implementations must be safe for concurrent use.
+type FileResolver interface {
+	// Resolve returns the content of the file at uri and its MIME type, or an error wrapping
+	// [ErrFileNotAllowed] or [ErrFileTooLarge] if it is refused.
+	Resolve(ctx context.Context, uri string) (data []byte, mimeType string, err error)
+}
+
+// FileResolverOption represents an option for configuring a [HTTPFileResolver].
+type FileResolverOption func(*HTTPFileResolver)
+
+// WithAllowedSchemes sets the URI schemes a [HTTPFileResolver] fetches, https only by default.
+// Only http and https are supported.
+func WithAllowedSchemes(schemes ...string) FileResolverOption {
+	return func(r *HTTPFileResolver) {
+		r.schemes = schemes
+	}
+}
+
+// WithAllowedHosts restricts the hosts a [HTTPFileResolver] fetches from, which any public host
+// is by default. A host starting with "*." allows the subdomains of the rest of it.
+func WithAllowedHosts(hosts ...string) FileResolverOption {
+	return func(r *HTTPFileResolver) {
+		r.hosts = hosts
+	}
+}
+
+// WithMaxFetchBytes sets the largest file a [HTTPFileResolver] downloads. Defaults to
+// [DefaultMaxFetchBytes].
+func WithMaxFetchBytes(n int64) FileResolverOption {
+	return func(r *HTTPFileResolver) {
+		r.maxBytes = n
+	}
+}
+
+// WithFetchTimeout sets how long a [HTTPFileResolver] waits for a download, redirects included.
+// Defaults to [DefaultFetchTimeout].
+func WithFetchTimeout(d time.Duration) FileResolverOption {
+	return func(r *HTTPFileResolver) {
+		r.timeout = d
+	}
+}
+
+// WithPrivateNetworks makes a [HTTPFileResolver] fetch from loopback, private and link-local
+// addresses, which it refuses by default so that clients cannot reach the internal network of
+// the agent through it.
+func WithPrivateNetworks(allowed bool) FileResolverOption {
+	return func(r *HTTPFileResolver) {
+		r.privateNetworks = allowed
+	}
+}
+
+// HTTPFileResolver is the [FileResolver] fetching files over HTTP, within an allowlist of schemes
+// and hosts, a size limit and a timeout.
+//
+// The addresses a host resolves to are checked when connecting, so a host cannot redirect the
+// resolver to a private address by changing its DNS records, and every redirect is checked
+// like the original URI.
+type HTTPFileResolver struct {
+	schemes         []string
+	hosts           []string
+	maxBytes        int64
+	timeout         time.Duration
+	privateNetworks bool
+
+	httpClient *http.Client
+}
+
+var _ FileResolver = (*HTTPFileResolver)(nil)
+
+// NewHTTPFileResolver creates a new [HTTPFileResolver] configured with opts.
+func NewHTTPFileResolver(opts ...FileResolverOption) *HTTPFileResolver {
+	r := &HTTPFileResolver{
+		schemes:  []string{"https"},
+		maxBytes: DefaultMaxFetchBytes,
+		timeout:  DefaultFetchTimeout,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	dialer := &net.Dialer{
+		Timeout: r.timeout,
+		Control: r.checkDial,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	r.httpClient = &http.Client{
+		Transport: transport,
+		Timeout:   r.timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxFetchRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxFetchRedirects)
+			}
+			return r.checkURL(req.URL)
+		},
+	}
+	return r
+}
+
+// Resolve implements [FileResolver].
+func (r *HTTPFileResolver) Resolve(ctx context.Context, uri string) ([]byte, string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid file URI: %w", err)
+	}
+	if err := r.checkURL(u); err != nil {
+		return nil, "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("create HTTP request: %w", err)
+	}
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("fetch file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, "", fmt.Errorf("fetch file: unexpected status %s", resp.Status)
+	}
+	if resp.ContentLength > r.maxBytes {
+		return nil, "", fmt.Errorf("%w: %d bytes exceeds %d", ErrFileTooLarge, resp.ContentLength, r.maxBytes)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, r.maxBytes+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("read file: %w", err)
+	}
+	if int64(len(data)) > r.maxBytes {
+		return nil, "", fmt.Errorf("%w: exceeds %d bytes", ErrFileTooLarge, r.maxBytes)
+	}
+
+	return data, detectMIMEType(resp.Header.Get("Content-Type"), data), nil
+}
+
+// checkURL returns an error wrapping [ErrFileNotAllowed] unless the scheme and host of u are allowed.
+func (r *HTTPFileResolver) checkURL(u *url.URL) error {
+	if !slices.Contains(r.schemes, u.Scheme) || (u.Scheme != "http" && u.Scheme != "https") {
+		return fmt.Errorf("%w: scheme %q", ErrFileNotAllowed, u.Scheme)
+	}
+	host := strings.ToLower(u.Hostname())
+	if host == "" {
+		return fmt.Errorf("%w: no host", ErrFileNotAllowed)
+	}
+	if len(r.hosts) > 0 && !slices.ContainsFunc(r.hosts, func(allowed string) bool {
+		allowed = strings.ToLower(allowed)
+		if suffix, ok := strings.CutPrefix(allowed, "*."); ok {
+			return strings.HasSuffix(host, "."+suffix)
+		}
+		return host == allowed
+	}) {
+		return fmt.Errorf("%w: host %q", ErrFileNotAllowed, host)
+	}
+	return nil
+}
+
+// checkDial refuses connections to private addresses unless they are allowed.
+func (r *HTTPFileResolver) checkDial(network, address string, _ syscall.RawConn) error {
+	if r.privateNetworks {
+		return nil
+	}
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: address %q", ErrFileNotAllowed, address)
+	}
+	if isPrivateAddr(addrPort.Addr().Unmap()) {
+		return fmt.Errorf("%w: private address %s", ErrFileNotAllowed, addrPort.Addr())
+	}
+	return nil
+}
+
+// sharedAddressSpace is the carrier-grade NAT range of RFC 6598.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// isPrivateAddr reports whether addr is not a public unicast address.
+func isPrivateAddr(addr netip.Addr) bool {
+	return addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() || addr.IsMulticast() ||
+		addr.IsUnspecified() || sharedAddressSpace.Contains(addr)
+}
+
+// detectMIMEType returns the media type of contentType, or the one sniffed from data when the
+// server did not say or sent a generic one.
+func detectMIMEType(contentType string, data []byte) string {
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil && mediaType != "application/octet-stream" {
+		return mediaType
+	}
+	mediaType, _, _ := mime.ParseMediaType(http.DetectContentType(data))
+	return mediaType
+}
+
+// resolveFiles replaces the file parts of msg sent by URI with their content, fetched with the
+// [FileResolver] of the server, if any. Parts declaring a MIME type keep it.
+func (s *Server) resolveFiles(ctx context.Context, msg *a2a.Message) *a2a.JSONRPCError {
+	if s.fileResolver == nil {
+		return nil
+	}
+
+	for i, part := range msg.Parts {
+		fp, ok := part.(*a2a.FilePart)
+		if !ok || fp == nil || fp.File.URI == "" || fp.File.Bytes != "" {
+			continue
+		}
+
+		data, mimeType, err := s.fileResolver.Resolve(ctx, fp.File.URI)
+		if err != nil {
+			err = fmt.Errorf("part %d: resolve %s: %w", i, fp.File.URI, err)
+			if errors.Is(err, ErrFileNotAllowed) || errors.Is(err, ErrFileTooLarge) {
+				return a2a.ToJSONRPCError(fmt.Errorf("%w: %w", a2a.ErrContentTypeNotSupported, err))
+			}
+			return invalidParams(err)
+		}
+		if len(data) == 0 {
+			return invalidParams(fmt.Errorf("part %d: file %s is empty", i, fp.File.URI))
+		}
+
+		resolved := *fp
+		resolved.File.URI = ""
+		resolved.File.Bytes = base64.StdEncoding.EncodeToString(data)
+		if resolved.File.MIMEType == "" {
+			resolved.File.MIMEType = mimeType
+		}
+		msg.Parts[i] = &resolved
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+// newFileServer serves the files of the resolver tests.
+func newFileServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hello.txt", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		io.WriteString(w, "hello")
+	})
+	mux.HandleFunc("/image", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Write([]byte("\x89PNG\r\n\x1a\n\x00\x00\x00\x0dIHDR"))
+	})
+	mux.HandleFunc("/large", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(bytes.Repeat([]byte("a"), 4096))
+	})
+	mux.HandleFunc("/large-chunked", func(w http.ResponseWriter, r *http.Request) {
+		// Flushing first sends the body chunked, without a Content-Length.
+		w.(http.Flusher).Flush()
+		w.Write(bytes.Repeat([]byte("a"), 4096))
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "ftp://example.com/file", http.StatusFound)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestHTTPFileResolver(t *testing.T) {
+	t.Parallel()
+
+	srv := newFileServer(t)
+	local := []server.FileResolverOption{server.WithAllowedSchemes("http"), server.WithPrivateNetworks(true)}
+
+	tests := map[string]struct {
+		opts         []server.FileResolverOption
+		uri          string
+		wantData     string
+		wantMIMEType string
+		wantErr      error
+	}{
+		"fetched": {
+			opts:         local,
+			uri:          srv.URL + "/hello.txt",
+			wantData:     "hello",
+			wantMIMEType: "text/plain",
+		},
+		"sniffed MIME type": {
+			opts:         local,
+			uri:          srv.URL + "/image",
+			wantData:     "\x89PNG\r\n\x1a\n\x00\x00\x00\x0dIHDR",
+			wantMIMEType: "image/png",
+		},
+		"private address": {
+			opts:    []server.FileResolverOption{server.WithAllowedSchemes("http")},
+			uri:     srv.URL + "/hello.txt",
+			wantErr: server.ErrFileNotAllowed,
+		},
+		"private host name": {
+			opts:    []server.FileResolverOption{server.WithAllowedSchemes("http")},
+			uri:     strings.Replace(srv.URL, "127.0.0.1", "localhost", 1) + "/hello.txt",
+			wantErr: server.ErrFileNotAllowed,
+		},
+		"scheme not allowed": {
+			opts:    []server.FileResolverOption{server.WithPrivateNetworks(true)},
+			uri:     srv.URL + "/hello.txt",
+			wantErr: server.ErrFileNotAllowed,
+		},
+		"file scheme": {
+			opts:    []server.FileResolverOption{server.WithAllowedSchemes("file")},
+			uri:     "file:///etc/passwd",
+			wantErr: server.ErrFileNotAllowed,
+		},
+		"host not allowed": {
+			opts:    append([]server.FileResolverOption{server.WithAllowedHosts("*.example.com")}, local...),
+			uri:     srv.URL + "/hello.txt",
+			wantErr: server.ErrFileNotAllowed,
+		},
+		"host allowed": {
+			opts:         append([]server.FileResolverOption{server.WithAllowedHosts("127.0.0.1")}, local...),
+			uri:          srv.URL + "/hello.txt",
+			wantData:     "hello",
+			wantMIMEType: "text/plain",
+		},
+		"redirect to a scheme not allowed": {
+			opts:    local,
+			uri:     srv.URL + "/redirect",
+			wantErr: server.ErrFileNotAllowed,
+		},
+		"oversized": {
+			opts:    append([]server.FileResolverOption{server.WithMaxFetchBytes(1024)}, local...),
+			uri:     srv.URL + "/large",
+			wantErr: server.ErrFileTooLarge,
+		},
+		"oversized without length": {
+			opts:    append([]server.FileResolverOption{server.WithMaxFetchBytes(1024)}, local...),
+			uri:     srv.URL + "/large-chunked",
+			wantErr: server.ErrFileTooLarge,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, mimeType, err := server.NewHTTPFileResolver(tt.opts...).Resolve(t.Context(), tt.uri)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Resolve() error = %v, want %v", err, tt.wantErr)
+			}
+			if string(data) != tt.wantData || mimeType != tt.wantMIMEType {
+				t.Errorf("Resolve() = %q, %q, want %q, %q", data, mimeType, tt.wantData, tt.wantMIMEType)
+			}
+		})
+	}
+}
+
+func TestWithFileResolver(t *testing.T) {
+	t.Parallel()
+
+	files := newFileServer(t)
+
+	tests := map[string]struct {
+		opts      []server.FileResolverOption
+		wantBytes string
+		wantCode  int
+	}{
+		"resolved": {
+			opts:      []server.FileResolverOption{server.WithAllowedSchemes("http"), server.WithPrivateNetworks(true)},
+			wantBytes: "aGVsbG8=",
+		},
+		"refused": {
+			opts:     []server.FileResolverOption{server.WithAllowedSchemes("http")},
+			wantCode: a2a.ContentTypeNotSupportedErrorCode,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager(),
+				server.WithFileResolver(server.NewHTTPFileResolver(tt.opts...)),
+			))
+			t.Cleanup(srv.Close)
+
+			body := `{"jsonrpc":"2.0","id":1,"method":"tasks/send","params":{"id":"task-1","message":{"role":"user",` +
+				`"parts":[{"type":"file","file":{"uri":"` + files.URL + `/hello.txt"}}]}}}`
+			resp, err := http.Post(srv.URL, "application/json", strings.NewReader(body))
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+			defer resp.Body.Close()
+			var rpcResp a2a.SendTaskResponse
+			if err := sonic.ConfigFastest.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+
+			if tt.wantCode != 0 {
+				if rpcResp.Error == nil || rpcResp.Error.Code != tt.wantCode {
+					t.Fatalf("error = %v, want code %d", rpcResp.Error, tt.wantCode)
+				}
+				return
+			}
+			if rpcResp.Error != nil {
+				t.Fatalf("error = %v", rpcResp.Error)
+			}
+			fp, ok := rpcResp.Result.History[0].Parts[0].(*a2a.FilePart)
+			if !ok {
+				t.Fatalf("part = %T, want *a2a.FilePart", rpcResp.Result.History[0].Parts[0])
+			}
+			if fp.File.Bytes != tt.wantBytes || fp.File.URI != "" || fp.File.MIMEType != "text/plain" {
+				t.Errorf("file = %+v, want bytes %q of text/plain", fp.File, tt.wantBytes)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/go-a2a/a2a"
+)
+
+// Handler serves the calls of a JSON-RPC method registered with [Server.Handle].
+type Handler interface {
+	// ServeJSONRPC returns the result of a call with params, or the error it fails with.
+	//
+	// The params of an A2A method are decoded into its params type, by value, such as
+	// [a2a.TaskQueryParams] for [a2a.MethodTasksGet]. The params of any other method are
+	// passed as a [json.RawMessage]. Errors are mapped by [a2a.ToJSONRPCError], so handlers
+	// can return the a2a sentinel errors or a [*a2a.JSONRPCError].
+	ServeJSONRPC(ctx context.Context, params any) (any, error)
+}
+
+// HandlerFunc adapts a function to a [Handler].
+type HandlerFunc func(ctx context.Context, params any) (any, error)
+
+// ServeJSONRPC implements [Handler].
+func (f HandlerFunc) ServeJSONRPC(ctx context.Context, params any) (any, error) {
+	return f(ctx, params)
+}
+
+// methodHandler serves a JSON-RPC call once its params are decoded.
+type methodHandler func(w http.ResponseWriter, r *http.Request, req *a2a.JSONRPCRequest, params any)
+
+// typed adapts a handler of the params type P of a method to a [methodHandler].
+func typed[P any](handle func(http.ResponseWriter, *http.Request, *a2a.JSONRPCRequest, P)) methodHandler {
+	return func(w http.ResponseWriter, r *http.Request, req *a2a.JSONRPCRequest, params any) {
+		handle(w, r, req, params.(P))
+	}
+}
+
+// defaultMethods returns the default registry, serving every A2A method with the task manager.
+// It is empty without a task manager.
+func (s *Server) defaultMethods() map[string]methodHandler {
+	if s.taskManager == nil {
+		return make(map[string]methodHandler)
+	}
+	return map[string]methodHandler{
+		a2a.MethodTasksSend:                typed(s.handleSendTask),
+		a2a.MethodTasksGet:                 typed(s.handleGetTask),
+		a2a.MethodTasksCancel:              typed(s.handleCancelTask),
+		a2a.MethodTasksPushNotificationSet: typed(s.handleSetTaskPushNotification),
+		a2a.MethodTasksPushNotificationGet: typed(s.handleGetTaskPushNotification),
+		a2a.MethodTasksSendSubscribe:       typed(s.handleSendTaskStreaming),
+		a2a.MethodTasksResubscribe:         typed(s.handleTaskResubscription),
+		a2a.MethodTasksInputAppend:         typed(s.handleAppendTaskInput),
+		a2a.MethodTasksHistoryGet:          typed(s.handleGetTaskHistory),
+		a2a.MethodTasksArtifactsGet:        typed(s.handleGetTaskArtifacts),
+		a2a.MethodTasksList:                typed(s.handleListTasks),
+		a2a.MethodTasksJournalGet:          typed(s.handleGetTaskJournal),
+	}
+}
+
+// Handle registers h to serve the JSON-RPC method, in place of the task manager if it is an
+// A2A method. Calls to methods that are neither registered, with Handle or [Server.HandlePrefix],
+// nor served by the task manager fail with the method not found error.
+//
+// A [Server] created with a nil [TaskManager] serves the registered methods only, so a server
+// can be composed of the methods it supports. A handler returns a single result, so it cannot
+// serve the streaming methods.
+//
+// The capabilities advertised in the served agent card follow the methods served, see
+// [Server.InferCapabilities]. Handle must be called before the server starts serving.
+func (s *Server) Handle(method string, h Handler) {
+	s.methods[method] = s.serveHandler(h)
+	s.registered[method] = h
+}
+
+// prefixRoute is a handler registered with [Server.HandlePrefix].
+type prefixRoute struct {
+	prefix  string
+	handler Handler
+	handle  methodHandler
+}
+
+// HandlePrefix registers h to serve every JSON-RPC method starting with prefix, such as
+// "experimental/" or "x-", that has no handler of its own.
+//
+// A call is served by the handler of its exact method, registered with [Server.Handle] or
+// served by the task manager, then by the handler of the longest prefix matching its method,
+// and fails with the method not found error otherwise. The handler reads the method called
+// with [MethodFrom] to dispatch it, and receives its params as [Server.Handle] describes.
+// Registering a prefix again replaces its handler. HandlePrefix must be called before the
+// server starts serving.
+func (s *Server) HandlePrefix(prefix string, h Handler) {
+	route := prefixRoute{prefix: prefix, handler: h, handle: s.serveHandler(h)}
+	i, found := slices.BinarySearchFunc(s.prefixes, prefix, func(r prefixRoute, prefix string) int {
+		// Longest prefixes first, then in lexical order.
+		if c := cmp.Compare(len(prefix), len(r.prefix)); c != 0 {
+			return c
+		}
+		return cmp.Compare(r.prefix, prefix)
+	})
+	if found {
+		s.prefixes[i] = route
+		return
+	}
+	s.prefixes = slices.Insert(s.prefixes, i, route)
+}
+
+// lookupMethod returns the handler of method: its own, or that of the longest prefix of it.
+func (s *Server) lookupMethod(method string) (methodHandler, bool) {
+	if handle, ok := s.methods[method]; ok {
+		return handle, true
+	}
+	for _, route := range s.prefixes {
+		if strings.HasPrefix(method, route.prefix) {
+			return route.handle, true
+		}
+	}
+	return nil, false
+}
+
+// serveHandler adapts h to a [methodHandler], writing its result or error as the response.
+func (s *Server) serveHandler(h Handler) methodHandler {
+	return func(w http.ResponseWriter, r *http.Request, req *a2a.JSONRPCRequest, params any) {
+		result, err := h.ServeJSONRPC(r.Context(), params)
+		if err != nil {
+			s.writeJSONRPCError(w, r, a2a.ToJSONRPCError(err))
+			return
+		}
+		s.writeResponse(w, r, req.ID, result)
+	}
+}
+
+// CapabilityDeclarer is implemented by task managers and handlers declaring capabilities that
+// [Server.InferCapabilities] cannot infer from the methods served.
+type CapabilityDeclarer interface {
+	// DeclareCapabilities sets the capabilities provided in caps.
+	DeclareCapabilities(caps *a2a.AgentCapabilities)
+}
+
+// InferCapabilities returns the capabilities of the server, inferred from the methods it serves.
+//
+// Streaming is set when [a2a.MethodTasksSendSubscribe] is served, and push notifications when
+// [a2a.MethodTasksPushNotificationSet] is registered with [Server.Handle], or served by the
+// task manager with a push config store, see [WithPushNotifications]. State transition history
+// cannot be inferred, so it is taken from the agent card. The task manager and the handlers
+// registered with [Server.Handle] or [Server.HandlePrefix] implementing [CapabilityDeclarer]
+// then declare any other capability they provide.
+//
+// The served agent card advertises these capabilities, unless overridden by [WithCapabilities].
+func (s *Server) InferCapabilities() a2a.AgentCapabilities {
+	var caps a2a.AgentCapabilities
+	if s.agentCard != nil {
+		caps.StateTransitionHistory = s.agentCard.Capabilities.StateTransitionHistory
+	}
+	caps.Streaming = s.serves(a2a.MethodTasksSendSubscribe)
+	_, pushHandler := s.registered[a2a.MethodTasksPushNotificationSet]
+	caps.PushNotifications = pushHandler || s.serves(a2a.MethodTasksPushNotificationSet) && s.pushStore != nil
+
+	if declarer, ok := s.taskManager.(CapabilityDeclarer); ok {
+		declarer.DeclareCapabilities(&caps)
+	}
+	for _, method := range slices.Sorted(maps.Keys(s.registered)) {
+		if declarer, ok := s.registered[method].(CapabilityDeclarer); ok {
+			declarer.DeclareCapabilities(&caps)
+		}
+	}
+	for _, route := range s.prefixes {
+		if declarer, ok := route.handler.(CapabilityDeclarer); ok {
+			declarer.DeclareCapabilities(&caps)
+		}
+	}
+	return caps
+}
+
+// serves reports whether the server has a handler for method.
+func (s *Server) serves(method string) bool {
+	_, ok := s.methods[method]
+	return ok
+}
+
+// servedCard returns the agent card to serve, advertising the capabilities of the server.
+func (s *Server) servedCard() *a2a.AgentCard {
+	caps := s.InferCapabilities()
+	if s.capabilities != nil {
+		caps = *s.capabilities
+	}
+	if caps == s.agentCard.Capabilities {
+		return s.agentCard
+	}
+	card := *s.agentCard
+	card.Capabilities = caps
+	return &card
+}
+
+// decodeMethodParams decodes the params of req for its handler: into the params type of an A2A
+// method, or as raw JSON for any other method.
+func decodeMethodParams(req *a2a.JSONRPCRequest) (any, *a2a.JSONRPCError) {
+	params, jerr := a2a.DecodeMethodParams(req)
+	if jerr != nil && jerr.Code == a2a.MethodNotFoundErrorCode {
+		return json.RawMessage(req.Params), nil
+	}
+	return params, jerr
+}
@@ -0,0 +1,489 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/sonic"
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestServer_Handle(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{
+		Name: "test", URL: "http://example.com", Version: "1.0.0",
+		Capabilities: a2a.AgentCapabilities{Streaming: true, StateTransitionHistory: true},
+	}
+	srv := server.NewServer("", "", card, nil)
+	srv.Handle(a2a.MethodTasksGet, server.HandlerFunc(func(ctx context.Context, params any) (any, error) {
+		query := params.(a2a.TaskQueryParams)
+		if query.ID != "task-1" {
+			return nil, a2a.ErrTaskNotFound
+		}
+		return a2a.TaskIDParams{ID: query.ID}, nil
+	}))
+	srv.Handle("agent/echo", server.HandlerFunc(func(ctx context.Context, params any) (any, error) {
+		return params.(json.RawMessage), nil
+	}))
+	srv.Handle(a2a.MethodTasksPushNotificationSet, server.HandlerFunc(func(ctx context.Context, params any) (any, error) {
+		return params.(a2a.TaskPushNotificationConfig), nil
+	}))
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+
+	tests := map[string]struct {
+		body       string
+		wantResult string
+		wantCode   int
+	}{
+		"registered A2A method": {
+			body:       `{"jsonrpc":"2.0","id":1,"method":"tasks/get","params":{"id":"task-1"}}`,
+			wantResult: `{"id":"task-1"}`,
+		},
+		"handler error": {
+			body:     `{"jsonrpc":"2.0","id":1,"method":"tasks/get","params":{"id":"missing"}}`,
+			wantCode: a2a.TaskNotFoundErrorCode,
+		},
+		"invalid params": {
+			body:     `{"jsonrpc":"2.0","id":1,"method":"tasks/get","params":{"id":1}}`,
+			wantCode: a2a.InvalidParamsErrorCode,
+		},
+		"registered custom method": {
+			body:       `{"jsonrpc":"2.0","id":1,"method":"agent/echo","params":{"hello":"world"}}`,
+			wantResult: `{"hello":"world"}`,
+		},
+		"unregistered A2A method": {
+			body:     `{"jsonrpc":"2.0","id":1,"method":"tasks/send","params":{"id":"task-1","message":{"role":"user","parts":[]}}}`,
+			wantCode: a2a.MethodNotFoundErrorCode,
+		},
+		"unknown method": {
+			body:     `{"jsonrpc":"2.0","id":1,"method":"agent/unknown"}`,
+			wantCode: a2a.MethodNotFoundErrorCode,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := http.Post(ts.URL, "application/json", strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			var rpcResp struct {
+				Result json.RawMessage   `json:"result"`
+				Error  *a2a.JSONRPCError `json:"error"`
+			}
+			if err := sonic.ConfigFastest.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if tt.wantCode != 0 {
+				if rpcResp.Error == nil || rpcResp.Error.Code != tt.wantCode {
+					t.Fatalf("error = %v, want code %d", rpcResp.Error, tt.wantCode)
+				}
+				return
+			}
+			if rpcResp.Error != nil {
+				t.Fatalf("error = %v", rpcResp.Error)
+			}
+			if got := string(rpcResp.Result); got != tt.wantResult {
+				t.Errorf("result = %s, want %s", got, tt.wantResult)
+			}
+		})
+	}
+
+	t.Run("capabilities", func(t *testing.T) {
+		t.Parallel()
+
+		want := a2a.AgentCapabilities{PushNotifications: true, StateTransitionHistory: true}
+		if diff := gocmp.Diff(want, srv.InferCapabilities()); diff != "" {
+			t.Errorf("InferCapabilities() mismatch (-want +got):\n%s", diff)
+		}
+
+		resp, err := http.Get(ts.URL + server.AgantPath)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		defer resp.Body.Close()
+		var served a2a.AgentCard
+		if err := sonic.ConfigFastest.NewDecoder(resp.Body).Decode(&served); err != nil {
+			t.Fatalf("decode agent card: %v", err)
+		}
+		if diff := gocmp.Diff(want, served.Capabilities); diff != "" {
+			t.Errorf("served capabilities mismatch (-want +got):\n%s", diff)
+		}
+		if !card.Capabilities.Streaming {
+			t.Error("serving the agent card modified it")
+		}
+	})
+}
+
+// nilGetTaskManager answers tasks/get with a response without a task.
+type nilGetTaskManager struct {
+	*server.InMemoryTaskManager
+}
+
+func (tm *nilGetTaskManager) OnGetTask(ctx context.Context, req *a2a.GetTaskRequest) (*a2a.GetTaskResponse, error) {
+	return &a2a.GetTaskResponse{}, nil
+}
+
+func TestServer_InvalidResponse(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	// Without recovery, a handler panicking on the response fails the request.
+	srv := server.NewServer("", "", card, &nilGetTaskManager{InMemoryTaskManager: server.NewInMemoryTaskManager()}, server.WithRecovery(false))
+	srv.Handle("agent/nothing", server.HandlerFunc(func(ctx context.Context, params any) (any, error) {
+		return nil, nil
+	}))
+	srv.Handle("agent/nil-task", server.HandlerFunc(func(ctx context.Context, params any) (any, error) {
+		return (*a2a.Task)(nil), nil
+	}))
+	srv.Handle("agent/nil-map", server.HandlerFunc(func(ctx context.Context, params any) (any, error) {
+		return map[string]any(nil), nil
+	}))
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+
+	// A response with neither a result nor an error is a bug of the handler, answered with an
+	// internal error rather than a null result.
+	tests := map[string]string{
+		"agent/nothing":  "",
+		"agent/nil-task": "",
+		"agent/nil-map":  "",
+		// A task asked for only if modified is not compared before it is checked.
+		a2a.MethodTasksGet: `,"params":{"id":"task-1","updatedSince":"2025-01-01T00:00:00Z"}`,
+	}
+	for method, params := range tests {
+		t.Run(method, func(t *testing.T) {
+			t.Parallel()
+
+			body := fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":%q%s}`, method, params)
+			resp, err := http.Post(ts.URL, "application/json", strings.NewReader(body))
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+			defer resp.Body.Close()
+			data, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("read body: %v", err)
+			}
+			want := `{"jsonrpc":"2.0","id":1,"error":{"code":-32603,"message":"Internal error"}}`
+			if got := strings.TrimSpace(string(data)); got != want {
+				t.Errorf("response = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestServer_HandlePrefix(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := server.NewServer("", "", card, server.NewInMemoryTaskManager())
+	route := func(name string) server.Handler {
+		return server.HandlerFunc(func(ctx context.Context, params any) (any, error) {
+			method, _ := server.MethodFrom(ctx)
+			return name + " " + method, nil
+		})
+	}
+	srv.HandlePrefix("x-", route("replaced"))
+	srv.HandlePrefix("x-", route("x"))
+	srv.HandlePrefix("x-vendor/beta/", route("beta"))
+	srv.HandlePrefix("x-vendor/", route("vendor"))
+	srv.HandlePrefix("tasks/", route("tasks"))
+	srv.Handle("x-vendor/exact", route("exact"))
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+
+	tests := map[string]struct {
+		method     string
+		wantResult string
+		wantCode   int
+	}{
+		"exact method":           {method: "x-vendor/exact", wantResult: `"exact x-vendor/exact"`},
+		"longest prefix":         {method: "x-vendor/beta/run", wantResult: `"beta x-vendor/beta/run"`},
+		"overlapping prefix":     {method: "x-vendor/run", wantResult: `"vendor x-vendor/run"`},
+		"shortest prefix":        {method: "x-other", wantResult: `"x x-other"`},
+		"prefix itself":          {method: "x-vendor/", wantResult: `"vendor x-vendor/"`},
+		"task manager first":     {method: a2a.MethodTasksGet, wantCode: a2a.TaskNotFoundErrorCode},
+		"unserved under prefix":  {method: "tasks/archive", wantResult: `"tasks tasks/archive"`},
+		"no matching prefix":     {method: "experimental/run", wantCode: a2a.MethodNotFoundErrorCode},
+		"prefix matched exactly": {method: "x", wantCode: a2a.MethodNotFoundErrorCode},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			body := `{"jsonrpc":"2.0","id":1,"method":"` + tt.method + `","params":{"id":"missing"}}`
+			resp, err := http.Post(ts.URL, "application/json", strings.NewReader(body))
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			var rpcResp struct {
+				Result json.RawMessage   `json:"result"`
+				Error  *a2a.JSONRPCError `json:"error"`
+			}
+			if err := sonic.ConfigFastest.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if tt.wantCode != 0 {
+				if rpcResp.Error == nil || rpcResp.Error.Code != tt.wantCode {
+					t.Fatalf("error = %v, want code %d", rpcResp.Error, tt.wantCode)
+				}
+				return
+			}
+			if rpcResp.Error != nil {
+				t.Fatalf("error = %v", rpcResp.Error)
+			}
+			if got := string(rpcResp.Result); got != tt.wantResult {
+				t.Errorf("result = %s, want %s", got, tt.wantResult)
+			}
+		})
+	}
+}
+
+// historyTaskManager declares state transition history, which cannot be inferred.
+type historyTaskManager struct {
+	*server.InMemoryTaskManager
+}
+
+func (historyTaskManager) DeclareCapabilities(caps *a2a.AgentCapabilities) {
+	caps.StateTransitionHistory = true
+}
+
+func TestServer_InferCapabilities(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		declared a2a.AgentCapabilities
+		tm       server.TaskManager
+		opts     []server.Option
+		want     a2a.AgentCapabilities
+		wantCard a2a.AgentCapabilities
+	}{
+		"task manager": {
+			declared: a2a.AgentCapabilities{PushNotifications: true},
+			tm:       server.NewInMemoryTaskManager(),
+			want:     a2a.AgentCapabilities{Streaming: true},
+			wantCard: a2a.AgentCapabilities{Streaming: true},
+		},
+		"push notifications": {
+			tm:       server.NewInMemoryTaskManager(),
+			opts:     []server.Option{server.WithPushNotifications(server.NewInMemoryPushNotificationStore())},
+			want:     a2a.AgentCapabilities{Streaming: true, PushNotifications: true},
+			wantCard: a2a.AgentCapabilities{Streaming: true, PushNotifications: true},
+		},
+		"declared by the task manager": {
+			tm:       historyTaskManager{server.NewInMemoryTaskManager()},
+			want:     a2a.AgentCapabilities{Streaming: true, StateTransitionHistory: true},
+			wantCard: a2a.AgentCapabilities{Streaming: true, StateTransitionHistory: true},
+		},
+		"overridden": {
+			tm:       server.NewInMemoryTaskManager(),
+			opts:     []server.Option{server.WithCapabilities(a2a.AgentCapabilities{PushNotifications: true})},
+			want:     a2a.AgentCapabilities{Streaming: true},
+			wantCard: a2a.AgentCapabilities{PushNotifications: true},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0", Capabilities: tt.declared}
+			srv := server.NewServer("", "", card, tt.tm, tt.opts...)
+			if diff := gocmp.Diff(tt.want, srv.InferCapabilities()); diff != "" {
+				t.Errorf("InferCapabilities() mismatch (-want +got):\n%s", diff)
+			}
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, server.AgantPath, nil)
+			rec := httptest.NewRecorder()
+			srv.ServeHTTP(rec, req)
+			var served a2a.AgentCard
+			if err := sonic.ConfigFastest.Unmarshal(rec.Body.Bytes(), &served); err != nil {
+				t.Fatalf("decode agent card: %v", err)
+			}
+			if diff := gocmp.Diff(tt.wantCard, served.Capabilities); diff != "" {
+				t.Errorf("served capabilities mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServer_DryRun(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method    string
+		params    string
+		wantState a2a.TaskState
+		wantCode  int
+	}{
+		"valid": {
+			method:    a2a.MethodTasksSend,
+			params:    `{"id":"task-1","sessionId":"b9c6b1a4-5f0e-4c6b-9a57-5b4f4f3e2d1c","message":{"role":"user","parts":[{"type":"text","text":"hi"}]},"dryRun":true}`,
+			wantState: a2a.TaskStateSubmitted,
+		},
+		"invalid message": {
+			method:   a2a.MethodTasksSend,
+			params:   `{"id":"task-1","message":{"role":"robot","parts":[{"type":"text","text":"hi"}]},"dryRun":true}`,
+			wantCode: a2a.InvalidParamsErrorCode,
+		},
+		"unsupported output mode": {
+			method:   a2a.MethodTasksSend,
+			params:   `{"id":"task-1","message":{"role":"user","parts":[{"type":"text","text":"hi"}]},"acceptedOutputModes":["image/png"],"dryRun":true}`,
+			wantCode: a2a.ContentTypeNotSupportedErrorCode,
+		},
+		"streaming": {
+			method:   a2a.MethodTasksSendSubscribe,
+			params:   `{"id":"task-1","message":{"role":"user","parts":[{"type":"text","text":"hi"}]},"dryRun":true}`,
+			wantCode: a2a.InvalidParamsErrorCode,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tm := &slowTaskManager{
+				InMemoryTaskManager: server.NewInMemoryTaskManager(),
+				work: func(context.Context, *server.InMemoryTaskManager, string) error {
+					t.Error("handler invoked in dry-run mode")
+					return nil
+				},
+			}
+			card := &a2a.AgentCard{
+				Name: "test", URL: "http://example.com", Version: "1.0.0",
+				DefaultOutputModes: []string{"text/plain"},
+			}
+			srv := httptest.NewServer(server.NewServer("", "", card, tm))
+			t.Cleanup(srv.Close)
+
+			body := fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":%q,"params":%s}`, tt.method, tt.params)
+			resp, err := http.Post(srv.URL, "application/json", strings.NewReader(body))
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+			defer resp.Body.Close()
+			var rpcResp a2a.SendTaskResponse
+			if err := sonic.ConfigFastest.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+
+			if tt.wantCode != 0 {
+				if rpcResp.Error == nil || rpcResp.Error.Code != tt.wantCode {
+					t.Fatalf("response error = %v, want code %d", rpcResp.Error, tt.wantCode)
+				}
+				return
+			}
+			if rpcResp.Error != nil {
+				t.Fatalf("response error = %v", rpcResp.Error)
+			}
+			if got := rpcResp.Result; got.ID != "task-1" || got.SessionID == "" || got.Status.State != tt.wantState {
+				t.Errorf("result = %+v, want task-1 in state %s with its session", got, tt.wantState)
+			}
+			if _, err := tm.OnGetTask(t.Context(), &a2a.GetTaskRequest{Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}}}); err == nil {
+				t.Error("dry run stored the task")
+			}
+		})
+	}
+}
+
+func TestRequestIDFromContext(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := server.NewServer("", "", card, nil)
+	srv.Handle("agent/id", server.HandlerFunc(func(ctx context.Context, params any) (any, error) {
+		id, ok := server.RequestIDFromContext(ctx)
+		if !ok {
+			return nil, a2a.ErrUnsupportedOperation
+		}
+		return []a2a.ID{id}, nil
+	}))
+	srv.Handle("agent/panic", server.HandlerFunc(func(ctx context.Context, params any) (any, error) {
+		panic("boom")
+	}))
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+
+	tests := map[string]struct {
+		method string
+		id     string
+	}{
+		"string":            {method: "agent/id", id: `"req-1"`},
+		"number":            {method: "agent/id", id: `7`},
+		"large number":      {method: "agent/id", id: `123456789012345678901234567890`},
+		"null":              {method: "agent/id", id: `null`},
+		"panic":             {method: "agent/panic", id: `"req-2"`},
+		"panic with number": {method: "agent/panic", id: `8`},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			body := fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"method":%q}`, tt.id, tt.method)
+			resp, err := http.Post(ts.URL, "application/json", strings.NewReader(body))
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			var rpcResp struct {
+				ID     json.RawMessage   `json:"id"`
+				Result json.RawMessage   `json:"result"`
+				Error  *a2a.JSONRPCError `json:"error"`
+			}
+			if err := sonic.ConfigFastest.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if got := string(rpcResp.ID); got != tt.id {
+				t.Errorf("response id = %s, want %s", got, tt.id)
+			}
+			if tt.method == "agent/panic" {
+				if rpcResp.Error == nil || rpcResp.Error.Code != a2a.InternalErrorCode {
+					t.Errorf("error = %v, want code %d", rpcResp.Error, a2a.InternalErrorCode)
+				}
+				return
+			}
+			if rpcResp.Error != nil {
+				t.Fatalf("error = %v", rpcResp.Error)
+			}
+			if got, want := string(rpcResp.Result), "["+tt.id+"]"; got != want {
+				t.Errorf("handler saw id %s, want %s", got, want)
+			}
+		})
+	}
+
+	t.Run("stream", func(t *testing.T) {
+		t.Parallel()
+
+		ids := make(chan a2a.ID, 1)
+		srv := newStreamingServer(t, func(ctx context.Context, w server.StreamWriter) error {
+			id, _ := server.RequestIDFromContext(ctx)
+			ids <- id
+			return w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateCompleted})
+		})
+		resp := postSendSubscribe(t.Context(), t, srv.URL)
+		resp.Body.Close()
+
+		if got := <-ids; !got.Equal(a2a.NewID[int64](42)) {
+			t.Errorf("stream handler saw id %v, want 42", got)
+		}
+	})
+}
@@ -0,0 +1,76 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// HealthPath is the path of the liveness probe, answering 200 OK while the server runs.
+	HealthPath = "/healthz"
+
+	// ReadyPath is the path of the readiness probe, answering 200 OK while the readiness checks
+	// of the server pass, and 503 Service Unavailable otherwise and once [Server.Shutdown] was
+	// called.
+	ReadyPath = "/readyz"
+)
+
+// ReadinessCheck reports an error while a dependency of the [Server], such as its task store,
+// is not able to serve requests, see [WithReadinessCheck].
+type ReadinessCheck func(ctx context.Context) error
+
+// errShuttingDown is the readiness error of a server shutting down.
+var errShuttingDown = errors.New("server shutting down")
+
+// withProbes returns the handler serving the probes at [HealthPath] and [ReadyPath], and the other
+// requests with next.
+//
+// The probes are served ahead of the middlewares, the tracing and the authentication of the
+// other requests, so that orchestrators need no credentials and probes leave no noise.
+func (s *Server) withProbes(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+		switch r.URL.Path {
+		case HealthPath:
+			writeProbe(w, nil)
+		case ReadyPath:
+			writeProbe(w, s.ready(r.Context()))
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+// ready returns why the server cannot serve requests, or nil if it can.
+func (s *Server) ready(ctx context.Context) error {
+	if s.inFlight.shuttingDown() {
+		return errShuttingDown
+	}
+	for _, check := range s.readinessChecks {
+		if err := check(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeProbe answers a probe with 200 OK, or 503 Service Unavailable reporting err.
+func writeProbe(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	if err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprintf(w, "not ready: %v\n", err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintln(w, "ok")
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestServer_Probes(t *testing.T) {
+	t.Parallel()
+
+	// denyAll stands for an authenticating middleware, which the probes must not go through.
+	denyAll := func(http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+		})
+	}
+	storeDown := func(context.Context) error { return errors.New("task store unreachable") }
+	storeUp := func(context.Context) error { return nil }
+
+	tests := map[string]struct {
+		opts       []server.Option
+		shutdown   bool
+		path       string
+		wantStatus int
+	}{
+		"healthy": {
+			path:       server.HealthPath,
+			wantStatus: http.StatusOK,
+		},
+		"ready": {
+			opts:       []server.Option{server.WithReadinessCheck(storeUp)},
+			path:       server.ReadyPath,
+			wantStatus: http.StatusOK,
+		},
+		"failing check": {
+			opts:       []server.Option{server.WithReadinessCheck(storeUp), server.WithReadinessCheck(storeDown)},
+			path:       server.ReadyPath,
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		"shutting down": {
+			shutdown:   true,
+			path:       server.ReadyPath,
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		"alive while shutting down": {
+			shutdown:   true,
+			path:       server.HealthPath,
+			wantStatus: http.StatusOK,
+		},
+		"no authentication": {
+			opts:       []server.Option{server.WithHandlers(denyAll)},
+			path:       server.ReadyPath,
+			wantStatus: http.StatusOK,
+		},
+		"other paths authenticated": {
+			opts:       []server.Option{server.WithHandlers(denyAll)},
+			path:       server.AgantPath,
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			s := server.NewServer("", "", card, server.NewInMemoryTaskManager(), tt.opts...)
+			srv := httptest.NewServer(s)
+			t.Cleanup(srv.Close)
+			if tt.shutdown {
+				if err := s.Shutdown(t.Context()); err != nil {
+					t.Fatalf("Shutdown() error = %v", err)
+				}
+			}
+
+			resp, err := http.Get(srv.URL + tt.path)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+}
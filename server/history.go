@@ -0,0 +1,150 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"slices"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/go-a2a/a2a"
+)
+
+const (
+	// DefaultHistoryPageSize is the number of messages in a history page when the request sets no limit.
+	DefaultHistoryPageSize = 100
+
+	// MaxHistoryPageSize caps the number of messages in a history page.
+	MaxHistoryPageSize = 1000
+)
+
+// ErrInvalidCursor is returned for a history or task list cursor that was not issued by the server.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// OnGetTaskHistory implements [HistoryReader].
+//
+// Cursors encode a position in the history. As history is only ever appended to,
+// a cursor stays valid while the task grows, and a client can resume from the last
+// cursor it saw to read only the newer messages.
+func (tm *InMemoryTaskManager) OnGetTaskHistory(ctx context.Context, req *a2a.GetTaskHistoryRequest) (*a2a.GetTaskHistoryResponse, error) {
+	ctx, span := tm.tracer.Start(ctx, "task_manager.OnGetTaskHistory",
+		trace.WithAttributes(attribute.String("a2a.task_id", req.Params.ID)))
+	defer span.End()
+
+	taskID := req.Params.ID
+	if taskID == "" {
+		return nil, errors.New("task ID cannot be empty")
+	}
+
+	offset, err := decodeHistoryCursor(req.Params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := tm.timedStore().Get(ctx, taskID)
+	if err != nil {
+		tm.logger.InfoContext(ctx, "task not found", slog.String("task_id", taskID))
+		return nil, err
+	}
+
+	limit := req.Params.Limit
+	if limit <= 0 {
+		limit = DefaultHistoryPageSize
+	}
+	limit = min(limit, MaxHistoryPageSize)
+
+	start := min(offset, len(task.History))
+	end := min(start+limit, len(task.History))
+	page := &a2a.TaskHistoryPage{
+		Messages: task.History[start:end],
+	}
+	if end < len(task.History) {
+		page.NextCursor = encodeHistoryCursor(end)
+	}
+
+	tm.logger.InfoContext(ctx, "task history retrieved",
+		slog.String("task_id", taskID),
+		slog.Int("offset", start),
+		slog.Int("messages", len(page.Messages)),
+	)
+
+	return &a2a.GetTaskHistoryResponse{
+		JSONRPCResponse: a2a.JSONRPCResponse{
+			JSONRPCMessage: a2a.NewJSONRPCMessage(req.ID),
+		},
+		Result: page,
+	}, nil
+}
+
+// encodeHistoryCursor returns the opaque cursor for the history position offset.
+func encodeHistoryCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+// decodeHistoryCursor returns the history position of cursor; the empty cursor is the start of the history.
+func decodeHistoryCursor(cursor string) (int, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidCursor, cursor)
+	}
+	offset, err := strconv.Atoi(string(data))
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidCursor, cursor)
+	}
+	return offset, nil
+}
+
+// HistoryForSession returns the conversation of a session: the history and final status message
+// of every task in store with the given session ID, oldest task first.
+//
+// Tasks of a session often repeat earlier turns, so a message with the same role and parts as one
+// already taken from another task is dropped. Repeats within a single task's history are kept, as
+// are the final status message unless it is already the task's last history entry.
+func HistoryForSession(ctx context.Context, store TaskStore, sessionID string) ([]a2a.Message, error) {
+	if sessionID == "" {
+		return nil, errors.New("session ID cannot be empty")
+	}
+	tasks, err := store.List(ctx, TaskFilter{SessionID: sessionID})
+	if err != nil {
+		return nil, fmt.Errorf("list session tasks: %w", err)
+	}
+	slices.SortStableFunc(tasks, func(a, b *a2a.Task) int { return a.CreatedAt.Compare(b.CreatedAt) })
+
+	var history []a2a.Message
+	for _, task := range tasks {
+		// Messages taken from the earlier tasks, which this task's messages are checked against.
+		earlier := len(history)
+		seenEarlier := func(msg a2a.Message) bool {
+			return slices.ContainsFunc(history[:earlier], func(m a2a.Message) bool { return sameMessage(m, msg) })
+		}
+
+		for _, msg := range task.History {
+			if !seenEarlier(msg) {
+				history = append(history, msg)
+			}
+		}
+		if msg := task.Status.Message; msg != nil && !seenEarlier(*msg) &&
+			(len(task.History) == 0 || !sameMessage(task.History[len(task.History)-1], *msg)) {
+			history = append(history, *msg)
+		}
+	}
+
+	return history, nil
+}
+
+// sameMessage reports whether a and b have the same role and parts.
+func sameMessage(a, b a2a.Message) bool {
+	return a.Role == b.Role && reflect.DeepEqual(a.Parts, b.Parts)
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+// listStore is a [server.TaskStore] whose List returns fixed tasks.
+type listStore struct {
+	server.TaskStore
+
+	tasks []*a2a.Task
+}
+
+func (s listStore) List(context.Context, server.TaskFilter) ([]*a2a.Task, error) {
+	return s.tasks, nil
+}
+
+func TestHistoryForSession(t *testing.T) {
+	t.Parallel()
+
+	text := func(role a2a.Role, s string) a2a.Message {
+		return a2a.Message{Role: role, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: s}}}
+	}
+	hello := text(a2a.RoleAgent, "hello")
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		tasks []*a2a.Task
+		want  []a2a.Message
+	}{
+		"ordered by creation": {
+			tasks: []*a2a.Task{
+				{ID: "a", CreatedAt: start.Add(time.Minute), History: []a2a.Message{text(a2a.RoleUser, "second")}},
+				{ID: "b", CreatedAt: start, History: []a2a.Message{text(a2a.RoleUser, "first")}},
+			},
+			want: []a2a.Message{text(a2a.RoleUser, "first"), text(a2a.RoleUser, "second")},
+		},
+		"final message": {
+			tasks: []*a2a.Task{{
+				ID:      "a",
+				History: []a2a.Message{text(a2a.RoleUser, "hi")},
+				Status:  a2a.TaskStatus{State: a2a.TaskStateCompleted, Message: &hello},
+			}},
+			want: []a2a.Message{text(a2a.RoleUser, "hi"), text(a2a.RoleAgent, "hello")},
+		},
+		"final message in history": {
+			tasks: []*a2a.Task{{
+				ID:      "a",
+				History: []a2a.Message{text(a2a.RoleUser, "hi"), text(a2a.RoleAgent, "hello")},
+				Status:  a2a.TaskStatus{State: a2a.TaskStateCompleted, Message: &hello},
+			}},
+			want: []a2a.Message{text(a2a.RoleUser, "hi"), text(a2a.RoleAgent, "hello")},
+		},
+		"overlapping histories": {
+			tasks: []*a2a.Task{
+				{ID: "a", CreatedAt: start, History: []a2a.Message{text(a2a.RoleUser, "hi"), text(a2a.RoleAgent, "hello")}},
+				{ID: "b", CreatedAt: start.Add(time.Minute), History: []a2a.Message{
+					text(a2a.RoleUser, "hi"), text(a2a.RoleAgent, "hello"), text(a2a.RoleUser, "bye"),
+				}},
+			},
+			want: []a2a.Message{text(a2a.RoleUser, "hi"), text(a2a.RoleAgent, "hello"), text(a2a.RoleUser, "bye")},
+		},
+		"repeats within a task": {
+			tasks: []*a2a.Task{
+				{ID: "a", History: []a2a.Message{text(a2a.RoleUser, "yes"), text(a2a.RoleAgent, "sure?"), text(a2a.RoleUser, "yes")}},
+			},
+			want: []a2a.Message{text(a2a.RoleUser, "yes"), text(a2a.RoleAgent, "sure?"), text(a2a.RoleUser, "yes")},
+		},
+		"same parts, other role": {
+			tasks: []*a2a.Task{
+				{ID: "a", CreatedAt: start, History: []a2a.Message{text(a2a.RoleUser, "ok")}},
+				{ID: "b", CreatedAt: start.Add(time.Minute), History: []a2a.Message{text(a2a.RoleAgent, "ok")}},
+			},
+			want: []a2a.Message{text(a2a.RoleUser, "ok"), text(a2a.RoleAgent, "ok")},
+		},
+		"no tasks": {},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := server.HistoryForSession(t.Context(), listStore{tasks: tt.tasks}, "session-1")
+			if err != nil {
+				t.Fatalf("HistoryForSession() error = %v", err)
+			}
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("HistoryForSession() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+
+	"github.com/go-a2a/a2a"
+)
+
+// IDGenerator mints the IDs of the tasks and sessions the [Server] creates, see [WithIDGenerator].
+// Implementations must be safe for concurrent use.
+type IDGenerator interface {
+	// NewTaskID returns the ID of a task sent without one.
+	NewTaskID() string
+
+	// NewSessionID returns the ID of a new session, see [WithAutoSessionID]. Sessions travel as
+	// UUIDs in [a2a.TaskSendParams], so it must return one, such as a ULID in UUID form.
+	NewSessionID() string
+}
+
+// IDValidator reports an error for a task ID sent by a client that the [Server] must reject,
+// see [WithIDValidator].
+type IDValidator func(id string) error
+
+// UUIDGenerator is the [IDGenerator] minting random UUIDs.
+type UUIDGenerator struct {
+	// Rand, if set, is the source of the random bits of the IDs, instead of crypto/rand. A
+	// seeded source makes the IDs deterministic, for tests; it must be safe for concurrent use
+	// if the server is, and generating an ID panics if reading it fails.
+	Rand io.Reader
+}
+
+var _ IDGenerator = UUIDGenerator{}
+
+// DefaultIDGenerator is the [IDGenerator] of a [Server] without [WithIDGenerator].
+var DefaultIDGenerator IDGenerator = UUIDGenerator{}
+
+// NewTaskID implements [IDGenerator].
+func (g UUIDGenerator) NewTaskID() string {
+	return g.newUUID().String()
+}
+
+// NewSessionID implements [IDGenerator].
+func (g UUIDGenerator) NewSessionID() string {
+	return g.newUUID().String()
+}
+
+// newUUID returns a random UUID read from Rand, if set.
+func (g UUIDGenerator) newUUID() uuid.UUID {
+	if g.Rand == nil {
+		return uuid.New()
+	}
+	return uuid.Must(uuid.NewRandomFromReader(g.Rand))
+}
+
+// assignIDs assigns a new task ID to params sent without one and, with [WithAutoSessionID], a
+// new session ID to params without a session.
+func (s *Server) assignIDs(params *a2a.TaskSendParams) *a2a.JSONRPCError {
+	if params.ID == "" {
+		params.ID = s.idGenerator.NewTaskID()
+	}
+	if s.autoSessionID && params.SessionID == uuid.Nil {
+		sessionID, err := uuid.Parse(s.idGenerator.NewSessionID())
+		if err != nil {
+			return a2a.ToJSONRPCError(fmt.Errorf("generate session ID: %w", err))
+		}
+		params.SessionID = sessionID
+	}
+	return nil
+}
+
+// taskParams is implemented by the params of the methods about a task, which embed
+// [a2a.TaskIDParams].
+type taskParams interface {
+	IDParams() a2a.TaskIDParams
+}
+
+// checkTaskID rejects the task ID that params, decoded for a method, carries if the ID validator
+// of the server refuses it. Empty IDs are left to the methods.
+func (s *Server) checkTaskID(params any) *a2a.JSONRPCError {
+	if s.idValidator == nil {
+		return nil
+	}
+
+	var id string
+	switch params := params.(type) {
+	case taskParams:
+		id = params.IDParams().ID
+	case a2a.TaskPushNotificationConfig:
+		id = params.ID
+	}
+	if id == "" {
+		return nil
+	}
+	if err := s.idValidator(id); err != nil {
+		return invalidParams(fmt.Errorf("task ID %q: %w", id, err))
+	}
+	return nil
+}
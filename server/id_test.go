@@ -0,0 +1,160 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+// lockedReader makes a random source safe for concurrent use.
+type lockedReader struct {
+	mu  sync.Mutex
+	rng *rand.ChaCha8
+}
+
+func (r *lockedReader) Read(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.rng.Read(p)
+}
+
+func TestUUIDGenerator(t *testing.T) {
+	t.Parallel()
+
+	seeded := func() server.UUIDGenerator {
+		return server.UUIDGenerator{Rand: &lockedReader{rng: rand.NewChaCha8([32]byte{1})}}
+	}
+	g1, g2 := seeded(), seeded()
+	for range 3 {
+		if id1, id2 := g1.NewTaskID(), g2.NewTaskID(); id1 != id2 {
+			t.Errorf("NewTaskID() = %q and %q with the same seed, want equal", id1, id2)
+		}
+	}
+	if id1, id2 := server.DefaultIDGenerator.NewSessionID(), server.DefaultIDGenerator.NewSessionID(); id1 == id2 {
+		t.Errorf("NewSessionID() = %q twice, want distinct IDs", id1)
+	}
+}
+
+// prefixGenerator mints sequential prefixed task IDs and seeded session UUIDs.
+type prefixGenerator struct {
+	server.UUIDGenerator
+
+	n atomic.Int64
+}
+
+func (g *prefixGenerator) NewTaskID() string {
+	return fmt.Sprintf("task-%03d", g.n.Add(1))
+}
+
+func TestWithIDGenerator(t *testing.T) {
+	t.Parallel()
+
+	gen := &prefixGenerator{UUIDGenerator: server.UUIDGenerator{Rand: &lockedReader{rng: rand.NewChaCha8([32]byte{2})}}}
+	want := server.UUIDGenerator{Rand: &lockedReader{rng: rand.NewChaCha8([32]byte{2})}}.NewSessionID()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager(),
+		server.WithIDGenerator(gen),
+		server.WithAutoSessionID(),
+	))
+	t.Cleanup(srv.Close)
+
+	body := `{"jsonrpc":"2.0","id":1,"method":"tasks/send","params":{"message":{"role":"user","parts":[{"type":"text","text":"hi"}]}}}`
+	resp, err := http.Post(srv.URL, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	defer resp.Body.Close()
+	var rpcResp a2a.SendTaskResponse
+	if err := sonic.ConfigFastest.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if rpcResp.Error != nil {
+		t.Fatalf("error = %v", rpcResp.Error)
+	}
+	if rpcResp.Result.ID != "task-001" || rpcResp.Result.SessionID != want {
+		t.Errorf("task = %q in session %q, want task-001 in session %q", rpcResp.Result.ID, rpcResp.Result.SessionID, want)
+	}
+}
+
+func TestWithIDValidator(t *testing.T) {
+	t.Parallel()
+
+	validator := func(id string) error {
+		if !strings.HasPrefix(id, "task-") {
+			return errors.New("want the task- prefix")
+		}
+		return nil
+	}
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager(), server.WithIDValidator(validator)))
+	t.Cleanup(srv.Close)
+
+	tests := map[string]struct {
+		body     string
+		wantCode int
+	}{
+		"valid": {
+			body: `{"jsonrpc":"2.0","id":1,"method":"tasks/send","params":{"id":"task-1","message":{"role":"user","parts":[{"type":"text","text":"hi"}]}}}`,
+		},
+		"minted": {
+			body: `{"jsonrpc":"2.0","id":1,"method":"tasks/send","params":{"message":{"role":"user","parts":[{"type":"text","text":"hi"}]}}}`,
+		},
+		"invalid send": {
+			body:     `{"jsonrpc":"2.0","id":1,"method":"tasks/send","params":{"id":"1;DROP","message":{"role":"user","parts":[{"type":"text","text":"hi"}]}}}`,
+			wantCode: a2a.InvalidParamsErrorCode,
+		},
+		"invalid get": {
+			body:     `{"jsonrpc":"2.0","id":1,"method":"tasks/get","params":{"id":"../etc"}}`,
+			wantCode: a2a.InvalidParamsErrorCode,
+		},
+		"invalid cancel": {
+			body:     `{"jsonrpc":"2.0","id":1,"method":"tasks/cancel","params":{"id":"<script>"}}`,
+			wantCode: a2a.InvalidParamsErrorCode,
+		},
+		"invalid artifacts get": {
+			body:     `{"jsonrpc":"2.0","id":1,"method":"tasks/artifacts/get","params":{"id":"../etc"}}`,
+			wantCode: a2a.InvalidParamsErrorCode,
+		},
+		"invalid push notification set": {
+			body:     `{"jsonrpc":"2.0","id":1,"method":"tasks/pushNotification/set","params":{"id":"../etc","pushNotificationConfig":{"url":"https://example.com/hook"}}}`,
+			wantCode: a2a.InvalidParamsErrorCode,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := http.Post(srv.URL, "application/json", strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+			defer resp.Body.Close()
+			var rpcResp a2a.JSONRPCResponse
+			if err := sonic.ConfigFastest.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			var gotCode int
+			if rpcResp.Error != nil {
+				gotCode = rpcResp.Error.Code
+			}
+			if gotCode != tt.wantCode {
+				t.Errorf("error = %v, want code %d", rpcResp.Error, tt.wantCode)
+			}
+		})
+	}
+}
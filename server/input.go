@@ -0,0 +1,61 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+
+	"github.com/go-a2a/a2a"
+)
+
+// inputBuffer is the number of chunks of input held for a handler that has not received them yet.
+const inputBuffer = 16
+
+// inputKey is the context key of the [runningHandler] of a task handler.
+type inputKey struct{}
+
+// InputFrom returns the channel carrying the chunks of input streamed into the task of the
+// handler running with ctx, as clients append them with tasks/input/append, and reports whether
+// ctx is the context of a task handler. The channel is never closed: a chunk marked final ends
+// the current turn, and the handler stops reading once it is done.
+//
+// Only handlers that called InputFrom get input, from the chunks appended after the call. A
+// chunk is delivered once the task manager recorded it, see [InputAppender], without waiting for
+// the handler: the channel holds a few chunks, and a chunk appended while it is full is only
+// recorded in the task history, where the handler can still read it.
+//
+//	input, _ := server.InputFrom(ctx)
+//	for {
+//		select {
+//		case chunk := <-input:
+//			// Work on chunk.Message, replying through the stream writer.
+//		case <-ctx.Done():
+//			return ctx.Err()
+//		}
+//	}
+func InputFrom(ctx context.Context) (<-chan a2a.TaskInputParams, bool) {
+	h, ok := ctx.Value(inputKey{}).(*runningHandler)
+	if !ok {
+		return nil, false
+	}
+	h.consuming.Store(true)
+	return h.input, true
+}
+
+// deliver hands chunk to the handlers running for taskID that read their input, see [InputFrom],
+// without blocking. It returns how many received it and how many had no room left for it.
+func (rt *runningTasks) deliver(taskID string, chunk a2a.TaskInputParams) (delivered, dropped int) {
+	for _, h := range rt.running(taskID) {
+		if !h.consuming.Load() {
+			continue
+		}
+		select {
+		case h.input <- chunk:
+			delivered++
+		default:
+			dropped++
+		}
+	}
+	return delivered, dropped
+}
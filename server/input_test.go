@@ -0,0 +1,203 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+// listeningTaskManager streams a reply to each chunk of input appended to its tasks while it
+// works on them, and completes them with the final chunk.
+type listeningTaskManager struct {
+	*server.InMemoryTaskManager
+}
+
+func (tm *listeningTaskManager) OnSendTaskStream(ctx context.Context, req *a2a.SendTaskStreamingRequest, w server.StreamWriter) error {
+	input, ok := server.InputFrom(ctx)
+	if !ok {
+		return errors.New("no input channel")
+	}
+	reply := func(state a2a.TaskState, text string) error {
+		return w.SendStatus(a2a.TaskStatus{
+			State:   state,
+			Message: &a2a.Message{Role: a2a.RoleAgent, Parts: []a2a.Part{a2a.NewTextPart(text)}},
+		})
+	}
+	if err := reply(a2a.TaskStateWorking, "listening to "+req.Params.Message.Text()); err != nil {
+		return err
+	}
+	for {
+		select {
+		case chunk := <-input:
+			state := a2a.TaskStateWorking
+			if chunk.Final {
+				state = a2a.TaskStateCompleted
+			}
+			if err := reply(state, "heard "+chunk.Message.Text()); err != nil || chunk.Final {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func TestInputFrom(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	tm := &listeningTaskManager{InMemoryTaskManager: server.NewInMemoryTaskManager()}
+	srv := httptest.NewServer(server.NewServer("", "", card, tm))
+	t.Cleanup(srv.Close)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+	resp := postSendSubscribe(ctx, t, srv.URL)
+	defer resp.Body.Close()
+
+	events := bufio.NewScanner(resp.Body)
+	nextEvent := func() string {
+		t.Helper()
+		for events.Scan() {
+			if data, ok := strings.CutPrefix(events.Text(), "data: "); ok {
+				return data
+			}
+		}
+		t.Fatalf("stream ended: %v", events.Err())
+		return ""
+	}
+	appendInput := func(text string, final bool) {
+		t.Helper()
+		params := `{"id":"task-1","message":{"role":"user","parts":[{"type":"text","text":"` + text + `"}]}`
+		if final {
+			params += `,"final":true`
+		}
+		if got := postRPC(t, srv.URL, a2a.MethodTasksInputAppend, params+`}`); got.Error != nil {
+			t.Fatalf("tasks/input/append error = %v", got.Error)
+		}
+	}
+
+	if got := nextEvent(); !strings.Contains(got, "listening to hi") {
+		t.Fatalf("first event = %s, want the agent listening", got)
+	}
+	appendInput("one", false)
+	if got := nextEvent(); !strings.Contains(got, "heard one") || strings.Contains(got, `"final":true`) {
+		t.Errorf("event = %s, want the agent replying to the first chunk", got)
+	}
+	appendInput("two", true)
+	if got := nextEvent(); !strings.Contains(got, "heard two") || !strings.Contains(got, `"final":true`) {
+		t.Errorf("event = %s, want the agent completing the task with the final chunk", got)
+	}
+
+	// Without a handler running, the input is only recorded.
+	appendInput("late", false)
+	task, err := tm.OnGetTask(ctx, &a2a.GetTaskRequest{Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}}})
+	if err != nil {
+		t.Fatalf("OnGetTask() error = %v", err)
+	}
+	if got := task.Result.History[len(task.Result.History)-1].Text(); got != "late" {
+		t.Errorf("last history message = %q, want the input appended without a handler", got)
+	}
+}
+
+// busyTaskManager works on its tasks until they are canceled, without reading their input.
+type busyTaskManager struct {
+	*server.InMemoryTaskManager
+}
+
+func (tm *busyTaskManager) OnSendTaskStream(ctx context.Context, req *a2a.SendTaskStreamingRequest, w server.StreamWriter) error {
+	if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking}); err != nil {
+		return err
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// missingTaskManager knows no task to append input to.
+type missingTaskManager struct {
+	*server.InMemoryTaskManager
+}
+
+func (tm *missingTaskManager) OnAppendTaskInput(ctx context.Context, req *a2a.AppendTaskInputRequest) (*a2a.AppendTaskInputResponse, error) {
+	return nil, fmt.Errorf("find task %s: %w", req.Params.ID, a2a.ErrTaskNotFound)
+}
+
+func TestServer_AppendTaskInput(t *testing.T) {
+	t.Parallel()
+
+	const chunk = `{"id":"task-1","message":{"role":"user","parts":[{"type":"text","text":"more"}]}}`
+	tests := map[string]struct {
+		tm       server.TaskManager
+		stream   bool
+		wantCode int
+	}{
+		"handler not reading input": {
+			tm:     &busyTaskManager{InMemoryTaskManager: server.NewInMemoryTaskManager()},
+			stream: true,
+		},
+		"task not found": {
+			tm:       &missingTaskManager{InMemoryTaskManager: server.NewInMemoryTaskManager()},
+			wantCode: a2a.TaskNotFoundErrorCode,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			srv := httptest.NewServer(server.NewServer("", "", card, tt.tm))
+			t.Cleanup(srv.Close)
+
+			if tt.stream {
+				ctx, cancel := context.WithCancel(t.Context())
+				resp := postSendSubscribe(ctx, t, srv.URL)
+				// Wait for the handler to be running before appending.
+				if _, err := bufio.NewReader(resp.Body).ReadString('\n'); err != nil {
+					t.Fatalf("read stream: %v", err)
+				}
+				t.Cleanup(func() {
+					cancel()
+					resp.Body.Close()
+				})
+			}
+
+			// The append returns without waiting for the handler to read the chunk.
+			hc := &http.Client{Timeout: 5 * time.Second}
+			resp, err := hc.Post(srv.URL, "application/json",
+				strings.NewReader(`{"jsonrpc":"2.0","id":7,"method":"tasks/input/append","params":`+chunk+`}`))
+			if err != nil {
+				t.Fatalf("tasks/input/append: %v", err)
+			}
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("read response: %v", err)
+			}
+			var got a2a.JSONRPCResponse
+			if err := a2a.DefaultCodec.Unmarshal(body, &got); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+
+			var gotCode int
+			if got.Error != nil {
+				gotCode = got.Error.Code
+			}
+			if gotCode != tt.wantCode {
+				t.Errorf("tasks/input/append error = %v, want code %d", got.Error, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/go-a2a/a2a"
+)
+
+const (
+	// DefaultJournalEntries is the number of entries an [InMemoryEventJournal] keeps per task
+	// by default.
+	DefaultJournalEntries = 256
+
+	// DefaultJournalTasks is the number of tasks an [InMemoryEventJournal] keeps the journal of
+	// by default.
+	DefaultJournalTasks = 1024
+)
+
+// EventJournal records an ordered journal of what happened to each task: the messages
+// received, the statuses it moved to, the artifacts it emitted and the errors its calls
+// failed with, see [WithEventJournal]. The journal of a task is read with the
+// tasks/journal/get method.
+//
+// Implementations must be safe for concurrent use.
+type EventJournal interface {
+	// Append records entry in the journal of taskID. It is called while serving the calls
+	// of the task, so it must not block.
+	Append(taskID string, entry a2a.JournalEntry)
+
+	// Entries returns the journal of taskID, oldest entry first. A task with no journal has
+	// no entries.
+	Entries(ctx context.Context, taskID string) ([]a2a.JournalEntry, error)
+}
+
+// InMemoryEventJournal is an in-memory implementation of [EventJournal].
+//
+// It keeps the most recent entries of each task in a ring buffer, dropping the oldest entry
+// once it is full, and the journals of the most recently started tasks, dropping the journal
+// of the oldest task once there are too many.
+type InMemoryEventJournal struct {
+	// size is the number of entries kept per task, and maxTasks the number of journals kept.
+	size     int
+	maxTasks int
+
+	mu    sync.Mutex
+	tasks map[string]*journalRing
+	// order lists the tasks with a journal, oldest first.
+	order []string
+}
+
+var _ EventJournal = (*InMemoryEventJournal)(nil)
+
+// NewInMemoryEventJournal creates a new [InMemoryEventJournal] keeping the last maxEntries
+// entries of each of the last maxTasks tasks. Non-positive limits default to
+// [DefaultJournalEntries] and [DefaultJournalTasks].
+func NewInMemoryEventJournal(maxEntries, maxTasks int) *InMemoryEventJournal {
+	if maxEntries <= 0 {
+		maxEntries = DefaultJournalEntries
+	}
+	if maxTasks <= 0 {
+		maxTasks = DefaultJournalTasks
+	}
+	return &InMemoryEventJournal{
+		size:     maxEntries,
+		maxTasks: maxTasks,
+		tasks:    make(map[string]*journalRing),
+	}
+}
+
+// Append implements [EventJournal].
+func (j *InMemoryEventJournal) Append(taskID string, entry a2a.JournalEntry) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	ring, ok := j.tasks[taskID]
+	if !ok {
+		if len(j.order) == j.maxTasks {
+			delete(j.tasks, j.order[0])
+			j.order = j.order[1:]
+		}
+		ring = &journalRing{}
+		j.tasks[taskID] = ring
+		j.order = append(j.order, taskID)
+	}
+	ring.append(entry, j.size)
+}
+
+// Entries implements [EventJournal].
+func (j *InMemoryEventJournal) Entries(ctx context.Context, taskID string) ([]a2a.JournalEntry, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	ring, ok := j.tasks[taskID]
+	if !ok {
+		return nil, nil
+	}
+	return ring.ordered(), nil
+}
+
+// journalRing is a ring buffer of the entries of one task.
+type journalRing struct {
+	entries []a2a.JournalEntry
+	// head is the index of the oldest entry once the ring is full.
+	head int
+}
+
+// append records entry, overwriting the oldest entry if size entries are held.
+func (r *journalRing) append(entry a2a.JournalEntry, size int) {
+	if len(r.entries) < size {
+		r.entries = append(r.entries, entry)
+		return
+	}
+	r.entries[r.head] = entry
+	r.head = (r.head + 1) % size
+}
+
+// ordered returns a copy of the entries, oldest first.
+func (r *journalRing) ordered() []a2a.JournalEntry {
+	return slices.Concat(r.entries[r.head:], r.entries[:r.head])
+}
+
+// appendJournal records entry in the journal of taskID, stamped with the current time and the
+// method of the call being served. It does nothing with a nil journal.
+func appendJournal(ctx context.Context, journal EventJournal, taskID string, entry a2a.JournalEntry) {
+	if journal == nil || taskID == "" {
+		return
+	}
+	entry.Time = time.Now().UTC()
+	entry.Method, _ = MethodFrom(ctx)
+	journal.Append(taskID, entry)
+}
+
+// journalMessage records msg as received for taskID.
+func (s *Server) journalMessage(ctx context.Context, taskID string, msg a2a.Message) {
+	appendJournal(ctx, s.journal, taskID, a2a.JournalEntry{Kind: a2a.JournalMessage, Message: &msg})
+}
+
+// journalStatus records status as the status taskID moved to.
+func (s *Server) journalStatus(ctx context.Context, taskID string, status a2a.TaskStatus) {
+	appendJournal(ctx, s.journal, taskID, a2a.JournalEntry{Kind: a2a.JournalStatus, Status: &status})
+}
+
+// journalError records jerr as the error a call on taskID failed with.
+func (s *Server) journalError(ctx context.Context, taskID string, jerr *a2a.JSONRPCError) {
+	appendJournal(ctx, s.journal, taskID, a2a.JournalEntry{Kind: a2a.JournalError, Error: jerr})
+}
+
+// journalResult records the status task moved to, and the artifacts it emitted, answering a
+// call on it. Artifacts are compared with those of the task before the call, carried by ctx.
+func (s *Server) journalResult(ctx context.Context, task *a2a.Task) {
+	if s.journal == nil || task == nil {
+		return
+	}
+	var known int
+	if before, ok := a2a.TaskFromContext(ctx); ok {
+		known = len(before.Artifacts)
+	}
+	for _, artifact := range task.Artifacts[min(known, len(task.Artifacts)):] {
+		appendJournal(ctx, s.journal, task.ID, a2a.JournalEntry{Kind: a2a.JournalArtifact, Artifact: &artifact})
+	}
+	s.journalStatus(ctx, task.ID, task.Status)
+}
+
+// journalEvent returns the journal entry recording event, and whether it is journaled.
+func journalEvent(event a2a.TaskEvent) (a2a.JournalEntry, bool) {
+	switch event := event.(type) {
+	case *a2a.TaskStatusUpdateEvent:
+		status := event.Status
+		return a2a.JournalEntry{Kind: a2a.JournalStatus, Status: &status}, true
+	case *a2a.TaskArtifactUpdateEvent:
+		artifact := event.Artifact
+		return a2a.JournalEntry{Kind: a2a.JournalArtifact, Artifact: &artifact}, true
+	default:
+		return a2a.JournalEntry{}, false
+	}
+}
+
+// handleGetTaskJournal handles the tasks/journal/get method.
+func (s *Server) handleGetTaskJournal(w http.ResponseWriter, r *http.Request, rpcReq *a2a.JSONRPCRequest, params a2a.TaskIDParams) {
+	ctx, span := s.tracer.Start(r.Context(), "server.handleGetTaskJournal")
+	defer span.End()
+
+	r = r.WithContext(ctx)
+
+	if s.journal == nil {
+		s.writeError(w, r, a2a.UnsupportedOperationErrorCode, "task journal is not enabled")
+		return
+	}
+
+	req := a2a.GetTaskJournalRequest{JSONRPCRequest: *rpcReq, Params: params}
+
+	span.SetAttributes(attribute.String("a2a.task_id", req.Params.ID))
+
+	entries, err := s.journal.Entries(ctx, req.Params.ID)
+	if err != nil {
+		s.writeJSONRPCError(w, r, taskError(err, "get task journal"))
+		return
+	}
+	// Tasks that were journaled are known even if their task manager forgot them.
+	if len(entries) == 0 && s.loadTask(ctx, req.Params.ID) == nil {
+		s.writeJSONRPCError(w, r, a2a.NewTaskNotFoundError())
+		return
+	}
+
+	if entries == nil {
+		entries = []a2a.JournalEntry{}
+	}
+
+	span.SetAttributes(attribute.Int("a2a.entry_count", len(entries)))
+
+	s.writeResponse(w, r, req.ID, &a2a.TaskJournal{ID: req.Params.ID, Entries: entries})
+}
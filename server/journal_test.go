@@ -0,0 +1,122 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/bytedance/sonic"
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestInMemoryEventJournal(t *testing.T) {
+	t.Parallel()
+
+	type appended struct {
+		taskID string
+		method string
+	}
+	tests := map[string]struct {
+		maxEntries int
+		maxTasks   int
+		appends    []appended
+		want       map[string][]string
+	}{
+		"keeps every entry under the limit": {
+			maxEntries: 3,
+			appends:    []appended{{"a", "1"}, {"a", "2"}},
+			want:       map[string][]string{"a": {"1", "2"}},
+		},
+		"drops the oldest entries of a full ring": {
+			maxEntries: 3,
+			appends:    []appended{{"a", "1"}, {"a", "2"}, {"a", "3"}, {"a", "4"}, {"a", "5"}},
+			want:       map[string][]string{"a": {"3", "4", "5"}},
+		},
+		"drops the journal of the oldest task": {
+			maxTasks: 2,
+			appends:  []appended{{"a", "1"}, {"b", "2"}, {"a", "3"}, {"c", "4"}},
+			want:     map[string][]string{"a": nil, "b": {"2"}, "c": {"4"}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			journal := server.NewInMemoryEventJournal(tt.maxEntries, tt.maxTasks)
+			for _, a := range tt.appends {
+				journal.Append(a.taskID, a2a.JournalEntry{Kind: a2a.JournalStatus, Method: a.method})
+			}
+			for taskID, want := range tt.want {
+				entries, err := journal.Entries(t.Context(), taskID)
+				if err != nil {
+					t.Fatalf("Entries(%q) error = %v", taskID, err)
+				}
+				var got []string
+				for _, entry := range entries {
+					got = append(got, entry.Method)
+				}
+				if diff := gocmp.Diff(want, got); diff != "" {
+					t.Errorf("Entries(%q) mismatch (-want +got):\n%s", taskID, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_EventJournal(t *testing.T) {
+	t.Parallel()
+
+	srv := newStreamingServer(t, func(ctx context.Context, w server.StreamWriter) error {
+		if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking}); err != nil {
+			return err
+		}
+		if err := w.SendArtifact(a2a.Artifact{Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "done"}}}); err != nil {
+			return err
+		}
+		return w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateCompleted})
+	}, server.WithEventJournal(server.NewInMemoryEventJournal(0, 0)))
+
+	resp := postSendSubscribe(t.Context(), t, srv.URL)
+	if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+		t.Fatalf("read stream: %v", err)
+	}
+	resp.Body.Close()
+
+	got := postRPC(t, srv.URL, a2a.MethodTasksJournalGet, `{"id":"task-1"}`)
+	if got.Error != nil {
+		t.Fatalf("tasks/journal/get error = %v", got.Error)
+	}
+	data, err := sonic.ConfigDefault.Marshal(got.Result)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var journal a2a.TaskJournal
+	if err := sonic.ConfigDefault.Unmarshal(data, &journal); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	var kinds []a2a.JournalEntryKind
+	for _, entry := range journal.Entries {
+		kinds = append(kinds, entry.Kind)
+		if entry.Method != a2a.MethodTasksSendSubscribe || entry.Time.IsZero() {
+			t.Errorf("entry %s: method = %q, time = %v, want %q and a time", entry.Kind, entry.Method, entry.Time, a2a.MethodTasksSendSubscribe)
+		}
+	}
+	want := []a2a.JournalEntryKind{a2a.JournalMessage, a2a.JournalStatus, a2a.JournalArtifact, a2a.JournalStatus}
+	if diff := gocmp.Diff(want, kinds); diff != "" {
+		t.Errorf("journal kinds mismatch (-want +got):\n%s", diff)
+	}
+	if last := journal.Entries[len(journal.Entries)-1]; last.Status == nil || last.Status.State != a2a.TaskStateCompleted {
+		t.Errorf("last entry status = %v, want %s", last.Status, a2a.TaskStateCompleted)
+	}
+
+	if got := postRPC(t, srv.URL, a2a.MethodTasksJournalGet, `{"id":"unknown"}`); got.Error == nil || got.Error.Code != a2a.TaskNotFoundErrorCode {
+		t.Errorf("unknown task error = %v, want code %d", got.Error, a2a.TaskNotFoundErrorCode)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"cmp"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/go-a2a/a2a"
+)
+
+const (
+	// DefaultTaskPageSize is the number of tasks in a task list page when the request sets no limit.
+	DefaultTaskPageSize = 50
+
+	// MaxTaskPageSize caps the number of tasks in a task list page.
+	MaxTaskPageSize = 500
+)
+
+// OnListTasks implements [TaskLister].
+//
+// Tasks are ordered by creation time, then ID. Cursors encode the position of the last task
+// of a page in that order, so a cursor stays valid while tasks are created or deleted, and
+// pages never repeat or skip a task that exists throughout the listing. Inline file content
+// is left out of the tasks unless the request asks for it.
+func (tm *InMemoryTaskManager) OnListTasks(ctx context.Context, req *a2a.ListTasksRequest) (*a2a.ListTasksResponse, error) {
+	ctx, span := tm.tracer.Start(ctx, "task_manager.OnListTasks",
+		trace.WithAttributes(
+			attribute.String("a2a.session_id", req.Params.SessionID),
+			attribute.String("a2a.state", string(req.Params.State)),
+			attribute.Int("a2a.labels", len(req.Params.Labels)),
+		))
+	defer span.End()
+
+	after, afterID, err := decodeListCursor(req.Params.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	if req.Params.SessionID != "" {
+		if _, err := a2a.NormalizeSessionID(req.Params.SessionID); err != nil {
+			return nil, fmt.Errorf("%w: session ID: %w", a2a.ErrInvalidParams, err)
+		}
+	}
+	if err := a2a.ValidateLabels(req.Params.Labels); err != nil {
+		return nil, fmt.Errorf("%w: %w", a2a.ErrInvalidParams, err)
+	}
+
+	tasks, err := tm.timedStore().List(ctx, TaskFilter{
+		SessionID: req.Params.SessionID,
+		Labels:    req.Params.Labels,
+		State:     req.Params.State,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list tasks: %w", err)
+	}
+	slices.SortFunc(tasks, compareTaskCreation)
+
+	start := 0
+	if req.Params.Cursor != "" {
+		start, _ = slices.BinarySearchFunc(tasks, &a2a.Task{ID: afterID, CreatedAt: after}, compareTaskCreation)
+		if start < len(tasks) && tasks[start].ID == afterID {
+			start++
+		}
+	}
+
+	limit := req.Params.Limit
+	if limit <= 0 {
+		limit = DefaultTaskPageSize
+	}
+	limit = min(limit, MaxTaskPageSize)
+
+	end := min(start+limit, len(tasks))
+	page := &a2a.TaskListPage{Tasks: make([]a2a.Task, 0, end-start)}
+	for _, task := range tasks[start:end] {
+		if !req.Params.IncludeFileBytes {
+			omitFileBytes(task)
+		}
+		page.Tasks = append(page.Tasks, *task)
+	}
+	if end < len(tasks) {
+		page.NextCursor = encodeListCursor(tasks[end-1])
+	}
+
+	tm.logger.InfoContext(ctx, "tasks listed",
+		slog.String("session_id", req.Params.SessionID),
+		slog.String("state", string(req.Params.State)),
+		slog.Int("tasks", len(page.Tasks)),
+	)
+
+	return &a2a.ListTasksResponse{
+		JSONRPCResponse: a2a.JSONRPCResponse{
+			JSONRPCMessage: a2a.NewJSONRPCMessage(req.ID),
+		},
+		Result: page,
+	}, nil
+}
+
+// compareTaskCreation orders tasks by creation time, then ID.
+func compareTaskCreation(a, b *a2a.Task) int {
+	// Compare wall clock times, as cursors do: monotonic clock readings are not persisted.
+	if c := cmp.Compare(a.CreatedAt.UnixNano(), b.CreatedAt.UnixNano()); c != 0 {
+		return c
+	}
+	return strings.Compare(a.ID, b.ID)
+}
+
+// encodeListCursor returns the opaque cursor resuming a task list after task.
+func encodeListCursor(task *a2a.Task) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(task.CreatedAt.UnixNano(), 10) + ":" + task.ID))
+}
+
+// decodeListCursor returns the creation time and ID of the task cursor resumes after.
+func decodeListCursor(cursor string) (time.Time, string, error) {
+	if cursor == "" {
+		return time.Time{}, "", nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("%w: %q", ErrInvalidCursor, cursor)
+	}
+	nanos, id, ok := strings.Cut(string(data), ":")
+	if !ok {
+		return time.Time{}, "", fmt.Errorf("%w: %q", ErrInvalidCursor, cursor)
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("%w: %q", ErrInvalidCursor, cursor)
+	}
+	return time.Unix(0, n), id, nil
+}
+
+// omitFileBytes removes the inline content of the file parts of task, which must be a copy
+// owned by the caller. The parts themselves are replaced, not modified, as copies share them.
+func omitFileBytes(task *a2a.Task) {
+	omit := func(parts []a2a.Part) {
+		for i, part := range parts {
+			fp, ok := part.(*a2a.FilePart)
+			if !ok || fp == nil || fp.File.Bytes == "" {
+				continue
+			}
+			compact := *fp
+			compact.File.Bytes = ""
+			parts[i] = &compact
+		}
+	}
+	for i := range task.History {
+		omit(task.History[i].Parts)
+	}
+	for i := range task.Artifacts {
+		omit(task.Artifacts[i].Parts)
+	}
+	if task.Status.Message != nil {
+		omit(task.Status.Message.Parts)
+	}
+}
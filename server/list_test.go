@@ -0,0 +1,167 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"errors"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestInMemoryTaskManager_OnListTasks(t *testing.T) {
+	t.Parallel()
+
+	const session = "b9c6b1a4-5f0e-4c6b-9a57-5b4f4f3e2d1c"
+	file := &a2a.FilePart{Type: a2a.PartTypeFile, File: a2a.FileContent{Name: "f.txt", MIMEType: "text/plain", Bytes: "aGVsbG8="}}
+	newManager := func(t *testing.T) *server.InMemoryTaskManager {
+		tm := server.NewInMemoryTaskManager()
+		tasks := []*a2a.Task{
+			{ID: "task-1", SessionID: session, Status: a2a.TaskStatus{State: a2a.TaskStateCompleted}, Labels: map[string]string{"team": "a"}},
+			{ID: "task-2", Status: a2a.TaskStatus{State: a2a.TaskStateWorking}, Labels: map[string]string{"team": "b"}},
+			{ID: "task-3", SessionID: session, Status: a2a.TaskStatus{State: a2a.TaskStateWorking}, Labels: map[string]string{"team": "a", "env": "prod"}},
+			{
+				ID: "task-4", Status: a2a.TaskStatus{State: a2a.TaskStateCompleted},
+				Artifacts: []a2a.Artifact{{Parts: []a2a.Part{file}}},
+			},
+			{ID: "task-5", SessionID: session, Status: a2a.TaskStatus{State: a2a.TaskStateCompleted}},
+		}
+		// Tasks are created in ID order, so that creation order and ID order agree.
+		for _, task := range tasks {
+			if _, err := tm.TaskStore().Create(t.Context(), task); err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+		}
+		return tm
+	}
+
+	// listAll pages through the tasks matching params, returning the IDs of each page.
+	listAll := func(t *testing.T, tm *server.InMemoryTaskManager, params a2a.TaskListParams) ([][]string, error) {
+		var pages [][]string
+		for {
+			resp, err := tm.OnListTasks(t.Context(), a2a.NewListTasksRequest(a2a.NewID("1"), params))
+			if err != nil {
+				return nil, err
+			}
+			var ids []string
+			for _, task := range resp.Result.Tasks {
+				ids = append(ids, task.ID)
+			}
+			pages = append(pages, ids)
+			if resp.Result.NextCursor == "" {
+				return pages, nil
+			}
+			params.Cursor = resp.Result.NextCursor
+		}
+	}
+
+	tests := map[string]struct {
+		params    a2a.TaskListParams
+		wantPages [][]string
+		wantErr   error
+	}{
+		"all tasks": {
+			wantPages: [][]string{{"task-1", "task-2", "task-3", "task-4", "task-5"}},
+		},
+		"pages": {
+			params:    a2a.TaskListParams{Limit: 2},
+			wantPages: [][]string{{"task-1", "task-2"}, {"task-3", "task-4"}, {"task-5"}},
+		},
+		"by session": {
+			params:    a2a.TaskListParams{SessionID: session, Limit: 2},
+			wantPages: [][]string{{"task-1", "task-3"}, {"task-5"}},
+		},
+		"by state": {
+			params:    a2a.TaskListParams{State: a2a.TaskStateCompleted},
+			wantPages: [][]string{{"task-1", "task-4", "task-5"}},
+		},
+		"by session and state": {
+			params:    a2a.TaskListParams{SessionID: session, State: a2a.TaskStateWorking},
+			wantPages: [][]string{{"task-3"}},
+		},
+		"by labels": {
+			params:    a2a.TaskListParams{Labels: map[string]string{"team": "a"}},
+			wantPages: [][]string{{"task-1", "task-3"}},
+		},
+		"by labels and state": {
+			params:    a2a.TaskListParams{Labels: map[string]string{"team": "a", "env": "prod"}, State: a2a.TaskStateWorking},
+			wantPages: [][]string{{"task-3"}},
+		},
+		"invalid cursor": {
+			params:  a2a.TaskListParams{Cursor: "not a cursor"},
+			wantErr: server.ErrInvalidCursor,
+		},
+		"invalid session": {
+			params:  a2a.TaskListParams{SessionID: "not a session"},
+			wantErr: a2a.ErrInvalidParams,
+		},
+		"invalid labels": {
+			params:  a2a.TaskListParams{Labels: map[string]string{"": "a"}},
+			wantErr: a2a.ErrInvalidParams,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			pages, err := listAll(t, newManager(t), tt.params)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("OnListTasks() error = %v, want %v", err, tt.wantErr)
+			}
+			if diff := gocmp.Diff(tt.wantPages, pages); diff != "" {
+				t.Errorf("pages mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("stable cursor", func(t *testing.T) {
+		t.Parallel()
+
+		tm := newManager(t)
+		resp, err := tm.OnListTasks(t.Context(), a2a.NewListTasksRequest(a2a.NewID("1"), a2a.TaskListParams{Limit: 2}))
+		if err != nil {
+			t.Fatalf("OnListTasks() error = %v", err)
+		}
+		// Tasks deleted before the cursor, or created after the listing started, do not move it.
+		if err := tm.TaskStore().Delete(t.Context(), "task-1"); err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+		if _, err := tm.TaskStore().Create(t.Context(), &a2a.Task{ID: "task-0"}); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+
+		pages, err := listAll(t, tm, a2a.TaskListParams{Limit: 2, Cursor: resp.Result.NextCursor})
+		if err != nil {
+			t.Fatalf("OnListTasks() error = %v", err)
+		}
+		want := [][]string{{"task-3", "task-4"}, {"task-5", "task-0"}}
+		if diff := gocmp.Diff(want, pages); diff != "" {
+			t.Errorf("pages mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("file bytes", func(t *testing.T) {
+		t.Parallel()
+
+		tm := newManager(t)
+		for _, include := range []bool{false, true} {
+			params := a2a.TaskListParams{State: a2a.TaskStateCompleted, IncludeFileBytes: include}
+			resp, err := tm.OnListTasks(t.Context(), a2a.NewListTasksRequest(a2a.NewID("1"), params))
+			if err != nil {
+				t.Fatalf("OnListTasks() error = %v", err)
+			}
+			got := resp.Result.Tasks[1].Artifacts[0].Parts[0].(*a2a.FilePart).File
+			want := file.File
+			if !include {
+				want.Bytes = ""
+			}
+			if diff := gocmp.Diff(want, got); diff != "" {
+				t.Errorf("IncludeFileBytes = %v: file mismatch (-want +got):\n%s", include, diff)
+			}
+		}
+	})
+}
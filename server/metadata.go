@@ -0,0 +1,125 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"maps"
+
+	"github.com/go-a2a/a2a"
+)
+
+// MetadataPropagator copies the metadata of a tasks/send or tasks/sendSubscribe request, reqMeta,
+// to a part of an artifact the task produces, see [WithMetadataPropagation].
+//
+// The metadata of part is never nil when the propagator is called, so it can add keys to it
+// directly. Keys the handler set on the part keep their value whatever the propagator does.
+type MetadataPropagator func(reqMeta map[string]any, part a2a.Part)
+
+// PropagateMetadataKeys returns the [MetadataPropagator] copying the given keys of the request
+// metadata, such as a correlation ID, to every part.
+func PropagateMetadataKeys(keys ...string) MetadataPropagator {
+	return func(reqMeta map[string]any, part a2a.Part) {
+		meta := partMetadata(part)
+		if meta == nil {
+			return
+		}
+		for _, key := range keys {
+			if value, ok := reqMeta[key]; ok {
+				if _, set := (*meta)[key]; !set {
+					(*meta)[key] = value
+				}
+			}
+		}
+	}
+}
+
+// partMetadata returns a pointer to the metadata of part, or nil for a part of an unknown type.
+func partMetadata(part a2a.Part) *map[string]any {
+	switch p := part.(type) {
+	case *a2a.TextPart:
+		if p != nil {
+			return &p.Metadata
+		}
+	case *a2a.FilePart:
+		if p != nil {
+			return &p.Metadata
+		}
+	case *a2a.DataPart:
+		if p != nil {
+			return &p.Metadata
+		}
+	}
+	return nil
+}
+
+// copyPart returns a shallow copy of part, or part itself if it is of an unknown type.
+func copyPart(part a2a.Part) a2a.Part {
+	switch p := part.(type) {
+	case *a2a.TextPart:
+		if p != nil {
+			c := *p
+			return &c
+		}
+	case *a2a.FilePart:
+		if p != nil {
+			c := *p
+			return &c
+		}
+	case *a2a.DataPart:
+		if p != nil {
+			c := *p
+			return &c
+		}
+	}
+	return part
+}
+
+// propagateMetadata returns the function applying the [MetadataPropagator] of the server to the
+// artifacts of a task sent with reqMeta, or nil without one.
+func (s *Server) propagateMetadata(reqMeta map[string]any) func(a2a.Artifact) a2a.Artifact {
+	if s.metadataPropagator == nil {
+		return nil
+	}
+	return func(artifact a2a.Artifact) a2a.Artifact {
+		parts := make([]a2a.Part, len(artifact.Parts))
+		for i, part := range artifact.Parts {
+			part = copyPart(part)
+			parts[i] = part
+			meta := partMetadata(part)
+			if meta == nil {
+				continue
+			}
+
+			// The propagator gets a map of its own, the one set by the handler being shared
+			// with the stored task.
+			handlerMeta := *meta
+			*meta = maps.Clone(handlerMeta)
+			if *meta == nil {
+				*meta = make(map[string]any)
+			}
+			s.metadataPropagator(reqMeta, part)
+			maps.Copy(*meta, handlerMeta)
+			if len(*meta) == 0 {
+				*meta = handlerMeta
+			}
+		}
+		artifact.Parts = parts
+		return artifact
+	}
+}
+
+// propagateTaskMetadata returns task with the metadata of the request that sent it, reqMeta,
+// propagated to the parts of its artifacts, leaving task itself untouched.
+func (s *Server) propagateTaskMetadata(task *a2a.Task, reqMeta map[string]any) *a2a.Task {
+	propagate := s.propagateMetadata(reqMeta)
+	if propagate == nil || task == nil || len(task.Artifacts) == 0 {
+		return task
+	}
+	propagated := *task
+	propagated.Artifacts = make([]a2a.Artifact, len(task.Artifacts))
+	for i, artifact := range task.Artifacts {
+		propagated.Artifacts[i] = propagate(artifact)
+	}
+	return &propagated
+}
@@ -0,0 +1,132 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/sonic"
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+// artifactTaskManager answers with an artifact whose first part has metadata set by the handler.
+type artifactTaskManager struct {
+	*server.InMemoryTaskManager
+
+	// artifact is the artifact of every task, shared to catch propagators mutating it.
+	artifact a2a.Artifact
+}
+
+var _ server.StreamHandler = (*artifactTaskManager)(nil)
+
+func (tm *artifactTaskManager) OnSendTask(ctx context.Context, req *a2a.SendTaskRequest) (*a2a.SendTaskResponse, error) {
+	return &a2a.SendTaskResponse{Result: &a2a.Task{
+		ID:        req.Params.ID,
+		Status:    a2a.TaskStatus{State: a2a.TaskStateCompleted},
+		Artifacts: []a2a.Artifact{tm.artifact},
+	}}, nil
+}
+
+func (tm *artifactTaskManager) OnSendTaskStream(ctx context.Context, req *a2a.SendTaskStreamingRequest, w server.StreamWriter) error {
+	if err := w.SendArtifact(tm.artifact); err != nil {
+		return err
+	}
+	return w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateCompleted})
+}
+
+func TestWithMetadataPropagation(t *testing.T) {
+	t.Parallel()
+
+	tm := &artifactTaskManager{
+		InMemoryTaskManager: server.NewInMemoryTaskManager(),
+		artifact: a2a.Artifact{Parts: []a2a.Part{
+			&a2a.TextPart{Type: a2a.PartTypeText, Text: "done", Metadata: map[string]any{"traceId": "handler"}},
+			&a2a.DataPart{Type: a2a.PartTypeData, Data: map[string]any{"n": 1}},
+		}},
+	}
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, tm,
+		server.WithMetadataPropagation(server.PropagateMetadataKeys("traceId", "correlationId")),
+	))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() {
+		if diff := gocmp.Diff(map[string]any{"traceId": "handler"}, tm.artifact.Parts[0].(*a2a.TextPart).Metadata); diff != "" {
+			t.Errorf("handler part metadata changed (-want +got):\n%s", diff)
+		}
+		if meta := tm.artifact.Parts[1].(*a2a.DataPart).Metadata; meta != nil {
+			t.Errorf("handler part metadata = %v, want nil", meta)
+		}
+	})
+
+	want := []map[string]any{
+		{"traceId": "handler", "correlationId": "c-1"},
+		{"traceId": "t-1", "correlationId": "c-1"},
+	}
+	tests := map[string]struct {
+		method string
+	}{
+		"send":      {method: "tasks/send"},
+		"subscribe": {method: "tasks/sendSubscribe"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			body := `{"jsonrpc":"2.0","id":1,"method":"` + tt.method + `","params":{"id":"task-1",` +
+				`"message":{"role":"user","parts":[{"type":"text","text":"hi"}]},` +
+				`"metadata":{"traceId":"t-1","correlationId":"c-1","tenant":"acme"}}}`
+			resp, err := http.Post(srv.URL, "application/json", strings.NewReader(body))
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			// The task of tasks/send has artifacts, the first event of the stream an artifact.
+			type result struct {
+				Artifacts []struct {
+					Parts []struct {
+						Metadata map[string]any `json:"metadata"`
+					} `json:"parts"`
+				} `json:"artifacts"`
+				Artifact struct {
+					Parts []struct {
+						Metadata map[string]any `json:"metadata"`
+					} `json:"parts"`
+				} `json:"artifact"`
+			}
+			var got []map[string]any
+			scanner := bufio.NewScanner(resp.Body)
+			for scanner.Scan() && got == nil {
+				data := strings.TrimPrefix(scanner.Text(), "data: ")
+				if data == "" {
+					continue
+				}
+				var frame struct {
+					Result result `json:"result"`
+				}
+				if err := sonic.ConfigFastest.Unmarshal([]byte(data), &frame); err != nil {
+					t.Fatalf("Unmarshal(%q) error = %v", data, err)
+				}
+				parts := frame.Result.Artifact.Parts
+				if len(frame.Result.Artifacts) > 0 {
+					parts = frame.Result.Artifacts[0].Parts
+				}
+				for _, part := range parts {
+					got = append(got, part.Metadata)
+				}
+			}
+			if diff := gocmp.Diff(want, got); diff != "" {
+				t.Errorf("part metadata mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
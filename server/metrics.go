@@ -0,0 +1,172 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/go-a2a/a2a"
+)
+
+// Metrics receives the measurements of a [Server], see [WithMetrics]. Implementations adapt
+// them to a metrics library, and must be safe for concurrent use.
+type Metrics interface {
+	// RequestStarted is called when the server starts serving a call of the JSON-RPC method.
+	RequestStarted(method string)
+
+	// RequestFinished is called when the server is done serving a call of the JSON-RPC method,
+	// dur after it started, with the [*a2a.JSONRPCError] the call was answered with, or nil.
+	// The call of a streaming method is done once its stream ends.
+	RequestFinished(method string, dur time.Duration, err error)
+
+	// TaskStateChanged is called when a task moves from one state to another. A new task moves
+	// from the empty state.
+	TaskStateChanged(from, to a2a.TaskState)
+
+	// ActiveStreams is called with 1 when an event stream opens and -1 when it closes.
+	ActiveStreams(delta int)
+}
+
+// MetricsHolder is implemented by task managers reporting the state changes of their tasks,
+// letting [WithMetrics] set the [Metrics] they report to.
+type MetricsHolder interface {
+	// SetMetrics sets the metrics the manager reports the state changes of its tasks to.
+	SetMetrics(metrics Metrics)
+}
+
+// NopMetrics is a [Metrics] discarding every measurement. It is the default of a [Server].
+type NopMetrics struct{}
+
+var _ Metrics = NopMetrics{}
+
+// RequestStarted implements [Metrics].
+func (NopMetrics) RequestStarted(string) {}
+
+// RequestFinished implements [Metrics].
+func (NopMetrics) RequestFinished(string, time.Duration, error) {}
+
+// TaskStateChanged implements [Metrics].
+func (NopMetrics) TaskStateChanged(a2a.TaskState, a2a.TaskState) {}
+
+// ActiveStreams implements [Metrics].
+func (NopMetrics) ActiveStreams(int) {}
+
+// OTelMetrics is a [Metrics] recording the measurements as OpenTelemetry metrics:
+//
+//   - a2a.server.active_requests, the calls being served, by method;
+//   - a2a.server.request.duration, the duration of the calls in seconds, by method and
+//     JSON-RPC error code, if any;
+//   - a2a.server.task.state_changes, the task state changes, by previous and new state;
+//   - a2a.server.active_streams, the event streams open.
+type OTelMetrics struct {
+	activeRequests metric.Int64UpDownCounter
+	duration       metric.Float64Histogram
+	stateChanges   metric.Int64Counter
+	activeStreams  metric.Int64UpDownCounter
+}
+
+var _ Metrics = (*OTelMetrics)(nil)
+
+// NewOTelMetrics creates a new [OTelMetrics] recording its instruments with meter.
+func NewOTelMetrics(meter metric.Meter) (*OTelMetrics, error) {
+	m := &OTelMetrics{}
+	var err error
+	if m.activeRequests, err = meter.Int64UpDownCounter("a2a.server.active_requests",
+		metric.WithDescription("Number of JSON-RPC calls being served."),
+		metric.WithUnit("{request}"),
+	); err != nil {
+		return nil, fmt.Errorf("create active requests counter: %w", err)
+	}
+	if m.duration, err = meter.Float64Histogram("a2a.server.request.duration",
+		metric.WithDescription("Duration of the JSON-RPC calls served."),
+		metric.WithUnit("s"),
+	); err != nil {
+		return nil, fmt.Errorf("create request duration histogram: %w", err)
+	}
+	if m.stateChanges, err = meter.Int64Counter("a2a.server.task.state_changes",
+		metric.WithDescription("Number of task state changes."),
+		metric.WithUnit("{change}"),
+	); err != nil {
+		return nil, fmt.Errorf("create task state changes counter: %w", err)
+	}
+	if m.activeStreams, err = meter.Int64UpDownCounter("a2a.server.active_streams",
+		metric.WithDescription("Number of event streams open."),
+		metric.WithUnit("{stream}"),
+	); err != nil {
+		return nil, fmt.Errorf("create active streams counter: %w", err)
+	}
+	return m, nil
+}
+
+// RequestStarted implements [Metrics].
+func (m *OTelMetrics) RequestStarted(method string) {
+	m.activeRequests.Add(context.Background(), 1, metric.WithAttributes(attribute.String("a2a.method", method)))
+}
+
+// RequestFinished implements [Metrics].
+func (m *OTelMetrics) RequestFinished(method string, dur time.Duration, err error) {
+	ctx := context.Background()
+	m.activeRequests.Add(ctx, -1, metric.WithAttributes(attribute.String("a2a.method", method)))
+
+	attrs := []attribute.KeyValue{attribute.String("a2a.method", method)}
+	if err != nil {
+		code := a2a.InternalErrorCode
+		if jerr := (*a2a.JSONRPCError)(nil); errors.As(err, &jerr) {
+			code = jerr.Code
+		}
+		attrs = append(attrs, attribute.Int("rpc.jsonrpc.error_code", code))
+	}
+	m.duration.Record(ctx, dur.Seconds(), metric.WithAttributes(attrs...))
+}
+
+// TaskStateChanged implements [Metrics].
+func (m *OTelMetrics) TaskStateChanged(from, to a2a.TaskState) {
+	m.stateChanges.Add(context.Background(), 1, metric.WithAttributes(
+		attribute.String("a2a.task_state.from", string(from)),
+		attribute.String("a2a.task_state.to", string(to)),
+	))
+}
+
+// ActiveStreams implements [Metrics].
+func (m *OTelMetrics) ActiveStreams(delta int) {
+	m.activeStreams.Add(context.Background(), int64(delta))
+}
+
+// startCallMetrics reports the start of the JSON-RPC call req to the metrics of the server, and
+// returns r with a context recording its outcome along with the function reporting its end.
+func (s *Server) startCallMetrics(r *http.Request, req *a2a.JSONRPCRequest) (*http.Request, func()) {
+	if _, ok := s.metrics.(NopMetrics); ok {
+		return r, func() {}
+	}
+
+	start := time.Now()
+	r, outcome := withCallOutcome(r)
+	s.metrics.RequestStarted(req.Method)
+
+	return r, func() {
+		var err error
+		if outcome.err != nil {
+			err = outcome.err
+		}
+		s.metrics.RequestFinished(req.Method, time.Since(start), err)
+	}
+}
+
+// addStream registers sw as an event stream in flight, reporting it to the metrics of the
+// server, and returns the function removing it.
+func (s *Server) addStream(sw *sseWriter) func() {
+	remove := s.inFlight.addStream(sw)
+	s.metrics.ActiveStreams(1)
+	return func() {
+		remove()
+		s.metrics.ActiveStreams(-1)
+	}
+}
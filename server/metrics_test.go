@@ -0,0 +1,119 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	gocmp "github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+// recordingMetrics counts the measurements it receives.
+type recordingMetrics struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func (m *recordingMetrics) record(format string, args ...any) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.counts[fmt.Sprintf(format, args...)]++
+}
+
+func (m *recordingMetrics) RequestStarted(method string) {
+	m.record("started %s", method)
+}
+
+func (m *recordingMetrics) RequestFinished(method string, dur time.Duration, err error) {
+	if dur <= 0 {
+		m.record("finished %s without duration", method)
+	}
+	if jerr := (*a2a.JSONRPCError)(nil); errors.As(err, &jerr) {
+		m.record("finished %s with %d", method, jerr.Code)
+		return
+	}
+	m.record("finished %s", method)
+}
+
+func (m *recordingMetrics) TaskStateChanged(from, to a2a.TaskState) {
+	m.record("state %q to %q", from, to)
+}
+
+func (m *recordingMetrics) ActiveStreams(delta int) {
+	m.record("streams %+d", delta)
+}
+
+func TestWithMetrics(t *testing.T) {
+	t.Parallel()
+
+	metrics := &recordingMetrics{counts: make(map[string]int)}
+	srv := newStreamingServer(t, func(ctx context.Context, w server.StreamWriter) error {
+		return w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateCompleted})
+	}, server.WithMetrics(metrics))
+
+	for _, body := range []string{
+		`{"jsonrpc":"2.0","id":1,"method":"tasks/send","params":{"id":"task-2","message":{"role":"user","parts":[{"type":"text","text":"hi"}]}}}`,
+		`{"jsonrpc":"2.0","id":2,"method":"tasks/cancel","params":{"id":"task-2"}}`,
+		`{"jsonrpc":"2.0","id":3,"method":"tasks/get","params":{"id":"missing"}}`,
+	} {
+		resp, err := http.Post(srv.URL, "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Post() error = %v", err)
+		}
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}
+	resp := postSendSubscribe(t.Context(), t, srv.URL)
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	// Close waits for the calls to be done.
+	srv.Close()
+
+	want := map[string]int{
+		"started tasks/send":              1,
+		"finished tasks/send":             1,
+		"started tasks/cancel":            1,
+		"finished tasks/cancel":           1,
+		"started tasks/get":               1,
+		"finished tasks/get with -32001":  1,
+		"started tasks/sendSubscribe":     1,
+		"finished tasks/sendSubscribe":    1,
+		`state "" to "submitted"`:         1,
+		`state "submitted" to "canceled"`: 1,
+		"streams +1":                      1,
+		"streams -1":                      1,
+	}
+	metrics.mu.Lock()
+	defer metrics.mu.Unlock()
+	if diff := gocmp.Diff(want, metrics.counts); diff != "" {
+		t.Errorf("measurements mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestOTelMetrics(t *testing.T) {
+	t.Parallel()
+
+	metrics, err := server.NewOTelMetrics(noop.NewMeterProvider().Meter("test"))
+	if err != nil {
+		t.Fatalf("NewOTelMetrics() error = %v", err)
+	}
+	metrics.RequestStarted(a2a.MethodTasksGet)
+	metrics.RequestFinished(a2a.MethodTasksGet, time.Millisecond, a2a.NewTaskNotFoundError())
+	metrics.TaskStateChanged(a2a.TaskStateSubmitted, a2a.TaskStateWorking)
+	metrics.ActiveStreams(1)
+}
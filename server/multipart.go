@@ -0,0 +1,81 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/go-a2a/a2a"
+)
+
+// maxMultipartMemory is how much of a multipart upload is held in memory; larger files spill to disk.
+const maxMultipartMemory = 32 << 20 // 32MiB
+
+// readRequest returns the JSON-RPC request carried by the body of r.
+//
+// A multipart/form-data body is parsed as a multipart upload: the request is read from the
+// [a2a.MultipartRequestField] field, and the form files are kept in r.MultipartForm for
+// [attachUploads]. The caller must remove the form when done.
+func readRequest(r *http.Request) ([]byte, error) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "multipart/form-data" {
+		return io.ReadAll(r.Body)
+	}
+
+	if err := r.ParseMultipartForm(maxMultipartMemory); err != nil {
+		return nil, fmt.Errorf("parse multipart upload: %w", err)
+	}
+	fields := r.MultipartForm.Value[a2a.MultipartRequestField]
+	if len(fields) != 1 {
+		return nil, fmt.Errorf("multipart upload must have exactly one %q field", a2a.MultipartRequestField)
+	}
+	return []byte(fields[0]), nil
+}
+
+// attachUploads replaces the content ID reference of each file part of msg with the bytes of
+// the form file it names. It does nothing unless r carries a multipart upload.
+func attachUploads(r *http.Request, msg *a2a.Message) error {
+	if r.MultipartForm == nil {
+		return nil
+	}
+
+	for i, part := range msg.Parts {
+		fp, ok := part.(*a2a.FilePart)
+		if !ok || fp == nil {
+			continue
+		}
+		contentID, ok := strings.CutPrefix(fp.File.URI, a2a.ContentIDScheme)
+		if !ok {
+			continue
+		}
+
+		files := r.MultipartForm.File[contentID]
+		if len(files) != 1 {
+			return fmt.Errorf("part %d references unknown upload %q", i, contentID)
+		}
+		f, err := files[0].Open()
+		if err != nil {
+			return fmt.Errorf("open upload %q: %w", contentID, err)
+		}
+		data, err := io.ReadAll(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("read upload %q: %w", contentID, err)
+		}
+
+		attached := *fp
+		attached.File.URI = ""
+		attached.File.Bytes = base64.StdEncoding.EncodeToString(data)
+		if attached.File.Bytes == "" {
+			return fmt.Errorf("upload %q is empty", contentID)
+		}
+		msg.Parts[i] = &attached
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-a2a/a2a"
+)
+
+const (
+	// DefaultNotifyMaxRetries is the default number of times a [Notifier] retries a failed delivery.
+	DefaultNotifyMaxRetries = 3
+
+	// DefaultNotifyBackoff is the default delay before a [Notifier] first retries a failed delivery.
+	DefaultNotifyBackoff = 500 * time.Millisecond
+
+	// MethodTasksPushNotification is the JSON-RPC method of the notifications a [Notifier] posts.
+	MethodTasksPushNotification = "tasks/pushNotification"
+
+	defaultNotifyTimeout = 10 * time.Second
+)
+
+// errRetryable marks a delivery failure worth retrying.
+var errRetryable = errors.New("retryable")
+
+// Notifier delivers task events to the webhooks registered in a [PushNotificationStore].
+//
+// Each event is posted as a JSON-RPC notification whose params are the event, with the
+// config token, if any, as a bearer token in the Authorization header, and the body signed
+// with the config secret, if any, in the [a2a.WebhookSignatureHeader]. The body is the
+// canonical JSON of the notification, see [a2a.CanonicalJSON], so that the signed bytes of an
+// event do not depend on the ordering of its metadata. Deliveries run in
+// the background; server errors and transport failures are retried with exponential
+// backoff, and a delivery that still fails is logged and dropped.
+type Notifier struct {
+	store      PushNotificationStore
+	httpClient *http.Client
+	logger     *slog.Logger
+	maxRetries int
+	backoff    time.Duration
+
+	wg sync.WaitGroup
+}
+
+// NotifierOption represents an option for configuring a [Notifier].
+type NotifierOption func(*Notifier)
+
+// WithNotifyMaxRetries sets how many times a failed delivery is retried. Zero disables retries.
+//
+// Defaults to [DefaultNotifyMaxRetries].
+func WithNotifyMaxRetries(retries int) NotifierOption {
+	return func(n *Notifier) {
+		n.maxRetries = max(retries, 0)
+	}
+}
+
+// WithNotifyBackoff sets the delay before the first retry. Each further retry waits twice as long.
+//
+// Defaults to [DefaultNotifyBackoff].
+func WithNotifyBackoff(d time.Duration) NotifierOption {
+	return func(n *Notifier) {
+		n.backoff = d
+	}
+}
+
+// WithNotifyHTTPClient sets the [*http.Client] used to post notifications.
+func WithNotifyHTTPClient(client *http.Client) NotifierOption {
+	return func(n *Notifier) {
+		n.httpClient = client
+	}
+}
+
+// NewNotifier creates a new [Notifier] delivering to the webhooks in store and logging failures to logger.
+func NewNotifier(store PushNotificationStore, logger *slog.Logger, opts ...NotifierOption) *Notifier {
+	n := &Notifier{
+		store: store,
+		httpClient: &http.Client{
+			Timeout: defaultNotifyTimeout,
+		},
+		logger:     logger,
+		maxRetries: DefaultNotifyMaxRetries,
+		backoff:    DefaultNotifyBackoff,
+	}
+	for _, opt := range opts {
+		opt(n)
+	}
+	return n
+}
+
+// Notify delivers event to the webhook registered for its task, if any, without blocking.
+//
+// Tasks without push notification config, and configs not subscribed to the event type,
+// are skipped. Delivery outlives ctx being canceled but keeps its values.
+func (n *Notifier) Notify(ctx context.Context, event a2a.TaskEvent) {
+	typ := eventType(event)
+	if typ == "" {
+		return
+	}
+
+	taskID := event.TaskID()
+	config, err := n.store.Get(ctx, taskID)
+	if err != nil {
+		if !errors.Is(err, ErrPushConfigNotFound) {
+			n.logger.ErrorContext(ctx, "get push notification config", slog.String("task_id", taskID), slog.Any("error", err))
+		}
+		return
+	}
+	if !config.Wants(typ) {
+		return
+	}
+
+	body, err := a2a.CanonicalJSON(&notification{
+		JSONRPC: "2.0",
+		Method:  MethodTasksPushNotification,
+		Params:  event,
+	})
+	if err != nil {
+		n.logger.ErrorContext(ctx, "marshal push notification", slog.String("task_id", taskID), slog.Any("error", err))
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	n.wg.Add(1)
+	go func() {
+		defer n.wg.Done()
+
+		if err := n.deliver(ctx, config, body); err != nil {
+			n.logger.ErrorContext(ctx, "deliver push notification",
+				slog.String("task_id", taskID),
+				slog.String("url", config.URL),
+				slog.Any("error", err))
+		}
+	}()
+}
+
+// Wait blocks until every delivery started by [Notifier.Notify] has finished.
+func (n *Notifier) Wait() {
+	n.wg.Wait()
+}
+
+// notification is the JSON-RPC notification posted to a webhook.
+type notification struct {
+	JSONRPC string        `json:"jsonrpc"`
+	Method  string        `json:"method"`
+	Params  a2a.TaskEvent `json:"params"`
+}
+
+// deliver posts body to the webhook of config, retrying retryable failures with exponential backoff.
+func (n *Notifier) deliver(ctx context.Context, config a2a.PushNotificationConfig, body []byte) error {
+	backoff := n.backoff
+	for attempt := 0; ; attempt++ {
+		err := n.post(ctx, config, body)
+		if err == nil || !errors.Is(err, errRetryable) || attempt >= n.maxRetries {
+			return err
+		}
+
+		n.logger.DebugContext(ctx, "retrying push notification",
+			slog.String("url", config.URL),
+			slog.Int("attempt", attempt+1),
+			slog.Any("error", err))
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%w (after %d attempts)", ctx.Err(), attempt+1)
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
+
+// post makes a single delivery attempt. Transport failures and 5xx responses wrap [errRetryable].
+func (n *Notifier) post(ctx context.Context, config a2a.PushNotificationConfig, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, config.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create HTTP request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if config.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+config.Token)
+	}
+	if config.Secret != "" {
+		// Each attempt is signed anew, so retries are not rejected as stale.
+		req.Header.Set(a2a.WebhookSignatureHeader, a2a.SignWebhookPayload(config.Secret, body, time.Now()))
+	}
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: send HTTP request: %w", errRetryable, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return fmt.Errorf("%w: webhook responded %s", errRetryable, resp.Status)
+	case resp.StatusCode >= http.StatusBadRequest:
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return nil
+}
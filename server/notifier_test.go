@@ -0,0 +1,120 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+// webhook records the push notifications it receives, answering the first failures requests with 503 Service Unavailable.
+// It verifies the signature of the notifications against secret, if set.
+type webhook struct {
+	mu         sync.Mutex
+	failures   int
+	secret     string
+	auth       []string
+	signatures []error
+	states     []a2a.TaskState
+}
+
+func (wh *webhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	wh.mu.Lock()
+	defer wh.mu.Unlock()
+
+	if wh.failures > 0 {
+		wh.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	var notification struct {
+		Method string                    `json:"method"`
+		Params a2a.TaskStatusUpdateEvent `json:"params"`
+	}
+	if err := sonic.ConfigFastest.Unmarshal(data, &notification); err != nil || notification.Method != server.MethodTasksPushNotification {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	wh.auth = append(wh.auth, r.Header.Get("Authorization"))
+	if wh.secret != "" {
+		wh.signatures = append(wh.signatures, a2a.VerifyWebhookSignature(wh.secret, data, r.Header, time.Minute))
+	}
+	wh.states = append(wh.states, notification.Params.Status.State)
+}
+
+func TestNotifier(t *testing.T) {
+	t.Parallel()
+
+	wh := &webhook{failures: 1, secret: "signing-secret"}
+	hook := httptest.NewTLSServer(wh)
+	t.Cleanup(hook.Close)
+
+	store := server.NewInMemoryPushNotificationStore()
+	err := store.Set(t.Context(), "task-1", a2a.PushNotificationConfig{
+		URL:        hook.URL,
+		Token:      "secret",
+		Secret:     "signing-secret",
+		EventTypes: []string{a2a.EventTypeStatus},
+	})
+	if err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	srv := newStreamingServer(t, func(ctx context.Context, w server.StreamWriter) error {
+		if err := w.SendArtifact(a2a.Artifact{Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "done"}}}); err != nil {
+			return err
+		}
+		return w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateCompleted})
+	}, server.WithPushNotifications(store,
+		server.WithNotifyHTTPClient(hook.Client()),
+		server.WithNotifyBackoff(10*time.Millisecond),
+	))
+
+	resp := postSendSubscribe(t.Context(), t, srv.URL)
+	defer resp.Body.Close()
+	if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+		t.Fatalf("read stream: %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		wh.mu.Lock()
+		states, auth, signatures := wh.states, wh.auth, wh.signatures
+		wh.mu.Unlock()
+
+		if len(states) > 0 {
+			if len(states) != 1 || states[0] != a2a.TaskStateCompleted {
+				t.Errorf("webhook received states %v, want only %q", states, a2a.TaskStateCompleted)
+			}
+			if auth[0] != "Bearer secret" {
+				t.Errorf("Authorization = %q, want %q", auth[0], "Bearer secret")
+			}
+			if signatures[0] != nil {
+				t.Errorf("VerifyWebhookSignature() error = %v", signatures[0])
+			}
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("webhook did not receive the completed notification")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
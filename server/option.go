@@ -6,8 +6,12 @@ package server
 import (
 	"log/slog"
 	"net/http"
+	"time"
 
+	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/trace"
+
+	"github.com/go-a2a/a2a"
 )
 
 // Option represents an option for configuring the [Server].
@@ -20,6 +24,81 @@ func WithEndpoint(endpoint string) Option {
 	}
 }
 
+// WithAgentCard sets the agent card the [Server] serves, replacing the one passed to [NewServer].
+//
+// With no card at all, the well-known endpoint responds 404 Not Found.
+func WithAgentCard(card *a2a.AgentCard) Option {
+	return func(s *Server) {
+		s.agentCard = card
+	}
+}
+
+// WithWellKnownPath sets the path the agent card is served at, instead of [AgantPath].
+func WithWellKnownPath(path string) Option {
+	return func(s *Server) {
+		s.wellKnownPath = path
+	}
+}
+
+// WithCardEncoder serves the agent card as mediaType, encoded by enc, to clients that accept it.
+//
+// JSON is always available and preferred when the client accepts any type. For example,
+// WithCardEncoder(MediaTypeYAML, YAMLCardEncoder) adds YAML discovery.
+func WithCardEncoder(mediaType string, enc CardEncoder) Option {
+	return func(s *Server) {
+		s.cardEncoders[mediaType] = enc
+	}
+}
+
+// WithPushNotifications enables the tasks/pushNotification methods, keeping each task's config in store.
+//
+// Task status changes and artifacts seen by the [Server] are then delivered to the registered
+// webhooks by a [Notifier] configured with opts, logging to the server's logger.
+// Without it, both methods respond with the push-notification-not-supported error.
+func WithPushNotifications(store PushNotificationStore, opts ...NotifierOption) Option {
+	return func(s *Server) {
+		s.pushStore = store
+		s.notifierOpts = opts
+	}
+}
+
+// WithTaskStore sets the [TaskStore] holding the tasks of a task manager that implements [TaskStoreHolder],
+// such as [InMemoryTaskManager]. It has no effect on other task managers.
+func WithTaskStore(store TaskStore) Option {
+	return func(s *Server) {
+		s.taskStore = store
+	}
+}
+
+// WithAutoSessionID makes the [Server] assign a new session ID to tasks/send and tasks/sendSubscribe
+// requests that omit one, instead of leaving the task outside any session.
+//
+// Task managers keep the session of an existing task, so only the first turn of a task
+// starts a new session. The generated ID is returned to the client with the task.
+func WithAutoSessionID() Option {
+	return func(s *Server) {
+		s.autoSessionID = true
+	}
+}
+
+// WithIDGenerator sets the [IDGenerator] minting the IDs of tasks sent without one and, with
+// [WithAutoSessionID], of new sessions. Defaults to [DefaultIDGenerator].
+func WithIDGenerator(generator IDGenerator) Option {
+	return func(s *Server) {
+		s.idGenerator = generator
+	}
+}
+
+// WithIDValidator makes the [Server] reject the requests whose task ID validator refuses, with
+// an invalid params error, before they reach the task manager. It lets operators enforce the
+// format of their IDs, and keep malformed ones out of logs and stores. IDs minted by the server
+// are not validated.
+func WithIDValidator(validator IDValidator) Option {
+	return func(s *Server) {
+		s.idValidator = validator
+	}
+}
+
 // WithHandlers sets the custom handlers for the [Server].
 func WithHandlers(handlers ...func(http.Handler) http.Handler) Option {
 	return func(s *Server) {
@@ -27,6 +106,88 @@ func WithHandlers(handlers ...func(http.Handler) http.Handler) Option {
 	}
 }
 
+// WithMaxFrameRate limits each streaming subscriber to n server-sent events per second.
+//
+// Events produced faster than the limit are queued without blocking the handler and
+// coalesced: a newer status update replaces an older pending one, and appended artifact
+// chunks are merged. Final status updates, errors and artifact content are never dropped.
+// A non-positive n, the default, disables the limit.
+func WithMaxFrameRate(n int) Option {
+	return func(s *Server) {
+		s.maxFrameRate = n
+	}
+}
+
+// WithStreamKeepalive makes the [Server] write a keepalive comment on a server-sent event
+// stream that stays silent for interval, so that proxies and load balancers do not drop the
+// streams of tasks working for long without updates. Clients ignore comments.
+//
+// The interval restarts whenever an event is written, so active streams get no keepalives. A
+// keepalive starts a stream that sent no event yet: an error hit later is then reported as
+// an error event rather than a plain JSON-RPC response. A non-positive interval, the default,
+// disables keepalives.
+func WithStreamKeepalive(interval time.Duration) Option {
+	return func(s *Server) {
+		s.streamKeepalive = interval
+	}
+}
+
+// WithStreamCoalesce makes the [Server] hold back each artifact update of a server-sent event
+// stream for interval, merging the chunks that append to the same artifact in the meantime
+// into a single event. Agents streaming many tiny chunks then send fewer, larger events, at
+// the cost of up to interval of latency.
+//
+// Status updates and errors are not held back: they are written at once, behind the
+// artifact chunks held so far. Chunks still held when the stream closes are written before
+// it ends. A non-positive interval, the default, writes each chunk at once.
+func WithStreamCoalesce(interval time.Duration) Option {
+	return func(s *Server) {
+		s.streamCoalesce = interval
+	}
+}
+
+// WithAuthenticator makes the [Server] authenticate every request to its A2A endpoint with auth,
+// such as a [BearerTokenAuthenticator]. The agent card stays public.
+//
+// Requests failing authentication are answered with 401 Unauthorized and a JSON-RPC error.
+// Handlers read the authenticated caller with [CallerFrom]. See [Server.RequireScheme] to
+// restrict methods to some schemes.
+func WithAuthenticator(auth Authenticator) Option {
+	return func(s *Server) {
+		s.authenticator = auth
+	}
+}
+
+// WithEventReplay makes the [Server] keep the last n events streamed for each task, so that
+// a client whose stream dropped can resume it without losing events.
+//
+// Each event is then sent with an increasing server-sent event ID. A tasks/resubscribe request
+// carrying the last ID received in a Last-Event-ID header replays the kept events after it, then
+// follows the stream of the task until it ends. Events sent after the client dropped are kept too.
+// Without a Last-Event-ID header, all kept events are replayed. Events stay available for
+// five minutes after the stream ends. A non-positive n, the default, disables replay.
+//
+// Whether replay is enabled or not, tasks/resubscribe answers an unknown task with the
+// task-not-found error, and a task that is over, with no kept events, with a single event
+// carrying its final status. Other tasks are left to the task manager.
+func WithEventReplay(n int) Option {
+	return func(s *Server) {
+		s.eventReplay = n
+	}
+}
+
+// WithEventJournal makes the [Server] record an ordered journal of what happens to each task
+// in journal: the messages received with tasks/send, tasks/sendSubscribe and tasks/input/append,
+// the statuses the task moves to, the artifacts it emits and the errors its calls fail with, each
+// with the time it happened. The tasks/journal/get method then returns the journal of a task.
+//
+// Without a journal, the default, tasks/journal/get fails with the unsupported operation error.
+func WithEventJournal(journal EventJournal) Option {
+	return func(s *Server) {
+		s.journal = journal
+	}
+}
+
 // WithLogger sets the [*slog.Logger] for the [Server].
 func WithLogger(logger *slog.Logger) Option {
 	return func(s *Server) {
@@ -34,9 +195,324 @@ func WithLogger(logger *slog.Logger) Option {
 	}
 }
 
+// WithPropagator sets the [propagation.TextMapPropagator] extracting the trace context, such as
+// the W3C traceparent and tracestate headers, from incoming requests. The spans of the
+// [Server] then join the trace of the calling client.
+//
+// Defaults to the global propagator, set with otel.SetTextMapPropagator.
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(s *Server) {
+		s.propagator = propagator
+	}
+}
+
 // WithTracer sets the [trace.Tracer] for the [Server].
 func WithTracer(tracer trace.Tracer) Option {
 	return func(s *Server) {
 		s.tracer = tracer
 	}
 }
+
+// WithMaxDataDepth sets the maximum nesting depth accepted for the structured data of incoming data parts.
+//
+// A non-positive depth disables the check. Defaults to [a2a.DefaultMaxDataDepth].
+func WithMaxDataDepth(depth int) Option {
+	return func(s *Server) {
+		s.maxDataDepth = depth
+	}
+}
+
+// WithMaxRequestBytes limits the size of request bodies to n bytes.
+//
+// The body is read through an [http.MaxBytesReader], so an oversized request is rejected as
+// soon as the limit is crossed, without buffering the rest, and is answered with 413 Request
+// Entity Too Large and an invalid request error. A non-positive n disables the limit, which is the default.
+func WithMaxRequestBytes(n int64) Option {
+	return func(s *Server) {
+		s.maxRequestBytes = n
+	}
+}
+
+// WithMaxFileBytes limits the decoded size of the content of each incoming file part to n bytes.
+//
+// Requests carrying a larger file are rejected with an invalid params error wrapping
+// [a2a.ErrFileTooLarge]. A non-positive n disables the limit, which is the default.
+func WithMaxFileBytes(n int64) Option {
+	return func(s *Server) {
+		s.maxFileBytes = n
+	}
+}
+
+// WithMessageLimits limits the messages of tasks/send and tasks/sendSubscribe requests to
+// maxParts parts and maxTotalBytes bytes of content, counting the length of their texts, the
+// decoded size of their inline files and the JSON-encoded size of their data.
+//
+// Requests carrying a larger message are rejected with an invalid params error wrapping
+// [a2a.ErrMessageTooLarge], whose data tells which limit was exceeded. A non-positive limit is
+// disabled, which both are by default.
+func WithMessageLimits(maxParts int, maxTotalBytes int64) Option {
+	return func(s *Server) {
+		s.maxMessageParts = maxParts
+		s.maxMessageBytes = maxTotalBytes
+	}
+}
+
+// WithBatchLimits limits the requests of a JSON-RPC batch served at once to concurrency, so
+// that a large batch does not start as many goroutines, and bounds the batch as a whole by
+// timeout. A concurrency below 1 serves [DefaultBatchConcurrency] requests at once, which is
+// the default; a concurrency of 1 serves the requests in turn, in their order in the batch.
+//
+// The requests of a batch share its deadline: those not answered within timeout of the batch
+// being received are answered with a deadline exceeded error, without delaying the responses
+// of the others. A non-positive timeout, the default, bounds batches by their request only.
+func WithBatchLimits(concurrency int, timeout time.Duration) Option {
+	return func(s *Server) {
+		s.batchConcurrency = concurrency
+		s.batchTimeout = timeout
+	}
+}
+
+// WithRateLimiter limits the rate of requests to the A2A endpoint with limiter, such as a
+// [TokenBucketLimiter].
+//
+// Requests are keyed by [KeyByCaller] unless [WithRateLimitKey] sets another key function.
+// A rejected request is answered with 429 Too Many Requests and a Retry-After header.
+func WithRateLimiter(limiter RateLimiter) Option {
+	return func(s *Server) {
+		s.rateLimiter = limiter
+	}
+}
+
+// WithRateLimitKey sets the function returning the key requests are rate limited by, for
+// example [KeyByRemoteIP] or [KeyByHeader]. It has no effect without [WithRateLimiter].
+func WithRateLimitKey(key RateLimitKeyFunc) Option {
+	return func(s *Server) {
+		s.rateLimitKey = key
+	}
+}
+
+// WithErrorEncoder sets the [ErrorEncoder] used to render JSON-RPC errors for the [Server].
+//
+// Defaults to [DefaultErrorEncoder].
+func WithErrorEncoder(encoder ErrorEncoder) Option {
+	return func(s *Server) {
+		s.errorEncoder = encoder
+	}
+}
+
+// WithCodec sets the [a2a.Codec] encoding the JSON-RPC responses, batches and server-sent
+// events of the [Server].
+//
+// Defaults to [a2a.DefaultCodec]. Requests and their params are decoded with
+// [a2a.DefaultCodec], as is done by [DefaultErrorEncoder].
+func WithCodec(codec a2a.Codec) Option {
+	return func(s *Server) {
+		s.codec = codec
+	}
+}
+
+// WithRequestLogging enables or disables the logging of each JSON-RPC call served by the
+// [Server] to its logger. It is enabled by default.
+//
+// Calls are logged with their method, task ID, caller, duration and outcome, at info level,
+// or at error level along with the JSON-RPC error they failed with. At debug level, the params
+// are logged too, with credentials and push notification tokens redacted and file contents
+// replaced by their size; see [WithRedactor] to redact more. Headers are never logged.
+func WithRequestLogging(enabled bool) Option {
+	return func(s *Server) {
+		s.requestLogging = enabled
+	}
+}
+
+// WithStreamingFallback chooses how a [Server] that does not serve tasks/sendSubscribe, such
+// as one composed of the methods registered with [Server.Handle], answers it. Its agent card
+// does not advertise streaming.
+//
+// With the fallback enabled, the default, the call is served by the handler of tasks/send: its
+// task is answered as a single server-sent event, marked final, before the stream closes.
+// Disabled, or without a tasks/send handler, the call fails with the unsupported operation
+// error before the response turns into an event stream.
+func WithStreamingFallback(enabled bool) Option {
+	return func(s *Server) {
+		s.streamingFallback = enabled
+	}
+}
+
+// WithRecovery enables or disables the recovery from the panics of handlers. It is enabled
+// by default.
+//
+// A panic is logged along with its stack, at error level, and answered with an internal
+// error, or, for a streamed task, ends the stream with a failed status. The task the handler
+// ran is failed with an [a2a.TaskError] coded [TaskInternalErrorCode], provided the task
+// manager implements [StatusUpdater]. Without recovery, panics are left to [net/http], except
+// those of the requests of a batch, which are answered with an internal error so as not to
+// abort the others.
+func WithRecovery(enabled bool) Option {
+	return func(s *Server) {
+		s.recovery = enabled
+	}
+}
+
+// WithRedactor adds a [Redactor] applied to the params logged by [WithRequestLogging], after
+// the built-in redaction, for example to hide sensitive members of data parts.
+func WithRedactor(redactor Redactor) Option {
+	return func(s *Server) {
+		s.redactors = append(s.redactors, redactor)
+	}
+}
+
+// WithTaskTimeout fails the tasks not done within d of being sent. A task may set its own
+// deadline under [a2a.DeadlineKey] in the metadata of its send request, in place of d.
+//
+// Once a task times out, its handler's context is done, and the task moves to failed with an
+// [a2a.TaskError] coded [TaskTimeoutCode], provided the task manager implements
+// [StatusUpdater]. A stream ends with the final failed status, and tasks/send answers with the
+// failed task, without waiting for the handler to return. The timer is disarmed once the task
+// is done, canceled, or sent again.
+func WithTaskTimeout(d time.Duration) Option {
+	return func(s *Server) {
+		s.taskTimeout = d
+	}
+}
+
+// WithSessionConcurrency caps the tasks each session runs at once to n, 1 if n is less, so
+// that the turns of a session do not interleave while different sessions run concurrently.
+//
+// A task is in flight while tasks/send or tasks/sendSubscribe serves it. Tasks beyond the cap
+// wait for a slot of their session, in the order they were sent, and fail with
+// [ErrSessionBusy] if their request ends first or the queue is full, see [WithSessionQueue].
+// Tasks sent without a session ID are not limited.
+func WithSessionConcurrency(n int) Option {
+	return func(s *Server) {
+		s.sessionLimit = max(n, 1)
+	}
+}
+
+// WithSessionQueue caps the tasks waiting per session under [WithSessionConcurrency] to depth,
+// rejecting the tasks beyond with [ErrSessionBusy] at once. A depth of 0 rejects every task
+// finding its session busy. By default, any number of tasks wait.
+func WithSessionQueue(depth int) Option {
+	return func(s *Server) {
+		s.sessionQueue = max(depth, 0)
+	}
+}
+
+// WithMetrics sets the [Metrics] receiving the measurements of the [Server], such as
+// [OTelMetrics]. They cover the JSON-RPC calls served and the event streams open, and the task
+// state changes of a task manager implementing [MetricsHolder], such as [InMemoryTaskManager].
+func WithMetrics(metrics Metrics) Option {
+	return func(s *Server) {
+		s.metrics = metrics
+	}
+}
+
+// WithCapabilities sets the capabilities advertised in the served agent card, in place of
+// those inferred by [Server.InferCapabilities].
+func WithCapabilities(caps a2a.AgentCapabilities) Option {
+	return func(s *Server) {
+		s.capabilities = &caps
+	}
+}
+
+// WithWebSocket serves a WebSocket transport at [WebSocketPath] alongside the A2A endpoint, as
+// an alternative to server-sent events for clients behind proxies that buffer them.
+//
+// Upgrade requests are authenticated and rate limited like any other request. Each text message
+// received carries a JSON-RPC request or batch, and each response, or event of a streaming
+// method, is sent back as a text message of its own. The server pings the client periodically
+// and sends a close frame when it shuts down.
+func WithWebSocket() Option {
+	return func(s *Server) {
+		s.webSocket = true
+	}
+}
+
+// WithServerTiming enables Server-Timing headers on unary responses of the [Server].
+//
+// The header breaks the request latency down into decode, handler and encode time, plus any
+// metrics recorded with [RecordServerTiming].
+func WithServerTiming(enabled bool) Option {
+	return func(s *Server) {
+		s.serverTiming = enabled
+	}
+}
+
+// WithCompression gzips the responses of the A2A endpoint to clients accepting it, once they
+// reach [DefaultCompressionMinSize] bytes, setting their Content-Encoding header. Event streams
+// are left uncompressed unless [WithStreamCompression] is given, since a compressor buffering
+// events would hold them back.
+//
+// Requests whose body is gzipped, with a Content-Encoding header saying so, are decompressed
+// whether or not the option is set.
+func WithCompression(opts ...CompressionOption) Option {
+	return func(s *Server) {
+		s.compression = &compression{minSize: DefaultCompressionMinSize}
+		for _, opt := range opts {
+			opt(s.compression)
+		}
+	}
+}
+
+// WithFileResolver makes the [Server] fetch the files sent by URI in the messages of tasks/send,
+// tasks/sendSubscribe and tasks/input/append with resolver, before invoking the task manager.
+// The parts then carry the content of the files as bytes, with the detected MIME type unless
+// they declared one. Files the resolver refuses are answered with the
+// content-type-not-supported error.
+//
+// [NewHTTPFileResolver] returns a resolver guarding against server-side request forgery.
+func WithFileResolver(resolver FileResolver) Option {
+	return func(s *Server) {
+		s.fileResolver = resolver
+	}
+}
+
+// WithMetadataPropagation makes the [Server] pass the metadata of each tasks/send and
+// tasks/sendSubscribe request to propagate, along with every part of the artifacts the task
+// produces, so that a correlation or trace ID sent by the client reaches each part without the
+// handler carrying it over. It applies to the artifacts of the tasks/send response and of the
+// stream events; the stored task is left as the handler made it.
+//
+// The metadata the handler set on a part is never overwritten. [PropagateMetadataKeys] returns
+// the propagator copying a set of keys.
+func WithMetadataPropagation(propagate MetadataPropagator) Option {
+	return func(s *Server) {
+		s.metadataPropagator = propagate
+	}
+}
+
+// WithReadinessCheck adds check to the checks the readiness probe served at [ReadyPath] runs,
+// such as pinging the task store. The server is ready when all of them pass. The check gets
+// the context of the probe request.
+//
+// The server itself is ready once [NewServer] returns; a check failing until the agent loaded
+// what it needs keeps it out of rotation in the meantime.
+func WithReadinessCheck(check ReadinessCheck) Option {
+	return func(s *Server) {
+		s.readinessChecks = append(s.readinessChecks, check)
+	}
+}
+
+// WithMIMEValidation makes the [Server] check the inline content of the file parts of the
+// messages of tasks/send, tasks/sendSubscribe and tasks/input/append against the MIME type they
+// declare, so that clients cannot pass off content as another type. Parts whose content is
+// clearly of another family of types, as checked by [a2a.FilePart.CheckMIMEType], are answered
+// with the content-type-not-supported error, and parts declaring no type get the sniffed one.
+//
+// Files sent by URI are only checked once fetched, with [WithFileResolver].
+func WithMIMEValidation(enabled bool) Option {
+	return func(s *Server) {
+		s.mimeValidation = enabled
+	}
+}
+
+// WithCORS makes the [Server] answer cross-origin requests from the origins allowed by config,
+// so that browser-based clients can call the agent directly. Preflight OPTIONS requests are
+// answered by the server, and the Access-Control headers are set on every response to an allowed
+// origin, event streams included, ahead of authentication so that browsers can read its errors.
+//
+// Without it, the default, the server sets no Access-Control headers.
+func WithCORS(config CORSConfig) Option {
+	return func(s *Server) {
+		s.cors = newCORS(config)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/go-a2a/a2a"
+)
+
+// ErrPushConfigNotFound is returned by a [PushNotificationStore] for a task without push notification config.
+var ErrPushConfigNotFound = errors.New("push notification config not found")
+
+// PushNotificationStore persists the push notification config of each task.
+//
+// Implementations must be safe for concurrent use.
+type PushNotificationStore interface {
+	// Set stores the config for a task, replacing any previous one.
+	Set(ctx context.Context, taskID string, config a2a.PushNotificationConfig) error
+
+	// Get returns the config for a task, or an error wrapping [ErrPushConfigNotFound].
+	Get(ctx context.Context, taskID string) (a2a.PushNotificationConfig, error)
+
+	// Delete removes the config for a task. Deleting a missing config is not an error.
+	Delete(ctx context.Context, taskID string) error
+}
+
+// InMemoryPushNotificationStore is an in-memory implementation of [PushNotificationStore].
+type InMemoryPushNotificationStore struct {
+	mu      sync.RWMutex
+	configs map[string]a2a.PushNotificationConfig
+}
+
+var _ PushNotificationStore = (*InMemoryPushNotificationStore)(nil)
+
+// NewInMemoryPushNotificationStore creates a new [InMemoryPushNotificationStore].
+func NewInMemoryPushNotificationStore() *InMemoryPushNotificationStore {
+	return &InMemoryPushNotificationStore{
+		configs: make(map[string]a2a.PushNotificationConfig),
+	}
+}
+
+// Set implements [PushNotificationStore].
+func (s *InMemoryPushNotificationStore) Set(ctx context.Context, taskID string, config a2a.PushNotificationConfig) error {
+	if taskID == "" {
+		return errors.New("task ID cannot be empty")
+	}
+	config.EventTypes = slices.Clone(config.EventTypes)
+
+	s.mu.Lock()
+	s.configs[taskID] = config
+	s.mu.Unlock()
+
+	return nil
+}
+
+// Get implements [PushNotificationStore].
+func (s *InMemoryPushNotificationStore) Get(ctx context.Context, taskID string) (a2a.PushNotificationConfig, error) {
+	s.mu.RLock()
+	config, ok := s.configs[taskID]
+	s.mu.RUnlock()
+
+	if !ok {
+		return a2a.PushNotificationConfig{}, fmt.Errorf("%w: %s", ErrPushConfigNotFound, taskID)
+	}
+	config.EventTypes = slices.Clone(config.EventTypes)
+	return config, nil
+}
+
+// Delete implements [PushNotificationStore].
+func (s *InMemoryPushNotificationStore) Delete(ctx context.Context, taskID string) error {
+	s.mu.Lock()
+	delete(s.configs, taskID)
+	s.mu.Unlock()
+
+	return nil
+}
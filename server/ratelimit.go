@@ -0,0 +1,186 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-a2a/a2a"
+)
+
+// RateLimiter decides whether a request may be served, see [WithRateLimiter].
+type RateLimiter interface {
+	// Allow reports whether a request identified by key may be served now, consuming
+	// its share of the allowance if so.
+	Allow(ctx context.Context, key string) bool
+}
+
+// RetryAfterer is implemented by rate limiters that can tell when a rejected key may retry.
+//
+// Its result is sent in the Retry-After header of rejected requests, which otherwise
+// ask the client to retry after one second.
+type RetryAfterer interface {
+	// RetryAfter returns how long the key has to wait before its next request is allowed.
+	RetryAfter(ctx context.Context, key string) time.Duration
+}
+
+// RateLimitKeyFunc returns the key a request is rate limited by.
+type RateLimitKeyFunc func(r *http.Request) string
+
+// KeyByCaller rate limits requests by their authenticated caller, see [CallerFrom], and
+// requests without one by their remote IP. It is the default [RateLimitKeyFunc].
+func KeyByCaller(r *http.Request) string {
+	if caller, ok := CallerFrom(r.Context()); ok && caller.ID != "" {
+		return "caller:" + caller.Scheme + ":" + caller.ID
+	}
+	return KeyByRemoteIP(r)
+}
+
+// KeyByRemoteIP rate limits requests by the IP address of the client connection.
+//
+// Behind a proxy, all requests share the proxy's address; use [KeyByHeader] with the header
+// the proxy sets instead.
+func KeyByRemoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "ip:" + host
+}
+
+// KeyByHeader returns a [RateLimitKeyFunc] keying requests by the value of the header name,
+// such as [DefaultAPIKeyHeader] or a session header set by the client. Requests without the
+// header are keyed by their remote IP.
+func KeyByHeader(name string) RateLimitKeyFunc {
+	return func(r *http.Request) string {
+		if v := r.Header.Get(name); v != "" {
+			return "header:" + v
+		}
+		return KeyByRemoteIP(r)
+	}
+}
+
+// TokenBucketLimiter is a [RateLimiter] giving each key a bucket of burst tokens, refilled at
+// a steady rate. Each allowed request takes one token.
+//
+// Buckets that have been refilled completely are forgotten, so idle keys cost no memory.
+type TokenBucketLimiter struct {
+	rate  float64
+	burst float64
+
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastPrune time.Time
+}
+
+// tokenBucket holds the tokens of one key as of last.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+var (
+	_ RateLimiter  = (*TokenBucketLimiter)(nil)
+	_ RetryAfterer = (*TokenBucketLimiter)(nil)
+)
+
+// NewTokenBucketLimiter creates a new [TokenBucketLimiter] allowing each key rate requests per
+// second on average, and bursts of up to burst requests.
+func NewTokenBucketLimiter(rate float64, burst int) *TokenBucketLimiter {
+	return &TokenBucketLimiter{
+		rate:    rate,
+		burst:   float64(max(burst, 1)),
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// Allow implements [RateLimiter].
+func (l *TokenBucketLimiter) Allow(ctx context.Context, key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.prune(now)
+
+	b := l.refill(key, now)
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// RetryAfter implements [RetryAfterer].
+func (l *TokenBucketLimiter) RetryAfter(ctx context.Context, key string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b := l.refill(key, time.Now())
+	if b.tokens >= 1 || l.rate <= 0 {
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+}
+
+// refill returns the bucket of key with the tokens earned since its last use. The caller must hold mu.
+func (l *TokenBucketLimiter) refill(key string, now time.Time) *tokenBucket {
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+		return b
+	}
+	b.tokens = min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+	b.last = now
+	return b
+}
+
+// prune forgets the buckets that are full again, at most once a minute. The caller must hold mu.
+func (l *TokenBucketLimiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < time.Minute {
+		return
+	}
+	l.lastPrune = now
+	for key, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// allowRequest applies the rate limiter of the server, if any, to r.
+//
+// A rejected request is answered with 429 Too Many Requests, a Retry-After header and a
+// JSON-RPC error, and allowRequest returns false.
+func (s *Server) allowRequest(w http.ResponseWriter, r *http.Request) bool {
+	if s.rateLimiter == nil {
+		return true
+	}
+
+	ctx := r.Context()
+	key := s.rateLimitKey(r)
+	if s.rateLimiter.Allow(ctx, key) {
+		return true
+	}
+
+	retryAfter := time.Second
+	if ra, ok := s.rateLimiter.(RetryAfterer); ok {
+		retryAfter = ra.RetryAfter(ctx, key)
+	}
+	s.logger.InfoContext(ctx, "rate limit exceeded", slog.String("key", key), slog.Duration("retry_after", retryAfter))
+
+	w.Header().Set("Retry-After", strconv.Itoa(max(1, int(math.Ceil(retryAfter.Seconds())))))
+	s.writeJSONRPCError(&statusWriter{ResponseWriter: w, status: http.StatusTooManyRequests}, r, &a2a.JSONRPCError{
+		Code:    a2a.InvalidRequestErrorCode,
+		Message: "Rate limit exceeded",
+	})
+	return false
+}
@@ -0,0 +1,110 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestServer_WithRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		opts []server.Option
+		// keys are the API keys the requests are sent with, round robin.
+		keys       []string
+		wantStatus map[int]int
+	}{
+		"by caller": {
+			keys:       []string{"secret"},
+			wantStatus: map[int]int{http.StatusOK: 5, http.StatusTooManyRequests: 15},
+		},
+		"by remote IP": {
+			opts:       []server.Option{server.WithRateLimitKey(server.KeyByRemoteIP)},
+			keys:       []string{"secret", "other"},
+			wantStatus: map[int]int{http.StatusOK: 5, http.StatusTooManyRequests: 15},
+		},
+		"by header": {
+			opts:       []server.Option{server.WithRateLimitKey(server.KeyByHeader(server.DefaultAPIKeyHeader))},
+			keys:       []string{"secret", "other"},
+			wantStatus: map[int]int{http.StatusOK: 10, http.StatusTooManyRequests: 10},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			opts := append([]server.Option{
+				server.WithAuthenticator(&server.APIKeyAuthenticator{
+					Verify: server.StaticCredentials(map[string]string{"secret": "alice", "other": "bob"}),
+				}),
+				// No token is refilled while the test runs.
+				server.WithRateLimiter(server.NewTokenBucketLimiter(0.001, 5)),
+			}, tt.opts...)
+			srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager(), opts...))
+			t.Cleanup(srv.Close)
+
+			var (
+				mu   sync.Mutex
+				got  = make(map[int]int)
+				wg   sync.WaitGroup
+				errs = make(chan error, 20)
+			)
+			for i := range 20 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+
+					req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, srv.URL,
+						strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"tasks/get","params":{"id":"missing"}}`))
+					if err != nil {
+						errs <- err
+						return
+					}
+					req.Header.Set("Content-Type", "application/json")
+					req.Header.Set(server.DefaultAPIKeyHeader, tt.keys[i%len(tt.keys)])
+					resp, err := http.DefaultClient.Do(req)
+					if err != nil {
+						errs <- err
+						return
+					}
+					body, _ := io.ReadAll(resp.Body)
+					resp.Body.Close()
+
+					if resp.StatusCode == http.StatusTooManyRequests {
+						if resp.Header.Get("Retry-After") == "" {
+							t.Error("429 response without Retry-After header")
+						}
+						if want := `{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"Rate limit exceeded"}}`; string(body) != want {
+							t.Errorf("429 body = %s, want %s", body, want)
+						}
+					}
+					mu.Lock()
+					got[resp.StatusCode]++
+					mu.Unlock()
+				}()
+			}
+			wg.Wait()
+			close(errs)
+			for err := range errs {
+				t.Fatal(err)
+			}
+
+			if diff := gocmp.Diff(tt.wantStatus, got); diff != "" {
+				t.Errorf("status counts mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/go-a2a/a2a"
+)
+
+// TaskInternalErrorCode is the [a2a.TaskError] code of the tasks failed by [WithRecovery]
+// because their handler panicked.
+const TaskInternalErrorCode = a2a.TaskErrInternal
+
+// handlerPanic carries a panic of a handler run in another goroutine, along with the stack it
+// panicked at, to the goroutine serving the request.
+type handlerPanic struct {
+	value any
+	stack []byte
+}
+
+// Error implements error.
+func (p *handlerPanic) Error() string {
+	return fmt.Sprintf("handler panicked: %v", p.value)
+}
+
+// recovered returns the panic value v, and the stack it panicked at, unwrapping the panics of
+// handlers run in another goroutine.
+func recovered(v any) (any, []byte) {
+	if p, ok := v.(*handlerPanic); ok {
+		return p.value, p.stack
+	}
+	return v, debug.Stack()
+}
+
+// panicStatus returns the failed status of a task whose handler panicked, with a
+// [TaskInternalErrorCode] error.
+func panicStatus() a2a.TaskStatus {
+	return a2a.TaskStatus{
+		State: a2a.TaskStateFailed,
+		Message: &a2a.Message{
+			Role:  a2a.RoleAgent,
+			Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "internal error"}},
+		},
+		Error:     a2a.NewTaskError(TaskInternalErrorCode, "the agent failed to process the task"),
+		Timestamp: time.Now().UTC(),
+	}
+}
+
+// panicTaskID returns the ID of the task run by a call with params, or the empty string if the
+// call runs no task.
+func panicTaskID(params any) string {
+	switch params := params.(type) {
+	case a2a.TaskSendParams:
+		return params.ID
+	case a2a.TaskInputParams:
+		return params.ID
+	}
+	return ""
+}
+
+// recoverHandler recovers from a panic of the handler of req, logging it along with its stack,
+// failing the task the handler ran, and writing an internal error in response.
+// It must be deferred.
+func (s *Server) recoverHandler(w http.ResponseWriter, r *http.Request, req *a2a.JSONRPCRequest, params any) {
+	v := recover()
+	if v == nil {
+		return
+	}
+	if v == http.ErrAbortHandler {
+		panic(v)
+	}
+
+	ctx := r.Context()
+	value, stack := recovered(v)
+	s.logger.ErrorContext(ctx, "handler panicked",
+		slog.String("method", req.Method),
+		slog.Any("panic", value),
+		slog.String("stack", string(stack)),
+	)
+	if taskID := panicTaskID(params); taskID != "" {
+		s.failTask(context.WithoutCancel(ctx), taskID, panicStatus(), true)
+	}
+	s.writeJSONRPCError(w, r, a2a.NewInternalError())
+}
+
+// recoverStream recovers from a panic of the handler streaming the task taskID to sw, logging
+// it along with its stack, failing the task, and ending the stream with the failed status.
+// It must be deferred.
+func (s *Server) recoverStream(ctx context.Context, timer *taskTimer, taskID string, sw *sseWriter) {
+	v := recover()
+	if v == nil {
+		return
+	}
+	if v == http.ErrAbortHandler {
+		panic(v)
+	}
+
+	value, stack := recovered(v)
+	s.logger.ErrorContext(ctx, "handler panicked",
+		slog.String("method", a2a.MethodTasksSendSubscribe),
+		slog.String("task_id", taskID),
+		slog.Any("panic", value),
+		slog.String("stack", string(stack)),
+	)
+	status := panicStatus()
+	// The stream notifies the failed status along with sending it.
+	s.failTask(context.WithoutCancel(ctx), taskID, status, false)
+	sw.end(status)
+	s.settleStream(context.WithoutCancel(ctx), timer, taskID)
+}
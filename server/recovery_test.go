@@ -0,0 +1,154 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+func postRPC(t *testing.T, url, method, params string) *a2a.JSONRPCResponse {
+	t.Helper()
+
+	body := fmt.Sprintf(`{"jsonrpc":"2.0","id":7,"method":%q,"params":%s}`, method, params)
+	resp, err := http.Post(url, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	var got a2a.JSONRPCResponse
+	if err := sonic.ConfigDefault.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	return &got
+}
+
+func TestServer_Recovery(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		opts []server.Option
+	}{
+		"without timeout": {},
+		"with timeout": {
+			// The handler runs in a goroutine of its own, watched by the timer of the task.
+			opts: []server.Option{server.WithTaskTimeout(time.Minute)},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tm := &slowTaskManager{
+				InMemoryTaskManager: server.NewInMemoryTaskManager(),
+				work: func(context.Context, *server.InMemoryTaskManager, string) error {
+					panic("boom")
+				},
+			}
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			srv := httptest.NewServer(server.NewServer("", "", card, tm, tt.opts...))
+			t.Cleanup(srv.Close)
+
+			got := postRPC(t, srv.URL, a2a.MethodTasksSend,
+				`{"id":"task-1","message":{"role":"user","parts":[{"type":"text","text":"hi"}]}}`)
+			if got.Error == nil || got.Error.Code != a2a.InternalErrorCode {
+				t.Fatalf("error = %v, want code %d", got.Error, a2a.InternalErrorCode)
+			}
+			if id := got.ID.String(); id != "7" {
+				t.Errorf("id = %v, want 7", got.ID)
+			}
+
+			resp, err := tm.OnGetTask(t.Context(), &a2a.GetTaskRequest{
+				Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}},
+			})
+			if err != nil {
+				t.Fatalf("OnGetTask() error = %v", err)
+			}
+			status := resp.Result.Status
+			if status.State != a2a.TaskStateFailed || status.Error == nil || status.Error.Code != server.TaskInternalErrorCode {
+				t.Errorf("task status = %s with error %v, want %s with code %q", status.State, status.Error, a2a.TaskStateFailed, server.TaskInternalErrorCode)
+			}
+		})
+	}
+}
+
+func TestServer_RecoveryDisabled(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := server.NewServer("", "", card, nil, server.WithRecovery(false))
+	srv.Handle("agent/boom", server.HandlerFunc(func(ctx context.Context, params any) (any, error) {
+		panic("boom")
+	}))
+
+	defer func() {
+		if got := recover(); got != "boom" {
+			t.Errorf("recover() = %v, want boom", got)
+		}
+	}()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"agent/boom"}`))
+	req.Header.Set("Content-Type", "application/json")
+	srv.ServeHTTP(httptest.NewRecorder(), req)
+}
+
+func TestServer_RecoveryStream(t *testing.T) {
+	t.Parallel()
+
+	srv := newStreamingServer(t, func(ctx context.Context, w server.StreamWriter) error {
+		if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking}); err != nil {
+			return err
+		}
+		panic("boom")
+	})
+
+	resp := postSendSubscribe(t.Context(), t, srv.URL)
+	defer resp.Body.Close()
+
+	type frame struct {
+		ID     int `json:"id"`
+		Result struct {
+			Status a2a.TaskStatus `json:"status"`
+			Final  bool           `json:"final"`
+		} `json:"result"`
+	}
+	var frames []frame
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+		if !ok {
+			continue
+		}
+		var f frame
+		if err := sonic.ConfigDefault.Unmarshal([]byte(data), &f); err != nil {
+			t.Fatalf("Unmarshal(%q) error = %v", data, err)
+		}
+		frames = append(frames, f)
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("read stream: %v", err)
+	}
+
+	if len(frames) != 2 {
+		t.Fatalf("got %d events, want 2", len(frames))
+	}
+	last := frames[1]
+	if last.ID != 42 || !last.Result.Final || last.Result.Status.State != a2a.TaskStateFailed {
+		t.Errorf("last event: id = %d, final = %t, state = %s, want 42, true and %s", last.ID, last.Result.Final, last.Result.Status.State, a2a.TaskStateFailed)
+	}
+	if err := last.Result.Status.Error; err == nil || err.Code != server.TaskInternalErrorCode {
+		t.Errorf("last event error = %v, want code %q", err, server.TaskInternalErrorCode)
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-a2a/a2a"
+)
+
+// replayRetention is how long the events of a stream stay available for replay after the stream ended.
+const replayRetention = 5 * time.Minute
+
+// errNoEventLog is returned by [eventLog.follow] for a task without recorded events.
+var errNoEventLog = errors.New("no events recorded for task")
+
+// loggedEvent is a streamed task event with the server-sent event ID it was sent with.
+type loggedEvent struct {
+	id    int64
+	event a2a.TaskEvent
+}
+
+// taskEvents holds the recent events of the streams of one task.
+type taskEvents struct {
+	// last is the ID of the last event recorded. IDs start at 1 and keep increasing
+	// across the streams of the task.
+	last int64
+
+	// events holds the most recent events, oldest first.
+	events []loggedEvent
+
+	// streams is the number of open streams of the task.
+	streams int
+
+	// changed is closed, and replaced, whenever an event is recorded or the stream ends.
+	changed chan struct{}
+
+	// expiry deletes the events once the stream has been over for [replayRetention].
+	expiry *time.Timer
+}
+
+// eventLog keeps the recent events streamed for each task, so that a client resubscribing
+// with a Last-Event-ID header can resume where its stream dropped. See [WithEventReplay].
+type eventLog struct {
+	// size is the number of events kept per task.
+	size int
+
+	mu    sync.Mutex
+	tasks map[string]*taskEvents
+}
+
+func newEventLog(size int) *eventLog {
+	return &eventLog{
+		size:  size,
+		tasks: make(map[string]*taskEvents),
+	}
+}
+
+// open marks the start of a stream of taskID.
+func (l *eventLog) open(taskID string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	te, ok := l.tasks[taskID]
+	if !ok {
+		te = &taskEvents{changed: make(chan struct{})}
+		l.tasks[taskID] = te
+	}
+	if te.expiry != nil {
+		te.expiry.Stop()
+		te.expiry = nil
+	}
+	te.streams++
+}
+
+// append records event for taskID and returns its event ID.
+func (l *eventLog) append(taskID string, event a2a.TaskEvent) int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	te, ok := l.tasks[taskID]
+	if !ok {
+		return 0
+	}
+	te.last++
+	te.events = append(te.events, loggedEvent{id: te.last, event: event})
+	if len(te.events) > l.size {
+		te.events = slices.Delete(te.events, 0, len(te.events)-l.size)
+	}
+	te.notify()
+	return te.last
+}
+
+// close marks the end of a stream of taskID. Once no stream of the task is open, its events
+// are deleted after [replayRetention], unless another stream of the task starts first.
+func (l *eventLog) close(taskID string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	te, ok := l.tasks[taskID]
+	if !ok {
+		return
+	}
+	te.streams--
+	te.notify()
+	if te.streams > 0 {
+		return
+	}
+	te.expiry = time.AfterFunc(replayRetention, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if te.streams == 0 && l.tasks[taskID] == te {
+			delete(l.tasks, taskID)
+		}
+	})
+}
+
+// notify wakes the followers of the task. The caller must hold the log's mu.
+func (te *taskEvents) notify() {
+	close(te.changed)
+	te.changed = make(chan struct{})
+}
+
+// has reports whether events are recorded for taskID.
+func (l *eventLog) has(taskID string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	_, ok := l.tasks[taskID]
+	return ok
+}
+
+// follow calls send with each event of taskID recorded after the event with ID after, in
+// order, including events recorded while following, until the stream of the task ends, send
+// fails, or ctx is done.
+//
+// It returns errNoEventLog if no events are recorded for taskID. Events older than the
+// last [WithEventReplay] events of the task are no longer available, and are skipped.
+func (l *eventLog) follow(ctx context.Context, taskID string, after int64, send func(loggedEvent) error) error {
+	for first := true; ; first = false {
+		l.mu.Lock()
+		te, ok := l.tasks[taskID]
+		if !ok {
+			l.mu.Unlock()
+			if first {
+				return errNoEventLog
+			}
+			// The events expired while following an ended stream.
+			return nil
+		}
+		i, _ := slices.BinarySearchFunc(te.events, after+1, func(e loggedEvent, id int64) int { return cmp.Compare(e.id, id) })
+		pending := slices.Clone(te.events[i:])
+		streaming, changed := te.streams > 0, te.changed
+		l.mu.Unlock()
+
+		for _, e := range pending {
+			if err := send(e); err != nil {
+				return err
+			}
+			after = e.id
+		}
+		if len(pending) > 0 {
+			continue
+		}
+		if !streaming {
+			return nil
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
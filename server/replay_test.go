@@ -0,0 +1,221 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/sonic"
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+// eventIDs returns the server-sent event IDs in an event stream body.
+func eventIDs(body string) []string {
+	var ids []string
+	for line := range strings.Lines(body) {
+		if id, ok := strings.CutPrefix(strings.TrimSuffix(line, "\n"), "id: "); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func TestServer_WithEventReplay(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		lastEventID string
+		wantIDs     []string
+		wantCode    int
+	}{
+		"all kept events": {
+			// Only the last two of the three events are kept.
+			wantIDs: []string{"2", "3"},
+		},
+		"after last event ID": {
+			lastEventID: "2",
+			wantIDs:     []string{"3"},
+		},
+		"nothing missed": {
+			lastEventID: "3",
+		},
+		"invalid last event ID": {
+			lastEventID: "three",
+			wantCode:    a2a.InvalidRequestErrorCode,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newStreamingServer(t, streamThreeUpdates, server.WithEventReplay(2))
+
+			resp := postSendSubscribe(t.Context(), t, srv.URL)
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if diff := gocmp.Diff([]string{"1", "2", "3"}, eventIDs(string(body))); diff != "" {
+				t.Fatalf("stream event IDs mismatch (-want +got):\n%s", diff)
+			}
+
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, srv.URL,
+				strings.NewReader(`{"jsonrpc":"2.0","id":43,"method":"tasks/resubscribe","params":{"id":"task-1"}}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			if tt.lastEventID != "" {
+				req.Header.Set("Last-Event-ID", tt.lastEventID)
+			}
+			resp, err = http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			body, _ = io.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			if tt.wantCode != 0 {
+				var rpcResp a2a.JSONRPCResponse
+				if err := sonic.ConfigFastest.Unmarshal(body, &rpcResp); err != nil {
+					t.Fatalf("Unmarshal() error = %v, body %s", err, body)
+				}
+				if rpcResp.Error == nil || rpcResp.Error.Code != tt.wantCode {
+					t.Errorf("resubscribe error = %v, want code %d", rpcResp.Error, tt.wantCode)
+				}
+				return
+			}
+			if got := resp.Header.Get("Content-Type"); got != "text/event-stream" {
+				t.Fatalf("resubscribe Content-Type = %q, body %s", got, body)
+			}
+			if diff := gocmp.Diff(tt.wantIDs, eventIDs(string(body))); diff != "" {
+				t.Errorf("replayed event IDs mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServer_ResubscribeTask(t *testing.T) {
+	t.Parallel()
+
+	type frame struct {
+		Result struct {
+			ID     string         `json:"id"`
+			Status a2a.TaskStatus `json:"status"`
+			Final  bool           `json:"final"`
+		} `json:"result"`
+	}
+	tests := map[string]struct {
+		taskID     string
+		wantStates []a2a.TaskState
+		wantCode   int
+	}{
+		"unknown task": {
+			taskID:   "missing",
+			wantCode: a2a.TaskNotFoundErrorCode,
+		},
+		"terminal task without kept events": {
+			taskID:     "done",
+			wantStates: []a2a.TaskState{a2a.TaskStateCompleted},
+		},
+		"active task": {
+			taskID:     "task-1",
+			wantStates: []a2a.TaskState{a2a.TaskStateWorking, a2a.TaskStateCompleted},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			store := server.NewInMemoryTaskStore()
+			if _, err := store.Create(t.Context(), &a2a.Task{ID: "done", Status: a2a.TaskStatus{State: a2a.TaskStateCompleted}}); err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			release := make(chan struct{})
+			srv := newStreamingServer(t, func(ctx context.Context, w server.StreamWriter) error {
+				if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking}); err != nil {
+					return err
+				}
+				select {
+				case <-release:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+				return w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateCompleted})
+			}, server.WithEventReplay(10), server.WithTaskStore(store))
+
+			// Keep task-1 streaming until the resubscriber got its first event.
+			stream := postSendSubscribe(t.Context(), t, srv.URL)
+			t.Cleanup(func() { stream.Body.Close() })
+			if !bufio.NewScanner(stream.Body).Scan() {
+				t.Fatal("task-1 stream ended before its first event")
+			}
+
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, srv.URL,
+				strings.NewReader(`{"jsonrpc":"2.0","id":43,"method":"tasks/resubscribe","params":{"id":"`+tt.taskID+`"}}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			if tt.wantCode != 0 {
+				close(release)
+				body, _ := io.ReadAll(resp.Body)
+				var rpcResp a2a.JSONRPCResponse
+				if err := sonic.ConfigFastest.Unmarshal(body, &rpcResp); err != nil {
+					t.Fatalf("Unmarshal() error = %v, body %s", err, body)
+				}
+				if rpcResp.Error == nil || rpcResp.Error.Code != tt.wantCode {
+					t.Errorf("resubscribe error = %v, want code %d", rpcResp.Error, tt.wantCode)
+				}
+				return
+			}
+			if got := resp.Header.Get("Content-Type"); got != "text/event-stream" {
+				t.Fatalf("resubscribe Content-Type = %q", got)
+			}
+
+			var frames []frame
+			scanner := bufio.NewScanner(resp.Body)
+			for scanner.Scan() {
+				data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+				if !ok {
+					continue
+				}
+				var f frame
+				if err := sonic.ConfigFastest.Unmarshal([]byte(data), &f); err != nil {
+					t.Fatalf("Unmarshal(%q) error = %v", data, err)
+				}
+				if len(frames) == 0 {
+					close(release)
+				}
+				frames = append(frames, f)
+			}
+
+			var states []a2a.TaskState
+			for _, f := range frames {
+				if f.Result.ID != tt.taskID {
+					t.Errorf("event task ID = %q, want %q", f.Result.ID, tt.taskID)
+				}
+				states = append(states, f.Result.Status.State)
+			}
+			if diff := gocmp.Diff(tt.wantStates, states); diff != "" {
+				t.Errorf("resubscribed states mismatch (-want +got):\n%s", diff)
+			}
+			if len(frames) > 0 && !frames[len(frames)-1].Result.Final {
+				t.Error("last event not marked final")
+			}
+		})
+	}
+}
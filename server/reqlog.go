@@ -0,0 +1,168 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/go-a2a/a2a"
+)
+
+// redacted replaces the values of redacted members in logged params.
+const redacted = "[REDACTED]"
+
+// Redactor returns the value to log in place of the member key of a JSON object found in the
+// params of a request, and whether to replace it, see [WithRedactor].
+//
+// The value is the member decoded as generic JSON: a string, float64, bool, nil,
+// []any or map[string]any. A Redactor is called for every member at any depth, including
+// the members of the data of data parts.
+type Redactor func(key string, value any) (any, bool)
+
+// redactSecrets is the built-in [Redactor]. It redacts credentials and push notification
+// tokens and secrets, and replaces file contents with their size.
+func redactSecrets(key string, value any) (any, bool) {
+	switch key {
+	case "credentials", "token", "secret":
+		return redacted, true
+	case "bytes", "fileBytes":
+		if content, ok := value.(string); ok {
+			padding := len(content) - len(strings.TrimRight(content, "="))
+			return fmt.Sprintf("[%d bytes]", base64.StdEncoding.DecodedLen(len(content))-padding), true
+		}
+	}
+	return nil, false
+}
+
+// redactValue applies redactors to the members of the objects in v, in place, and returns v.
+func redactValue(v any, redactors []Redactor) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, member := range v {
+			replaced := false
+			for _, redact := range redactors {
+				if value, ok := redact(key, member); ok {
+					v[key], replaced = value, true
+					break
+				}
+			}
+			if !replaced {
+				v[key] = redactValue(member, redactors)
+			}
+		}
+	case []any:
+		for i, elem := range v {
+			v[i] = redactValue(elem, redactors)
+		}
+	}
+	return v
+}
+
+// callOutcome records the error a JSON-RPC call is answered with, for the request log and metrics.
+type callOutcome struct {
+	err *a2a.JSONRPCError
+}
+
+type callOutcomeKey struct{}
+
+// recordOutcome records jerr as the outcome of the call being served, if it is logged or measured.
+func recordOutcome(ctx context.Context, jerr *a2a.JSONRPCError) {
+	if outcome, ok := ctx.Value(callOutcomeKey{}).(*callOutcome); ok && outcome.err == nil {
+		outcome.err = jerr
+	}
+}
+
+// withCallOutcome returns r with a context recording the outcome of the call being served,
+// along with the outcome. The outcome is shared by every caller.
+func withCallOutcome(r *http.Request) (*http.Request, *callOutcome) {
+	if outcome, ok := r.Context().Value(callOutcomeKey{}).(*callOutcome); ok {
+		return r, outcome
+	}
+	outcome := &callOutcome{}
+	return r.WithContext(context.WithValue(r.Context(), callOutcomeKey{}, outcome)), outcome
+}
+
+// startCallLog starts logging the JSON-RPC call req, and returns r with a context recording
+// its outcome along with the function logging it once served.
+//
+// Each call is logged with its method, task ID, caller and duration, at info level if it
+// succeeded and at error level with the JSON-RPC error otherwise. The params are only logged
+// at debug level, after redaction. No header is logged, so neither the Authorization header
+// nor API keys can reach the log.
+func (s *Server) startCallLog(r *http.Request, req *a2a.JSONRPCRequest) (*http.Request, func()) {
+	if !s.requestLogging {
+		return r, func() {}
+	}
+
+	start := time.Now()
+	r, outcome := withCallOutcome(r)
+	ctx := r.Context()
+
+	return r, func() {
+		attrs := []slog.Attr{
+			slog.String("method", req.Method),
+			slog.String("request_id", req.ID.String()),
+		}
+		if taskID := paramString(req.Params, "id"); taskID != "" {
+			attrs = append(attrs, slog.String("task_id", taskID))
+		}
+		if caller, ok := CallerFrom(ctx); ok {
+			attrs = append(attrs, slog.String("caller", caller.ID))
+		}
+		attrs = append(attrs, slog.Duration("duration", time.Since(start)))
+		if s.logger.Enabled(ctx, slog.LevelDebug) {
+			attrs = append(attrs, slog.Any("params", s.redactParams(req.Params)))
+		}
+
+		if outcome.err != nil {
+			attrs = append(attrs,
+				slog.String("outcome", "error"),
+				slog.Int("error_code", outcome.err.Code),
+				slog.String("error", outcome.err.Message),
+			)
+			s.logger.LogAttrs(ctx, slog.LevelError, "request failed", attrs...)
+			return
+		}
+		attrs = append(attrs, slog.String("outcome", "ok"))
+		s.logger.LogAttrs(ctx, slog.LevelInfo, "request served", attrs...)
+	}
+}
+
+// redactParams returns params decoded as generic JSON, with the redactors of the server applied.
+func (s *Server) redactParams(params []byte) any {
+	if len(params) == 0 {
+		return nil
+	}
+	var v any
+	if err := sonic.ConfigFastest.Unmarshal(params, &v); err != nil {
+		return redacted
+	}
+	return redactValue(v, s.redactors)
+}
+
+// paramString returns the string member key of the params object, or "" if there is none.
+//
+// The params are not decoded, since the handler decodes and validates them itself.
+func paramString(params []byte, key string) string {
+	if len(params) == 0 {
+		return ""
+	}
+	node, err := sonic.Get(params, key)
+	if err != nil {
+		return ""
+	}
+	s, err := node.String()
+	if err != nil {
+		return ""
+	}
+	return s
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestServer_WithRequestLogging(t *testing.T) {
+	t.Parallel()
+
+	const send = `{"jsonrpc":"2.0","id":1,"method":"tasks/send","params":{"id":"task-1",` +
+		`"message":{"role":"user","parts":[` +
+		`{"type":"file","file":{"mimeType":"text/plain","bytes":"aGVsbG8="}},` +
+		`{"type":"data","data":{"name":"alice","ssn":"123-45-6789"}}]},` +
+		`"pushNotification":{"url":"https://example.com/hook","token":"push-token",` +
+		`"authentication":{"schemes":["bearer"],"credentials":"secret-credentials"}}}}`
+
+	tests := map[string]struct {
+		body    string
+		opts    []server.Option
+		level   slog.Level
+		want    []string
+		wantNot []string
+	}{
+		"served call": {
+			body:    send,
+			level:   slog.LevelInfo,
+			want:    []string{`"level":"INFO"`, `"msg":"request served"`, `"method":"tasks/send"`, `"task_id":"task-1"`, `"outcome":"ok"`, `"duration":`},
+			wantNot: []string{`"params"`},
+		},
+		"redacted params": {
+			body: send,
+			opts: []server.Option{server.WithRedactor(func(key string, value any) (any, bool) {
+				return "***", key == "ssn"
+			})},
+			level:   slog.LevelDebug,
+			want:    []string{`"params":`, `"bytes":"[5 bytes]"`, `"mimeType":"text/plain"`, `"credentials":"[REDACTED]"`, `"token":"[REDACTED]"`, `"ssn":"***"`, `"name":"alice"`},
+			wantNot: []string{"aGVsbG8=", "secret-credentials", "push-token", "123-45-6789"},
+		},
+		"failed call": {
+			body:  `{"jsonrpc":"2.0","id":2,"method":"tasks/get","params":{"id":"missing"}}`,
+			level: slog.LevelInfo,
+			want:  []string{`"level":"ERROR"`, `"msg":"request failed"`, `"task_id":"missing"`, `"outcome":"error"`, `"error_code":-32001`},
+		},
+		"disabled": {
+			body:    send,
+			opts:    []server.Option{server.WithRequestLogging(false)},
+			level:   slog.LevelDebug,
+			wantNot: []string{`"method":"tasks/send"`},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var logs bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: tt.level}))
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			opts := append([]server.Option{server.WithLogger(logger)}, tt.opts...)
+			srv := server.NewServer("", "", card, server.NewInMemoryTaskManager(), opts...)
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer header-secret")
+			srv.ServeHTTP(httptest.NewRecorder(), req)
+
+			got := logs.String()
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("log does not contain %s:\n%s", want, got)
+				}
+			}
+			for _, notWant := range append(tt.wantNot, "header-secret") {
+				if strings.Contains(got, notWant) {
+					t.Errorf("log contains %s:\n%s", notWant, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/go-a2a/a2a"
+)
+
+type requestIDKey struct{}
+
+// withRequestID returns a copy of ctx carrying the ID of the JSON-RPC request being served.
+func withRequestID(ctx context.Context, id a2a.ID) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the ID of the JSON-RPC request being served, as sent by the
+// client: a string, a number or null. Handlers use it to log the call or to correlate work
+// they schedule, such as a push notification, with it. It is set for the handlers of every
+// transport, streaming ones included, and reports false for notifications, which have no ID.
+func RequestIDFromContext(ctx context.Context) (a2a.ID, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(a2a.ID)
+	return id, ok
+}
+
+// connRequests tracks the IDs of the requests in flight on a connection carrying many
+// requests at once, a WebSocket or the stdio transport, whose responses are told apart by ID.
+type connRequests struct {
+	mu  sync.Mutex
+	ids map[string]struct{}
+}
+
+type connRequestsKey struct{}
+
+// withConnRequests returns a copy of ctx tracking the IDs of the requests in flight on the
+// connection served with it.
+func withConnRequests(ctx context.Context) context.Context {
+	return context.WithValue(ctx, connRequestsKey{}, &connRequests{ids: make(map[string]struct{})})
+}
+
+// claimRequestID marks id in flight on the connection of ctx until release is called. It
+// reports false if a request with the same ID is already in flight on the connection.
+// Notifications, null IDs and requests served apart from such a connection always claim theirs.
+func claimRequestID(ctx context.Context, id a2a.ID) (release func(), ok bool) {
+	conn, _ := ctx.Value(connRequestsKey{}).(*connRequests)
+	if conn == nil || id.IsAbsent() || id.IsNull() {
+		return func() {}, true
+	}
+	// Quoting tells the string ID "1" from the number 1.
+	key := fmt.Sprintf("%q", id)
+
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+	if _, ok := conn.ids[key]; ok {
+		return nil, false
+	}
+	conn.ids[key] = struct{}{}
+	return func() {
+		conn.mu.Lock()
+		defer conn.mu.Unlock()
+		delete(conn.ids, key)
+	}, true
+}
+
+type methodKey struct{}
+
+// withMethod returns a copy of ctx carrying the JSON-RPC method being served.
+func withMethod(ctx context.Context, method string) context.Context {
+	return context.WithValue(ctx, methodKey{}, method)
+}
+
+// MethodFrom returns the JSON-RPC method of the call being served, for handlers registered
+// with [Server.HandlePrefix] serving several methods.
+func MethodFrom(ctx context.Context) (string, bool) {
+	method, ok := ctx.Value(methodKey{}).(string)
+	return method, ok
+}
+
+// discardWriter is the [http.ResponseWriter] handed to the handler of a notification: the
+// handler runs as usual, but nothing it writes reaches the client.
+type discardWriter struct {
+	header http.Header
+}
+
+var _ http.Flusher = (*discardWriter)(nil)
+
+// Header implements [http.ResponseWriter].
+func (w *discardWriter) Header() http.Header {
+	if w.header == nil {
+		w.header = make(http.Header)
+	}
+	return w.header
+}
+
+// Write implements [http.ResponseWriter].
+func (w *discardWriter) Write(p []byte) (int, error) { return len(p), nil }
+
+// WriteHeader implements [http.ResponseWriter].
+func (w *discardWriter) WriteHeader(int) {}
+
+// Flush implements [http.Flusher], so streaming handlers can run for notifications too.
+func (w *discardWriter) Flush() {}
@@ -6,19 +6,22 @@ package server
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
 	"net"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
-	"github.com/bytedance/sonic"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
 	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/net/http2"
@@ -49,9 +52,172 @@ type Server struct {
 	// agentCard is the agent card for the server.
 	agentCard *a2a.AgentCard
 
+	// wellKnownPath is the path the agent card is served at.
+	wellKnownPath string
+
+	// cardEncoders maps each media type the agent card can be served as to its encoder.
+	cardEncoders map[string]CardEncoder
+
 	// taskManager is the task manager to use.
 	taskManager TaskManager
 
+	// pushStore holds push notification configs, or is nil when push notifications are not supported.
+	pushStore PushNotificationStore
+
+	// notifierOpts configure the notifier delivering task events to push notification webhooks.
+	notifierOpts []NotifierOption
+
+	// notifier delivers task events to push notification webhooks, or is nil without a push store.
+	notifier *Notifier
+
+	// taskStore, if set, replaces the store of a task manager implementing [TaskStoreHolder].
+	taskStore TaskStore
+
+	// errorEncoder renders JSON-RPC errors to the HTTP response.
+	errorEncoder ErrorEncoder
+
+	// codec encodes the JSON-RPC responses and batches of the server.
+	codec a2a.Codec
+
+	// batchConcurrency is how many requests of a batch are served at once, and batchTimeout,
+	// if positive, how long a batch may take, see [WithBatchLimits].
+	batchConcurrency int
+	batchTimeout     time.Duration
+
+	// maxDataDepth is the maximum nesting depth accepted for incoming data parts.
+	maxDataDepth int
+
+	// maxRequestBytes is the maximum size of a request body, or zero for no limit.
+	maxRequestBytes int64
+
+	// maxFileBytes is the maximum decoded size of an incoming file part, or zero for no limit.
+	maxFileBytes int64
+
+	// maxMessageParts and maxMessageBytes limit the parts and content of incoming task messages,
+	// see [WithMessageLimits].
+	maxMessageParts int
+	maxMessageBytes int64
+
+	// requestLogging logs each JSON-RPC call served, see [WithRequestLogging].
+	requestLogging bool
+	// recovery recovers from the panics of handlers, see [WithRecovery].
+	recovery bool
+	// streamingFallback serves tasks/sendSubscribe with the tasks/send handler when it is not
+	// served otherwise, see [WithStreamingFallback].
+	streamingFallback bool
+
+	// redactors redact the params of logged calls, starting with the built-in one.
+	redactors []Redactor
+
+	// methods maps each JSON-RPC method served to its handler, see [Server.Handle].
+	methods map[string]methodHandler
+
+	// registered maps the methods registered with [Server.Handle] to their handler.
+	registered map[string]Handler
+
+	// skills maps the skills registered with [Server.RegisterSkill] to their function.
+	skills map[string]*skillFunc
+
+	// prefixes holds the handlers registered with [Server.HandlePrefix], longest prefix first.
+	prefixes []prefixRoute
+
+	// capabilities, if set, overrides the inferred capabilities advertised, see [WithCapabilities].
+	capabilities *a2a.AgentCapabilities
+
+	// taskTimeout is how long a task may run before it fails, see [WithTaskTimeout].
+	taskTimeout time.Duration
+
+	// taskTimers fails the tasks that outlive their deadline.
+	taskTimers taskTimers
+
+	// runningTasks cancels the handlers of the tasks canceled with tasks/cancel.
+	runningTasks runningTasks
+
+	// sessionLimit is the number of tasks each session may run at once, see
+	// [WithSessionConcurrency], and sessionQueue the number that may wait, if not negative.
+	sessionLimit int
+	sessionQueue int
+
+	// metrics receives the measurements of the server, see [WithMetrics].
+	metrics Metrics
+
+	// sessions caps the tasks in flight per session, or is nil without [WithSessionConcurrency].
+	sessions *sessionLimiter
+
+	// webSocket serves the WebSocket transport at [WebSocketPath], see [WithWebSocket].
+	webSocket bool
+
+	// autoSessionID assigns a new session ID to tasks/send requests that omit one.
+	autoSessionID bool
+
+	// idGenerator mints the IDs of new tasks and sessions, see [WithIDGenerator].
+	idGenerator IDGenerator
+
+	// idValidator, if set, rejects task IDs sent by clients, see [WithIDValidator].
+	idValidator IDValidator
+
+	// serverTiming enables Server-Timing headers on unary responses.
+	serverTiming bool
+
+	// fileResolver, if set, fetches the files sent by URI, see [WithFileResolver].
+	fileResolver FileResolver
+
+	// mimeValidation rejects file parts whose content does not match their MIME type, see
+	// [WithMIMEValidation].
+	mimeValidation bool
+
+	// readinessChecks must pass for the server to be ready, see [WithReadinessCheck].
+	readinessChecks []ReadinessCheck
+
+	// metadataPropagator, if set, adds request metadata to artifact parts, see
+	// [WithMetadataPropagation].
+	metadataPropagator MetadataPropagator
+
+	// compression, if set, compresses the responses of the A2A endpoint, see [WithCompression].
+	compression *compression
+
+	// maxFrameRate limits the server-sent events written per second to each subscriber.
+	maxFrameRate int
+
+	// streamKeepalive is how long a stream may stay silent before a keepalive comment is
+	// written, see [WithStreamKeepalive].
+	streamKeepalive time.Duration
+
+	// streamCoalesce is how long artifact chunks are held back to be merged into fewer events,
+	// see [WithStreamCoalesce].
+	streamCoalesce time.Duration
+
+	// eventReplay is the number of streamed events kept per task for resubscribing clients.
+	eventReplay int
+
+	// events records streamed events for resubscribing clients, or is nil without [WithEventReplay].
+	events *eventLog
+
+	// journal, if set, records what happens to each task, see [WithEventJournal].
+	journal EventJournal
+
+	// cors, if set, lets browsers call the server from other origins, see [WithCORS].
+	cors *cors
+
+	// authenticator, if set, authenticates the requests to the A2A endpoint.
+	authenticator Authenticator
+
+	// requiredSchemes holds the authentication schemes required by methods, see
+	// [Server.RequireScheme].
+	requiredSchemes map[string][]string
+
+	// rateLimiter, if set, limits the rate of requests to the A2A endpoint per key.
+	rateLimiter RateLimiter
+
+	// rateLimitKey returns the key a request is rate limited by.
+	rateLimitKey RateLimitKeyFunc
+
+	// inFlight tracks the requests being served, see [Server.Shutdown].
+	inFlight inFlight
+
+	// propagator extracts the trace context from incoming requests.
+	propagator propagation.TextMapPropagator
+
 	// logger is the logger to use.
 	logger *slog.Logger
 
@@ -62,10 +228,25 @@ type Server struct {
 // NewServer creates a new [Server].
 func NewServer(host, port string, agentCard *a2a.AgentCard, taskManager TaskManager, opts ...Option) *Server {
 	s := &Server{
-		endpoint:    RootPath,
-		agentCard:   agentCard,
-		taskManager: taskManager,
-		logger:      slog.Default(),
+		endpoint:      RootPath,
+		agentCard:     agentCard,
+		wellKnownPath: AgantPath,
+		cardEncoders: map[string]CardEncoder{
+			MediaTypeJSON: JSONCardEncoder,
+		},
+		taskManager:       taskManager,
+		maxDataDepth:      a2a.DefaultMaxDataDepth,
+		rateLimitKey:      KeyByCaller,
+		requestLogging:    true,
+		recovery:          true,
+		streamingFallback: true,
+		sessionQueue:      -1,
+		idGenerator:       DefaultIDGenerator,
+		redactors:         []Redactor{redactSecrets},
+		errorEncoder:      DefaultErrorEncoder,
+		codec:             a2a.DefaultCodec,
+		propagator:        otel.GetTextMapPropagator(),
+		logger:            slog.Default(),
 		tracer: otel.GetTracerProvider().Tracer("github.com/go-a2a/a2a/server",
 			trace.WithSchemaURL(semconv.SchemaURL),
 			trace.WithInstrumentationVersion(otel.Version()),
@@ -74,20 +255,48 @@ func NewServer(host, port string, agentCard *a2a.AgentCard, taskManager TaskMana
 	for _, opt := range opts {
 		opt(s)
 	}
+	s.methods = s.defaultMethods()
+	s.registered = make(map[string]Handler)
+	if s.eventReplay > 0 {
+		s.events = newEventLog(s.eventReplay)
+	}
+	if s.metrics != nil {
+		if holder, ok := s.taskManager.(MetricsHolder); ok {
+			holder.SetMetrics(s.metrics)
+		}
+	} else {
+		s.metrics = NopMetrics{}
+	}
+	if s.sessionLimit > 0 {
+		s.sessions = newSessionLimiter(s.sessionLimit, s.sessionQueue)
+	}
+	if s.pushStore != nil {
+		s.notifier = NewNotifier(s.pushStore, s.logger, s.notifierOpts...)
+	}
+	s.checkAuthScheme()
+	if s.taskStore != nil {
+		if holder, ok := s.taskManager.(TaskStoreHolder); ok {
+			holder.SetTaskStore(s.taskStore)
+		}
+	}
 
 	mux := http.NewServeMux()
 	// Handle well-known agent.json
-	mux.HandleFunc("GET "+AgantPath, s.agentCardRequestHandler)
+	mux.HandleFunc("GET "+s.wellKnownPath, s.agentCardRequestHandler)
 	// Handle A2A API requests
 	mux.HandleFunc("POST "+s.endpoint, s.requestHandler)
+	if s.webSocket {
+		mux.HandleFunc("GET "+WebSocketPath, s.webSocketHandler)
+	}
 
-	h := otelhttp.NewHandler(mux, "a2a", otelhttp.WithPublicEndpoint())
+	h := otelhttp.NewHandler(mux, "a2a", otelhttp.WithPublicEndpoint(), otelhttp.WithPropagators(s.propagator))
 	if len(s.handlers) > 0 {
 		h = s.handlers[len(s.handlers)-1](h)
 		for i := len(s.handlers) - 2; i >= 0; i-- {
 			h = s.handlers[i](h)
 		}
 	}
+	h = withMountPrefix(s.withCORS(s.withProbes(h)))
 
 	s.server = &http.Server{
 		Addr: net.JoinHostPort(host, port),
@@ -109,13 +318,57 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	s.server.Handler.ServeHTTP(w, r)
 }
 
+// Handler returns the handler serving everything the server serves: the JSON-RPC endpoint,
+// the agent card, the health probes and the WebSocket endpoint, with the middleware of
+// [WithHandlers] applied. It lets the server run alongside other APIs on the port of an
+// existing application, rather than on its own with [Server.ListenAndServe]:
+//
+//	mux.Handle("/a2a/", srv.Handler())
+//
+// Mounted at a subtree pattern of an [http.ServeMux] without wildcards, such as "/a2a/", the
+// paths of the server, such as the endpoint set with [WithEndpoint], are relative to it: the
+// endpoint "/rpc" is served at "/a2a/rpc" and the agent card at
+// "/a2a/.well-known/agent.json". Mount the handler elsewhere with [http.StripPrefix].
+func (s *Server) Handler() http.Handler {
+	return s.server.Handler
+}
+
+// withMountPrefix returns h serving paths relative to the subtree pattern an enclosing
+// [http.ServeMux] routed the request with, as [http.StripPrefix] would.
+func withMountPrefix(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		prefix := mountPrefix(r.Pattern)
+		if prefix == "" || !strings.HasPrefix(r.URL.Path, prefix+"/") {
+			h.ServeHTTP(w, r)
+			return
+		}
+		http.StripPrefix(prefix, h).ServeHTTP(w, r)
+	})
+}
+
+// mountPrefix returns the path prefix of the [http.ServeMux] pattern, such as "/a2a" for
+// "POST /a2a/", or an empty string unless it is a subtree pattern without wildcards.
+func mountPrefix(pattern string) string {
+	if _, path, ok := strings.Cut(pattern, " "); ok {
+		pattern = strings.TrimLeft(path, " \t")
+	}
+	if i := strings.IndexByte(pattern, '/'); i > 0 {
+		// Drop the host.
+		pattern = pattern[i:]
+	}
+	if !strings.HasSuffix(pattern, "/") || strings.Contains(pattern, "{") {
+		return ""
+	}
+	return strings.TrimSuffix(pattern, "/")
+}
+
 // ListenAndServe starts the server and listens for incoming requests.
 func (s *Server) ListenAndServe(ctx context.Context) error {
-	if s.agentCard.Name == "" || s.agentCard.URL == "" || s.agentCard.Version == "" {
+	if s.agentCard != nil && (s.agentCard.Name == "" || s.agentCard.URL == "" || s.agentCard.Version == "") {
 		return errors.New("agent card must have name, URL, and version")
 	}
-	if s.taskManager == nil {
-		return errors.New("task manager cannot be nil")
+	if s.taskManager == nil && len(s.methods) == 0 && len(s.prefixes) == 0 {
+		return errors.New("task manager cannot be nil without registered methods")
 	}
 
 	s.logger.DebugContext(ctx, "starting A2A server", "address", s.server.Addr, "endpoint", s.endpoint)
@@ -128,31 +381,49 @@ func (s *Server) ListenAndServe(ctx context.Context) error {
 	return nil
 }
 
-// Shutdown shutdowns the server gracefully.
-func (s *Server) Shutdown(ctx context.Context) error {
-	if s.server == nil {
-		return nil
-	}
-	return s.server.Shutdown(ctx)
-}
-
 // agentCardRequestHandler handles requests for the agent card.
+//
+// Responses carry an ETag derived from the canonical JSON of the card, and a request whose If-None-Match
+// matches it is answered with 304 Not Modified. Without a configured card it responds 404.
 func (s *Server) agentCardRequestHandler(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
 		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
 		return
 	}
+	if s.agentCard == nil {
+		http.NotFound(w, r)
+		return
+	}
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
+	w.Header().Add("Vary", "Accept")
+
+	mediaType, encode, ok := negotiateCardEncoder(r.Header.Get("Accept"), s.cardEncoders)
+	if !ok {
+		http.Error(w, "no acceptable agent card format", http.StatusNotAcceptable)
+		return
+	}
 
-	data, err := sonic.ConfigFastest.Marshal(s.agentCard)
+	card := s.servedCard()
+	var etag string
+	data, err := encode(card)
+	if err == nil {
+		etag, err = cardETag(card, mediaType)
+	}
 	if err != nil {
-		s.logger.Error("marshal agent card", slog.Any("error", err))
+		s.logger.Error("marshal agent card", slog.Any("error", err), slog.String("media_type", mediaType))
 		http.Error(w, "unable to marshal agent card", http.StatusInternalServerError)
 		return
 	}
 
+	w.Header().Set("ETag", etag)
+	if matchETag(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", mediaType)
+	w.WriteHeader(http.StatusOK)
+
 	_, err = w.Write(data)
 	if err != nil {
 		s.logger.Error("unable to write response", slog.Any("error", err))
@@ -161,24 +432,126 @@ func (s *Server) agentCardRequestHandler(w http.ResponseWriter, r *http.Request)
 
 // requestHandler is the main handler for the A2A API.
 func (s *Server) requestHandler(w http.ResponseWriter, r *http.Request) {
-	ctx, span := s.tracer.Start(r.Context(), "server.requestHandler")
+	ctx := r.Context()
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		// Join the trace of the client unless a middleware already did.
+		ctx = s.propagator.Extract(ctx, propagation.HeaderCarrier(r.Header))
+	}
+	ctx, span := s.tracer.Start(ctx, "server.requestHandler")
 	defer span.End()
 
 	r = r.WithContext(ctx)
 
+	if s.compression != nil {
+		var finish func()
+		w, finish = s.compression.wrap(w, r)
+		defer finish()
+	}
+
 	if r.Method != http.MethodPost {
 		span.SetAttributes(semconv.RPCJsonrpcErrorCode(a2a.InvalidRequestErrorCode))
 
-		s.writeError(ctx, w, a2a.InvalidRequestErrorCode, "method not allowed")
+		s.writeError(w, r, a2a.InvalidRequestErrorCode, "method not allowed")
+		return
+	}
+
+	ctx, done, ok := s.inFlight.enter(ctx)
+	if !ok {
+		span.SetAttributes(semconv.RPCJsonrpcErrorCode(a2a.InternalErrorCode))
+
+		s.rejectShuttingDown(w, r)
 		return
 	}
+	defer done()
+	r = r.WithContext(ctx)
+
+	r, ok = s.authenticate(w, r)
+	if !ok {
+		return
+	}
+	if !s.allowRequest(w, r) {
+		return
+	}
+	ctx = r.Context()
 
-	var req a2a.JSONRPCRequest
-	if err := sonic.ConfigFastest.NewDecoder(r.Body).Decode(&req); err != nil {
-		span.SetAttributes(semconv.RPCJsonrpcErrorCode(a2a.JSONParseErrorCode))
+	var timings *serverTimings
+	if s.serverTiming {
+		timings = newServerTimings()
+		ctx = context.WithValue(ctx, serverTimingKey{}, timings)
+		r = r.WithContext(ctx)
+	}
+
+	if s.maxRequestBytes > 0 && r.ContentLength > s.maxRequestBytes {
+		s.rejectTooLarge(w, r)
+		return
+	}
+	if !s.decompressRequest(w, r) {
+		return
+	}
+	if s.maxRequestBytes > 0 {
+		// Bound the decompressed body too, against compression bombs.
+		r.Body = http.MaxBytesReader(w, r.Body, s.maxRequestBytes)
+	}
+
+	decodeStart := time.Now()
+	body, err := readRequest(r)
+	if r.MultipartForm != nil {
+		defer r.MultipartForm.RemoveAll()
+	}
+	if err != nil {
+		if maxErr := (*http.MaxBytesError)(nil); errors.As(err, &maxErr) {
+			s.rejectTooLarge(w, r)
+			return
+		}
+
+		span.SetAttributes(semconv.RPCJsonrpcErrorCode(a2a.InvalidRequestErrorCode))
 		span.SetStatus(codes.Error, err.Error())
 
-		s.writeError(ctx, w, a2a.JSONParseErrorCode, "requestHandler: Invalid JSON payload")
+		jerr := a2a.NewInvalidRequestError()
+		jerr.Data = err.Error()
+		s.writeJSONRPCError(w, r, jerr)
+		return
+	}
+
+	if timings != nil {
+		timings.add(TimingDecode, time.Since(decodeStart))
+		timings.restart()
+	}
+
+	if isBatch(body) {
+		s.serveBatch(w, r, body)
+		return
+	}
+	s.serveRequest(w, r, body, false)
+}
+
+// rejectTooLarge answers a request whose body exceeds [WithMaxRequestBytes] with 413 Request Entity Too Large.
+func (s *Server) rejectTooLarge(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	span.SetAttributes(semconv.RPCJsonrpcErrorCode(a2a.InvalidRequestErrorCode))
+	span.SetStatus(codes.Error, "request body too large")
+
+	jerr := a2a.NewInvalidRequestError()
+	jerr.Data = fmt.Sprintf("request body exceeds %d bytes", s.maxRequestBytes)
+	w.Header().Set("Connection", "close")
+	s.writeJSONRPCError(&statusWriter{ResponseWriter: w, status: http.StatusRequestEntityTooLarge}, r, jerr)
+}
+
+// serveRequest parses the single JSON-RPC request in body and dispatches it.
+//
+// Within a batch, streaming methods are rejected, since their events cannot be part of the
+// batch response. On a connection carrying many requests at once, a request reusing the ID of
+// one still in flight is rejected, since their responses could not be told apart.
+func (s *Server) serveRequest(w http.ResponseWriter, r *http.Request, body []byte, inBatch bool) {
+	ctx := r.Context()
+	span := trace.SpanFromContext(ctx)
+
+	req, jerr := a2a.ParseRequest(body)
+	if req != nil && !req.IsNotification() {
+		r = r.WithContext(withRequestID(ctx, req.ID))
+	}
+	if jerr != nil {
+		s.writeJSONRPCError(w, r, jerr)
 		return
 	}
 
@@ -189,307 +562,938 @@ func (s *Server) requestHandler(w http.ResponseWriter, r *http.Request) {
 		attribute.String("a2a.method", req.Method),
 	)
 
-	// Handle method
-	switch req.Method {
-	case a2a.MethodTasksSend:
-		s.handleSendTask(w, r, req.Params)
-	case a2a.MethodTasksGet:
-		s.handleGetTask(w, r, req.Params)
-	case a2a.MethodTasksCancel:
-		s.handleCancelTask(w, r, req.Params)
-	case a2a.MethodTasksPushNotificationSet:
-		s.handleSetTaskPushNotification(w, r, req.Params)
-	case a2a.MethodTasksPushNotificationGet:
-		s.handleGetTaskPushNotification(w, r, req.Params)
-	case a2a.MethodTasksSendSubscribe:
-		s.handleSendTaskStreaming(w, r, req.ID, req.Params)
-	case a2a.MethodTasksResubscribe:
-		s.handleTaskResubscription(w, r, req.ID, req.Params)
-	default:
-		s.writeError(ctx, w, a2a.MethodNotFoundErrorCode, "Method not found")
-	}
-}
-
-func (s *Server) writeResponse(ctx context.Context, w http.ResponseWriter, id a2a.ID, result any) {
+	if inBatch {
+		r = r.WithContext(withBatchMethod(r.Context(), req.Method))
+	}
+	if inBatch && (req.Method == a2a.MethodTasksSendSubscribe || req.Method == a2a.MethodTasksResubscribe) {
+		jerr := a2a.NewInvalidRequestError()
+		jerr.Data = fmt.Sprintf("streaming method %s cannot be batched", req.Method)
+		s.writeJSONRPCError(w, r, jerr)
+		return
+	}
+
+	release, ok := claimRequestID(ctx, req.ID)
+	if !ok {
+		jerr := a2a.NewInvalidRequestError()
+		jerr.Data = fmt.Sprintf("request ID %s is already in flight on this connection", req.ID)
+		s.writeJSONRPCError(w, r, jerr)
+		return
+	}
+	defer release()
+
+	if req.IsNotification() {
+		// Notifications are processed, but the client expects no response.
+		s.dispatch(&discardWriter{}, r, req)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	s.dispatch(w, r, req)
+}
+
+// dispatch decodes the params of req and calls the handler of its method, within a span named
+// after the method.
+func (s *Server) dispatch(w http.ResponseWriter, r *http.Request, req *a2a.JSONRPCRequest) {
+	r, span := s.startRPCSpan(r, req)
+	defer span.End()
+	r, logCall := s.startCallLog(r, req)
+	defer logCall()
+	r, measureCall := s.startCallMetrics(r, req)
+	defer measureCall()
+
+	handle, ok := s.lookupMethod(req.Method)
+	if !ok {
+		handle, ok = s.lookupStreamingFallback(req.Method)
+	}
+	if !ok {
+		s.writeJSONRPCError(w, r, a2a.NewMethodNotFoundError())
+		return
+	}
+	if !s.authorizeScheme(w, r, req.Method) {
+		return
+	}
+	r = r.WithContext(withMethod(r.Context(), req.Method))
+
+	params, jerr := s.decodeParams(r.Context(), req)
+	if jerr != nil {
+		s.writeJSONRPCError(w, r, jerr)
+		return
+	}
+	if jerr := s.checkTaskID(params); jerr != nil {
+		s.writeJSONRPCError(w, r, jerr)
+		return
+	}
+
+	if s.recovery {
+		defer s.recoverHandler(w, r, req, params)
+	}
+	handle(w, r, req, params)
+}
+
+// writeResponse writes a successful JSON-RPC response.
+func (s *Server) writeResponse(w http.ResponseWriter, r *http.Request, id a2a.ID, result any) {
+	ctx := r.Context()
+
 	// Write success response
 	resp := &a2a.JSONRPCResponse{
 		JSONRPCMessage: a2a.NewJSONRPCMessage(id),
 		Result:         result,
 	}
 
-	data, err := sonic.ConfigFastest.Marshal(resp)
+	if err := resp.Validate(); err != nil {
+		// A handler returned a nil result without an error. The server answers with an
+		// internal error rather than a response clients cannot interpret.
+		method, _ := MethodFrom(ctx)
+		s.logger.ErrorContext(ctx, "invalid response", slog.String("method", method), slog.Any("error", err))
+		s.writeJSONRPCError(w, r, a2a.NewInternalError())
+		return
+	}
+
+	recordResult(ctx, result)
+
+	timings := serverTimingsFromContext(ctx)
+	if timings != nil {
+		timings.add(TimingHandler, timings.sinceStart())
+	}
+
+	encodeStart := time.Now()
+	data, err := s.codec.Marshal(resp)
 	if err != nil {
 		s.logger.ErrorContext(ctx, "marshal response", slog.Any("error", err))
-		s.writeError(ctx, w, a2a.InternalErrorCode, "marshal response")
+		s.writeError(w, r, a2a.InternalErrorCode, "marshal response")
 		return
 	}
+	if timings != nil {
+		timings.add(TimingEncode, time.Since(encodeStart))
+		setServerTimingHeader(w, timings)
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	_, err = w.Write(data)
-	if err != nil {
+	if _, err := w.Write(data); err != nil {
 		s.logger.ErrorContext(ctx, "write response", slog.Any("error", err))
-		s.writeError(ctx, w, a2a.InternalErrorCode, fmt.Errorf("write response: %w", err).Error())
 	}
 }
 
-// writeError writes an error response in JSON-RPC format.
-func (s *Server) writeError(ctx context.Context, w http.ResponseWriter, code int, message string) {
-	span := trace.SpanFromContext(ctx)
-	span.SetAttributes(semconv.RPCJsonrpcErrorCode(a2a.InternalErrorCode))
-	span.SetStatus(codes.Error, message)
+// writeError writes an error response using the configured [ErrorEncoder].
+func (s *Server) writeError(w http.ResponseWriter, r *http.Request, code int, message string) {
+	s.writeJSONRPCError(w, r, &a2a.JSONRPCError{
+		Code:    code,
+		Message: message,
+	})
+}
 
-	resp := &a2a.JSONRPCResponse{
-		JSONRPCMessage: a2a.JSONRPCMessage{
-			JSONRPC: "2.0",
-		},
-		Error: &a2a.JSONRPCError{
-			Code:    code,
-			Message: message,
-		},
+// writeJSONRPCError writes jerr as the JSON-RPC error response.
+func (s *Server) writeJSONRPCError(w http.ResponseWriter, r *http.Request, jerr *a2a.JSONRPCError) {
+	span := trace.SpanFromContext(r.Context())
+	span.SetAttributes(semconv.RPCJsonrpcErrorCode(jerr.Code))
+	span.SetStatus(codes.Error, jerr.Message)
+	recordRPCError(r.Context(), jerr)
+
+	if method, ok := r.Context().Value(batchMethodKey{}).(string); ok {
+		jerr = batchError(method, jerr)
 	}
-	data, err := sonic.ConfigFastest.Marshal(resp)
-	if err != nil {
-		s.logger.Error("marshal error response", slog.Any("error", err))
-		http.Error(w, "Marshal error response", http.StatusInternalServerError)
+
+	if timings := serverTimingsFromContext(r.Context()); timings != nil {
+		timings.add(TimingHandler, timings.sinceStart())
+		setServerTimingHeader(w, timings)
+	}
+
+	s.errorEncoder(w, r.WithContext(withLogger(r.Context(), s.logger)), jerr)
+}
+
+// taskError maps an error returned by the task manager for op to the JSON-RPC error to send.
+//
+// Errors wrapping [ErrPushConfigNotFound] become the task-not-found error; others are
+// mapped by [a2a.ToJSONRPCError], so handlers can return the a2a sentinel errors.
+func taskError(err error, op string) *a2a.JSONRPCError {
+	if errors.Is(err, ErrPushConfigNotFound) {
+		return a2a.NewTaskNotFoundError()
+	}
+	return a2a.ToJSONRPCError(fmt.Errorf("%s: %w", op, err))
+}
+
+// notifyStatus posts the status of task, returned by the task manager, to its push notification webhook.
+func (s *Server) notifyStatus(ctx context.Context, task *a2a.Task) {
+	if s.notifier == nil || task == nil {
 		return
 	}
+	s.notifier.Notify(ctx, &a2a.TaskStatusUpdateEvent{
+		ID:     task.ID,
+		Status: task.Status,
+		Final:  isFinalState(task.Status.State),
+	})
+}
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK) // error is in the JSON-RPC response, not HTTP
-	_, err = w.Write(data)
-	if err != nil {
-		s.logger.Error("write error response", slog.Any("error", err))
+// checkOutputModes reports an error wrapping [a2a.ErrNoOutputMode] if the agent produces none of the accepted output modes.
+//
+// Agents whose card declares no output modes are assumed to produce anything.
+func (s *Server) checkOutputModes(accepted []string) error {
+	if s.agentCard == nil {
+		return nil
+	}
+	available := slices.Clone(s.agentCard.DefaultOutputModes)
+	for _, skill := range s.agentCard.Skills {
+		for _, mode := range skill.OutputModes {
+			if !slices.Contains(available, mode) {
+				available = append(available, mode)
+			}
+		}
+	}
+	if len(available) == 0 {
+		return nil
+	}
+
+	_, err := a2a.NegotiateOutputMode(accepted, available)
+	return err
+}
+
+// checkSkillParams validates the data parts of the message against the parameters schema
+// of the skill named by the [a2a.SkillIDKey] metadata of params.
+//
+// Requests naming no skill, or a skill without parameters, are accepted as is.
+func (s *Server) checkSkillParams(params a2a.TaskSendParams) error {
+	skillID, _ := params.Metadata[a2a.SkillIDKey].(string)
+	if skillID == "" || s.agentCard == nil {
+		return nil
+	}
+	i := slices.IndexFunc(s.agentCard.Skills, func(skill a2a.AgentSkill) bool { return skill.ID == skillID })
+	if i < 0 {
+		return fmt.Errorf("unknown skill %q", skillID)
+	}
+
+	skill := s.agentCard.Skills[i]
+	for _, data := range params.Message.DataParts() {
+		if err := skill.ValidateParams(data); err != nil {
+			return err
+		}
 	}
+	return nil
+}
+
+// checkMIMETypes checks the content of the file parts of msg against the MIME type they declare,
+// if [WithMIMEValidation] is enabled, giving the parts declaring none the sniffed type.
+func (s *Server) checkMIMETypes(msg *a2a.Message) *a2a.JSONRPCError {
+	if !s.mimeValidation {
+		return nil
+	}
+	for i, part := range msg.Parts {
+		fp, ok := part.(*a2a.FilePart)
+		if !ok || fp == nil {
+			continue
+		}
+		if err := fp.CheckMIMEType(); err != nil {
+			err = fmt.Errorf("part %d: %w", i, err)
+			if errors.Is(err, a2a.ErrMIMETypeMismatch) {
+				return a2a.ToJSONRPCError(fmt.Errorf("%w: %w", a2a.ErrContentTypeNotSupported, err))
+			}
+			return invalidParams(err)
+		}
+	}
+	return nil
+}
+
+// checkInputModes reports the content type not supported error for the first part of msg sent
+// in an input mode the agent does not accept, see [a2a.AgentCard.AcceptsInputMode]. A message
+// invoking a skill, as named by metadata, is checked against the input modes of the skill, or
+// the default ones if it declares none.
+func (s *Server) checkInputModes(msg a2a.Message, metadata map[string]any) *a2a.JSONRPCError {
+	if s.agentCard == nil {
+		return nil
+	}
+	card := s.agentCard
+	if skillID, _ := metadata[a2a.SkillIDKey].(string); skillID != "" {
+		if i := slices.IndexFunc(card.Skills, func(skill a2a.AgentSkill) bool { return skill.ID == skillID }); i >= 0 {
+			modes := card.Skills[i].InputModes
+			if len(modes) == 0 {
+				modes = card.DefaultInputModes
+			}
+			card = &a2a.AgentCard{DefaultInputModes: modes}
+		}
+	}
+	for i, part := range msg.Parts {
+		modes := a2a.PartInputModes(part)
+		if len(modes) == 0 || slices.ContainsFunc(modes, card.AcceptsInputMode) {
+			continue
+		}
+		err := fmt.Errorf("part %d: input mode %q is not accepted", i, modes[len(modes)-1])
+		return a2a.ToJSONRPCError(fmt.Errorf("%w: %w", a2a.ErrContentTypeNotSupported, err))
+	}
+	return nil
+}
+
+// checkMessage runs the checks of a message sent to the server, attaching the files uploaded
+// along with r and resolving the files sent by URI, see [WithFileResolver]. metadata is the
+// metadata of the request, naming the skill invoked, if any.
+func (s *Server) checkMessage(r *http.Request, msg *a2a.Message, metadata map[string]any) *a2a.JSONRPCError {
+	if err := attachUploads(r, msg); err != nil {
+		return invalidParams(err)
+	}
+	if err := a2a.ValidateMessageSize(*msg, s.maxMessageParts, s.maxMessageBytes); err != nil {
+		return invalidParams(err)
+	}
+	if err := msg.Validate(); err != nil {
+		return invalidParams(err)
+	}
+	if jerr := s.resolveFiles(r.Context(), msg); jerr != nil {
+		return jerr
+	}
+	if jerr := s.checkMIMETypes(msg); jerr != nil {
+		return jerr
+	}
+	if jerr := s.checkInputModes(*msg, metadata); jerr != nil {
+		return jerr
+	}
+	if err := a2a.ValidateMessageDataDepth(*msg, s.maxDataDepth); err != nil {
+		return invalidParams(err)
+	}
+	if err := a2a.ValidateMessageFileSize(*msg, s.maxFileBytes); err != nil {
+		return invalidParams(err)
+	}
+	return nil
+}
+
+// checkSendParams runs the checks of a task sent with tasks/send or tasks/sendSubscribe: those
+// of its message, see [Server.checkMessage], then of its labels, skill and output modes.
+func (s *Server) checkSendParams(r *http.Request, params *a2a.TaskSendParams) *a2a.JSONRPCError {
+	if jerr := s.checkMessage(r, &params.Message, params.Metadata); jerr != nil {
+		return jerr
+	}
+	if err := a2a.ValidateLabels(params.Labels); err != nil {
+		return invalidParams(err)
+	}
+	if err := s.checkSkillParams(*params); err != nil {
+		return invalidParams(err)
+	}
+	if err := s.checkOutputModes(params.AcceptedOutputModes); err != nil {
+		return a2a.ToJSONRPCError(fmt.Errorf("%w: %w", a2a.ErrContentTypeNotSupported, err))
+	}
+	return nil
+}
+
+// decodeParams decodes the params of req into the params type of its method, recording the
+// time spent for Server-Timing.
+func (s *Server) decodeParams(ctx context.Context, req *a2a.JSONRPCRequest) (any, *a2a.JSONRPCError) {
+	start := time.Now()
+	params, jerr := decodeMethodParams(req)
+	if timings := serverTimingsFromContext(ctx); timings != nil {
+		timings.add(TimingDecode, time.Since(start))
+		timings.restart()
+	}
+	return params, jerr
+}
+
+// invalidParams returns the invalid params error reporting err.
+func invalidParams(err error) *a2a.JSONRPCError {
+	return a2a.ToJSONRPCError(fmt.Errorf("%w: %w", a2a.ErrInvalidParams, err))
 }
 
 // handleSendTask handles the tasks/send method.
-func (s *Server) handleSendTask(w http.ResponseWriter, r *http.Request, params json.RawMessage) {
+func (s *Server) handleSendTask(w http.ResponseWriter, r *http.Request, rpcReq *a2a.JSONRPCRequest, params a2a.TaskSendParams) {
 	ctx, span := s.tracer.Start(r.Context(), "server.handleSendTask")
 	defer span.End()
 
-	var req a2a.SendTaskRequest
-	if err := sonic.ConfigFastest.Unmarshal(params, &req); err != nil {
-		s.writeError(ctx, w, a2a.InternalErrorCode, fmt.Errorf("invalid params: %w", err).Error())
+	r = r.WithContext(ctx)
+
+	req := a2a.SendTaskRequest{JSONRPCRequest: *rpcReq, Params: params}
+	if jerr := s.checkSendParams(r, &req.Params); jerr != nil {
+		s.writeJSONRPCError(w, r, jerr)
+		return
+	}
+	if jerr := s.assignIDs(&req.Params); jerr != nil {
+		s.writeJSONRPCError(w, r, jerr)
 		return
 	}
 
 	span.SetAttributes(attribute.String("a2a.task_id", req.Params.ID))
 
-	resp, err := s.taskManager.OnSendTask(ctx, &req)
+	if req.Params.DryRun {
+		span.SetAttributes(attribute.Bool("a2a.dry_run", true))
+		s.writeResponse(w, r, req.ID, dryRunTask(req.Params))
+		return
+	}
+	s.journalMessage(ctx, req.Params.ID, req.Params.Message)
+
+	release, ok := s.acquireSession(w, r, req.Params)
+	if !ok {
+		return
+	}
+	defer release()
+
+	ctx = s.withSentTask(ctx, req.Params)
+	timer, err := s.startTaskTimer(ctx, req.Params, nil)
 	if err != nil {
-		s.writeError(ctx, w, a2a.InternalErrorCode, fmt.Errorf("process task: %w", err).Error())
+		s.writeJSONRPCError(w, r, invalidParams(err))
 		return
 	}
 
-	s.writeResponse(ctx, w, req.ID, resp.Result)
+	runCtx, done := s.runningTasks.start(ctx, req.Params.ID)
+	defer done()
+
+	var resp *a2a.SendTaskResponse
+	err = timer.run(runCtx, func(ctx context.Context) (err error) {
+		resp, err = s.taskManager.OnSendTask(ctx, &req)
+		return err
+	})
+	if errors.Is(err, errTaskExpired) {
+		// Answer with the task as it stands, failed unless it was done in time.
+		s.handleGetTask(w, r, rpcReq, a2a.TaskQueryParams{TaskIDParams: req.Params.TaskIDParams})
+		return
+	}
+	if _, canceled := canceledByClient(runCtx); err != nil && canceled {
+		// The handler gave up with its context, such as while it waited for input: answer
+		// with the canceled task rather than the error it returned.
+		s.handleGetTask(w, r, rpcReq, a2a.TaskQueryParams{TaskIDParams: req.Params.TaskIDParams})
+		return
+	}
+	if err != nil {
+		err = s.checkDeadline(runCtx, req.Params.ID, err)
+		jerr := taskError(err, "process task")
+		s.journalError(ctx, req.Params.ID, jerr)
+		s.writeJSONRPCError(w, r, jerr)
+		return
+	}
+	timer.settle(resp.Result)
+	s.journalResult(ctx, resp.Result)
+	s.notifyStatus(ctx, resp.Result)
+
+	s.writeResponse(w, r, req.ID, s.propagateTaskMetadata(resp.Result, req.Params.Metadata))
+}
+
+// dryRunTask returns the task answering a dry-run tasks/send request with params, as the
+// task manager would submit it.
+func dryRunTask(params a2a.TaskSendParams) *a2a.Task {
+	task := &a2a.Task{
+		ID: params.ID,
+		Status: a2a.TaskStatus{
+			State:     a2a.TaskStateSubmitted,
+			Timestamp: time.Now().UTC(),
+		},
+		Labels: params.Labels,
+	}
+	if params.SessionID != uuid.Nil {
+		task.SessionID = params.SessionID.String()
+	}
+	return task
 }
 
 // handleGetTask handles the tasks/get method.
-func (s *Server) handleGetTask(w http.ResponseWriter, r *http.Request, params json.RawMessage) {
+func (s *Server) handleGetTask(w http.ResponseWriter, r *http.Request, rpcReq *a2a.JSONRPCRequest, params a2a.TaskQueryParams) {
 	ctx, span := s.tracer.Start(r.Context(), "server.handleGetTask")
 	defer span.End()
 
-	var req a2a.GetTaskRequest
-	if err := sonic.ConfigFastest.Unmarshal(params, &req); err != nil {
-		s.writeError(ctx, w, a2a.InternalErrorCode, fmt.Errorf("invalid params: %w", err).Error())
-		return
-	}
+	r = r.WithContext(ctx)
+
+	req := a2a.GetTaskRequest{JSONRPCRequest: *rpcReq, Params: params}
 
 	span.SetAttributes(attribute.String("a2a.task_id", req.Params.ID))
 
 	resp, err := s.taskManager.OnGetTask(ctx, &req)
 	if err != nil {
-		s.writeError(ctx, w, a2a.InternalErrorCode, fmt.Errorf("get task: %w", err).Error())
+		s.writeJSONRPCError(w, r, taskError(err, "get task"))
+		return
+	}
+	// A nil result is rejected as such by writeResponse rather than answered as not modified.
+	if since := req.Params.UpdatedSince; resp != nil && resp.Result != nil && !since.IsZero() &&
+		!resp.Result.UpdatedAt.IsZero() && !resp.Result.UpdatedAt.After(since) {
+		span.SetAttributes(attribute.Bool("a2a.not_modified", true))
+		s.writeResponse(w, r, req.ID, &a2a.TaskNotModified{
+			ID:          resp.Result.ID,
+			UpdatedAt:   resp.Result.UpdatedAt,
+			NotModified: true,
+		})
 		return
 	}
 
-	s.writeResponse(ctx, w, req.ID, resp.Result)
+	var task *a2a.Task
+	if resp != nil {
+		task = resp.Result
+	}
+	// Trim the task here too, for task managers returning it whole.
+	s.writeResponse(w, r, req.ID, req.Params.Trim(task))
 }
 
-// handleCancelTask handles the tasks/cancel method.
-func (s *Server) handleCancelTask(w http.ResponseWriter, r *http.Request, params json.RawMessage) {
+// handleCancelTask handles the tasks/cancel method. Once the task manager canceled the task,
+// the contexts of its handlers still running are canceled with a [CancelError] cause.
+func (s *Server) handleCancelTask(w http.ResponseWriter, r *http.Request, rpcReq *a2a.JSONRPCRequest, params a2a.TaskIDParams) {
 	ctx, span := s.tracer.Start(r.Context(), "server.handleCancelTask")
 	defer span.End()
 
-	var req a2a.CancelTaskRequest
-	if err := sonic.ConfigFastest.Unmarshal(params, &req); err != nil {
-		s.writeError(ctx, w, a2a.InternalErrorCode, fmt.Errorf("invalid params: %w", err).Error())
-		return
-	}
+	r = r.WithContext(ctx)
+
+	req := a2a.CancelTaskRequest{JSONRPCRequest: *rpcReq, Params: params}
 
 	span.SetAttributes(attribute.String("a2a.task_id", req.Params.ID))
 
+	ctx = s.withTask(ctx, req.Params.ID)
+	cause := &CancelError{Reason: req.Params.CancelReason()}
 	resp, err := s.taskManager.OnCancelTask(ctx, &req)
+	if errors.Is(err, a2a.ErrTaskNotFound) && s.runningTasks.cancel(req.Params.ID, cause) > 0 {
+		// The task of a skill, see [Server.RegisterSkill], is not stored: canceling its
+		// function is all there is to do.
+		s.taskTimers.stopTask(req.Params.ID)
+		status := a2a.TaskStatus{State: a2a.TaskStateCanceled, Reason: cause.Reason, Timestamp: time.Now().UTC()}
+		s.writeResponse(w, r, req.ID, &a2a.Task{ID: req.Params.ID, Status: status})
+		return
+	}
 	if err != nil {
-		s.writeError(ctx, w, a2a.InternalErrorCode, fmt.Errorf("cancel task: %w", err).Error())
+		s.writeJSONRPCError(w, r, taskError(err, "cancel task"))
 		return
 	}
+	s.taskTimers.stopTask(req.Params.ID)
+	if n := s.runningTasks.cancel(req.Params.ID, cause); n > 0 {
+		s.logger.DebugContext(ctx, "task handlers canceled", slog.String("task_id", req.Params.ID), slog.Int("handlers", n))
+	}
+	s.journalResult(ctx, resp.Result)
+	s.notifyStatus(ctx, resp.Result)
 
-	s.writeResponse(ctx, w, req.ID, resp.Result)
+	s.writeResponse(w, r, req.ID, resp.Result)
 }
 
 // handleSetTaskPushNotification handles the tasks/pushNotification/set method.
-func (s *Server) handleSetTaskPushNotification(w http.ResponseWriter, r *http.Request, params json.RawMessage) {
+func (s *Server) handleSetTaskPushNotification(w http.ResponseWriter, r *http.Request, rpcReq *a2a.JSONRPCRequest, params a2a.TaskPushNotificationConfig) {
 	ctx, span := s.tracer.Start(r.Context(), "server.handleSetTaskPushNotification")
 	defer span.End()
 
-	var req a2a.SetTaskPushNotificationRequest
-	if err := sonic.ConfigFastest.Unmarshal(params, &req); err != nil {
-		s.writeError(ctx, w, a2a.InternalErrorCode, fmt.Errorf("invalid params: %w", err).Error())
+	r = r.WithContext(ctx)
+
+	if s.pushStore == nil {
+		jerr := a2a.NewPushNotificationNotSupportedError()
+		s.writeError(w, r, jerr.Code, jerr.Message)
+		return
+	}
+
+	req := a2a.SetTaskPushNotificationRequest{JSONRPCRequest: *rpcReq, Params: params}
+	if err := req.Params.PushNotificationConfig.Validate(); err != nil {
+		s.writeJSONRPCError(w, r, invalidParams(err))
 		return
 	}
 
 	span.SetAttributes(attribute.String("a2a.task_id", req.Params.ID))
 
-	resp, err := s.taskManager.OnSetTaskPushNotification(ctx, &req)
-	if err != nil {
-		s.writeError(ctx, w, a2a.InternalErrorCode, fmt.Errorf("set push notification: %w", err).Error())
+	// Only existing tasks can be subscribed to.
+	if _, err := s.taskManager.OnGetTask(ctx, &a2a.GetTaskRequest{
+		JSONRPCRequest: req.JSONRPCRequest,
+		Params:         a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: req.Params.ID}},
+	}); err != nil {
+		s.writeJSONRPCError(w, r, taskError(err, "set push notification"))
+		return
+	}
+
+	if err := s.pushStore.Set(ctx, req.Params.ID, req.Params.PushNotificationConfig); err != nil {
+		s.writeJSONRPCError(w, r, taskError(err, "set push notification"))
 		return
 	}
 
-	s.writeResponse(ctx, w, req.ID, resp.Result)
+	s.writeResponse(w, r, req.ID, &req.Params)
 }
 
 // handleGetTaskPushNotification handles the tasks/pushNotification/get method.
-func (s *Server) handleGetTaskPushNotification(w http.ResponseWriter, r *http.Request, params json.RawMessage) {
+func (s *Server) handleGetTaskPushNotification(w http.ResponseWriter, r *http.Request, rpcReq *a2a.JSONRPCRequest, params a2a.TaskIDParams) {
 	ctx, span := s.tracer.Start(r.Context(), "server.handleGetTaskPushNotification")
 	defer span.End()
 
-	var req a2a.GetTaskPushNotificationRequest
-	if err := sonic.ConfigFastest.Unmarshal(params, &req); err != nil {
-		s.writeError(ctx, w, a2a.InternalErrorCode, fmt.Errorf("invalid params: %w", err).Error())
+	r = r.WithContext(ctx)
+
+	if s.pushStore == nil {
+		jerr := a2a.NewPushNotificationNotSupportedError()
+		s.writeError(w, r, jerr.Code, jerr.Message)
 		return
 	}
 
+	req := a2a.GetTaskPushNotificationRequest{JSONRPCRequest: *rpcReq, Params: params}
+
 	span.SetAttributes(attribute.String("a2a.task_id", req.Params.ID))
 
-	resp, err := s.taskManager.OnGetTaskPushNotification(ctx, &req)
+	config, err := s.pushStore.Get(ctx, req.Params.ID)
 	if err != nil {
-		s.writeError(ctx, w, a2a.InternalErrorCode, fmt.Errorf("get push notification: %w", err).Error())
+		s.writeJSONRPCError(w, r, taskError(err, "get push notification"))
 		return
 	}
 
-	s.writeResponse(ctx, w, req.ID, resp.Result)
+	s.writeResponse(w, r, req.ID, &a2a.TaskPushNotificationConfig{
+		ID:                     req.Params.ID,
+		PushNotificationConfig: config,
+	})
 }
 
-// handleSendTaskStreaming handles the tasks/sendSubscribe method.
-func (s *Server) handleSendTaskStreaming(w http.ResponseWriter, r *http.Request, id a2a.ID, params json.RawMessage) {
-	ctx, span := s.tracer.Start(r.Context(), "server.handleSendTaskStreaming")
+// handleAppendTaskInput handles the tasks/input/append method.
+func (s *Server) handleAppendTaskInput(w http.ResponseWriter, r *http.Request, rpcReq *a2a.JSONRPCRequest, params a2a.TaskInputParams) {
+	ctx, span := s.tracer.Start(r.Context(), "server.handleAppendTaskInput")
 	defer span.End()
 
-	var req a2a.SendTaskStreamingRequest
-	if err := sonic.ConfigFastest.Unmarshal(params, &req); err != nil {
-		s.writeError(ctx, w, a2a.InternalErrorCode, fmt.Errorf("invalid params: %w", err).Error())
+	r = r.WithContext(ctx)
+
+	appender, ok := s.taskManager.(InputAppender)
+	if !ok {
+		s.writeError(w, r, a2a.UnsupportedOperationErrorCode, "incremental input is not supported")
 		return
 	}
 
-	span.SetAttributes(attribute.String("a2a.task_id", req.Params.ID))
+	req := a2a.AppendTaskInputRequest{JSONRPCRequest: *rpcReq, Params: params}
+	if jerr := s.checkMessage(r, &req.Params.Message, nil); jerr != nil {
+		s.writeJSONRPCError(w, r, jerr)
+		return
+	}
 
-	// Set up SSE headers
-	w.Header().Set("Content-Type", "text/event-stream")
-	w.Header().Set("Cache-Control", "no-cache")
-	w.Header().Set("Connection", "keep-alive")
-	w.WriteHeader(http.StatusOK)
+	span.SetAttributes(
+		attribute.String("a2a.task_id", req.Params.ID),
+		attribute.Int("a2a.part_count", len(req.Params.Message.Parts)),
+		attribute.Bool("a2a.final", req.Params.Final),
+	)
 
-	// Create a channel for the task events
-	eventsCh, err := s.taskManager.OnSendTaskSubscribe(ctx, &req)
+	s.journalMessage(ctx, req.Params.ID, req.Params.Message)
+	ctx = s.withTask(ctx, req.Params.ID)
+	resp, err := appender.OnAppendTaskInput(ctx, &req)
 	if err != nil {
-		s.writeError(ctx, w, a2a.InternalErrorCode, fmt.Errorf("subscribe to task: %w", err).Error())
+		jerr := taskError(err, "append task input")
+		s.journalError(ctx, req.Params.ID, jerr)
+		s.writeJSONRPCError(w, r, jerr)
 		return
 	}
 
-	// Flush the headers to the client
-	flusher, ok := w.(http.Flusher)
+	// The chunk recorded, the handlers reading the input of the task get it, see [InputFrom].
+	delivered, dropped := s.runningTasks.deliver(req.Params.ID, req.Params)
+	span.SetAttributes(attribute.Int("a2a.input_delivered", delivered))
+	if dropped > 0 {
+		s.logger.WarnContext(ctx, "task input not delivered, handler input full",
+			slog.String("task_id", req.Params.ID), slog.Int("handlers", dropped))
+	}
+
+	s.writeResponse(w, r, req.ID, resp.Result)
+}
+
+// handleGetTaskHistory handles the tasks/history/get method.
+func (s *Server) handleGetTaskHistory(w http.ResponseWriter, r *http.Request, rpcReq *a2a.JSONRPCRequest, params a2a.TaskHistoryParams) {
+	ctx, span := s.tracer.Start(r.Context(), "server.handleGetTaskHistory")
+	defer span.End()
+
+	r = r.WithContext(ctx)
+
+	reader, ok := s.taskManager.(HistoryReader)
 	if !ok {
-		s.writeError(ctx, w, a2a.InternalErrorCode, "streaming not supported by response writer")
+		s.writeError(w, r, a2a.UnsupportedOperationErrorCode, "history paging is not supported")
 		return
 	}
 
-	// Begin streaming events
-	for event := range eventsCh {
-		// Marshal event to JSON
-		resp := &a2a.JSONRPCResponse{
-			JSONRPCMessage: a2a.NewJSONRPCMessage(id),
-			Result:         event,
-		}
+	req := a2a.GetTaskHistoryRequest{JSONRPCRequest: *rpcReq, Params: params}
 
-		data, err := sonic.ConfigFastest.Marshal(resp)
-		if err != nil {
-			s.logger.ErrorContext(ctx, "marshal event", slog.Any("error", err))
-			continue
-		}
+	span.SetAttributes(attribute.String("a2a.task_id", req.Params.ID))
 
-		// Write event to the client
-		_, err = fmt.Fprintf(w, "data: %s\n\n", data)
-		if err != nil {
-			s.logger.ErrorContext(ctx, "write event", slog.Any("error", err))
-			break
+	ctx = s.withTask(ctx, req.Params.ID)
+	resp, err := reader.OnGetTaskHistory(ctx, &req)
+	if err != nil {
+		if errors.Is(err, ErrInvalidCursor) {
+			s.writeJSONRPCError(w, r, invalidParams(err))
+			return
 		}
-
-		flusher.Flush()
+		s.writeJSONRPCError(w, r, taskError(err, "get task history"))
+		return
 	}
+
+	s.writeResponse(w, r, req.ID, resp.Result)
 }
 
-// handleTaskResubscription handles the tasks/resubscribe method.
-func (s *Server) handleTaskResubscription(w http.ResponseWriter, r *http.Request, id a2a.ID, params json.RawMessage) {
-	ctx, span := s.tracer.Start(r.Context(), "server.handleTaskResubscription")
+// handleListTasks handles the tasks/list method.
+func (s *Server) handleListTasks(w http.ResponseWriter, r *http.Request, rpcReq *a2a.JSONRPCRequest, params a2a.TaskListParams) {
+	ctx, span := s.tracer.Start(r.Context(), "server.handleListTasks")
 	defer span.End()
 
-	var req a2a.TaskResubscriptionRequest
-	if err := sonic.ConfigFastest.Unmarshal(params, &req); err != nil {
-		s.writeError(ctx, w, a2a.InternalErrorCode, fmt.Errorf("invalid params: %w", err).Error())
+	r = r.WithContext(ctx)
+
+	lister, ok := s.taskManager.(TaskLister)
+	if !ok {
+		s.writeError(w, r, a2a.UnsupportedOperationErrorCode, "task listing is not supported")
 		return
 	}
 
-	span.SetAttributes(attribute.String("a2a.task_id", req.Params.ID))
+	req := a2a.ListTasksRequest{JSONRPCRequest: *rpcReq, Params: params}
 
-	// Set up SSE headers
-	w.Header().Set("Content-Type", "text/event-stream")
-	w.Header().Set("Cache-Control", "no-cache")
-	w.Header().Set("Connection", "keep-alive")
-	w.WriteHeader(http.StatusOK)
+	span.SetAttributes(
+		attribute.String("a2a.session_id", req.Params.SessionID),
+		attribute.String("a2a.state", string(req.Params.State)),
+		attribute.Int("a2a.labels", len(req.Params.Labels)),
+	)
 
-	// Create a channel for the task events
-	ch, err := s.taskManager.OnResubscribeToTask(ctx, &req)
+	resp, err := lister.OnListTasks(ctx, &req)
 	if err != nil {
-		s.writeError(ctx, w, a2a.InternalErrorCode, fmt.Errorf("subscribe to task: %w", err).Error())
+		if errors.Is(err, ErrInvalidCursor) {
+			s.writeJSONRPCError(w, r, invalidParams(err))
+			return
+		}
+		s.writeJSONRPCError(w, r, taskError(err, "list tasks"))
 		return
 	}
 
-	// Flush the headers to the client
-	flusher, ok := w.(http.Flusher)
+	s.writeResponse(w, r, req.ID, resp.Result)
+}
+
+// handleGetTaskArtifacts handles the tasks/artifacts/get method.
+func (s *Server) handleGetTaskArtifacts(w http.ResponseWriter, r *http.Request, rpcReq *a2a.JSONRPCRequest, params a2a.TaskArtifactsParams) {
+	ctx, span := s.tracer.Start(r.Context(), "server.handleGetTaskArtifacts")
+	defer span.End()
+
+	r = r.WithContext(ctx)
+
+	reader, ok := s.taskManager.(ArtifactReader)
 	if !ok {
-		s.writeError(ctx, w, a2a.InternalErrorCode, "streaming not supported by response writer")
+		s.writeError(w, r, a2a.UnsupportedOperationErrorCode, "artifact ranges are not supported")
 		return
 	}
 
-	events, ok := ch.(<-chan a2a.TaskEvent)
+	req := a2a.GetTaskArtifactsRequest{JSONRPCRequest: *rpcReq, Params: params}
+
+	span.SetAttributes(attribute.String("a2a.task_id", req.Params.ID))
+
+	ctx = s.withTask(ctx, req.Params.ID)
+	resp, err := reader.OnGetTaskArtifacts(ctx, &req)
+	if err != nil {
+		s.writeJSONRPCError(w, r, taskError(err, "get task artifacts"))
+		return
+	}
+
+	s.writeResponse(w, r, req.ID, resp.Result)
+}
+
+// handleSendTaskStreaming handles the tasks/sendSubscribe method.
+func (s *Server) handleSendTaskStreaming(w http.ResponseWriter, r *http.Request, rpcReq *a2a.JSONRPCRequest, params a2a.TaskSendParams) {
+	ctx, span := s.tracer.Start(r.Context(), "server.handleSendTaskStreaming")
+	defer span.End()
+
+	r = r.WithContext(ctx)
+
+	req := a2a.SendTaskStreamingRequest{JSONRPCRequest: *rpcReq, Params: params}
+	if req.Params.DryRun {
+		s.writeJSONRPCError(w, r, invalidParams(errors.New("dry run is not supported by tasks/sendSubscribe")))
+		return
+	}
+	if err := a2a.ValidateEventTypes(req.Params.EventTypes); err != nil {
+		s.writeJSONRPCError(w, r, invalidParams(err))
+		return
+	}
+	if jerr := s.checkSendParams(r, &req.Params); jerr != nil {
+		s.writeJSONRPCError(w, r, jerr)
+		return
+	}
+	if jerr := s.assignIDs(&req.Params); jerr != nil {
+		s.writeJSONRPCError(w, r, jerr)
+		return
+	}
+
+	span.SetAttributes(attribute.String("a2a.task_id", req.Params.ID))
+	s.journalMessage(ctx, req.Params.ID, req.Params.Message)
+
+	release, ok := s.acquireSession(w, r, req.Params)
 	if !ok {
 		return
 	}
+	defer release()
+
+	ctx = s.withSentTask(ctx, req.Params)
+	sw, err := newSSEWriter(ctx, w, s.codec, req.ID, req.Params.ID, s.maxFrameRate, s.notifier, s.events)
+	if err != nil {
+		s.writeError(w, r, a2a.InternalErrorCode, err.Error())
+		return
+	}
+	sw.propagate = s.propagateMetadata(req.Params.Metadata)
+	sw.eventTypes = req.Params.EventTypes
+	sw.journal = s.journal
+	sw.coalesce = s.streamCoalesce
+	sw.startKeepalive(s.streamKeepalive)
+	defer sw.Close()
+	defer s.addStream(sw)()
+
+	timer, err := s.startTaskTimer(ctx, req.Params, sw)
+	if err != nil {
+		sw.abandon()
+		s.writeJSONRPCError(w, r, invalidParams(err))
+		return
+	}
+
+	// The stream outlives the cancellation of the handler, which may still end the task.
+	runCtx, done := s.runningTasks.start(ctx, req.Params.ID)
+	defer done()
+	if s.recovery {
+		defer s.recoverStream(ctx, timer, req.Params.ID, sw)
+	}
 
-	// Begin streaming events
-	for event := range events {
-		// Marshal event to JSON
-		resp := &a2a.JSONRPCResponse{
-			JSONRPCMessage: a2a.NewJSONRPCMessage(id),
-			Result:         event,
+	if handler, ok := s.taskManager.(StreamHandler); ok {
+		err := timer.run(runCtx, func(ctx context.Context) error {
+			return handler.OnSendTaskStream(ctx, &req, sw)
+		})
+		if cerr, canceled := canceledByClient(runCtx); err != nil && canceled {
+			// The handler gave up with its context: end the stream with the canceled status,
+			// unless it already ended, such as in input-required.
+			sw.end(s.canceledStatus(ctx, req.Params.ID, cerr))
+		} else if err != nil && !errors.Is(err, errTaskExpired) {
+			err = s.checkDeadline(runCtx, req.Params.ID, err)
+			s.writeStreamError(w, r, sw, taskError(err, "stream task"))
 		}
+		s.settleStream(ctx, timer, req.Params.ID)
+		return
+	}
 
-		data, err := sonic.ConfigFastest.Marshal(resp)
+	err = timer.run(runCtx, func(ctx context.Context) error {
+		eventsCh, err := s.taskManager.OnSendTaskSubscribe(ctx, &req)
 		if err != nil {
-			s.logger.ErrorContext(ctx, "marshal event", slog.Any("error", err))
-			continue
+			s.writeStreamError(w, r, sw, taskError(err, "subscribe to task"))
+			return nil
 		}
 
-		// Write event to the client
-		_, err = fmt.Fprintf(w, "data: %s\n\n", data)
+		for resp := range eventsCh {
+			if resp == nil {
+				continue
+			}
+
+			var err error
+			switch {
+			case resp.Error != nil:
+				err = sw.sendError(resp.Error)
+			case resp.Result != nil:
+				event := resp.Result
+				if ae, ok := event.(*a2a.TaskArtifactUpdateEvent); ok && sw.propagate != nil && !ae.IsCompressed() {
+					propagated := *ae
+					propagated.Artifact = sw.propagate(ae.Artifact)
+					event = &propagated
+				}
+				err = sw.sendEvent(event)
+			default:
+				continue
+			}
+			if err != nil {
+				s.logger.ErrorContext(ctx, "write event", slog.Any("error", err))
+				return nil
+			}
+		}
+		return nil
+	})
+	if err == nil {
+		s.settleStream(ctx, timer, req.Params.ID)
+	}
+}
+
+// canceledStatus returns the status of taskID, canceled by tasks/cancel with cerr, as stored
+// by the task manager if it can be loaded.
+func (s *Server) canceledStatus(ctx context.Context, taskID string, cerr *CancelError) a2a.TaskStatus {
+	if task := s.loadTask(ctx, taskID); task != nil && task.Status.State == a2a.TaskStateCanceled {
+		return task.Status
+	}
+	return a2a.TaskStatus{State: a2a.TaskStateCanceled, Reason: cerr.Reason, Timestamp: time.Now().UTC()}
+}
+
+// settleStream disarms the timer of a streamed task once the stream is over, if the task is done.
+func (s *Server) settleStream(ctx context.Context, timer *taskTimer, taskID string) {
+	if timer == nil {
+		return
+	}
+	resp, err := s.taskManager.OnGetTask(ctx, &a2a.GetTaskRequest{
+		Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: taskID}},
+	})
+	if err == nil {
+		timer.settle(resp.Result)
+	}
+}
+
+// handleTaskResubscription handles the tasks/resubscribe method.
+//
+// Unknown tasks get the task-not-found error. The stream of a task whose events are kept, see
+// [WithEventReplay], is resumed; a task that is over otherwise gets a single event with its
+// final status, and other tasks are left to the task manager.
+func (s *Server) handleTaskResubscription(w http.ResponseWriter, r *http.Request, rpcReq *a2a.JSONRPCRequest, params a2a.TaskResubscriptionParams) {
+	ctx, span := s.tracer.Start(r.Context(), "server.handleTaskResubscription")
+	defer span.End()
+
+	r = r.WithContext(ctx)
+
+	req := a2a.TaskResubscriptionRequest{JSONRPCRequest: *rpcReq, Params: params}
+
+	span.SetAttributes(attribute.String("a2a.task_id", req.Params.ID))
+
+	if err := a2a.ValidateEventTypes(req.Params.EventTypes); err != nil {
+		s.writeJSONRPCError(w, r, invalidParams(err))
+		return
+	}
+
+	var lastEventID int64
+	if v := r.Header.Get("Last-Event-ID"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || id < 0 {
+			jerr := a2a.NewInvalidRequestError()
+			jerr.Data = fmt.Sprintf("invalid Last-Event-ID %q", v)
+			s.writeJSONRPCError(w, r, jerr)
+			return
+		}
+		lastEventID = id
+	}
+
+	// Unknown tasks are refused before the response turns into an event stream. Tasks with
+	// kept events are known, even to task managers that do not store streamed tasks.
+	var task *a2a.Task
+	if s.events == nil || !s.events.has(req.Params.ID) {
+		resp, err := s.taskManager.OnGetTask(ctx, &a2a.GetTaskRequest{
+			JSONRPCRequest: req.JSONRPCRequest,
+			Params:         a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: req.Params.ID}},
+		})
 		if err != nil {
+			s.writeJSONRPCError(w, r, taskError(err, "resubscribe to task"))
+			return
+		}
+		task = resp.Result
+	}
+
+	// Events replayed to resubscribers were already pushed by the stream that produced them.
+	sw, err := newSSEWriter(ctx, w, s.codec, req.ID, req.Params.ID, s.maxFrameRate, nil, nil)
+	if err != nil {
+		s.writeError(w, r, a2a.InternalErrorCode, err.Error())
+		return
+	}
+	sw.eventTypes = req.Params.EventTypes
+	sw.coalesce = s.streamCoalesce
+	sw.startKeepalive(s.streamKeepalive)
+	defer sw.Close()
+	defer s.addStream(sw)()
+
+	if s.events != nil {
+		err := s.events.follow(ctx, req.Params.ID, lastEventID, sw.replayEvent)
+		if !errors.Is(err, errNoEventLog) {
+			if err != nil {
+				s.logger.ErrorContext(ctx, "replay events", slog.String("task_id", req.Params.ID), slog.Any("error", err))
+			}
+			return
+		}
+	}
+
+	// A task that is over has no events to follow, and those it streamed, if any, are no
+	// longer kept: its final status stands for them.
+	if task != nil && isFinalState(task.Status.State) {
+		span.SetAttributes(attribute.String("a2a.task_state", string(task.Status.State)))
+		if err := sw.sendEvent(&a2a.TaskStatusUpdateEvent{ID: task.ID, Status: task.Status, Final: true}); err != nil {
 			s.logger.ErrorContext(ctx, "write event", slog.Any("error", err))
-			break
 		}
+		return
+	}
 
-		flusher.Flush()
+	ctx = s.withTask(ctx, req.Params.ID)
+	result, err := s.taskManager.OnResubscribeToTask(ctx, &req)
+	if err != nil {
+		s.writeStreamError(w, r, sw, taskError(err, "subscribe to task"))
+		return
+	}
+
+	switch result := result.(type) {
+	case <-chan a2a.TaskEvent:
+		for event := range result {
+			if err := sw.sendEvent(event); err != nil {
+				s.logger.ErrorContext(ctx, "write event", slog.Any("error", err))
+				return
+			}
+		}
+
+	case *a2a.JSONRPCResponse:
+		sw.abandon()
+		if result.Error != nil {
+			s.writeError(w, r, result.Error.Code, result.Error.Message)
+			return
+		}
+		s.writeResponse(w, r, req.ID, result.Result)
 	}
 }
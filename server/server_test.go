@@ -0,0 +1,830 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"cmp"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/sonic"
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestServer_AcceptedOutputModes(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{
+		Name:               "test",
+		URL:                "http://example.com",
+		Version:            "1.0.0",
+		DefaultOutputModes: []string{"text/plain"},
+		Skills:             []a2a.AgentSkill{{ID: "chart", Name: "chart", OutputModes: []string{"image/png"}}},
+	}
+	srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager()))
+	t.Cleanup(srv.Close)
+
+	tests := map[string]struct {
+		accepted string
+		wantCode int
+		// wantData is part of the data of the error, telling why the modes were refused.
+		wantData string
+	}{
+		"none sent": {
+			accepted: `[]`,
+		},
+		"default mode": {
+			accepted: `["TEXT/PLAIN"]`,
+		},
+		"skill mode by wildcard": {
+			accepted: `["image/*"]`,
+		},
+		"no overlap": {
+			accepted: `["audio/mpeg"]`,
+			wantCode: a2a.ContentTypeNotSupportedErrorCode,
+			wantData: a2a.ErrNoOutputMode.Error(),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			body := `{"jsonrpc":"2.0","id":1,"method":"tasks/send","params":{"id":"task-` + strings.ReplaceAll(name, " ", "-") + `",` +
+				`"acceptedOutputModes":` + tt.accepted + `,"message":{"role":"user","parts":[{"type":"text","text":"hi"}]}}}`
+			resp, err := http.Post(srv.URL, "application/json", strings.NewReader(body))
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			data, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("read body: %v", err)
+			}
+			var got a2a.JSONRPCResponse
+			if err := sonic.ConfigFastest.Unmarshal(data, &got); err != nil {
+				t.Fatalf("Unmarshal(%q) error = %v", data, err)
+			}
+
+			var code int
+			if got.Error != nil {
+				code = got.Error.Code
+			}
+			if code != tt.wantCode {
+				t.Errorf("response error = %+v, want code %d", got.Error, tt.wantCode)
+			}
+			if got.Error != nil {
+				if data, _ := got.Error.Data.(string); !strings.Contains(data, tt.wantData) {
+					t.Errorf("response error data = %v, want it to contain %q", got.Error.Data, tt.wantData)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_AcceptedInputModes(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{
+		Name:              "test",
+		URL:               "http://example.com",
+		Version:           "1.0.0",
+		DefaultInputModes: []string{"text", "image/*"},
+		Skills: []a2a.AgentSkill{
+			{ID: "ocr", Name: "ocr", InputModes: []string{"application/pdf"}},
+			{ID: "chat", Name: "chat"},
+		},
+	}
+	srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager()))
+	t.Cleanup(srv.Close)
+
+	file := func(mimeType string) string {
+		return `{"type":"file","file":{"mimeType":"` + mimeType + `","uri":"https://example.com/f"}}`
+	}
+	tests := map[string]struct {
+		method   string
+		part     string
+		skill    string
+		wantCode int
+	}{
+		"text": {
+			part: `{"type":"text","text":"hi"}`,
+		},
+		"default file type by wildcard": {
+			part: file("image/png"),
+		},
+		"skill file type": {
+			part: file("application/pdf"),
+		},
+		"unsupported file type": {
+			part:     file("audio/mpeg"),
+			wantCode: a2a.ContentTypeNotSupportedErrorCode,
+		},
+		"unsupported file type streaming": {
+			method:   a2a.MethodTasksSendSubscribe,
+			part:     file("audio/mpeg"),
+			wantCode: a2a.ContentTypeNotSupportedErrorCode,
+		},
+		"data": {
+			part:     `{"type":"data","data":{"a":1}}`,
+			wantCode: a2a.ContentTypeNotSupportedErrorCode,
+		},
+		"other skill file type": {
+			part:     file("application/pdf"),
+			skill:    "chat",
+			wantCode: a2a.ContentTypeNotSupportedErrorCode,
+		},
+		"skill without modes takes the defaults": {
+			part:  file("image/png"),
+			skill: "chat",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method := cmp.Or(tt.method, a2a.MethodTasksSend)
+			metadata := `{}`
+			if tt.skill != "" {
+				metadata = `{"skillId":"` + tt.skill + `"}`
+			}
+			params := `{"id":"task-` + strings.ReplaceAll(name, " ", "-") + `","metadata":` + metadata +
+				`,"message":{"role":"user","parts":[` + tt.part + `]}}`
+			resp, err := http.Post(srv.URL, "application/json", strings.NewReader(
+				`{"jsonrpc":"2.0","id":1,"method":"`+method+`","params":`+params+`}`))
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			if tt.wantCode == 0 {
+				if resp.StatusCode != http.StatusOK {
+					t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+				}
+				data, _ := io.ReadAll(resp.Body)
+				if strings.Contains(string(data), `"error"`) {
+					t.Errorf("response = %s, want no error", data)
+				}
+				return
+			}
+			var got a2a.JSONRPCResponse
+			if err := sonic.ConfigFastest.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if got.Error == nil || got.Error.Code != tt.wantCode {
+				t.Errorf("error = %+v, want code %d", got.Error, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestServer_RequestEnvelope(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager()))
+	t.Cleanup(srv.Close)
+
+	tests := map[string]struct {
+		body       string
+		wantStatus int
+		// wantBody is the exact response, or empty for notifications.
+		wantBody string
+	}{
+		"parse error": {
+			body:       `{"jsonrpc":"2.0","id":1,`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"Invalid JSON payload"}}`,
+		},
+		"wrong version echoes ID": {
+			body:       `{"jsonrpc":"1.0","id":"req-1","method":"tasks/get"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"jsonrpc":"2.0","id":"req-1","error":{"code":-32600,"message":"Request payload validation error","data":"unsupported jsonrpc version \"1.0\""}}`,
+		},
+		"missing method": {
+			body:       `{"jsonrpc":"2.0","id":3}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"jsonrpc":"2.0","id":3,"error":{"code":-32600,"message":"Request payload validation error","data":"missing method"}}`,
+		},
+		"method not found echoes ID": {
+			body:       `{"jsonrpc":"2.0","id":"req-2","method":"tasks/unknown"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"jsonrpc":"2.0","id":"req-2","error":{"code":-32601,"message":"Method not found"}}`,
+		},
+		"missing params": {
+			body:       `{"jsonrpc":"2.0","id":4,"method":"tasks/get"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"jsonrpc":"2.0","id":4,"error":{"code":-32602,"message":"Invalid parameters","data":"invalid params: missing params"}}`,
+		},
+		"null ID": {
+			body:       `{"jsonrpc":"2.0","id":null,"method":"tasks/unknown"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"jsonrpc":"2.0","id":null,"error":{"code":-32601,"message":"Method not found"}}`,
+		},
+		"notification": {
+			body:       `{"jsonrpc":"2.0","method":"tasks/get","params":{"id":"missing"}}`,
+			wantStatus: http.StatusNoContent,
+		},
+		"empty batch": {
+			body:       `[]`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"Request payload validation error","data":"empty batch"}}`,
+		},
+		"batch": {
+			body:       `[{"jsonrpc":"2.0","id":1,"method":"tasks/unknown"},{"jsonrpc":"2.0","method":"tasks/unknown"},1]`,
+			wantStatus: http.StatusOK,
+			wantBody: `[{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"Method not found","data":{"method":"tasks/unknown","reason":"Method not found"}}},` +
+				`{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"Request payload validation error","data":"not a request object"}}]`,
+		},
+		"batch of notifications": {
+			body:       `[{"jsonrpc":"2.0","method":"tasks/get","params":{"id":"missing"}}]`,
+			wantStatus: http.StatusNoContent,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := http.Post(srv.URL, "application/json", strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			data, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("read body: %v", err)
+			}
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if diff := gocmp.Diff(tt.wantBody, string(data)); diff != "" {
+				t.Errorf("body mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServer_SkillParams(t *testing.T) {
+	t.Parallel()
+
+	type lookup struct {
+		Query string `json:"query"`
+		Limit int    `json:"limit,omitempty"`
+	}
+	card := &a2a.AgentCard{
+		Name:    "test",
+		URL:     "http://example.com",
+		Version: "1.0.0",
+		Skills:  []a2a.AgentSkill{{ID: "lookup", Name: "lookup", Parameters: a2a.SkillParamsFromStruct(lookup{})}},
+	}
+	srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager()))
+	t.Cleanup(srv.Close)
+
+	tests := map[string]struct {
+		metadata string
+		data     string
+		wantCode int
+	}{
+		"no skill": {
+			metadata: `{}`,
+			data:     `{"anything":true}`,
+		},
+		"valid params": {
+			metadata: `{"skillId":"lookup"}`,
+			data:     `{"query":"go","limit":5}`,
+		},
+		"wrong shape": {
+			metadata: `{"skillId":"lookup"}`,
+			data:     `{"limit":"five"}`,
+			wantCode: a2a.InvalidParamsErrorCode,
+		},
+		"unknown skill": {
+			metadata: `{"skillId":"translate"}`,
+			data:     `{"query":"go"}`,
+			wantCode: a2a.InvalidParamsErrorCode,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			body := `{"jsonrpc":"2.0","id":1,"method":"tasks/send","params":{"id":"task-` + strings.ReplaceAll(name, " ", "-") + `",` +
+				`"metadata":` + tt.metadata + `,"message":{"role":"user","parts":[{"type":"data","data":` + tt.data + `}]}}}`
+			resp, err := http.Post(srv.URL, "application/json", strings.NewReader(body))
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			data, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("read body: %v", err)
+			}
+			var got a2a.JSONRPCResponse
+			if err := sonic.ConfigFastest.Unmarshal(data, &got); err != nil {
+				t.Fatalf("Unmarshal(%q) error = %v", data, err)
+			}
+
+			var code int
+			if got.Error != nil {
+				code = got.Error.Code
+			}
+			if code != tt.wantCode {
+				t.Errorf("response error = %+v, want code %d", got.Error, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestServer_AutoSessionID(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager(), server.WithAutoSessionID()))
+	t.Cleanup(srv.Close)
+
+	send := func(taskID string) string {
+		t.Helper()
+
+		body := `{"jsonrpc":"2.0","id":1,"method":"tasks/send","params":{"id":"` + taskID + `",` +
+			`"message":{"role":"user","parts":[{"type":"text","text":"hi"}]}}}`
+		resp, err := http.Post(srv.URL, "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Post() error = %v", err)
+		}
+		defer resp.Body.Close()
+
+		var got a2a.SendTaskResponse
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		if err := sonic.ConfigFastest.Unmarshal(data, &got); err != nil {
+			t.Fatalf("Unmarshal(%q) error = %v", data, err)
+		}
+		if got.Error != nil || got.Result == nil {
+			t.Fatalf("tasks/send error = %+v", got.Error)
+		}
+		return got.Result.SessionID
+	}
+
+	first := send("task-1")
+	if _, err := a2a.NormalizeSessionID(first); err != nil {
+		t.Fatalf("generated session ID %q: %v", first, err)
+	}
+	if again := send("task-1"); again != first {
+		t.Errorf("second turn session ID = %q, want %q", again, first)
+	}
+	if other := send("task-2"); other == first {
+		t.Errorf("unrelated task joined session %q", first)
+	}
+}
+
+func TestServer_PushNotifications(t *testing.T) {
+	t.Parallel()
+
+	post := func(t *testing.T, url, method, params string) a2a.JSONRPCResponse {
+		t.Helper()
+
+		body := `{"jsonrpc":"2.0","id":1,"method":"` + method + `","params":` + params + `}`
+		resp, err := http.Post(url, "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Post() error = %v", err)
+		}
+		defer resp.Body.Close()
+
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		var got a2a.JSONRPCResponse
+		if err := sonic.ConfigFastest.Unmarshal(data, &got); err != nil {
+			t.Fatalf("Unmarshal(%q) error = %v", data, err)
+		}
+		return got
+	}
+	errorCode := func(resp a2a.JSONRPCResponse) int {
+		if resp.Error == nil {
+			return 0
+		}
+		return resp.Error.Code
+	}
+
+	const (
+		config = `{"id":"task-1","pushNotificationConfig":{"url":"https://example.com/hook","token":"secret","eventTypes":["status"]}}`
+		taskID = `{"id":"task-1"}`
+	)
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+
+	t.Run("not supported", func(t *testing.T) {
+		t.Parallel()
+
+		srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager()))
+		t.Cleanup(srv.Close)
+
+		for _, method := range []string{a2a.MethodTasksPushNotificationSet, a2a.MethodTasksPushNotificationGet} {
+			if got := errorCode(post(t, srv.URL, method, config)); got != a2a.PushNotificationNotSupportedErrorCode {
+				t.Errorf("%s error code = %d, want %d", method, got, a2a.PushNotificationNotSupportedErrorCode)
+			}
+		}
+	})
+
+	t.Run("set and get", func(t *testing.T) {
+		t.Parallel()
+
+		tm := server.NewInMemoryTaskManager()
+		srv := httptest.NewServer(server.NewServer("", "", card, tm,
+			server.WithPushNotifications(server.NewInMemoryPushNotificationStore())))
+		t.Cleanup(srv.Close)
+
+		// Unknown tasks cannot be subscribed to, and have no config.
+		if got := errorCode(post(t, srv.URL, a2a.MethodTasksPushNotificationSet, config)); got != a2a.TaskNotFoundErrorCode {
+			t.Errorf("set on unknown task error code = %d, want %d", got, a2a.TaskNotFoundErrorCode)
+		}
+
+		if _, err := tm.OnSendTask(t.Context(), &a2a.SendTaskRequest{
+			Params: a2a.TaskSendParams{
+				TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+				Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Text: "hi"}}},
+			},
+		}); err != nil {
+			t.Fatalf("OnSendTask() error = %v", err)
+		}
+
+		if got := errorCode(post(t, srv.URL, a2a.MethodTasksPushNotificationGet, taskID)); got != a2a.TaskNotFoundErrorCode {
+			t.Errorf("get before set error code = %d, want %d", got, a2a.TaskNotFoundErrorCode)
+		}
+
+		insecure := `{"id":"task-1","pushNotificationConfig":{"url":"http://example.com/hook"}}`
+		if got := errorCode(post(t, srv.URL, a2a.MethodTasksPushNotificationSet, insecure)); got != a2a.InvalidParamsErrorCode {
+			t.Errorf("set with http URL error code = %d, want %d", got, a2a.InvalidParamsErrorCode)
+		}
+
+		if resp := post(t, srv.URL, a2a.MethodTasksPushNotificationSet, config); resp.Error != nil {
+			t.Fatalf("set error = %+v", resp.Error)
+		}
+		resp := post(t, srv.URL, a2a.MethodTasksPushNotificationGet, taskID)
+		if resp.Error != nil {
+			t.Fatalf("get error = %+v", resp.Error)
+		}
+		data, err := sonic.ConfigFastest.Marshal(resp.Result)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		var got a2a.TaskPushNotificationConfig
+		if err := sonic.ConfigFastest.Unmarshal(data, &got); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		want := a2a.TaskPushNotificationConfig{
+			ID: "task-1",
+			PushNotificationConfig: a2a.PushNotificationConfig{
+				URL:        "https://example.com/hook",
+				Token:      "secret",
+				EventTypes: []string{a2a.EventTypeStatus},
+			},
+		}
+		if diff := gocmp.Diff(want, got); diff != "" {
+			t.Errorf("get result mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func TestServer_MaxRequestBytes(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager(),
+		server.WithMaxRequestBytes(256), server.WithMaxFileBytes(8)))
+	t.Cleanup(srv.Close)
+
+	sendFile := func(content string) string {
+		return `{"jsonrpc":"2.0","id":1,"method":"tasks/send","params":{"id":"task-1","message":` +
+			`{"role":"user","parts":[{"type":"file","file":{"bytes":"` + content + `"}}]}}}`
+	}
+
+	tests := map[string]struct {
+		body io.Reader
+		// wantStatus is the HTTP status, and wantCode the JSON-RPC error code, if any.
+		wantStatus int
+		wantCode   int
+	}{
+		"within limits": {
+			body:       strings.NewReader(sendFile("aGVsbG8=")),
+			wantStatus: http.StatusOK,
+		},
+		"content length over limit": {
+			body:       strings.NewReader(sendFile(strings.Repeat("A", 256))),
+			wantStatus: http.StatusRequestEntityTooLarge,
+			wantCode:   a2a.InvalidRequestErrorCode,
+		},
+		"chunked body over limit": {
+			// Without a known length, the body is sent chunked and cut off while reading.
+			body:       io.MultiReader(strings.NewReader(sendFile(strings.Repeat("A", 256)))),
+			wantStatus: http.StatusRequestEntityTooLarge,
+			wantCode:   a2a.InvalidRequestErrorCode,
+		},
+		"file over limit": {
+			body:       strings.NewReader(sendFile("aGVsbG8gd29ybGQh")),
+			wantStatus: http.StatusOK,
+			wantCode:   a2a.InvalidParamsErrorCode,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := http.Post(srv.URL, "application/json", tt.body)
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			var rpcResp a2a.JSONRPCResponse
+			if err := sonic.ConfigFastest.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			var gotCode int
+			if rpcResp.Error != nil {
+				gotCode = rpcResp.Error.Code
+			}
+			if gotCode != tt.wantCode {
+				t.Errorf("error = %v, want code %d", rpcResp.Error, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestServer_MessageLimits(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager(),
+		server.WithMessageLimits(2, 16)))
+	t.Cleanup(srv.Close)
+
+	request := func(method, parts string) string {
+		return `{"jsonrpc":"2.0","id":1,"method":"` + method + `","params":{"id":"task-1","message":` +
+			`{"role":"user","parts":[` + parts + `]}}}`
+	}
+
+	tests := map[string]struct {
+		body     string
+		wantCode int
+		wantData string
+	}{
+		"within limits": {
+			body: request(a2a.MethodTasksSend, `{"type":"text","text":"hello"},{"type":"data","data":{"n":1}}`),
+		},
+		"too many parts": {
+			body:     request(a2a.MethodTasksSend, `{"type":"text","text":"a"},{"type":"text","text":"b"},{"type":"text","text":"c"}`),
+			wantCode: a2a.InvalidParamsErrorCode,
+			wantData: "3 parts, limit is 2",
+		},
+		"too much content": {
+			body:     request(a2a.MethodTasksSend, `{"type":"text","text":"hello"},{"type":"file","file":{"bytes":"aGVsbG8gd29ybGQh"}}`),
+			wantCode: a2a.InvalidParamsErrorCode,
+			wantData: "limit is 16",
+		},
+		"streaming": {
+			body:     request(a2a.MethodTasksSendSubscribe, `{"type":"data","data":{"text":"hello world"}}`),
+			wantCode: a2a.InvalidParamsErrorCode,
+			wantData: "limit is 16",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := http.Post(srv.URL, "application/json", strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			var rpcResp a2a.JSONRPCResponse
+			if err := sonic.ConfigFastest.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if tt.wantCode == 0 {
+				if rpcResp.Error != nil {
+					t.Fatalf("error = %v, want none", rpcResp.Error)
+				}
+				return
+			}
+			if rpcResp.Error == nil || rpcResp.Error.Code != tt.wantCode {
+				t.Fatalf("error = %v, want code %d", rpcResp.Error, tt.wantCode)
+			}
+			if data, _ := rpcResp.Error.Data.(string); !strings.Contains(data, tt.wantData) {
+				t.Errorf("error data = %v, want it to contain %q", rpcResp.Error.Data, tt.wantData)
+			}
+		})
+	}
+}
+
+func TestServer_GetTaskTrim(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager()))
+	t.Cleanup(srv.Close)
+
+	post := func(t *testing.T, body string) *a2a.Task {
+		t.Helper()
+
+		resp, err := http.Post(srv.URL, "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Post() error = %v", err)
+		}
+		defer resp.Body.Close()
+
+		var rpcResp a2a.GetTaskResponse
+		if err := sonic.ConfigFastest.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if rpcResp.Error != nil {
+			t.Fatalf("response error = %v", rpcResp.Error)
+		}
+		return rpcResp.Result
+	}
+	for _, text := range []string{"one", "two", "three"} {
+		post(t, `{"jsonrpc":"2.0","id":1,"method":"tasks/send","params":{"id":"task-1",`+
+			`"message":{"role":"user","parts":[{"type":"text","text":"`+text+`"}]}}}`)
+	}
+
+	tests := map[string]struct {
+		params      string
+		wantHistory int
+	}{
+		"whole history":  {params: `{"id":"task-1"}`, wantHistory: 3},
+		"last message":   {params: `{"id":"task-1","historyLength":1}`, wantHistory: 1},
+		"no history":     {params: `{"id":"task-1","historyLength":0}`, wantHistory: 0},
+		"status only":    {params: `{"id":"task-1","historyLength":0,"includeArtifacts":false}`, wantHistory: 0},
+		"over the limit": {params: `{"id":"task-1","historyLength":10}`, wantHistory: 3},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			task := post(t, `{"jsonrpc":"2.0","id":2,"method":"tasks/get","params":`+tt.params+`}`)
+			if len(task.History) != tt.wantHistory {
+				t.Errorf("history length = %d, want %d", len(task.History), tt.wantHistory)
+			}
+			if task.Status.State != a2a.TaskStateSubmitted {
+				t.Errorf("state = %q, want %q", task.Status.State, a2a.TaskStateSubmitted)
+			}
+		})
+	}
+}
+
+func TestServer_MIMEValidation(t *testing.T) {
+	t.Parallel()
+
+	const (
+		pdf = "JVBERi0xLjcK" // %PDF-1.7
+		png = "iVBORw0KGgo=" // PNG signature
+	)
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager(),
+		server.WithMIMEValidation(true)))
+	t.Cleanup(srv.Close)
+
+	request := func(method, file string) string {
+		return `{"jsonrpc":"2.0","id":1,"method":"` + method + `","params":{"message":` +
+			`{"role":"user","parts":[{"type":"file","file":` + file + `}]}}}`
+	}
+
+	tests := map[string]struct {
+		body     string
+		wantCode int
+		wantType string
+	}{
+		"matching": {
+			body:     request(a2a.MethodTasksSend, `{"mimeType":"image/png","bytes":"`+png+`"}`),
+			wantType: "image/png",
+		},
+		"sniffed": {
+			body:     request(a2a.MethodTasksSend, `{"bytes":"`+pdf+`"}`),
+			wantType: "application/pdf",
+		},
+		"spoofed": {
+			body:     request(a2a.MethodTasksSend, `{"mimeType":"image/png","bytes":"`+pdf+`"}`),
+			wantCode: a2a.ContentTypeNotSupportedErrorCode,
+		},
+		"spoofed streaming": {
+			body:     request(a2a.MethodTasksSendSubscribe, `{"mimeType":"image/png","bytes":"`+pdf+`"}`),
+			wantCode: a2a.ContentTypeNotSupportedErrorCode,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := http.Post(srv.URL, "application/json", strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			var rpcResp a2a.SendTaskResponse
+			if err := sonic.ConfigFastest.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+			if tt.wantCode != 0 {
+				if rpcResp.Error == nil || rpcResp.Error.Code != tt.wantCode {
+					t.Fatalf("error = %v, want code %d", rpcResp.Error, tt.wantCode)
+				}
+				return
+			}
+			if rpcResp.Error != nil {
+				t.Fatalf("error = %v, want none", rpcResp.Error)
+			}
+			fp := rpcResp.Result.History[0].Parts[0].(*a2a.FilePart)
+			if fp.File.MIMEType != tt.wantType {
+				t.Errorf("MIME type = %q, want %q", fp.File.MIMEType, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestServer_Handler(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com/a2a/rpc", Version: "1.0.0"}
+	srv := server.NewServer("", "", card, server.NewInMemoryTaskManager(), server.WithEndpoint("/rpc"))
+
+	mux := http.NewServeMux()
+	mux.Handle("/a2a/", srv.Handler())
+	mux.Handle("/stripped/", http.StripPrefix("/stripped", srv.Handler()))
+	mux.HandleFunc("GET /other", func(w http.ResponseWriter, _ *http.Request) {
+		io.WriteString(w, "other")
+	})
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+
+	getTask := `{"jsonrpc":"2.0","id":1,"method":"tasks/get","params":{"id":"missing"}}`
+	tests := map[string]struct {
+		method, path, body string
+		wantStatus         int
+		wantBody           string
+	}{
+		"endpoint": {
+			method: http.MethodPost, path: "/a2a/rpc", body: getTask,
+			wantStatus: http.StatusOK, wantBody: `"code":-32001`,
+		},
+		"agent card": {
+			method: http.MethodGet, path: "/a2a/.well-known/agent.json",
+			wantStatus: http.StatusOK, wantBody: `"name":"test"`,
+		},
+		"health probe": {
+			method: http.MethodGet, path: "/a2a/healthz",
+			wantStatus: http.StatusOK,
+		},
+		"endpoint outside the mount": {
+			method: http.MethodPost, path: "/rpc", body: getTask,
+			wantStatus: http.StatusNotFound,
+		},
+		"mounted with StripPrefix": {
+			method: http.MethodPost, path: "/stripped/rpc", body: getTask,
+			wantStatus: http.StatusOK, wantBody: `"code":-32001`,
+		},
+		"other route": {
+			method: http.MethodGet, path: "/other",
+			wantStatus: http.StatusOK, wantBody: "other",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req, err := http.NewRequestWithContext(t.Context(), tt.method, ts.URL+tt.path, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("NewRequest() error = %v", err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(resp.Body)
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body %s", resp.StatusCode, tt.wantStatus, body)
+			}
+			if !strings.Contains(string(body), tt.wantBody) {
+				t.Errorf("body = %s, want it to contain %s", body, tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/go-a2a/a2a"
+)
+
+// ErrSessionBusy is returned for a task sent to a session already running as many tasks as
+// [WithSessionConcurrency] allows, when it cannot wait for one of them to finish.
+var ErrSessionBusy = errors.New("session busy")
+
+// sessionLimiter is a keyed semaphore, capping the tasks in flight per session.
+type sessionLimiter struct {
+	// limit is the number of tasks a session may run at once.
+	limit int
+
+	// queue is the number of tasks that may wait per session, or negative for no bound.
+	queue int
+
+	mu       sync.Mutex
+	sessions map[uuid.UUID]*sessionSlots
+}
+
+// sessionSlots holds the slots of one session. It is forgotten once no task holds or waits
+// for a slot, so idle sessions cost no memory.
+type sessionSlots struct {
+	sem chan struct{}
+
+	// waiting is the number of tasks waiting for a slot, and users the number of tasks
+	// holding or waiting for one. The limiter's mu guards both.
+	waiting int
+	users   int
+}
+
+// newSessionLimiter creates a new [sessionLimiter] of limit slots per session, with at most
+// queue tasks waiting per session, or any number if queue is negative.
+func newSessionLimiter(limit, queue int) *sessionLimiter {
+	return &sessionLimiter{
+		limit:    max(limit, 1),
+		queue:    queue,
+		sessions: make(map[uuid.UUID]*sessionSlots),
+	}
+}
+
+// acquire takes a slot of the session, waiting until one is free, and returns the function
+// releasing it. It fails with [ErrSessionBusy] if the queue of the session is full, or ctx is
+// done before a slot is free. Tasks without a session, or without a limiter, are not limited.
+func (l *sessionLimiter) acquire(ctx context.Context, session uuid.UUID) (func(), error) {
+	if l == nil || session == uuid.Nil {
+		return func() {}, nil
+	}
+
+	l.mu.Lock()
+	slots := l.sessions[session]
+	if slots == nil {
+		slots = &sessionSlots{sem: make(chan struct{}, l.limit)}
+		l.sessions[session] = slots
+	}
+	slots.users++
+	select {
+	case slots.sem <- struct{}{}:
+		l.mu.Unlock()
+		return l.releaser(session, slots), nil
+	default:
+	}
+	if l.queue >= 0 && slots.waiting >= l.queue {
+		l.leave(session, slots)
+		l.mu.Unlock()
+		return nil, ErrSessionBusy
+	}
+	slots.waiting++
+	l.mu.Unlock()
+
+	select {
+	case slots.sem <- struct{}{}:
+		l.mu.Lock()
+		slots.waiting--
+		l.mu.Unlock()
+		return l.releaser(session, slots), nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		slots.waiting--
+		l.leave(session, slots)
+		l.mu.Unlock()
+		return nil, fmt.Errorf("%w: %w", ErrSessionBusy, context.Cause(ctx))
+	}
+}
+
+// releaser returns the function releasing the slot taken in slots. It only releases it once.
+func (l *sessionLimiter) releaser(session uuid.UUID, slots *sessionSlots) func() {
+	return sync.OnceFunc(func() {
+		<-slots.sem
+		l.mu.Lock()
+		l.leave(session, slots)
+		l.mu.Unlock()
+	})
+}
+
+// leave drops a user of the slots of session, forgetting them once unused. The caller must hold mu.
+func (l *sessionLimiter) leave(session uuid.UUID, slots *sessionSlots) {
+	slots.users--
+	if slots.users == 0 && l.sessions[session] == slots {
+		delete(l.sessions, session)
+	}
+}
+
+// acquireSession takes a slot of the session of a sent task, see [WithSessionConcurrency], and
+// returns the function releasing it. A task that cannot get one is answered with an error, and
+// acquireSession returns false.
+func (s *Server) acquireSession(w http.ResponseWriter, r *http.Request, params a2a.TaskSendParams) (func(), bool) {
+	release, err := s.sessions.acquire(r.Context(), params.SessionID)
+	if err == nil {
+		return release, true
+	}
+
+	s.logger.InfoContext(r.Context(), "session busy",
+		slog.String("session_id", params.SessionID.String()),
+		slog.String("task_id", params.ID),
+		slog.Any("error", err),
+	)
+	jerr := a2a.NewInvalidRequestError()
+	jerr.Message = "Session busy"
+	jerr.Data = err.Error()
+	s.writeJSONRPCError(w, r, jerr)
+	return nil, false
+}
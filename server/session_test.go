@@ -0,0 +1,155 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+// gatedTaskManager reports each task it starts on entered, then runs it once released.
+type gatedTaskManager struct {
+	*server.InMemoryTaskManager
+
+	entered chan string
+	release chan struct{}
+}
+
+func (tm *gatedTaskManager) OnSendTask(ctx context.Context, req *a2a.SendTaskRequest) (*a2a.SendTaskResponse, error) {
+	tm.entered <- req.Params.ID
+	select {
+	case <-tm.release:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return tm.InMemoryTaskManager.OnSendTask(ctx, req)
+}
+
+func TestWithSessionConcurrency(t *testing.T) {
+	t.Parallel()
+
+	const (
+		session1 = "9f0e1c52-5d6a-4c8e-a1c4-0a4fb2a6a001"
+		session2 = "9f0e1c52-5d6a-4c8e-a1c4-0a4fb2a6a002"
+	)
+
+	tests := map[string]struct {
+		opts           []server.Option
+		sessions       [2]string
+		wantConcurrent bool
+		wantBusy       bool
+	}{
+		"same session": {
+			opts:     []server.Option{server.WithSessionConcurrency(1)},
+			sessions: [2]string{session1, session1},
+		},
+		"different sessions": {
+			opts:           []server.Option{server.WithSessionConcurrency(1)},
+			sessions:       [2]string{session1, session2},
+			wantConcurrent: true,
+		},
+		"no session": {
+			opts:           []server.Option{server.WithSessionConcurrency(1)},
+			wantConcurrent: true,
+		},
+		"limit of two": {
+			opts:           []server.Option{server.WithSessionConcurrency(2)},
+			sessions:       [2]string{session1, session1},
+			wantConcurrent: true,
+		},
+		"no queue": {
+			opts:     []server.Option{server.WithSessionConcurrency(1), server.WithSessionQueue(0)},
+			sessions: [2]string{session1, session1},
+			wantBusy: true,
+		},
+		"no limit": {
+			sessions:       [2]string{session1, session1},
+			wantConcurrent: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tm := &gatedTaskManager{
+				InMemoryTaskManager: server.NewInMemoryTaskManager(),
+				entered:             make(chan string, 2),
+				release:             make(chan struct{}),
+			}
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			srv := httptest.NewServer(server.NewServer("", "", card, tm, tt.opts...))
+			t.Cleanup(srv.Close)
+
+			errs := make(chan *a2a.JSONRPCError, 2)
+			send := func(taskID, session string) {
+				var sessionID string
+				if session != "" {
+					sessionID = `"sessionId":"` + session + `",`
+				}
+				body := `{"jsonrpc":"2.0","id":1,"method":"tasks/send","params":{"id":"` + taskID + `",` + sessionID +
+					`"message":{"role":"user","parts":[{"type":"text","text":"hi"}]}}}`
+				resp, err := http.Post(srv.URL, "application/json", strings.NewReader(body))
+				if err != nil {
+					errs <- &a2a.JSONRPCError{Message: err.Error()}
+					return
+				}
+				defer resp.Body.Close()
+				var rpcResp a2a.SendTaskResponse
+				if err := sonic.ConfigFastest.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+					errs <- &a2a.JSONRPCError{Message: err.Error()}
+					return
+				}
+				errs <- rpcResp.Error
+			}
+
+			go send("task-1", tt.sessions[0])
+			if got := <-tm.entered; got != "task-1" {
+				t.Fatalf("entered %q, want task-1", got)
+			}
+			go send("task-2", tt.sessions[1])
+
+			if tt.wantBusy {
+				jerr := <-errs
+				if jerr == nil || jerr.Code != a2a.InvalidRequestErrorCode || jerr.Message != "Session busy" {
+					t.Errorf("task-2 error = %v, want session busy", jerr)
+				}
+				tm.release <- struct{}{}
+				if jerr := <-errs; jerr != nil {
+					t.Errorf("task-1 error = %v", jerr)
+				}
+				return
+			}
+
+			select {
+			case <-tm.entered:
+				if !tt.wantConcurrent {
+					t.Fatal("task-2 started while task-1 held the session")
+				}
+			case <-time.After(100 * time.Millisecond):
+				if tt.wantConcurrent {
+					t.Fatal("task-2 did not start while task-1 was running")
+				}
+				tm.release <- struct{}{}
+				if got := <-tm.entered; got != "task-2" {
+					t.Fatalf("entered %q, want task-2", got)
+				}
+			}
+			close(tm.release)
+			for range 2 {
+				if jerr := <-errs; jerr != nil {
+					t.Errorf("tasks/send error = %v", jerr)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-a2a/a2a"
+)
+
+// errServerShutdown is the cause of the cancellation of requests still in flight when the
+// deadline of [Server.Shutdown] is hit.
+var errServerShutdown = errors.New("server shut down")
+
+// inFlight tracks the requests being served, so that [Server.Shutdown] can wait for them.
+type inFlight struct {
+	wg sync.WaitGroup
+
+	mu      sync.Mutex
+	closing bool
+	count   int
+
+	// cancels cancels the context of each request in flight.
+	cancels map[*context.CancelCauseFunc]struct{}
+
+	// streams holds the event streams being written.
+	streams map[*sseWriter]struct{}
+}
+
+// enter registers a new request and returns its context, canceled when the request is interrupted
+// by [Server.Shutdown], and the function to call when the request is done.
+//
+// enter returns false once the server is shutting down.
+func (f *inFlight) enter(ctx context.Context) (context.Context, func(), bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closing {
+		return ctx, nil, false
+	}
+	ctx, cancel := context.WithCancelCause(ctx)
+	if f.cancels == nil {
+		f.cancels = make(map[*context.CancelCauseFunc]struct{})
+	}
+	f.cancels[&cancel] = struct{}{}
+	f.count++
+	f.wg.Add(1)
+
+	return ctx, func() {
+		f.mu.Lock()
+		delete(f.cancels, &cancel)
+		f.count--
+		f.mu.Unlock()
+
+		cancel(nil)
+		f.wg.Done()
+	}, true
+}
+
+// addStream registers sw as an event stream in flight, and returns the function removing it.
+func (f *inFlight) addStream(sw *sseWriter) func() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.streams == nil {
+		f.streams = make(map[*sseWriter]struct{})
+	}
+	f.streams[sw] = struct{}{}
+
+	return func() {
+		f.mu.Lock()
+		delete(f.streams, sw)
+		f.mu.Unlock()
+	}
+}
+
+// drain stops accepting requests and returns a channel closed once the requests in flight are done.
+func (f *inFlight) drain() <-chan struct{} {
+	f.mu.Lock()
+	f.closing = true
+	f.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		f.wg.Wait()
+		close(done)
+	}()
+	return done
+}
+
+// shuttingDown reports whether the server stopped accepting requests.
+func (f *inFlight) shuttingDown() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.closing
+}
+
+// interrupt ends the event streams in flight with a final canceled status, then cancels the
+// requests in flight.
+func (f *inFlight) interrupt() {
+	f.mu.Lock()
+	streams := make([]*sseWriter, 0, len(f.streams))
+	for sw := range f.streams {
+		streams = append(streams, sw)
+	}
+	cancels := make([]context.CancelCauseFunc, 0, len(f.cancels))
+	for cancel := range f.cancels {
+		cancels = append(cancels, *cancel)
+	}
+	f.mu.Unlock()
+
+	for _, sw := range streams {
+		sw.cancel("server shutting down")
+	}
+	for _, cancel := range cancels {
+		cancel(errServerShutdown)
+	}
+}
+
+// InFlight returns the number of requests being served, including open event streams.
+//
+// Together with the readiness probe at [ReadyPath], failing once [Server.Shutdown] was called,
+// it lets a deployment tell when an instance is idle.
+func (s *Server) InFlight() int {
+	s.inFlight.mu.Lock()
+	defer s.inFlight.mu.Unlock()
+	return s.inFlight.count
+}
+
+// Shutdown shutdowns the server gracefully.
+//
+// It stops accepting requests, answering new ones with 503 Service Unavailable, and waits for
+// the requests in flight, including open event streams, to complete before closing the
+// underlying [http.Server]. When ctx is done first, the remaining streams receive a final
+// canceled status, the contexts of the remaining requests are canceled, the connections are
+// closed, and Shutdown returns the error of ctx.
+func (s *Server) Shutdown(ctx context.Context) error {
+	drained := s.inFlight.drain()
+	defer s.taskTimers.stopAll()
+	if s.server == nil {
+		select {
+		case <-drained:
+			return nil
+		case <-ctx.Done():
+			s.inFlight.interrupt()
+			return ctx.Err()
+		}
+	}
+
+	// Stop listening at once, while the requests in flight drain.
+	shutdown := make(chan error, 1)
+	go func() {
+		shutdown <- s.server.Shutdown(ctx)
+	}()
+
+	select {
+	case <-drained:
+		return <-shutdown
+	case <-ctx.Done():
+		s.inFlight.interrupt()
+		<-shutdown
+		if err := s.server.Close(); err != nil {
+			return fmt.Errorf("close server: %w", err)
+		}
+		return ctx.Err()
+	}
+}
+
+// rejectShuttingDown answers a request arriving while the server is shutting down.
+func (s *Server) rejectShuttingDown(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Connection", "close")
+	s.writeJSONRPCError(&statusWriter{ResponseWriter: w, status: http.StatusServiceUnavailable}, r, &a2a.JSONRPCError{
+		Code:    a2a.InternalErrorCode,
+		Message: "Server shutting down",
+	})
+}
+
+// cancel ends the stream with a final canceled status carrying reason, unless a final status
+// was already sent, and closes it.
+func (sw *sseWriter) cancel(reason string) {
+	sw.end(a2a.TaskStatus{
+		State: a2a.TaskStateCanceled,
+		Message: &a2a.Message{
+			Role:  a2a.RoleAgent,
+			Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: reason}},
+		},
+		Timestamp: time.Now().UTC(),
+	})
+}
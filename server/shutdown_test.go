@@ -0,0 +1,143 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+// lastStatus returns the state of the last status update in an event stream body, and whether it is final.
+func lastStatus(t *testing.T, body string) (a2a.TaskState, bool) {
+	t.Helper()
+
+	var (
+		state a2a.TaskState
+		final bool
+	)
+	for line := range strings.Lines(body) {
+		data, ok := strings.CutPrefix(strings.TrimSuffix(line, "\n"), "data: ")
+		if !ok {
+			continue
+		}
+		var frame struct {
+			Result struct {
+				Status *a2a.TaskStatus `json:"status"`
+				Final  bool            `json:"final"`
+			} `json:"result"`
+		}
+		if err := sonic.ConfigFastest.UnmarshalFromString(data, &frame); err != nil {
+			t.Fatalf("Unmarshal(%s) error = %v", data, err)
+		}
+		if frame.Result.Status != nil {
+			state, final = frame.Result.Status.State, frame.Result.Final
+		}
+	}
+	return state, final
+}
+
+func TestServer_Shutdown(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		// finish tells whether the stream completes on its own once released.
+		finish    bool
+		timeout   time.Duration
+		wantErr   error
+		wantState a2a.TaskState
+	}{
+		"drains in-flight stream": {
+			finish:    true,
+			timeout:   5 * time.Second,
+			wantState: a2a.TaskStateCompleted,
+		},
+		"cancels stream at deadline": {
+			timeout:   200 * time.Millisecond,
+			wantErr:   context.DeadlineExceeded,
+			wantState: a2a.TaskStateCanceled,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			release := make(chan struct{})
+			tm := &streamingTaskManager{
+				InMemoryTaskManager: server.NewInMemoryTaskManager(),
+				stream: func(ctx context.Context, w server.StreamWriter) error {
+					if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking}); err != nil {
+						return err
+					}
+					if !tt.finish {
+						<-ctx.Done()
+						return nil
+					}
+					<-release
+					return w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateCompleted})
+				},
+			}
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			s := server.NewServer("", "", card, tm)
+			srv := httptest.NewServer(s)
+			t.Cleanup(srv.Close)
+
+			resp := postSendSubscribe(t.Context(), t, srv.URL)
+			defer resp.Body.Close()
+
+			// Wait for the first event, so that the stream is in flight.
+			body := bufio.NewReader(resp.Body)
+			first, err := body.ReadString('\n')
+			if err != nil {
+				t.Fatalf("read first event: %v", err)
+			}
+			if got := s.InFlight(); got != 1 {
+				t.Errorf("InFlight() = %d, want 1", got)
+			}
+
+			ctx, cancel := context.WithTimeout(t.Context(), tt.timeout)
+			defer cancel()
+			shutdown := make(chan error, 1)
+			go func() {
+				shutdown <- s.Shutdown(ctx)
+			}()
+
+			// New requests are turned away while the stream drains.
+			for {
+				rejected, err := http.Post(srv.URL, "application/json",
+					strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"tasks/get","params":{"id":"task-1"}}`))
+				if err != nil {
+					t.Fatalf("Post() error = %v", err)
+				}
+				rejected.Body.Close()
+				if rejected.StatusCode == http.StatusServiceUnavailable {
+					break
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			close(release)
+			if err := <-shutdown; !errors.Is(err, tt.wantErr) {
+				t.Errorf("Shutdown() error = %v, want %v", err, tt.wantErr)
+			}
+
+			rest, _ := io.ReadAll(body)
+			state, final := lastStatus(t, first+string(rest))
+			if state != tt.wantState || !final {
+				t.Errorf("last status = %s (final %t), want final %s", state, final, tt.wantState)
+			}
+		})
+	}
+}
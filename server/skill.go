@@ -0,0 +1,273 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/go-a2a/a2a"
+)
+
+// ErrInvalidSkillFunc is returned by [Server.RegisterSkill] for a function whose signature
+// is not func(context.Context, T) (R, error), with T a struct or a pointer to one.
+var ErrInvalidSkillFunc = errors.New("invalid skill function")
+
+var (
+	contextType = reflect.TypeFor[context.Context]()
+	errorType   = reflect.TypeFor[error]()
+)
+
+// skillFunc is a Go function registered as a skill with [Server.RegisterSkill].
+type skillFunc struct {
+	// skill is the card entry of the skill, holding the parameters schema of its input.
+	skill a2a.AgentSkill
+	fn    reflect.Value
+	// in is the type of the input the function takes, a struct or a pointer to one.
+	in reflect.Type
+}
+
+// RegisterSkill exposes fn as the skill name, so that it can be called without writing any
+// JSON-RPC plumbing. fn must be a func(context.Context, T) (R, error), with T a struct or a
+// pointer to one; other functions are rejected with an error wrapping [ErrInvalidSkillFunc].
+//
+// The skill is added to the served agent card, or updated if the card declares it already,
+// with parameters describing T, see [a2a.SkillParamsFromStruct]. A tasks/send call naming the
+// skill in its [a2a.SkillIDKey] metadata is then served by fn rather than the task manager:
+// the first data part of the message, validated against the parameters, is decoded into a T
+// and fn is called with it. The call is answered with a completed task holding an artifact
+// named after the skill, whose data part is the JSON encoding of the result, or an object
+// holding it under "result" if it does not encode to one. An error returned by fn fails the
+// call, mapped by [a2a.ToJSONRPCError].
+//
+// The call goes through the checks and limits of any tasks/send call, such as
+// [WithSessionConcurrency] and [WithTaskTimeout]. The task is not stored, but fn runs with a
+// context canceled by a tasks/cancel call for the task, which is then answered as canceled.
+//
+// Registering a skill again replaces its function. RegisterSkill must be called before the
+// server starts serving, and after any handler registered for [a2a.MethodTasksSend] with
+// [Server.Handle], which would otherwise take over the calls to the skill.
+func (s *Server) RegisterSkill(name string, fn any) error {
+	if name == "" {
+		return errors.New("register skill: missing name")
+	}
+	if err := checkSkillFunc(fn); err != nil {
+		return fmt.Errorf("register skill %q: %w", name, err)
+	}
+	v := reflect.ValueOf(fn)
+
+	in := v.Type().In(1)
+	skill := a2a.AgentSkill{
+		ID:         name,
+		Name:       name,
+		Parameters: a2a.SkillParamsFromStruct(reflect.Zero(in).Interface()),
+	}
+	if s.agentCard != nil {
+		card := *s.agentCard
+		card.Skills = slices.Clone(card.Skills)
+		if i := slices.IndexFunc(card.Skills, func(skill a2a.AgentSkill) bool { return skill.ID == name }); i >= 0 {
+			card.Skills[i].Parameters = skill.Parameters
+			skill = card.Skills[i]
+		} else {
+			card.Skills = append(card.Skills, skill)
+		}
+		s.agentCard = &card
+	}
+
+	if s.skills == nil {
+		s.skills = make(map[string]*skillFunc)
+		s.methods[a2a.MethodTasksSend] = s.serveSkills(s.methods[a2a.MethodTasksSend])
+	}
+	s.skills[name] = &skillFunc{skill: skill, fn: v, in: in}
+	return nil
+}
+
+// checkSkillFunc reports why fn is not a function [Server.RegisterSkill] can register.
+func checkSkillFunc(fn any) error {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return fmt.Errorf("%w: %T is not a function", ErrInvalidSkillFunc, fn)
+	}
+	t := v.Type()
+	if t.IsVariadic() || t.NumIn() != 2 || t.NumOut() != 2 {
+		return fmt.Errorf("%w: %s does not take a context and an input and return a result and an error", ErrInvalidSkillFunc, t)
+	}
+	if t.In(0) != contextType {
+		return fmt.Errorf("%w: first parameter %s is not a context.Context", ErrInvalidSkillFunc, t.In(0))
+	}
+	in := t.In(1)
+	if in.Kind() == reflect.Pointer {
+		in = in.Elem()
+	}
+	if in.Kind() != reflect.Struct {
+		return fmt.Errorf("%w: input %s is not a struct", ErrInvalidSkillFunc, t.In(1))
+	}
+	if t.Out(1) != errorType {
+		return fmt.Errorf("%w: second result %s is not an error", ErrInvalidSkillFunc, t.Out(1))
+	}
+	return nil
+}
+
+// serveSkills returns the handler of tasks/send serving the calls to a skill registered with
+// [Server.RegisterSkill], and the others with next, if any.
+func (s *Server) serveSkills(next methodHandler) methodHandler {
+	return typed(func(w http.ResponseWriter, r *http.Request, req *a2a.JSONRPCRequest, params a2a.TaskSendParams) {
+		skillID, _ := params.Metadata[a2a.SkillIDKey].(string)
+		if skill, ok := s.skills[skillID]; ok {
+			s.handleSkill(w, r, req, params, skill)
+			return
+		}
+		if next == nil {
+			s.writeJSONRPCError(w, r, invalidParams(fmt.Errorf("unknown skill %q", skillID)))
+			return
+		}
+		next(w, r, req, params)
+	})
+}
+
+// handleSkill serves a tasks/send call to skill. The call goes through the checks and limits of
+// any other task: the function runs as the handler of the task, bounded by its deadline, see
+// [WithTaskTimeout], and canceled by tasks/cancel.
+func (s *Server) handleSkill(w http.ResponseWriter, r *http.Request, rpcReq *a2a.JSONRPCRequest, params a2a.TaskSendParams, skill *skillFunc) {
+	ctx, span := s.tracer.Start(r.Context(), "server.handleSkill")
+	defer span.End()
+
+	r = r.WithContext(ctx)
+
+	if jerr := s.checkSendParams(r, &params); jerr != nil {
+		s.writeJSONRPCError(w, r, jerr)
+		return
+	}
+	if err := skill.skill.ValidateParams(firstData(params.Message)); err != nil {
+		s.writeJSONRPCError(w, r, invalidParams(err))
+		return
+	}
+	if jerr := s.assignIDs(&params); jerr != nil {
+		s.writeJSONRPCError(w, r, jerr)
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("a2a.task_id", params.ID),
+		attribute.String("a2a.skill", skill.skill.ID),
+	)
+	s.journalMessage(ctx, params.ID, params.Message)
+
+	in, err := s.decodeSkillInput(params.Message, skill.in)
+	if err != nil {
+		s.writeJSONRPCError(w, r, invalidParams(fmt.Errorf("skill %q: %w", skill.skill.ID, err)))
+		return
+	}
+
+	release, ok := s.acquireSession(w, r, params)
+	if !ok {
+		return
+	}
+	defer release()
+
+	ctx = s.withSentTask(ctx, params)
+	timer, err := s.startTaskTimer(ctx, params, nil)
+	if err != nil {
+		s.writeJSONRPCError(w, r, invalidParams(err))
+		return
+	}
+
+	runCtx, done := s.runningTasks.start(ctx, params.ID)
+	defer done()
+
+	var result any
+	err = timer.run(runCtx, func(ctx context.Context) error {
+		out := skill.fn.Call([]reflect.Value{reflect.ValueOf(ctx), in})
+		result = out[0].Interface()
+		err, _ := out[1].Interface().(error)
+		return err
+	})
+
+	// The task of a skill is not stored: answer a timeout or cancellation with the task as it
+	// ended, as tasks/send does with a stored task.
+	task := newTask(params)
+	cerr, canceled := canceledByClient(runCtx)
+	switch {
+	case errors.Is(err, errTaskExpired):
+		task.Status = timeoutStatus("task not done by " + timer.deadline.UTC().Format(time.RFC3339))
+	case err != nil && canceled:
+		task.Status = a2a.TaskStatus{State: a2a.TaskStateCanceled, Reason: cerr.Reason, Timestamp: time.Now().UTC()}
+	case err != nil:
+		err = s.checkDeadline(runCtx, params.ID, err)
+		jerr := a2a.ToJSONRPCError(err)
+		s.journalError(ctx, params.ID, jerr)
+		s.writeJSONRPCError(w, r, jerr)
+		return
+	default:
+		data, err := s.skillResultData(result)
+		if err != nil {
+			s.writeError(w, r, a2a.InternalErrorCode, fmt.Sprintf("skill %q: %v", skill.skill.ID, err))
+			return
+		}
+		task.Status = a2a.TaskStatus{State: a2a.TaskStateCompleted, Timestamp: time.Now().UTC()}
+		task.Artifacts = []a2a.Artifact{{
+			Name:  skill.skill.ID,
+			Parts: []a2a.Part{a2a.NewDataPart(data)},
+		}}
+	}
+	timer.settle(task)
+	s.journalResult(ctx, task)
+
+	s.writeResponse(w, r, rpcReq.ID, s.propagateTaskMetadata(task, params.Metadata))
+}
+
+// firstData returns the structured data of the first data part of msg, or an empty object if
+// it has none.
+func firstData(msg a2a.Message) any {
+	if data := msg.DataParts(); len(data) > 0 {
+		return data[0]
+	}
+	return map[string]any{}
+}
+
+// decodeSkillInput decodes the first data part of msg into a new value of type in, a struct or
+// a pointer to one. A message without data parts decodes to the zero struct.
+func (s *Server) decodeSkillInput(msg a2a.Message, in reflect.Type) (reflect.Value, error) {
+	t := in
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	v := reflect.New(t)
+
+	data, err := s.codec.Marshal(firstData(msg))
+	if err != nil {
+		return reflect.Value{}, fmt.Errorf("marshal data: %w", err)
+	}
+	if err := s.codec.Unmarshal(data, v.Interface()); err != nil {
+		return reflect.Value{}, fmt.Errorf("decode data into %s: %w", t, err)
+	}
+	if in.Kind() == reflect.Pointer {
+		return v, nil
+	}
+	return v.Elem(), nil
+}
+
+// skillResultData returns the data part content of the result of a skill: its JSON encoding if
+// it is an object, and an object holding it under "result" otherwise.
+func (s *Server) skillResultData(result any) (map[string]any, error) {
+	data, err := s.codec.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("marshal result: %w", err)
+	}
+	var value any
+	if err := s.codec.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("unmarshal result: %w", err)
+	}
+	if obj, ok := value.(map[string]any); ok {
+		return obj, nil
+	}
+	return map[string]any{"result": value}, nil
+}
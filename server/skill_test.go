@@ -0,0 +1,258 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bytedance/sonic"
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+type addInput struct {
+	A int `json:"a"`
+	B int `json:"b"`
+}
+
+type addOutput struct {
+	Sum int `json:"sum"`
+}
+
+func TestServer_RegisterSkill(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	s := server.NewServer("", "", card, server.NewInMemoryTaskManager())
+	err := s.RegisterSkill("add", func(ctx context.Context, in addInput) (addOutput, error) {
+		if in.A < 0 {
+			return addOutput{}, a2a.ErrUnsupportedOperation
+		}
+		return addOutput{Sum: in.A + in.B}, nil
+	})
+	if err != nil {
+		t.Fatalf("RegisterSkill(add) error = %v", err)
+	}
+	err = s.RegisterSkill("count", func(ctx context.Context, in *addInput) (int, error) {
+		return 2, nil
+	})
+	if err != nil {
+		t.Fatalf("RegisterSkill(count) error = %v", err)
+	}
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+
+	send := func(skill, part string) string {
+		return `{"id":"task-1","metadata":{"skillId":"` + skill + `"},"message":{"role":"user","parts":[` + part + `]}}`
+	}
+	tests := map[string]struct {
+		params   string
+		wantData map[string]any
+		wantCode int
+	}{
+		"skill": {
+			params:   send("add", `{"type":"data","data":{"a":1,"b":2}}`),
+			wantData: map[string]any{"sum": float64(3)},
+		},
+		"skill result not an object": {
+			params:   send("count", `{"type":"data","data":{"a":1,"b":2}}`),
+			wantData: map[string]any{"result": float64(2)},
+		},
+		"invalid input": {
+			params:   send("add", `{"type":"data","data":{"a":1}}`),
+			wantCode: a2a.InvalidParamsErrorCode,
+		},
+		"skill error": {
+			params:   send("add", `{"type":"data","data":{"a":-1,"b":2}}`),
+			wantCode: a2a.UnsupportedOperationErrorCode,
+		},
+		"no skill": {
+			params: `{"id":"task-1","message":{"role":"user","parts":[{"type":"text","text":"hi"}]}}`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := postRPC(t, srv.URL, a2a.MethodTasksSend, tt.params)
+			if tt.wantCode != 0 {
+				if got.Error == nil || got.Error.Code != tt.wantCode {
+					t.Fatalf("error = %v, want code %d", got.Error, tt.wantCode)
+				}
+				return
+			}
+			if got.Error != nil {
+				t.Fatalf("tasks/send error = %v", got.Error)
+			}
+			data, err := sonic.ConfigDefault.Marshal(got.Result)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			var task a2a.Task
+			if err := sonic.ConfigDefault.Unmarshal(data, &task); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if tt.wantData == nil {
+				if task.Status.State == a2a.TaskStateCompleted && len(task.Artifacts) > 0 {
+					t.Errorf("task = %+v, want it served by the task manager", task)
+				}
+				return
+			}
+
+			if task.Status.State != a2a.TaskStateCompleted || len(task.Artifacts) != 1 {
+				t.Fatalf("task: state = %s, %d artifacts, want %s and 1", task.Status.State, len(task.Artifacts), a2a.TaskStateCompleted)
+			}
+			dp, ok := task.Artifacts[0].Parts[0].(*a2a.DataPart)
+			if !ok {
+				t.Fatalf("artifact part = %T, want a data part", task.Artifacts[0].Parts[0])
+			}
+			if diff := gocmp.Diff(tt.wantData, dp.Data); diff != "" {
+				t.Errorf("artifact data mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("agent card", func(t *testing.T) {
+		t.Parallel()
+
+		resp, err := http.Get(srv.URL + server.AgantPath)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		defer resp.Body.Close()
+		var got a2a.AgentCard
+		if err := sonic.ConfigDefault.NewDecoder(resp.Body).Decode(&got); err != nil {
+			t.Fatalf("decode card: %v", err)
+		}
+		if len(got.Skills) != 2 || got.Skills[0].ID != "add" || got.Skills[0].Parameters == nil {
+			t.Fatalf("card skills = %+v, want add and count with parameters", got.Skills)
+		}
+		if err := got.Skills[0].ValidateParams(map[string]any{"a": 1}); !errors.Is(err, a2a.ErrInvalidSkillParams) {
+			t.Errorf("ValidateParams() error = %v, want %v", err, a2a.ErrInvalidSkillParams)
+		}
+		if len(card.Skills) != 0 {
+			t.Errorf("card passed to NewServer has %d skills, want it untouched", len(card.Skills))
+		}
+	})
+}
+
+func TestServer_RegisterSkill_Task(t *testing.T) {
+	t.Parallel()
+
+	send := func(metadata string) string {
+		return `{"id":"task-1","metadata":{"skillId":"wait"` + metadata + `},"message":{"role":"user","parts":[{"type":"data","data":{"a":1,"b":2}}]}}`
+	}
+	tests := map[string]struct {
+		params string
+		// cancel cancels the task once the skill runs.
+		cancel    bool
+		wantState a2a.TaskState
+		wantCode  int
+	}{
+		"invalid labels": {
+			params:   `{"id":"task-1","labels":{"":"x"},"metadata":{"skillId":"wait"},"message":{"role":"user","parts":[{"type":"data","data":{"a":1,"b":2}}]}}`,
+			wantCode: a2a.InvalidParamsErrorCode,
+		},
+		"invalid deadline": {
+			params:   send(`,"deadline":"soon"`),
+			wantCode: a2a.InvalidParamsErrorCode,
+		},
+		"timed out": {
+			params:    send(`,"deadline":"` + time.Now().Add(100*time.Millisecond).UTC().Format(time.RFC3339Nano) + `"`),
+			wantState: a2a.TaskStateFailed,
+		},
+		"canceled": {
+			params:    send(""),
+			cancel:    true,
+			wantState: a2a.TaskStateCanceled,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			s := server.NewServer("", "", card, server.NewInMemoryTaskManager())
+			started := make(chan struct{})
+			err := s.RegisterSkill("wait", func(ctx context.Context, in addInput) (addOutput, error) {
+				close(started)
+				<-ctx.Done()
+				return addOutput{}, context.Cause(ctx)
+			})
+			if err != nil {
+				t.Fatalf("RegisterSkill(wait) error = %v", err)
+			}
+			srv := httptest.NewServer(s)
+			t.Cleanup(srv.Close)
+
+			if tt.cancel {
+				go func() {
+					<-started
+					body := `{"jsonrpc":"2.0","id":8,"method":"tasks/cancel","params":{"id":"task-1"}}`
+					resp, err := http.Post(srv.URL, "application/json", strings.NewReader(body))
+					if err != nil {
+						t.Errorf("Post(tasks/cancel) error = %v", err)
+						return
+					}
+					resp.Body.Close()
+				}()
+			}
+
+			got := postRPC(t, srv.URL, a2a.MethodTasksSend, tt.params)
+			if tt.wantCode != 0 {
+				if got.Error == nil || got.Error.Code != tt.wantCode {
+					t.Fatalf("error = %v, want code %d", got.Error, tt.wantCode)
+				}
+				return
+			}
+			if got.Error != nil {
+				t.Fatalf("tasks/send error = %v", got.Error)
+			}
+			data, err := sonic.ConfigDefault.Marshal(got.Result)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			var task a2a.Task
+			if err := sonic.ConfigDefault.Unmarshal(data, &task); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if task.Status.State != tt.wantState {
+				t.Errorf("task state = %s, want %s", task.Status.State, tt.wantState)
+			}
+		})
+	}
+}
+
+func TestServer_RegisterSkill_InvalidFunc(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]any{
+		"nil":                nil,
+		"not a function":     42,
+		"no context":         func(in addInput) (addOutput, error) { return addOutput{}, nil },
+		"input not a struct": func(ctx context.Context, n int) (int, error) { return n, nil },
+		"no error":           func(ctx context.Context, in addInput) (addOutput, bool) { return addOutput{}, true },
+		"single result":      func(ctx context.Context, in addInput) error { return nil },
+		"variadic":           func(ctx context.Context, in ...addInput) (int, error) { return 0, nil },
+	}
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			s := server.NewServer("", "", card, nil)
+			if err := s.RegisterSkill("skill", fn); !errors.Is(err, server.ErrInvalidSkillFunc) {
+				t.Errorf("RegisterSkill() error = %v, want %v", err, server.ErrInvalidSkillFunc)
+			}
+		})
+	}
+}
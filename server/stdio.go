@@ -0,0 +1,178 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/go-a2a/a2a"
+)
+
+// ServeStdio serves the JSON-RPC requests read from r, one per line, writing their responses
+// to w, one per line, as an agent run as a subprocess of its client does on its stdin and
+// stdout. It returns nil once r is exhausted and the requests being served are done, or the
+// error of ctx once it is done, which stops them.
+//
+// Each line carries a request or a batch, served concurrently with the others as if it had
+// been posted to the A2A endpoint, and responses are written in the order they are ready. A
+// streaming method writes each of its events as a response of its own, with the ID of the
+// request, then a response whose result is an [a2a.StreamEnd]. A request reusing the ID of a
+// request still in flight is rejected with the invalid request error. The client owning the
+// pipes is trusted, so requests are not authenticated nor rate limited.
+//
+// Nothing else may be written to w while serving, so logs must go elsewhere, such as stderr.
+func (s *Server) ServeStdio(ctx context.Context, r io.Reader, w io.Writer) error {
+	ctx, span := s.tracer.Start(ctx, "server.ServeStdio")
+	defer span.End()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	// A request reusing the ID of one still in flight is rejected: their responses would mix.
+	ctx = withConnRequests(ctx)
+
+	var mu sync.Mutex
+	send := func(data []byte) error {
+		line, err := stdioLine(data)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		_, err = w.Write(line)
+		return err
+	}
+
+	// Lines are read apart, so that the server stops when ctx is done even if r blocks.
+	lines := make(chan []byte)
+	readErr := make(chan error, 1)
+	go func() {
+		br := bufio.NewReader(r)
+		for {
+			line, err := br.ReadBytes('\n')
+			if len(bytes.TrimSpace(line)) > 0 {
+				select {
+				case lines <- line:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if err != nil {
+				readErr <- err
+				return
+			}
+		}
+	}()
+
+	var (
+		handlers sync.WaitGroup
+		err      error
+	)
+loop:
+	for {
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+			break loop
+		case err = <-readErr:
+			if !errors.Is(err, io.EOF) {
+				err = fmt.Errorf("read request: %w", err)
+			}
+			break loop
+		case line := <-lines:
+			handlers.Add(1)
+			go func() {
+				defer handlers.Done()
+				s.serveStdioMessage(ctx, line, send)
+			}()
+		}
+	}
+
+	if errors.Is(err, io.EOF) {
+		// The client is done sending; let it have the responses still coming.
+		handlers.Wait()
+		return nil
+	}
+
+	cancel()
+	handlers.Wait()
+	span.SetStatus(codes.Error, err.Error())
+	return err
+}
+
+// serveStdioMessage serves the request or batch in body, read by [Server.ServeStdio], and sends
+// its responses with send.
+func (s *Server) serveStdioMessage(ctx context.Context, body []byte, send func(data []byte) error) {
+	ctx, span := s.tracer.Start(ctx, "server.serveStdioMessage")
+	defer span.End()
+
+	rw := &wsResponseWriter{send: send}
+	ctx, done, ok := s.inFlight.enter(ctx)
+	r := (&http.Request{
+		Method: http.MethodPost,
+		URL:    &url.URL{Path: "/"},
+		Header: make(http.Header),
+		Body:   http.NoBody,
+	}).WithContext(ctx)
+
+	switch {
+	case !ok:
+		s.rejectShuttingDown(rw, r)
+	case s.maxRequestBytes > 0 && int64(len(body)) > s.maxRequestBytes:
+		s.rejectTooLarge(rw, r)
+	case isBatch(body):
+		s.serveBatch(rw, r, body)
+	default:
+		s.serveRequest(rw, r, body, false)
+	}
+	if ok {
+		done()
+	}
+
+	if err := rw.finish(); err != nil {
+		s.logger.DebugContext(ctx, "write stdio response", slog.Any("error", err))
+		return
+	}
+	if !rw.streaming() {
+		return
+	}
+	req, _ := a2a.ParseRequest(body)
+	if req == nil {
+		return
+	}
+	data, err := s.codec.Marshal(&a2a.JSONRPCResponse{
+		JSONRPCMessage: a2a.NewJSONRPCMessage(req.ID),
+		Result:         &a2a.StreamEnd{StreamEnd: true},
+	})
+	if err == nil {
+		err = send(data)
+	}
+	if err != nil {
+		s.logger.DebugContext(ctx, "write stdio stream end", slog.Any("error", err))
+	}
+}
+
+// stdioLine returns the JSON-RPC message data as a single line, terminated by a newline.
+func stdioLine(data []byte) ([]byte, error) {
+	data = bytes.TrimSpace(data)
+	if bytes.ContainsAny(data, "\r\n") {
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, data); err != nil {
+			return nil, fmt.Errorf("compact response: %w", err)
+		}
+		data = buf.Bytes()
+	}
+	return append(data[:len(data):len(data)], '\n'), nil
+}
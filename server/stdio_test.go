@@ -0,0 +1,177 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/bytedance/sonic"
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestServer_ServeStdio(t *testing.T) {
+	t.Parallel()
+
+	tm := &streamingTaskManager{
+		InMemoryTaskManager: server.NewInMemoryTaskManager(),
+		stream: func(ctx context.Context, w server.StreamWriter) error {
+			if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking}); err != nil {
+				return err
+			}
+			return w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateCompleted})
+		},
+	}
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := server.NewServer("", "", card, tm)
+
+	stdinR, stdinW := io.Pipe()
+	stdoutR, stdoutW := io.Pipe()
+	served := make(chan error, 1)
+	go func() {
+		served <- srv.ServeStdio(t.Context(), stdinR, stdoutW)
+		stdoutW.Close()
+	}()
+
+	// The requests are written in turn, each once the responses to the previous one are read,
+	// so that the order of the responses is known.
+	type response struct {
+		ID     string         `json:"id"`
+		Result map[string]any `json:"result"`
+		Error  *struct {
+			Code int `json:"code"`
+		} `json:"error"`
+	}
+	scanner := bufio.NewScanner(stdoutR)
+	read := func(n int) []string {
+		var got []string
+		for range n {
+			if !scanner.Scan() {
+				t.Fatalf("read response: %v", scanner.Err())
+			}
+			var resp response
+			if err := sonic.ConfigFastest.Unmarshal(scanner.Bytes(), &resp); err != nil {
+				t.Fatalf("Unmarshal(%q) error = %v", scanner.Text(), err)
+			}
+			switch {
+			case resp.Error != nil:
+				got = append(got, resp.ID+" error")
+			case resp.Result["streamEnd"] == true:
+				got = append(got, resp.ID+" end")
+			case resp.Result["status"] != nil:
+				got = append(got, resp.ID+" "+resp.Result["status"].(map[string]any)["state"].(string))
+			default:
+				got = append(got, resp.ID+" ?")
+			}
+		}
+		return got
+	}
+	write := func(line string) {
+		if _, err := io.WriteString(stdinW, line+"\n"); err != nil {
+			t.Fatalf("write request: %v", err)
+		}
+	}
+
+	write(`{"jsonrpc":"2.0","id":"send","method":"tasks/send","params":{"id":"task-1","message":{"role":"user","parts":[{"type":"text","text":"hi"}]}}}`)
+	got := read(1)
+	write(`{"jsonrpc":"2.0","id":"stream","method":"tasks/sendSubscribe","params":{"id":"task-2","message":{"role":"user","parts":[{"type":"text","text":"hi"}]}}}`)
+	got = append(got, read(3)...)
+	write(`{"jsonrpc":"2.0","id":"get","method":"tasks/get","params":{"id":"missing"}}`)
+	got = append(got, read(1)...)
+
+	want := []string{"send submitted", "stream working", "stream completed", "stream end", "get error"}
+	if diff := gocmp.Diff(want, got); diff != "" {
+		t.Errorf("responses mismatch (-want +got):\n%s", diff)
+	}
+
+	stdinW.Close()
+	if err := <-served; err != nil {
+		t.Errorf("ServeStdio() error = %v, want nil once stdin is closed", err)
+	}
+}
+
+func TestServer_ServeStdio_DuplicateID(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	tm := &streamingTaskManager{
+		InMemoryTaskManager: server.NewInMemoryTaskManager(),
+		stream: func(ctx context.Context, w server.StreamWriter) error {
+			if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking}); err != nil {
+				return err
+			}
+			<-release
+			return w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateCompleted})
+		},
+	}
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := server.NewServer("", "", card, tm)
+
+	stdinR, stdinW := io.Pipe()
+	stdoutR, stdoutW := io.Pipe()
+	served := make(chan error, 1)
+	go func() {
+		served <- srv.ServeStdio(t.Context(), stdinR, stdoutW)
+		stdoutW.Close()
+	}()
+
+	type response struct {
+		ID     string         `json:"id"`
+		Result map[string]any `json:"result"`
+		Error  *struct {
+			Code int    `json:"code"`
+			Data string `json:"data"`
+		} `json:"error"`
+	}
+	scanner := bufio.NewScanner(stdoutR)
+	read := func() response {
+		if !scanner.Scan() {
+			t.Fatalf("read response: %v", scanner.Err())
+		}
+		var resp response
+		if err := sonic.ConfigFastest.Unmarshal(scanner.Bytes(), &resp); err != nil {
+			t.Fatalf("Unmarshal(%q) error = %v", scanner.Text(), err)
+		}
+		return resp
+	}
+	write := func(line string) {
+		if _, err := io.WriteString(stdinW, line+"\n"); err != nil {
+			t.Fatalf("write request: %v", err)
+		}
+	}
+
+	write(`{"jsonrpc":"2.0","id":"dup","method":"tasks/sendSubscribe","params":{"id":"task-1","message":{"role":"user","parts":[{"type":"text","text":"hi"}]}}}`)
+	if resp := read(); resp.ID != "dup" || resp.Error != nil {
+		t.Fatalf("first response = %+v, want the first event of the stream", resp)
+	}
+
+	// The stream is in flight: a request reusing its ID is rejected.
+	write(`{"jsonrpc":"2.0","id":"dup","method":"tasks/get","params":{"id":"task-1"}}`)
+	if resp := read(); resp.ID != "dup" || resp.Error == nil || resp.Error.Code != a2a.InvalidRequestErrorCode {
+		t.Fatalf("duplicate response = %+v, want code %d", resp, a2a.InvalidRequestErrorCode)
+	}
+
+	close(release)
+	for resp := read(); resp.Result["streamEnd"] != true; resp = read() {
+		if resp.Error != nil {
+			t.Fatalf("stream error = %+v", resp.Error)
+		}
+	}
+
+	// Once the stream ended, its ID is free. The streamed task is not stored, so it is not found.
+	write(`{"jsonrpc":"2.0","id":"dup","method":"tasks/get","params":{"id":"task-1"}}`)
+	if resp := read(); resp.Error == nil || resp.Error.Code != a2a.TaskNotFoundErrorCode {
+		t.Errorf("tasks/get after the stream error = %+v, want code %d", resp.Error, a2a.TaskNotFoundErrorCode)
+	}
+
+	stdinW.Close()
+	if err := <-served; err != nil {
+		t.Errorf("ServeStdio() error = %v, want nil once stdin is closed", err)
+	}
+}
@@ -0,0 +1,671 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-a2a/a2a"
+)
+
+// ErrStreamClosed is returned when writing to a [StreamWriter] that has been closed.
+var ErrStreamClosed = errors.New("stream closed")
+
+// StreamWriter pushes incremental task updates to a streaming client.
+//
+// Each update is written as a server-sent event carrying a JSON-RPC response with the
+// ID of the originating request. Once the client disconnects, SendStatus and SendArtifact
+// return an error so the handler can stop working.
+type StreamWriter interface {
+	// SendStatus sends a task status update. Updates in a terminal or input-required state are marked final.
+	// When the handler runs under a deadline, other updates without an expiry carry the deadline
+	// as [a2a.TaskStatus.Expiry]. A handler streaming a message token by token sends each
+	// fragment as [a2a.TaskStatus.Delta] of a working update.
+	SendStatus(status a2a.TaskStatus) error
+
+	// SendArtifact sends a task artifact update.
+	SendArtifact(artifact a2a.Artifact) error
+
+	// SendCompressedArtifact sends a task artifact update with its parts gzip-compressed,
+	// see [a2a.TaskArtifactUpdateEvent.Compress]. The client decompresses it transparently.
+	SendCompressedArtifact(artifact a2a.Artifact) error
+
+	// FinalizePartial ends a task that failed after producing some output. It sends the
+	// produced artifacts, then a final failed status whose message carries err, and closes
+	// the stream. The client receives both the partial output and the failure reason.
+	FinalizePartial(produced []a2a.Artifact, err error) error
+
+	// Close ends the stream. Later sends return [ErrStreamClosed].
+	Close() error
+}
+
+// StreamHandler is implemented by task managers that push tasks/sendSubscribe updates through a [StreamWriter]
+// instead of returning a channel from [TaskManager.OnSendTaskSubscribe].
+type StreamHandler interface {
+	// OnSendTaskStream runs a streaming task, writing its updates to w until the task is done.
+	//
+	// An error returned before anything was written is reported as a plain JSON-RPC error response,
+	// otherwise it is sent as a final error event on the stream.
+	OnSendTaskStream(ctx context.Context, req *a2a.SendTaskStreamingRequest, w StreamWriter) error
+}
+
+// sseWriter is the server-sent events implementation of [StreamWriter].
+type sseWriter struct {
+	ctx     context.Context
+	w       http.ResponseWriter
+	flusher http.Flusher
+	codec   a2a.Codec
+	id      a2a.ID
+	taskID  string
+
+	// expiry is the deadline of the task set by [WithTaskTimeout], or zero.
+	expiry time.Time
+
+	// interval is the minimum time between frames, or zero for no limit.
+	interval time.Duration
+
+	mu      sync.Mutex
+	started bool
+	closed  bool
+	// ended is set once a final status update was written or queued.
+	ended bool
+
+	// next is the earliest time the next frame may be written.
+	next time.Time
+	// pending holds frames waiting for their slot, coalesced as they arrive.
+	pending []sseFrame
+	// timer writes the next pending frame when its slot opens.
+	timer *time.Timer
+	// err is the first error hit while writing a deferred frame.
+	err error
+
+	// coalesce is how long artifact chunks are held back to be merged with the chunks
+	// following them, or zero to write each chunk at once, see [WithStreamCoalesce].
+	coalesce time.Duration
+	// held is the artifact frame held back for coalesce, or nil.
+	held *sseFrame
+	// coalesceTimer writes the held frame once coalesce has elapsed.
+	coalesceTimer *time.Timer
+
+	// keepalive is the longest time the stream stays silent before a keepalive comment is
+	// written, or zero for no keepalives, see [WithStreamKeepalive].
+	keepalive time.Duration
+	// keepaliveTimer writes the next keepalive comment.
+	keepaliveTimer *time.Timer
+
+	// notifier, if set, also delivers each event to the task's push notification webhook.
+	notifier *Notifier
+
+	// events, if set, records each event and numbers its frame, see [WithEventReplay].
+	events *eventLog
+
+	// journal, if set, records each event and error in the journal of the task, see
+	// [WithEventJournal].
+	journal EventJournal
+
+	// propagate, if set, adds the request metadata to the parts of each artifact, see
+	// [WithMetadataPropagation].
+	propagate func(a2a.Artifact) a2a.Artifact
+
+	// eventTypes, if set, lists the types of the events written to the client, see
+	// [a2a.TaskSendParams.EventTypes].
+	eventTypes []string
+}
+
+// sseFrame is a JSON-RPC response waiting to be written as a server-sent event.
+type sseFrame struct {
+	resp *a2a.JSONRPCResponse
+
+	// eventID is the ID of the event, or zero to send it without one.
+	eventID int64
+}
+
+var _ StreamWriter = (*sseWriter)(nil)
+
+// newSSEWriter returns a [StreamWriter] that frames updates for request id as server-sent events on w,
+// encoded with codec.
+//
+// The response headers are written lazily with the first event, so the handler can still
+// answer with a plain JSON-RPC error until then. A positive maxFrameRate limits the frames
+// written per second; see [WithMaxFrameRate]. A non-nil notifier also receives every event, and
+// a non-nil events log records every event until the writer is closed.
+func newSSEWriter(ctx context.Context, w http.ResponseWriter, codec a2a.Codec, id a2a.ID, taskID string, maxFrameRate int, notifier *Notifier, events *eventLog) (*sseWriter, error) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, errors.New("streaming not supported by response writer")
+	}
+	sw := &sseWriter{
+		ctx:      ctx,
+		w:        w,
+		flusher:  flusher,
+		codec:    codec,
+		id:       id,
+		taskID:   taskID,
+		notifier: notifier,
+		events:   events,
+	}
+	if maxFrameRate > 0 {
+		sw.interval = time.Second / time.Duration(maxFrameRate)
+	}
+	if events != nil {
+		events.open(taskID)
+	}
+	return sw, nil
+}
+
+// SendStatus implements [StreamWriter].
+func (sw *sseWriter) SendStatus(status a2a.TaskStatus) error {
+	final := isFinalState(status.State)
+	if deadline, ok := sw.deadline(); ok && !final && status.Expiry == nil {
+		status.Expiry = &deadline
+	}
+	return sw.sendEvent(&a2a.TaskStatusUpdateEvent{
+		ID:     sw.taskID,
+		Status: status,
+		Final:  final,
+	})
+}
+
+// deadline returns when the server stops working on the streamed task, if it bounds it.
+func (sw *sseWriter) deadline() (time.Time, bool) {
+	if !sw.expiry.IsZero() {
+		return sw.expiry, true
+	}
+	return sw.ctx.Deadline()
+}
+
+// SendArtifact implements [StreamWriter].
+func (sw *sseWriter) SendArtifact(artifact a2a.Artifact) error {
+	if sw.propagate != nil {
+		artifact = sw.propagate(artifact)
+	}
+	return sw.sendEvent(&a2a.TaskArtifactUpdateEvent{
+		ID:       sw.taskID,
+		Artifact: artifact,
+	})
+}
+
+// SendCompressedArtifact implements [StreamWriter].
+func (sw *sseWriter) SendCompressedArtifact(artifact a2a.Artifact) error {
+	if sw.propagate != nil {
+		artifact = sw.propagate(artifact)
+	}
+	event := &a2a.TaskArtifactUpdateEvent{
+		ID:       sw.taskID,
+		Artifact: artifact,
+	}
+	if err := event.Compress(); err != nil {
+		return err
+	}
+	return sw.sendEvent(event)
+}
+
+// FinalizePartial implements [StreamWriter].
+func (sw *sseWriter) FinalizePartial(produced []a2a.Artifact, err error) error {
+	for _, artifact := range produced {
+		if err := sw.SendArtifact(artifact); err != nil {
+			return err
+		}
+	}
+
+	reason := "task failed with partial results"
+	if err != nil {
+		reason = err.Error()
+	}
+	status := a2a.TaskStatus{
+		State: a2a.TaskStateFailed,
+		Message: &a2a.Message{
+			Role:  a2a.RoleAgent,
+			Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: reason}},
+		},
+		Timestamp: time.Now().UTC(),
+	}
+	if err := sw.SendStatus(status); err != nil {
+		return err
+	}
+	return sw.Close()
+}
+
+// Close implements [StreamWriter].
+//
+// Frames still held back by the frame rate limit or for coalescing are written, at the limited
+// rate, before Close returns.
+// Closing a stream that never sent an event still writes the event stream headers,
+// so the client sees an empty stream rather than an empty response.
+func (sw *sseWriter) Close() error {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+
+	if sw.closed {
+		return nil
+	}
+	sw.closed = true
+	if sw.events != nil {
+		sw.events.close(sw.taskID)
+	}
+	// The chunks held for coalescing are dropped along with a client that is gone.
+	if sw.ctx.Err() != nil {
+		sw.held = nil
+	}
+	sw.release()
+	if sw.timer != nil {
+		sw.timer.Stop()
+		sw.timer = nil
+	}
+	sw.stopKeepalive()
+
+	for len(sw.pending) > 0 && sw.err == nil {
+		if wait := time.Until(sw.next); wait > 0 {
+			sw.mu.Unlock()
+			select {
+			case <-time.After(wait):
+			case <-sw.ctx.Done():
+			}
+			sw.mu.Lock()
+		}
+		if sw.ctx.Err() != nil {
+			break
+		}
+		sw.writeNext()
+	}
+	sw.pending = nil
+
+	if !sw.started && sw.ctx.Err() == nil {
+		sw.start()
+		sw.flusher.Flush()
+	}
+	return sw.err
+}
+
+// sendEvent writes event as the result of a JSON-RPC response, and hands it to the notifier
+// and the event log.
+//
+// The event is recorded even when the client is gone, so that it can resume the stream.
+func (sw *sseWriter) sendEvent(event a2a.TaskEvent) error {
+	recordEvent(sw.ctx, event)
+	if entry, ok := journalEvent(event); ok {
+		appendJournal(sw.ctx, sw.journal, sw.taskID, entry)
+	}
+	if sw.notifier != nil {
+		sw.notifier.Notify(sw.ctx, event)
+	}
+	var eventID int64
+	if sw.events != nil {
+		eventID = sw.events.append(sw.taskID, event)
+	}
+	if !sw.wants(event) {
+		return nil
+	}
+	return sw.write(sseFrame{
+		resp: &a2a.JSONRPCResponse{
+			JSONRPCMessage: a2a.NewJSONRPCMessage(sw.id),
+			Result:         event,
+		},
+		eventID: eventID,
+	})
+}
+
+// replayEvent writes an event taken from the event log, with the ID it was first sent with.
+func (sw *sseWriter) replayEvent(e loggedEvent) error {
+	recordEvent(sw.ctx, e.event)
+	if !sw.wants(e.event) {
+		return nil
+	}
+	return sw.write(sseFrame{
+		resp: &a2a.JSONRPCResponse{
+			JSONRPCMessage: a2a.NewJSONRPCMessage(sw.id),
+			Result:         e.event,
+		},
+		eventID: e.id,
+	})
+}
+
+// wants reports whether event is of a type the client asked for. The final status update is
+// always wanted, as it ends the stream.
+func (sw *sseWriter) wants(event a2a.TaskEvent) bool {
+	if len(sw.eventTypes) == 0 {
+		return true
+	}
+	if status, ok := event.(*a2a.TaskStatusUpdateEvent); ok && status.Final {
+		return true
+	}
+	return slices.Contains(sw.eventTypes, eventType(event))
+}
+
+// eventType returns the type of event, [a2a.EventTypeStatus] or [a2a.EventTypeArtifact], or
+// the empty string for another event.
+func eventType(event a2a.TaskEvent) string {
+	switch event.(type) {
+	case *a2a.TaskStatusUpdateEvent:
+		return a2a.EventTypeStatus
+	case *a2a.TaskArtifactUpdateEvent:
+		return a2a.EventTypeArtifact
+	default:
+		return ""
+	}
+}
+
+// sendError writes jerr as a JSON-RPC error response.
+func (sw *sseWriter) sendError(jerr *a2a.JSONRPCError) error {
+	appendJournal(sw.ctx, sw.journal, sw.taskID, a2a.JournalEntry{Kind: a2a.JournalError, Error: jerr})
+	return sw.write(sseFrame{resp: &a2a.JSONRPCResponse{
+		JSONRPCMessage: a2a.NewJSONRPCMessage(sw.id),
+		Error:          jerr,
+	}})
+}
+
+// write sends frame to the client, deferring it when the frame rate limit has been reached.
+func (sw *sseWriter) write(frame sseFrame) error {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+
+	if sw.closed {
+		return ErrStreamClosed
+	}
+	if err := sw.ctx.Err(); err != nil {
+		return fmt.Errorf("client disconnected: %w", err)
+	}
+	if sw.err != nil {
+		return sw.err
+	}
+	if status, ok := frame.resp.Result.(*a2a.TaskStatusUpdateEvent); ok && status.Final {
+		sw.ended = true
+	}
+
+	if sw.coalesce > 0 {
+		if event, ok := frame.resp.Result.(*a2a.TaskArtifactUpdateEvent); ok && !event.IsCompressed() {
+			sw.hold(frame)
+			return sw.err
+		}
+		// Anything else is written at once, behind the chunks held back so far.
+		if err := sw.release(); err != nil {
+			return err
+		}
+	}
+	return sw.emit(frame)
+}
+
+// hold holds back the artifact frame, merging it into the held frame when it appends to the
+// same artifact, and writes the held frame it cannot merge with. The caller must hold mu.
+func (sw *sseWriter) hold(frame sseFrame) {
+	if sw.held != nil {
+		if merged, ok := mergeArtifactFrame(*sw.held, frame); ok {
+			sw.held = &merged
+			return
+		}
+		if sw.release() != nil {
+			return
+		}
+	}
+	sw.held = &frame
+	sw.coalesceTimer = time.AfterFunc(sw.coalesce, sw.releaseHeld)
+}
+
+// releaseHeld writes the held frame once the coalesce interval has elapsed.
+func (sw *sseWriter) releaseHeld() {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+
+	if sw.closed || sw.ctx.Err() != nil {
+		return
+	}
+	sw.release()
+}
+
+// release writes the held frame, if any. The caller must hold mu.
+func (sw *sseWriter) release() error {
+	if sw.coalesceTimer != nil {
+		sw.coalesceTimer.Stop()
+		sw.coalesceTimer = nil
+	}
+	frame := sw.held
+	sw.held = nil
+	if frame == nil || sw.err != nil {
+		return sw.err
+	}
+	if err := sw.emit(*frame); err != nil {
+		sw.err = err
+	}
+	return sw.err
+}
+
+// emit writes frame, or queues it when the frame rate limit has been reached. The caller must hold mu.
+func (sw *sseWriter) emit(frame sseFrame) error {
+	if sw.interval == 0 {
+		return sw.writeFrame(frame)
+	}
+
+	sw.pending = coalesceFrame(sw.pending, frame)
+	if sw.timer == nil {
+		if wait := time.Until(sw.next); wait > 0 {
+			sw.timer = time.AfterFunc(wait, sw.flushPending)
+		} else {
+			sw.writeNext()
+			sw.schedule()
+		}
+	}
+	return sw.err
+}
+
+// flushPending writes the next deferred frame once its slot opens.
+func (sw *sseWriter) flushPending() {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+
+	sw.timer = nil
+	if sw.closed || sw.ctx.Err() != nil {
+		return
+	}
+	sw.writeNext()
+	sw.schedule()
+}
+
+// writeNext writes the oldest pending frame and reserves the following slot. The caller must hold mu.
+func (sw *sseWriter) writeNext() {
+	frame := sw.pending[0]
+	sw.pending = sw.pending[1:]
+
+	if err := sw.writeFrame(frame); err != nil {
+		sw.err = err
+		sw.pending = nil
+	}
+	sw.next = time.Now().Add(sw.interval)
+}
+
+// schedule arms the timer for the next pending frame, if any. The caller must hold mu.
+func (sw *sseWriter) schedule() {
+	if len(sw.pending) > 0 && sw.timer == nil && sw.err == nil {
+		sw.timer = time.AfterFunc(time.Until(sw.next), sw.flushPending)
+	}
+}
+
+// writeFrame writes frame as a server-sent event and flushes it to the client. The caller must hold mu.
+func (sw *sseWriter) writeFrame(frame sseFrame) error {
+	data, err := sw.codec.Marshal(frame.resp)
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	if !sw.started {
+		sw.start()
+	}
+	if frame.eventID > 0 {
+		if _, err := fmt.Fprintf(sw.w, "id: %d\n", frame.eventID); err != nil {
+			return fmt.Errorf("write event: %w", err)
+		}
+	}
+	if _, err := fmt.Fprintf(sw.w, "data: %s\n\n", data); err != nil {
+		return fmt.Errorf("write event: %w", err)
+	}
+	sw.flusher.Flush()
+	sw.armKeepalive()
+
+	return nil
+}
+
+// startKeepalive makes the writer send a keepalive comment whenever the stream stays silent
+// for d, starting the stream if no event was sent yet. A non-positive d sends none.
+func (sw *sseWriter) startKeepalive(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+
+	sw.keepalive = d
+	sw.armKeepalive()
+}
+
+// armKeepalive schedules the next keepalive comment a full interval from now, so that only
+// silent streams get one. The caller must hold mu.
+func (sw *sseWriter) armKeepalive() {
+	if sw.keepalive <= 0 || sw.closed {
+		return
+	}
+	if sw.keepaliveTimer == nil {
+		sw.keepaliveTimer = time.AfterFunc(sw.keepalive, sw.sendKeepalive)
+		return
+	}
+	sw.keepaliveTimer.Reset(sw.keepalive)
+}
+
+// stopKeepalive stops sending keepalive comments. The caller must hold mu.
+func (sw *sseWriter) stopKeepalive() {
+	if sw.keepaliveTimer != nil {
+		sw.keepaliveTimer.Stop()
+		sw.keepaliveTimer = nil
+	}
+}
+
+// sendKeepalive writes a keepalive comment, which clients ignore, and schedules the next one.
+func (sw *sseWriter) sendKeepalive() {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+
+	if sw.closed || sw.err != nil || sw.ctx.Err() != nil {
+		return
+	}
+	if !sw.started {
+		sw.start()
+	}
+	if _, err := io.WriteString(sw.w, ": keepalive\n\n"); err != nil {
+		sw.err = fmt.Errorf("write keepalive: %w", err)
+		return
+	}
+	sw.flusher.Flush()
+	sw.armKeepalive()
+}
+
+// coalesceFrame queues frame behind pending, merging it with frames it supersedes.
+//
+// A non-final status update replaces any pending non-final status update, and an artifact
+// chunk appending to the artifact at the tail of the queue is merged into it. Final status
+// updates, message deltas, errors and artifact content are never dropped. Compressed chunks
+// are never merged.
+// A merged frame takes the event ID of the latest frame it holds.
+func coalesceFrame(pending []sseFrame, frame sseFrame) []sseFrame {
+	switch event := frame.resp.Result.(type) {
+	case *a2a.TaskStatusUpdateEvent:
+		if !event.Final && event.Status.Delta == nil {
+			pending = slices.DeleteFunc(pending, func(p sseFrame) bool {
+				status, ok := p.resp.Result.(*a2a.TaskStatusUpdateEvent)
+				return ok && !status.Final && status.Status.Delta == nil
+			})
+		}
+
+	case *a2a.TaskArtifactUpdateEvent:
+		if len(pending) == 0 {
+			break
+		}
+		if merged, ok := mergeArtifactFrame(pending[len(pending)-1], frame); ok {
+			pending[len(pending)-1] = merged
+			return pending
+		}
+	}
+
+	return append(pending, frame)
+}
+
+// mergeArtifactFrame merges the artifact chunk of frame into the artifact update of last,
+// reporting whether frame appends to the same, unfinished artifact. Compressed chunks are
+// never merged. The merged frame takes the event ID of frame.
+func mergeArtifactFrame(last, frame sseFrame) (sseFrame, bool) {
+	event, ok := frame.resp.Result.(*a2a.TaskArtifactUpdateEvent)
+	if !ok || !event.Artifact.Append {
+		return sseFrame{}, false
+	}
+	prev, ok := last.resp.Result.(*a2a.TaskArtifactUpdateEvent)
+	if !ok || prev.Artifact.Index != event.Artifact.Index || prev.Artifact.LastChunk {
+		return sseFrame{}, false
+	}
+	if prev.IsCompressed() || event.IsCompressed() {
+		return sseFrame{}, false
+	}
+	merged := *prev
+	merged.Artifact.Parts = append(slices.Clip(prev.Artifact.Parts), event.Artifact.Parts...)
+	merged.Artifact.LastChunk = event.Artifact.LastChunk
+	return sseFrame{
+		resp: &a2a.JSONRPCResponse{
+			JSONRPCMessage: frame.resp.JSONRPCMessage,
+			Result:         &merged,
+		},
+		eventID: frame.eventID,
+	}, true
+}
+
+// isStarted reports whether the response headers have been written.
+func (sw *sseWriter) isStarted() bool {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	return sw.started
+}
+
+// abandon closes the writer without writing anything, leaving the response to the caller.
+func (sw *sseWriter) abandon() {
+	sw.mu.Lock()
+	sw.closed = true
+	sw.held = nil
+	sw.release()
+	sw.stopKeepalive()
+	sw.mu.Unlock()
+}
+
+// start writes the event stream response headers. The caller must hold mu.
+func (sw *sseWriter) start() {
+	sw.started = true
+
+	h := sw.w.Header()
+	h.Set("Content-Type", "text/event-stream")
+	h.Set("Cache-Control", "no-cache")
+	h.Set("Connection", "keep-alive")
+	sw.w.WriteHeader(http.StatusOK)
+}
+
+// isFinalState reports whether a status update in state ends the current stream.
+func isFinalState(state a2a.TaskState) bool {
+	return state.IsTerminal() || state == a2a.TaskStateInputRequired
+}
+
+// writeStreamError reports a failure on a stream, as a plain JSON-RPC error if nothing was sent yet.
+func (s *Server) writeStreamError(w http.ResponseWriter, r *http.Request, sw *sseWriter, jerr *a2a.JSONRPCError) {
+	if !sw.isStarted() {
+		sw.abandon()
+		appendJournal(r.Context(), sw.journal, sw.taskID, a2a.JournalEntry{Kind: a2a.JournalError, Error: jerr})
+		s.writeJSONRPCError(w, r, jerr)
+		return
+	}
+
+	recordRPCError(r.Context(), jerr)
+	if err := sw.sendError(jerr); err != nil {
+		s.logger.ErrorContext(r.Context(), "write stream error", slog.Any("error", err))
+	}
+}
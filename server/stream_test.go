@@ -0,0 +1,561 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bytedance/sonic"
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+// streamingTaskManager runs streaming tasks through a [server.StreamWriter].
+type streamingTaskManager struct {
+	*server.InMemoryTaskManager
+
+	stream func(ctx context.Context, w server.StreamWriter) error
+}
+
+var _ server.StreamHandler = (*streamingTaskManager)(nil)
+
+func (tm *streamingTaskManager) OnSendTaskStream(ctx context.Context, req *a2a.SendTaskStreamingRequest, w server.StreamWriter) error {
+	return tm.stream(ctx, w)
+}
+
+func newStreamingServer(t *testing.T, stream func(ctx context.Context, w server.StreamWriter) error, opts ...server.Option) *httptest.Server {
+	t.Helper()
+
+	tm := &streamingTaskManager{InMemoryTaskManager: server.NewInMemoryTaskManager(), stream: stream}
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, tm, opts...))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func postSendSubscribe(ctx context.Context, t *testing.T, url string) *http.Response {
+	t.Helper()
+
+	body := `{"jsonrpc":"2.0","id":42,"method":"tasks/sendSubscribe","params":` +
+		`{"id":"task-1","message":{"role":"user","parts":[{"type":"text","text":"hi"}]}}}`
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	return resp
+}
+
+func TestStreamWriter(t *testing.T) {
+	t.Parallel()
+
+	srv := newStreamingServer(t, func(ctx context.Context, w server.StreamWriter) error {
+		if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking}); err != nil {
+			return err
+		}
+		if err := w.SendArtifact(a2a.Artifact{Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "done"}}}); err != nil {
+			return err
+		}
+		return w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateCompleted})
+	})
+
+	resp := postSendSubscribe(t.Context(), t, srv.URL)
+	defer resp.Body.Close()
+
+	if got, want := resp.Header.Get("Content-Type"), "text/event-stream"; got != want {
+		t.Fatalf("Content-Type = %q, want %q", got, want)
+	}
+
+	type frame struct {
+		JSONRPC string         `json:"jsonrpc"`
+		ID      int            `json:"id"`
+		Result  map[string]any `json:"result"`
+	}
+
+	var frames []frame
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		data, ok := strings.CutPrefix(line, "data: ")
+		if !ok {
+			t.Fatalf("unexpected line %q, want a data line", line)
+		}
+		var f frame
+		if err := sonic.ConfigFastest.Unmarshal([]byte(data), &f); err != nil {
+			t.Fatalf("Unmarshal(%q) error = %v", data, err)
+		}
+		frames = append(frames, f)
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("read stream: %v", err)
+	}
+
+	if len(frames) != 3 {
+		t.Fatalf("got %d events, want 3", len(frames))
+	}
+	for i, f := range frames {
+		if f.JSONRPC != "2.0" || f.ID != 42 {
+			t.Errorf("event %d: jsonrpc = %q, id = %d, want 2.0 and 42", i, f.JSONRPC, f.ID)
+		}
+		if f.Result["id"] != "task-1" {
+			t.Errorf("event %d: task id = %v, want task-1", i, f.Result["id"])
+		}
+	}
+
+	got := []string{
+		frames[0].Result["status"].(map[string]any)["state"].(string),
+		frames[1].Result["artifact"].(map[string]any)["parts"].([]any)[0].(map[string]any)["text"].(string),
+		frames[2].Result["status"].(map[string]any)["state"].(string),
+	}
+	if diff := gocmp.Diff([]string{"working", "done", "completed"}, got); diff != "" {
+		t.Errorf("events mismatch (-want +got):\n%s", diff)
+	}
+	if final, _ := frames[2].Result["final"].(bool); !final {
+		t.Error("completed status not marked final")
+	}
+}
+
+func TestStreamWriter_ClientDisconnect(t *testing.T) {
+	t.Parallel()
+
+	stopped := make(chan error, 1)
+	srv := newStreamingServer(t, func(ctx context.Context, w server.StreamWriter) error {
+		for {
+			if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking}); err != nil {
+				stopped <- err
+				return nil
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(t.Context())
+	resp := postSendSubscribe(ctx, t, srv.URL)
+
+	// Read the first event, then hang up.
+	if _, err := bufio.NewReader(resp.Body).ReadString('\n'); err != nil {
+		t.Fatalf("read first event: %v", err)
+	}
+	cancel()
+	resp.Body.Close()
+
+	select {
+	case err := <-stopped:
+		if err == nil {
+			t.Error("SendStatus() error = nil after disconnect")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("handler kept streaming after the client disconnected")
+	}
+}
+
+func TestStreamWriter_MaxFrameRate(t *testing.T) {
+	t.Parallel()
+
+	srv := newStreamingServer(t, func(ctx context.Context, w server.StreamWriter) error {
+		for range 50 {
+			if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking}); err != nil {
+				return err
+			}
+		}
+		for i, text := range []string{"a", "b", "c"} {
+			err := w.SendArtifact(a2a.Artifact{
+				Parts:     []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: text}},
+				Append:    i > 0,
+				LastChunk: i == 2,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateCompleted})
+	}, server.WithMaxFrameRate(20))
+
+	resp := postSendSubscribe(t.Context(), t, srv.URL)
+	defer resp.Body.Close()
+
+	var (
+		frames int
+		text   strings.Builder
+		last   a2a.TaskStatusUpdateEvent
+	)
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+		if !ok {
+			continue
+		}
+		frames++
+
+		var frame struct {
+			Result struct {
+				a2a.TaskStatusUpdateEvent
+				Artifact *a2a.Artifact `json:"artifact"`
+			} `json:"result"`
+		}
+		if err := sonic.ConfigFastest.Unmarshal([]byte(data), &frame); err != nil {
+			t.Fatalf("Unmarshal(%q) error = %v", data, err)
+		}
+		if frame.Result.Artifact != nil {
+			for _, part := range frame.Result.Artifact.Parts {
+				text.WriteString(part.(*a2a.TextPart).Text)
+			}
+			continue
+		}
+		last = frame.Result.TaskStatusUpdateEvent
+	}
+
+	// 50 working updates coalesce into at most a couple of frames, and the chunks merge.
+	if frames > 6 {
+		t.Errorf("got %d frames, want coalescing to at most 6", frames)
+	}
+	if got := text.String(); got != "abc" {
+		t.Errorf("artifact text = %q, want %q", got, "abc")
+	}
+	if last.Status.State != a2a.TaskStateCompleted || !last.Final {
+		t.Errorf("last status = %q (final %v), want final %q", last.Status.State, last.Final, a2a.TaskStateCompleted)
+	}
+}
+
+func TestStreamWriter_Coalesce(t *testing.T) {
+	t.Parallel()
+
+	sendChunks := func(w server.StreamWriter, index int, texts ...string) error {
+		for i, text := range texts {
+			err := w.SendArtifact(a2a.Artifact{
+				Index:     index,
+				Parts:     []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: text}},
+				Append:    i > 0,
+				LastChunk: i == len(texts)-1,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// The interval outlasts the test: held chunks are only written by the status update
+	// following them and by the end of the stream.
+	srv := newStreamingServer(t, func(ctx context.Context, w server.StreamWriter) error {
+		if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking}); err != nil {
+			return err
+		}
+		if err := sendChunks(w, 0, strings.Split(strings.Repeat("a", 50), "")...); err != nil {
+			return err
+		}
+		if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking}); err != nil {
+			return err
+		}
+		if err := sendChunks(w, 1, "b", "c"); err != nil {
+			return err
+		}
+		return sendChunks(w, 2, "d", "e")
+	}, server.WithStreamCoalesce(time.Hour))
+
+	resp := postSendSubscribe(t.Context(), t, srv.URL)
+	defer resp.Body.Close()
+
+	var got []string
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+		if !ok {
+			continue
+		}
+		var frame struct {
+			Result struct {
+				a2a.TaskStatusUpdateEvent
+				Artifact *a2a.Artifact `json:"artifact"`
+			} `json:"result"`
+		}
+		if err := sonic.ConfigFastest.Unmarshal([]byte(data), &frame); err != nil {
+			t.Fatalf("Unmarshal(%q) error = %v", data, err)
+		}
+		if artifact := frame.Result.Artifact; artifact != nil {
+			got = append(got, fmt.Sprintf("artifact %d: %s", artifact.Index, artifact.JoinText("")))
+			continue
+		}
+		got = append(got, "status "+string(frame.Result.Status.State))
+	}
+
+	want := []string{
+		"status working",
+		"artifact 0: " + strings.Repeat("a", 50),
+		"status working",
+		"artifact 1: bc",
+		"artifact 2: de",
+	}
+	if diff := gocmp.Diff(want, got); diff != "" {
+		t.Errorf("events mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestStreamWriter_FinalizePartial(t *testing.T) {
+	t.Parallel()
+
+	srv := newStreamingServer(t, func(ctx context.Context, w server.StreamWriter) error {
+		produced := []a2a.Artifact{
+			{Index: 0, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "step 1"}}},
+			{Index: 1, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "step 2"}}},
+		}
+		if err := w.FinalizePartial(produced, errors.New("step 3: quota exceeded")); err != nil {
+			return err
+		}
+		if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking}); !errors.Is(err, server.ErrStreamClosed) {
+			t.Errorf("SendStatus() after FinalizePartial() error = %v, want %v", err, server.ErrStreamClosed)
+		}
+		return nil
+	})
+
+	resp := postSendSubscribe(t.Context(), t, srv.URL)
+	defer resp.Body.Close()
+
+	var task a2a.Task
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+		if !ok {
+			continue
+		}
+		var frame struct {
+			Result struct {
+				a2a.TaskStatusUpdateEvent
+				Artifact *a2a.Artifact `json:"artifact"`
+			} `json:"result"`
+		}
+		if err := sonic.ConfigFastest.Unmarshal([]byte(data), &frame); err != nil {
+			t.Fatalf("Unmarshal(%q) error = %v", data, err)
+		}
+		if frame.Result.Artifact != nil {
+			task.Artifacts = append(task.Artifacts, *frame.Result.Artifact)
+			continue
+		}
+		task.Status = frame.Result.Status
+		if !frame.Result.Final {
+			t.Error("failed status not marked final")
+		}
+	}
+
+	if !task.IsPartial() {
+		t.Fatalf("task state = %q with %d artifacts, want a partial result", task.Status.State, len(task.Artifacts))
+	}
+	if len(task.Artifacts) != 2 {
+		t.Errorf("got %d artifacts, want 2", len(task.Artifacts))
+	}
+	if msg := task.Status.Message; msg == nil || msg.Parts[0].(*a2a.TextPart).Text != "step 3: quota exceeded" {
+		t.Errorf("status message = %+v, want the failure reason", msg)
+	}
+}
+
+func TestStreamWriter_Expiry(t *testing.T) {
+	t.Parallel()
+
+	deadline := time.Now().Add(time.Minute).UTC().Truncate(time.Second)
+	withDeadline := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, cancel := context.WithDeadline(r.Context(), deadline)
+			defer cancel()
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+	srv := newStreamingServer(t, func(ctx context.Context, w server.StreamWriter) error {
+		if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking}); err != nil {
+			return err
+		}
+		return w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateCompleted})
+	}, server.WithHandlers(withDeadline))
+
+	resp := postSendSubscribe(t.Context(), t, srv.URL)
+	defer resp.Body.Close()
+
+	var expiries []*time.Time
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+		if !ok {
+			continue
+		}
+		var frame struct {
+			Result a2a.TaskStatusUpdateEvent `json:"result"`
+		}
+		if err := sonic.ConfigFastest.Unmarshal([]byte(data), &frame); err != nil {
+			t.Fatalf("Unmarshal(%q) error = %v", data, err)
+		}
+		expiries = append(expiries, frame.Result.Status.Expiry)
+	}
+
+	if len(expiries) != 2 {
+		t.Fatalf("got %d events, want 2", len(expiries))
+	}
+	if expiries[0] == nil || !expiries[0].Equal(deadline) {
+		t.Errorf("working status expiry = %v, want %v", expiries[0], deadline)
+	}
+	if expiries[1] != nil {
+		t.Errorf("completed status expiry = %v, want none", expiries[1])
+	}
+}
+
+func TestStreamWriter_EventTypes(t *testing.T) {
+	t.Parallel()
+
+	srv := newStreamingServer(t, func(ctx context.Context, w server.StreamWriter) error {
+		if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking}); err != nil {
+			return err
+		}
+		if err := w.SendArtifact(a2a.Artifact{Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "done"}}}); err != nil {
+			return err
+		}
+		return w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateCompleted})
+	})
+
+	tests := map[string]struct {
+		eventTypes string
+		want       []string
+		wantErr    bool
+	}{
+		"all events": {
+			eventTypes: `[]`,
+			want:       []string{"working", "artifact", "completed"},
+		},
+		"status only": {
+			eventTypes: `["status"]`,
+			want:       []string{"working", "completed"},
+		},
+		"artifact only": {
+			// The final status update still ends the stream.
+			eventTypes: `["artifact"]`,
+			want:       []string{"artifact", "completed"},
+		},
+		"unknown type": {
+			eventTypes: `["message"]`,
+			wantErr:    true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			body := `{"jsonrpc":"2.0","id":42,"method":"tasks/sendSubscribe","params":` +
+				`{"id":"task-1","message":{"role":"user","parts":[{"type":"text","text":"hi"}]},"eventTypes":` + tt.eventTypes + `}}`
+			resp, err := http.Post(srv.URL, "application/json", strings.NewReader(body))
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			if tt.wantErr {
+				var got a2a.JSONRPCResponse
+				if err := sonic.ConfigDefault.NewDecoder(resp.Body).Decode(&got); err != nil {
+					t.Fatalf("decode response: %v", err)
+				}
+				if got.Error == nil || got.Error.Code != a2a.InvalidParamsErrorCode {
+					t.Errorf("error = %v, want code %d", got.Error, a2a.InvalidParamsErrorCode)
+				}
+				return
+			}
+
+			var got []string
+			scanner := bufio.NewScanner(resp.Body)
+			for scanner.Scan() {
+				data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+				if !ok {
+					continue
+				}
+				var f struct {
+					Result struct {
+						Status   *a2a.TaskStatus `json:"status"`
+						Artifact *struct{}       `json:"artifact"`
+					} `json:"result"`
+				}
+				if err := sonic.ConfigDefault.Unmarshal([]byte(data), &f); err != nil {
+					t.Fatalf("Unmarshal(%q) error = %v", data, err)
+				}
+				switch {
+				case f.Result.Status != nil:
+					got = append(got, string(f.Result.Status.State))
+				case f.Result.Artifact != nil:
+					got = append(got, "artifact")
+				}
+			}
+			if err := scanner.Err(); err != nil {
+				t.Fatalf("read stream: %v", err)
+			}
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("events mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestStreamWriter_Keepalive(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	srv := newStreamingServer(t, func(ctx context.Context, w server.StreamWriter) error {
+		if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking}); err != nil {
+			return err
+		}
+		// Stay silent until the client saw a keepalive.
+		select {
+		case <-release:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		return w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateCompleted})
+	}, server.WithStreamKeepalive(20*time.Millisecond))
+
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+	resp := postSendSubscribe(ctx, t, srv.URL)
+	defer resp.Body.Close()
+
+	var got []string
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == ": keepalive":
+			if len(got) > 0 && got[len(got)-1] != "keepalive" {
+				got = append(got, "keepalive")
+				close(release)
+			}
+		case strings.HasPrefix(line, "data: "):
+			var f struct {
+				Result struct {
+					Status a2a.TaskStatus `json:"status"`
+				} `json:"result"`
+			}
+			if err := sonic.ConfigDefault.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &f); err != nil {
+				t.Fatalf("Unmarshal(%q) error = %v", line, err)
+			}
+			got = append(got, string(f.Result.Status.State))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("read stream: %v", err)
+	}
+
+	if diff := gocmp.Diff([]string{"working", "keepalive", "completed"}, got); diff != "" {
+		t.Errorf("stream mismatch (-want +got):\n%s", diff)
+	}
+}
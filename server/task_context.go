@@ -0,0 +1,59 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/go-a2a/a2a"
+)
+
+// withTask returns ctx carrying the stored task taskID for the handler of a call operating on
+// it, see [a2a.TaskFromContext]. It returns ctx as is if the task cannot be loaded, leaving the
+// handler to report it.
+func (s *Server) withTask(ctx context.Context, taskID string) context.Context {
+	if task := s.loadTask(ctx, taskID); task != nil {
+		return a2a.ContextWithTask(ctx, task)
+	}
+	return ctx
+}
+
+// withSentTask returns ctx carrying the task sent with params for its handler: the stored task,
+// or the skeleton of the task about to be created.
+func (s *Server) withSentTask(ctx context.Context, params a2a.TaskSendParams) context.Context {
+	task := s.loadTask(ctx, params.ID)
+	if task == nil {
+		task = newTask(params)
+	}
+	return a2a.ContextWithTask(ctx, task)
+}
+
+// loadTask returns the stored task taskID, whole, or nil if it cannot be loaded. It reads the
+// store of a task manager implementing [TaskStoreHolder], and calls tasks/get otherwise.
+func (s *Server) loadTask(ctx context.Context, taskID string) *a2a.Task {
+	var (
+		task *a2a.Task
+		err  error
+	)
+	if holder, ok := s.taskManager.(TaskStoreHolder); ok && holder.TaskStore() != nil {
+		task, err = holder.TaskStore().Get(ctx, taskID)
+	} else {
+		var resp *a2a.GetTaskResponse
+		resp, err = s.taskManager.OnGetTask(ctx, &a2a.GetTaskRequest{
+			Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: taskID}},
+		})
+		if err == nil {
+			task = resp.Result
+		}
+	}
+	if err != nil {
+		if !errors.Is(err, a2a.ErrTaskNotFound) {
+			s.logger.DebugContext(ctx, "load task", slog.String("task_id", taskID), slog.Any("error", err))
+		}
+		return nil
+	}
+	return task
+}
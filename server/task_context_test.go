@@ -0,0 +1,128 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+// contextTaskManager records the task carried by the context of each call it handles.
+type contextTaskManager struct {
+	*server.InMemoryTaskManager
+
+	mu    sync.Mutex
+	tasks map[string]*a2a.Task
+}
+
+func (tm *contextTaskManager) record(method string, ctx context.Context) {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	task, _ := a2a.TaskFromContext(ctx)
+	tm.tasks[method] = task
+}
+
+func (tm *contextTaskManager) OnSendTask(ctx context.Context, req *a2a.SendTaskRequest) (*a2a.SendTaskResponse, error) {
+	tm.record(req.Method, ctx)
+	return tm.InMemoryTaskManager.OnSendTask(ctx, req)
+}
+
+func (tm *contextTaskManager) OnCancelTask(ctx context.Context, req *a2a.CancelTaskRequest) (*a2a.CancelTaskResponse, error) {
+	tm.record(req.Method, ctx)
+	return tm.InMemoryTaskManager.OnCancelTask(ctx, req)
+}
+
+func TestServer_TaskFromContext(t *testing.T) {
+	t.Parallel()
+
+	const sendBody = `{"jsonrpc":"2.0","id":1,"method":"tasks/send","params":{"id":"task-1","sessionId":"9f0e1c52-5d6a-4c8e-a1c4-0a4fb2a6a001",` +
+		`"message":{"role":"user","parts":[{"type":"text","text":"hi"}]}}}`
+
+	tests := map[string]struct {
+		bodies []string
+		method string
+		want   *a2a.Task
+	}{
+		"new task": {
+			bodies: []string{sendBody},
+			method: a2a.MethodTasksSend,
+			want: &a2a.Task{
+				ID:        "task-1",
+				SessionID: "9f0e1c52-5d6a-4c8e-a1c4-0a4fb2a6a001",
+				Status:    a2a.TaskStatus{State: a2a.TaskStateSubmitted},
+				History:   []a2a.Message{{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "hi"}}}},
+			},
+		},
+		"existing task": {
+			bodies: []string{
+				sendBody,
+				`{"jsonrpc":"2.0","id":2,"method":"tasks/cancel","params":{"id":"task-1"}}`,
+			},
+			method: a2a.MethodTasksCancel,
+			want: &a2a.Task{
+				ID:        "task-1",
+				SessionID: "9f0e1c52-5d6a-4c8e-a1c4-0a4fb2a6a001",
+				Status:    a2a.TaskStatus{State: a2a.TaskStateSubmitted},
+				History:   []a2a.Message{{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "hi"}}}},
+			},
+		},
+		"unknown task": {
+			bodies: []string{`{"jsonrpc":"2.0","id":1,"method":"tasks/cancel","params":{"id":"missing"}}`},
+			method: a2a.MethodTasksCancel,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tm := &contextTaskManager{
+				InMemoryTaskManager: server.NewInMemoryTaskManager(),
+				tasks:               make(map[string]*a2a.Task),
+			}
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			srv := httptest.NewServer(server.NewServer("", "", card, tm))
+			t.Cleanup(srv.Close)
+
+			for _, body := range tt.bodies {
+				resp, err := http.Post(srv.URL, "application/json", strings.NewReader(body))
+				if err != nil {
+					t.Fatalf("Post() error = %v", err)
+				}
+				io.Copy(io.Discard, resp.Body)
+				resp.Body.Close()
+			}
+
+			tm.mu.Lock()
+			defer tm.mu.Unlock()
+			got, ok := tm.tasks[tt.method]
+			if !ok {
+				t.Fatalf("%s was not handled", tt.method)
+			}
+			opts := gocmp.Options{
+				// The store stamps these.
+				gocmp.FilterPath(func(p gocmp.Path) bool {
+					switch p.Last().String() {
+					case ".Timestamp", ".CreatedAt", ".UpdatedAt", ".Version":
+						return true
+					}
+					return false
+				}, gocmp.Ignore()),
+			}
+			if diff := gocmp.Diff(tt.want, got, opts); diff != "" {
+				t.Errorf("task in context mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
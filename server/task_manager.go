@@ -8,9 +8,11 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -33,6 +35,8 @@ type TaskManager interface {
 	OnSendTaskSubscribe(ctx context.Context, req *a2a.SendTaskStreamingRequest) (<-chan *a2a.SendTaskStreamingResponse, error)
 
 	// OnSetTaskPushNotification configures push notification for a task.
+	//
+	// The [Server] itself keeps push notification config in the store given to [WithPushNotifications].
 	OnSetTaskPushNotification(ctx context.Context, req *a2a.SetTaskPushNotificationRequest) (*a2a.SetTaskPushNotificationResponse, error)
 
 	// OnGetTaskPushNotification retrieves push notification configuration for a task.
@@ -44,19 +48,63 @@ type TaskManager interface {
 	OnResubscribeToTask(ctx context.Context, req *a2a.TaskResubscriptionRequest) (any, error)
 }
 
+// InputAppender is implemented by task managers that accept input streamed incrementally into a task.
+//
+// OnAppendTaskInput is called as each chunk of parts arrives, to record it in the task. The
+// server then delivers the chunk to the handlers running the task, which read it with
+// [InputFrom], so the agent can start working before the client has finished sending the turn.
+type InputAppender interface {
+	// OnAppendTaskInput appends a chunk of input to the current turn of a task.
+	OnAppendTaskInput(ctx context.Context, req *a2a.AppendTaskInputRequest) (*a2a.AppendTaskInputResponse, error)
+}
+
+// HistoryReader is implemented by task managers that serve task history in pages.
+type HistoryReader interface {
+	// OnGetTaskHistory returns one page of a task's history, oldest message first.
+	OnGetTaskHistory(ctx context.Context, req *a2a.GetTaskHistoryRequest) (*a2a.GetTaskHistoryResponse, error)
+}
+
+// ArtifactReader is implemented by task managers that serve task artifacts in ranges.
+type ArtifactReader interface {
+	// OnGetTaskArtifacts returns a range of a task's artifacts, sorted by index, along with
+	// the number of artifacts of the task.
+	OnGetTaskArtifacts(ctx context.Context, req *a2a.GetTaskArtifactsRequest) (*a2a.GetTaskArtifactsResponse, error)
+}
+
+// TaskLister is implemented by task managers that list their tasks in pages.
+type TaskLister interface {
+	// OnListTasks returns one page of the tasks matching the request, oldest task first.
+	OnListTasks(ctx context.Context, req *a2a.ListTasksRequest) (*a2a.ListTasksResponse, error)
+}
+
+// TaskStoreHolder is implemented by task managers backed by a [TaskStore], letting [WithTaskStore] replace it.
+type TaskStoreHolder interface {
+	// TaskStore returns the store holding the manager's tasks.
+	TaskStore() TaskStore
+
+	// SetTaskStore replaces the store holding the manager's tasks.
+	SetTaskStore(store TaskStore)
+}
+
 // InMemoryTaskManager is an in-memory implementation of TaskManager.
 type InMemoryTaskManager struct {
-	// Tasks is a map of task ID to task.
-	tasks map[string]*a2a.Task
+	// store holds the tasks.
+	store TaskStore
+
+	// openTurns tracks tasks whose current input turn is still receiving parts.
+	openTurns map[string]bool
+
+	// TaskMutex protects the openTurns map and serializes input appends.
+	taskMu sync.Mutex
 
-	// TaskMutex protects the tasks map.
-	taskMu sync.RWMutex
+	// omitHistory leaves the history out of tasks/get results that do not ask for it.
+	omitHistory bool
 
-	// PushNotifications is a map of task ID to push notification config.
-	pushNotifications map[string]a2a.TaskPushNotificationConfig
+	// artifactChunks accepts stored artifacts sharing an index when they are chunks.
+	artifactChunks bool
 
-	// PushMutex protects the pushNotifications map.
-	pushMu sync.RWMutex
+	// push holds the push notification config of each task.
+	push PushNotificationStore
 
 	// Subscribers is a map of task ID to a list of subscriber channels.
 	subscribers map[string][]chan a2a.TaskEvent
@@ -64,6 +112,9 @@ type InMemoryTaskManager struct {
 	// SubMutex protects the subscribers map.
 	subMu sync.RWMutex
 
+	// metrics receives the state changes of the tasks.
+	metrics Metrics
+
 	// logger is the logger for the task manager.
 	logger *slog.Logger
 
@@ -71,16 +122,26 @@ type InMemoryTaskManager struct {
 	tracer trace.Tracer
 }
 
-var _ TaskManager = (*InMemoryTaskManager)(nil)
+var (
+	_ TaskManager     = (*InMemoryTaskManager)(nil)
+	_ InputAppender   = (*InMemoryTaskManager)(nil)
+	_ HistoryReader   = (*InMemoryTaskManager)(nil)
+	_ ArtifactReader  = (*InMemoryTaskManager)(nil)
+	_ TaskLister      = (*InMemoryTaskManager)(nil)
+	_ TaskStoreHolder = (*InMemoryTaskManager)(nil)
+	_ MetricsHolder   = (*InMemoryTaskManager)(nil)
+)
 
 // NewInMemoryTaskManager creates a new InMemoryTaskManager.
 func NewInMemoryTaskManager() *InMemoryTaskManager {
 	return &InMemoryTaskManager{
-		tasks:             make(map[string]*a2a.Task),
-		pushNotifications: make(map[string]a2a.TaskPushNotificationConfig),
-		subscribers:       make(map[string][]chan a2a.TaskEvent),
-		logger:            slog.Default(),
-		tracer:            otel.GetTracerProvider().Tracer("github.com/go-a2a/a2a/server.task_manager"),
+		store:       NewInMemoryTaskStore(),
+		openTurns:   make(map[string]bool),
+		push:        NewInMemoryPushNotificationStore(),
+		subscribers: make(map[string][]chan a2a.TaskEvent),
+		metrics:     NopMetrics{},
+		logger:      slog.Default(),
+		tracer:      otel.GetTracerProvider().Tracer("github.com/go-a2a/a2a/server.task_manager"),
 	}
 }
 
@@ -96,10 +157,142 @@ func (tm *InMemoryTaskManager) WithTracer(tracer trace.Tracer) *InMemoryTaskMana
 	return tm
 }
 
+// timedStore returns the store of the manager, recording the time spent in it for Server-Timing.
+func (tm *InMemoryTaskManager) timedStore() TaskStore {
+	return timedStore{store: tm.store}
+}
+
+// WithTaskStore sets the [TaskStore] holding the tasks of the TaskManager.
+func (tm *InMemoryTaskManager) WithTaskStore(store TaskStore) *InMemoryTaskManager {
+	tm.store = store
+	return tm
+}
+
+// WithOmitHistory makes tasks/get leave the task history out unless the request sets a history length.
+//
+// Clients of long-running conversational tasks then read the history in pages with tasks/history/get.
+func (tm *InMemoryTaskManager) WithOmitHistory(omit bool) *InMemoryTaskManager {
+	tm.omitHistory = omit
+	return tm
+}
+
+// WithArtifactChunks makes [InMemoryTaskManager.UpdateTask] accept artifacts sharing an index
+// when they are chunks appended to an artifact, see [a2a.AllowArtifactChunks], for agents
+// storing their artifacts in chunked-append mode.
+func (tm *InMemoryTaskManager) WithArtifactChunks(allow bool) *InMemoryTaskManager {
+	tm.artifactChunks = allow
+	return tm
+}
+
+// TaskStore implements [TaskStoreHolder].
+func (tm *InMemoryTaskManager) TaskStore() TaskStore {
+	return tm.store
+}
+
+// SetTaskStore implements [TaskStoreHolder].
+func (tm *InMemoryTaskManager) SetTaskStore(store TaskStore) {
+	tm.store = store
+}
+
+// SetMetrics implements [MetricsHolder].
+func (tm *InMemoryTaskManager) SetMetrics(metrics Metrics) {
+	tm.metrics = metrics
+}
+
+// stateChanged reports a task moving from one state to another, if it did.
+func (tm *InMemoryTaskManager) stateChanged(from, to a2a.TaskState) {
+	if tm.metrics != nil && from != to {
+		tm.metrics.TaskStateChanged(from, to)
+	}
+}
+
 // OnSendTask handles a new task.
+//
+// The message is recorded in the task history, creating the task in the submitted state
+// if it does not exist yet. Processing the task is left to the embedding agent.
 func (tm *InMemoryTaskManager) OnSendTask(ctx context.Context, req *a2a.SendTaskRequest) (*a2a.SendTaskResponse, error) {
-	// no-op
-	return &a2a.SendTaskResponse{}, nil
+	ctx, span := tm.tracer.Start(ctx, "task_manager.OnSendTask",
+		trace.WithAttributes(attribute.String("a2a.task_id", req.Params.ID)))
+	defer span.End()
+
+	taskID := req.Params.ID
+	if taskID == "" {
+		return nil, errors.New("task ID cannot be empty")
+	}
+
+	task, err := tm.upsertTask(ctx, req.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	tm.logger.InfoContext(ctx, "task received", slog.String("task_id", taskID), slog.String("state", string(task.Status.State)))
+
+	return &a2a.SendTaskResponse{
+		JSONRPCResponse: a2a.JSONRPCResponse{
+			JSONRPCMessage: a2a.NewJSONRPCMessage(req.ID),
+		},
+		Result: task,
+	}, nil
+}
+
+// upsertTask creates the task described by params, or appends its message to the existing task.
+func (tm *InMemoryTaskManager) upsertTask(ctx context.Context, params a2a.TaskSendParams) (*a2a.Task, error) {
+	created, err := tm.timedStore().Create(ctx, newTask(params))
+	if err == nil {
+		tm.stateChanged("", created.Status.State)
+	}
+	if !errors.Is(err, ErrTaskExists) {
+		return created, err
+	}
+
+	return tm.updateTask(ctx, params.ID, func(task *a2a.Task) error {
+		task.History = append(task.History, params.Message)
+		if params.Labels != nil {
+			task.Labels = params.Labels
+		}
+		return nil
+	})
+}
+
+// newTask returns the task created by sending params, in the submitted state.
+func newTask(params a2a.TaskSendParams) *a2a.Task {
+	task := &a2a.Task{
+		ID: params.ID,
+		Status: a2a.TaskStatus{
+			State:     a2a.TaskStateSubmitted,
+			Timestamp: time.Now().UTC(),
+		},
+		History:  []a2a.Message{params.Message},
+		Labels:   params.Labels,
+		Metadata: params.Metadata,
+	}
+	if params.SessionID != uuid.Nil {
+		task.SessionID = params.SessionID.String()
+	}
+	return task
+}
+
+// updateTask applies fn to the stored task and saves it, retrying when a concurrent update wins.
+func (tm *InMemoryTaskManager) updateTask(ctx context.Context, taskID string, fn func(task *a2a.Task) error) (*a2a.Task, error) {
+	for {
+		task, err := tm.timedStore().Get(ctx, taskID)
+		if err != nil {
+			return nil, err
+		}
+		version, state := task.Version, task.Status.State
+		if err := fn(task); err != nil {
+			return nil, err
+		}
+
+		updated, err := tm.timedStore().Update(ctx, task, version)
+		if errors.Is(err, ErrVersionConflict) {
+			continue
+		}
+		if err == nil {
+			tm.stateChanged(state, updated.Status.State)
+		}
+		return updated, err
+	}
 }
 
 // OnGetTask retrieves a task.
@@ -113,15 +306,17 @@ func (tm *InMemoryTaskManager) OnGetTask(ctx context.Context, req *a2a.GetTaskRe
 		return nil, errors.New("task ID cannot be empty")
 	}
 
-	tm.taskMu.RLock()
-	task, ok := tm.tasks[taskID]
-	tm.taskMu.RUnlock()
-
-	if !ok {
+	task, err := tm.timedStore().Get(ctx, taskID)
+	if err != nil {
 		tm.logger.InfoContext(ctx, "task not found", slog.String("task_id", taskID))
-		return nil, fmt.Errorf("task not found: %s", taskID)
+		return nil, err
 	}
 
+	if req.Params.HistoryLength == nil && tm.omitHistory {
+		task.History = nil
+	}
+	task = req.Params.Trim(task)
+
 	tm.logger.InfoContext(ctx, "task retrieved", slog.String("task_id", taskID), slog.String("state", string(task.Status.State)))
 
 	return &a2a.GetTaskResponse{
@@ -143,27 +338,22 @@ func (tm *InMemoryTaskManager) OnCancelTask(ctx context.Context, req *a2a.Cancel
 		return nil, errors.New("task ID cannot be empty")
 	}
 
-	tm.taskMu.Lock()
-	task, ok := tm.tasks[taskID]
-	if !ok {
-		tm.taskMu.Unlock()
-		tm.logger.InfoContext(ctx, "task not found", slog.String("task_id", taskID))
-		return nil, fmt.Errorf("task not found: %s", taskID)
-	}
-
-	// Only allow cancellation of tasks that are not already in terminal states
-	switch state := task.Status.State; state {
-	case a2a.TaskStateCompleted, a2a.TaskStateCanceled, a2a.TaskStateFailed:
-		tm.taskMu.Unlock()
-		tm.logger.InfoContext(ctx, "task cannot be canceled", slog.String("task_id", taskID), slog.String("state", string(state)))
-		return nil, fmt.Errorf("task cannot be canceled: already in state %s", state)
+	task, err := tm.updateTask(ctx, taskID, func(task *a2a.Task) error {
+		// Only allow cancellation of tasks that are not already in terminal states
+		if state := task.Status.State; !state.CanTransitionTo(a2a.TaskStateCanceled) {
+			return fmt.Errorf("%w: already in state %s", ErrTaskNotCancelable, state)
+		}
+
+		task.Status.State = a2a.TaskStateCanceled
+		task.Status.Reason = req.Params.CancelReason()
+		task.Status.Timestamp = time.Now().UTC()
+		return nil
+	})
+	if err != nil {
+		tm.logger.InfoContext(ctx, "task cannot be canceled", slog.String("task_id", taskID), slog.Any("error", err))
+		return nil, err
 	}
 
-	// Update task state
-	task.Status.State = a2a.TaskStateCanceled
-	task.Status.Timestamp = time.Now().UTC()
-	tm.taskMu.Unlock()
-
 	// Create status update event
 	event := &a2a.TaskStatusUpdateEvent{
 		ID:     taskID,
@@ -206,19 +396,15 @@ func (tm *InMemoryTaskManager) OnSetTaskPushNotification(ctx context.Context, re
 	}
 
 	// Verify task exists
-	tm.taskMu.RLock()
-	_, ok := tm.tasks[task.ID]
-	tm.taskMu.RUnlock()
-
-	if !ok {
+	if _, err := tm.timedStore().Get(ctx, task.ID); err != nil {
 		tm.logger.InfoContext(ctx, "task not found", slog.String("task_id", task.ID))
-		return nil, fmt.Errorf("task not found: %s", task.ID)
+		return nil, err
 	}
 
 	// Store push notification config
-	tm.pushMu.Lock()
-	tm.pushNotifications[task.ID] = task
-	tm.pushMu.Unlock()
+	if err := tm.push.Set(ctx, task.ID, task.PushNotificationConfig); err != nil {
+		return nil, err
+	}
 
 	tm.logger.InfoContext(ctx, "task push notification configured", slog.String("task_id", task.ID))
 
@@ -243,24 +429,18 @@ func (tm *InMemoryTaskManager) OnGetTaskPushNotification(ctx context.Context, re
 	}
 
 	// Verify task exists
-	tm.taskMu.RLock()
-	_, ok := tm.tasks[task.ID]
-	tm.taskMu.RUnlock()
-
-	if !ok {
+	if _, err := tm.timedStore().Get(ctx, task.ID); err != nil {
 		tm.logger.InfoContext(ctx, "task not found", slog.String("task_id", task.ID))
-		return nil, fmt.Errorf("task not found: %s", task.ID)
+		return nil, err
 	}
 
 	// Get push notification config
-	tm.pushMu.RLock()
-	config, ok := tm.pushNotifications[task.ID]
-	tm.pushMu.RUnlock()
-
-	if !ok {
+	pushConfig, err := tm.push.Get(ctx, task.ID)
+	if err != nil {
 		tm.logger.InfoContext(ctx, "push notification not found", slog.String("task_id", task.ID))
-		return nil, fmt.Errorf("push notification not found for task: %s", task.ID)
+		return nil, err
 	}
+	config := a2a.TaskPushNotificationConfig{ID: task.ID, PushNotificationConfig: pushConfig}
 
 	tm.logger.InfoContext(ctx, "task push notification retrieved", slog.String("task_id", task.ID))
 
@@ -272,6 +452,74 @@ func (tm *InMemoryTaskManager) OnGetTaskPushNotification(ctx context.Context, re
 	}, nil
 }
 
+// OnAppendTaskInput appends a chunk of input to the current turn of a task.
+//
+// The first chunk of a turn starts a new user message in the task history, creating the task if
+// needed; later chunks are appended to that message until a chunk marked final closes the turn.
+func (tm *InMemoryTaskManager) OnAppendTaskInput(ctx context.Context, req *a2a.AppendTaskInputRequest) (*a2a.AppendTaskInputResponse, error) {
+	ctx, span := tm.tracer.Start(ctx, "task_manager.OnAppendTaskInput",
+		trace.WithAttributes(attribute.String("a2a.task_id", req.Params.ID)))
+	defer span.End()
+
+	taskID := req.Params.ID
+	if taskID == "" {
+		return nil, errors.New("task ID cannot be empty")
+	}
+
+	tm.taskMu.Lock()
+	defer tm.taskMu.Unlock()
+
+	task := &a2a.Task{
+		ID: taskID,
+		Status: a2a.TaskStatus{
+			State:     a2a.TaskStateSubmitted,
+			Timestamp: time.Now().UTC(),
+		},
+	}
+	if req.Params.SessionID != uuid.Nil {
+		task.SessionID = req.Params.SessionID.String()
+	}
+	if _, err := tm.timedStore().Create(ctx, task); err != nil && !errors.Is(err, ErrTaskExists) {
+		return nil, err
+	}
+
+	task, err := tm.updateTask(ctx, taskID, func(task *a2a.Task) error {
+		if tm.openTurns[taskID] && len(task.History) > 0 {
+			last := &task.History[len(task.History)-1]
+			last.Parts = append(last.Parts, req.Params.Message.Parts...)
+		} else {
+			task.History = append(task.History, a2a.Message{
+				Role:     a2a.RoleUser,
+				Parts:    slices.Clone(req.Params.Message.Parts),
+				Metadata: req.Params.Message.Metadata,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Params.Final {
+		delete(tm.openTurns, taskID)
+	} else {
+		tm.openTurns[taskID] = true
+	}
+
+	tm.logger.InfoContext(ctx, "task input appended",
+		slog.String("task_id", taskID),
+		slog.Int("parts", len(req.Params.Message.Parts)),
+		slog.Bool("final", req.Params.Final),
+	)
+
+	return &a2a.AppendTaskInputResponse{
+		JSONRPCResponse: a2a.JSONRPCResponse{
+			JSONRPCMessage: a2a.NewJSONRPCMessage(req.ID),
+		},
+		Result: task,
+	}, nil
+}
+
 // OnResubscribeToTask resubscribes to a task's updates.
 func (tm *InMemoryTaskManager) OnResubscribeToTask(ctx context.Context, req *a2a.TaskResubscriptionRequest) (any, error) {
 	return &a2a.JSONRPCResponse{
@@ -291,13 +539,10 @@ func (tm *InMemoryTaskManager) onResubscribeToTask(ctx context.Context, req *a2a
 	}
 
 	// Get task
-	tm.taskMu.RLock()
-	task, ok := tm.tasks[req.Params.ID]
-	tm.taskMu.RUnlock()
-
-	if !ok {
+	task, err := tm.timedStore().Get(ctx, req.Params.ID)
+	if err != nil {
 		tm.logger.InfoContext(ctx, "task not found", slog.String("task_id", req.Params.ID))
-		return nil, fmt.Errorf("task not found: %s", req.Params.ID)
+		return nil, err
 	}
 
 	// Create event
@@ -336,6 +581,82 @@ func (tm *InMemoryTaskManager) notifySubscribers(ctx context.Context, taskID str
 	}
 }
 
+// UpdateTask replaces a stored task, provided its version still equals expectedVersion.
+//
+// Callers read a task, modify a copy and pass the version they read; if another update
+// landed in between, UpdateTask returns an error wrapping [ErrVersionConflict] and the
+// caller should re-read and retry. On success the stored task is returned.
+//
+// The artifacts of the task must pass [a2a.ValidateArtifacts], or UpdateTask returns an error
+// wrapping [a2a.ErrInvalidParams]; see [InMemoryTaskManager.WithArtifactChunks].
+func (tm *InMemoryTaskManager) UpdateTask(ctx context.Context, task *a2a.Task, expectedVersion int) (*a2a.Task, error) {
+	ctx, span := tm.tracer.Start(ctx, "task_manager.UpdateTask",
+		trace.WithAttributes(attribute.String("a2a.task_id", task.ID)))
+	defer span.End()
+
+	if task.ID == "" {
+		return nil, errors.New("task ID cannot be empty")
+	}
+
+	current, err := tm.timedStore().Get(ctx, task.ID)
+	if err != nil {
+		tm.logger.InfoContext(ctx, "task not found", slog.String("task_id", task.ID))
+		return nil, err
+	}
+	if err := checkTransition(current.Status.State, task.Status.State); err != nil {
+		tm.logger.InfoContext(ctx, "task not updated", slog.String("task_id", task.ID), slog.Any("error", err))
+		return nil, err
+	}
+	var opts []a2a.ArtifactOption
+	if tm.artifactChunks {
+		opts = append(opts, a2a.AllowArtifactChunks())
+	}
+	if err := a2a.ValidateArtifacts(task.Artifacts, opts...); err != nil {
+		tm.logger.InfoContext(ctx, "task not updated", slog.String("task_id", task.ID), slog.Any("error", err))
+		return nil, fmt.Errorf("%w: %w", a2a.ErrInvalidParams, err)
+	}
+
+	updated, err := tm.timedStore().Update(ctx, task, expectedVersion)
+	if err != nil {
+		tm.logger.InfoContext(ctx, "task not updated", slog.String("task_id", task.ID), slog.Any("error", err))
+		return nil, err
+	}
+
+	tm.stateChanged(current.Status.State, updated.Status.State)
+
+	tm.logger.InfoContext(ctx, "task updated", slog.String("task_id", task.ID), slog.Int("version", updated.Version))
+	return updated, nil
+}
+
+// SetTaskLabels replaces the labels of a task.
+func (tm *InMemoryTaskManager) SetTaskLabels(ctx context.Context, taskID string, labels map[string]string) error {
+	if taskID == "" {
+		return errors.New("task ID cannot be empty")
+	}
+	if err := a2a.ValidateLabels(labels); err != nil {
+		return fmt.Errorf("invalid labels: %w", err)
+	}
+
+	_, err := tm.updateTask(ctx, taskID, func(task *a2a.Task) error {
+		task.Labels = labels
+		return nil
+	})
+	if err != nil {
+		tm.logger.InfoContext(ctx, "task labels not updated", slog.String("task_id", taskID), slog.Any("error", err))
+		return err
+	}
+
+	tm.logger.InfoContext(ctx, "task labels updated", slog.String("task_id", taskID), slog.Int("labels", len(labels)))
+	return nil
+}
+
+// ListTasks returns the tasks carrying every key/value pair in selector, ordered by task ID.
+//
+// An empty selector returns every task.
+func (tm *InMemoryTaskManager) ListTasks(ctx context.Context, selector map[string]string) ([]*a2a.Task, error) {
+	return tm.timedStore().List(ctx, TaskFilter{Labels: selector})
+}
+
 // UpdateTaskStatus updates a task's status and notifies subscribers.
 func (tm *InMemoryTaskManager) UpdateTaskStatus(ctx context.Context, taskID string, status a2a.TaskStatus, artifacts []a2a.Artifact) error {
 	ctx, span := tm.tracer.Start(ctx, "task_manager.UpdateTaskStatus",
@@ -350,18 +671,19 @@ func (tm *InMemoryTaskManager) UpdateTaskStatus(ctx context.Context, taskID stri
 	}
 
 	// Update task
-	tm.taskMu.Lock()
-	task, ok := tm.tasks[taskID]
-	if !ok {
-		tm.taskMu.Unlock()
-		tm.logger.InfoContext(ctx, "task not found", slog.String("task_id", taskID))
-		return fmt.Errorf("task not found: %s", taskID)
+	_, err := tm.updateTask(ctx, taskID, func(task *a2a.Task) error {
+		if err := checkTransition(task.Status.State, status.State); err != nil {
+			return err
+		}
+		task.Status = status
+		task.Status.Timestamp = time.Now().UTC()
+		return nil
+	})
+	if err != nil {
+		tm.logger.InfoContext(ctx, "task status not updated", slog.String("task_id", taskID), slog.Any("error", err))
+		return err
 	}
 
-	task.Status = status
-	task.Status.Timestamp = time.Now().UTC()
-	tm.taskMu.Unlock()
-
 	// Create event
 	event := &a2a.TaskStatusUpdateEvent{
 		ID:     taskID,
@@ -374,3 +696,11 @@ func (tm *InMemoryTaskManager) UpdateTaskStatus(ctx context.Context, taskID stri
 	tm.logger.InfoContext(ctx, "task status updated", slog.String("task_id", taskID), slog.String("state", string(status.State)))
 	return nil
 }
+
+// checkTransition returns an error wrapping [ErrInvalidTransition] if a task cannot move from one state to the other.
+func checkTransition(from, to a2a.TaskState) error {
+	if !from.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %s to %s", ErrInvalidTransition, from, to)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestInMemoryTaskManager_UpdateTask(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	tm := server.NewInMemoryTaskManager()
+
+	// Appending input creates the task.
+	created, err := tm.OnAppendTaskInput(ctx, &a2a.AppendTaskInputRequest{
+		Params: a2a.TaskInputParams{
+			TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+			Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Text: "hi"}}},
+			Final:        true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("OnAppendTaskInput() error = %v", err)
+	}
+	read := *created.Result
+
+	// Two writers both start from the same read; only the first may win.
+	first := read
+	first.Status.State = a2a.TaskStateWorking
+	updated, err := tm.UpdateTask(ctx, &first, read.Version)
+	if err != nil {
+		t.Fatalf("UpdateTask() error = %v", err)
+	}
+	if got, want := updated.Version, read.Version+1; got != want {
+		t.Errorf("UpdateTask() version = %d, want %d", got, want)
+	}
+
+	second := read
+	second.Status.State = a2a.TaskStateCanceled
+	if _, err := tm.UpdateTask(ctx, &second, read.Version); !errors.Is(err, server.ErrVersionConflict) {
+		t.Fatalf("UpdateTask() error = %v, want %v", err, server.ErrVersionConflict)
+	}
+
+	got, err := tm.OnGetTask(ctx, &a2a.GetTaskRequest{Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}}})
+	if err != nil {
+		t.Fatalf("OnGetTask() error = %v", err)
+	}
+	if got.Result.Status.State != a2a.TaskStateWorking {
+		t.Errorf("task state = %q, want %q", got.Result.Status.State, a2a.TaskStateWorking)
+	}
+
+	if _, err := tm.UpdateTask(ctx, &a2a.Task{ID: "missing"}, 0); err == nil {
+		t.Error("UpdateTask() error = nil, want error for missing task")
+	}
+
+	chunked := *updated
+	chunked.Artifacts = []a2a.Artifact{{Index: 0}, {Index: 0, Append: true}}
+	if _, err := tm.UpdateTask(ctx, &chunked, updated.Version); !errors.Is(err, a2a.ErrInvalidArtifactIndex) {
+		t.Errorf("UpdateTask() with chunked artifacts error = %v, want %v", err, a2a.ErrInvalidArtifactIndex)
+	}
+	if _, err := tm.WithArtifactChunks(true).UpdateTask(ctx, &chunked, updated.Version); err != nil {
+		t.Errorf("UpdateTask() with chunked artifacts allowed error = %v", err)
+	}
+}
+
+func TestInMemoryTaskManager_UpdateTaskStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	tm := server.NewInMemoryTaskManager()
+	if _, err := tm.OnSendTask(ctx, &a2a.SendTaskRequest{
+		Params: a2a.TaskSendParams{
+			TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+			Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Text: "hi"}}},
+		},
+	}); err != nil {
+		t.Fatalf("OnSendTask() error = %v", err)
+	}
+
+	for _, state := range []a2a.TaskState{a2a.TaskStateWorking, a2a.TaskStateWorking, a2a.TaskStateCompleted} {
+		if err := tm.UpdateTaskStatus(ctx, "task-1", a2a.TaskStatus{State: state}, nil); err != nil {
+			t.Fatalf("UpdateTaskStatus(%q) error = %v", state, err)
+		}
+	}
+
+	err := tm.UpdateTaskStatus(ctx, "task-1", a2a.TaskStatus{State: a2a.TaskStateWorking}, nil)
+	if !errors.Is(err, server.ErrInvalidTransition) {
+		t.Errorf("UpdateTaskStatus() from completed error = %v, want %v", err, server.ErrInvalidTransition)
+	}
+
+	_, err = tm.OnCancelTask(ctx, &a2a.CancelTaskRequest{Params: a2a.TaskIDParams{ID: "task-1"}})
+	if !errors.Is(err, server.ErrTaskNotCancelable) {
+		t.Errorf("OnCancelTask() error = %v, want %v", err, server.ErrTaskNotCancelable)
+	}
+}
+
+func TestServer_CancelTerminalTask(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	tm := server.NewInMemoryTaskManager()
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, tm))
+	t.Cleanup(srv.Close)
+
+	if _, err := tm.OnSendTask(ctx, &a2a.SendTaskRequest{
+		Params: a2a.TaskSendParams{
+			TaskIDParams: a2a.TaskIDParams{ID: "task-1"},
+			Message:      a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Text: "hi"}}},
+		},
+	}); err != nil {
+		t.Fatalf("OnSendTask() error = %v", err)
+	}
+	if err := tm.UpdateTaskStatus(ctx, "task-1", a2a.TaskStatus{State: a2a.TaskStateFailed}, nil); err != nil {
+		t.Fatalf("UpdateTaskStatus() error = %v", err)
+	}
+
+	body := `{"jsonrpc":"2.0","id":1,"method":"tasks/cancel","params":{"id":"task-1"}}`
+	resp, err := http.Post(srv.URL, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	var got a2a.JSONRPCResponse
+	if err := sonic.ConfigFastest.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal(%q) error = %v", data, err)
+	}
+	if got.Error == nil || got.Error.Code != a2a.TaskNotCancelableErrorCode {
+		t.Errorf("response error = %+v, want code %d", got.Error, a2a.TaskNotCancelableErrorCode)
+	}
+}
@@ -0,0 +1,291 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-a2a/a2a"
+)
+
+var (
+	// ErrTaskNotFound is returned by a [TaskStore] for an unknown task ID. It is [a2a.ErrTaskNotFound].
+	ErrTaskNotFound = a2a.ErrTaskNotFound
+
+	// ErrTaskExists is returned by [TaskStore.Create] for a task ID that is already stored.
+	ErrTaskExists = errors.New("task already exists")
+
+	// ErrVersionConflict is returned when a task was modified since the version a caller expected.
+	ErrVersionConflict = errors.New("task version conflict")
+
+	// ErrInvalidTransition is returned when a task update moves it to a state its current state cannot reach.
+	ErrInvalidTransition = errors.New("invalid task state transition")
+
+	// ErrTaskNotCancelable is returned when canceling a task that is already in a terminal state.
+	// It is [a2a.ErrTaskNotCancelable].
+	ErrTaskNotCancelable = a2a.ErrTaskNotCancelable
+)
+
+// TaskFilter selects tasks in [TaskStore.List]. Zero fields match every task.
+type TaskFilter struct {
+	// SessionID selects tasks of a session.
+	SessionID string
+
+	// Labels selects tasks carrying every key/value pair.
+	Labels map[string]string
+
+	// State selects tasks in a state.
+	State a2a.TaskState
+}
+
+// TaskStore persists tasks between requests.
+//
+// Implementations must be safe for concurrent use and must not retain or return
+// tasks that callers can mutate in place.
+type TaskStore interface {
+	// Create stores a new task, returning an error wrapping [ErrTaskExists] if its ID is taken.
+	Create(ctx context.Context, task *a2a.Task) (*a2a.Task, error)
+
+	// Get returns the task with the given ID, or an error wrapping [ErrTaskNotFound].
+	Get(ctx context.Context, taskID string) (*a2a.Task, error)
+
+	// Update replaces a stored task, provided its version still equals expectedVersion;
+	// otherwise it returns an error wrapping [ErrVersionConflict].
+	Update(ctx context.Context, task *a2a.Task, expectedVersion int) (*a2a.Task, error)
+
+	// List returns the tasks matching filter, ordered by task ID.
+	List(ctx context.Context, filter TaskFilter) ([]*a2a.Task, error)
+
+	// Delete removes a task, returning an error wrapping [ErrTaskNotFound] if it does not exist.
+	Delete(ctx context.Context, taskID string) error
+}
+
+// InMemoryTaskStore is an in-memory implementation of [TaskStore].
+//
+// It stamps CreatedAt and UpdatedAt, increments Version on every write, indexes
+// tasks by label, and appends each new status message to the task history.
+// Session IDs are normalized with [a2a.NormalizeSessionID] on create and in filters.
+type InMemoryTaskStore struct {
+	mu    sync.RWMutex
+	tasks map[string]*a2a.Task
+
+	// labelIndex maps a label pair, joined by labelIndexKey, to the set of task IDs carrying it.
+	labelIndex map[string]map[string]struct{}
+}
+
+var _ TaskStore = (*InMemoryTaskStore)(nil)
+
+// NewInMemoryTaskStore creates a new [InMemoryTaskStore].
+func NewInMemoryTaskStore() *InMemoryTaskStore {
+	return &InMemoryTaskStore{
+		tasks:      make(map[string]*a2a.Task),
+		labelIndex: make(map[string]map[string]struct{}),
+	}
+}
+
+// Create implements [TaskStore].
+func (s *InMemoryTaskStore) Create(ctx context.Context, task *a2a.Task) (*a2a.Task, error) {
+	if task.ID == "" {
+		return nil, errors.New("task ID cannot be empty")
+	}
+	if err := a2a.ValidateLabels(task.Labels); err != nil {
+		return nil, fmt.Errorf("invalid labels: %w", err)
+	}
+
+	stored := cloneTask(task)
+	if stored.SessionID != "" {
+		sessionID, err := a2a.NormalizeSessionID(stored.SessionID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid session ID: %w", err)
+		}
+		stored.SessionID = sessionID
+	}
+	now := time.Now().UTC()
+	stored.CreatedAt = now
+	stored.UpdatedAt = now
+	stored.Version = 1
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.tasks[task.ID]; ok {
+		return nil, fmt.Errorf("%w: %s", ErrTaskExists, task.ID)
+	}
+	s.tasks[task.ID] = stored
+	s.reindexLabels(task.ID, nil, stored.Labels)
+
+	return cloneTask(stored), nil
+}
+
+// Get implements [TaskStore].
+func (s *InMemoryTaskStore) Get(ctx context.Context, taskID string) (*a2a.Task, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	task, ok := s.tasks[taskID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrTaskNotFound, taskID)
+	}
+	return cloneTask(task), nil
+}
+
+// Update implements [TaskStore].
+//
+// When the status carries a message that differs from the stored status message,
+// the message is added to the task history with [a2a.AppendUniqueMessage], so that
+// status updates streaming a growing message leave a single turn in history.
+func (s *InMemoryTaskStore) Update(ctx context.Context, task *a2a.Task, expectedVersion int) (*a2a.Task, error) {
+	if err := a2a.ValidateLabels(task.Labels); err != nil {
+		return nil, fmt.Errorf("invalid labels: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, ok := s.tasks[task.ID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrTaskNotFound, task.ID)
+	}
+	if current.Version != expectedVersion {
+		return nil, fmt.Errorf("%w: task %s is at version %d, expected %d", ErrVersionConflict, task.ID, current.Version, expectedVersion)
+	}
+
+	stored := cloneTask(task)
+	if msg := stored.Status.Message; msg != nil && !reflect.DeepEqual(msg, current.Status.Message) {
+		stored.History = a2a.AppendUniqueMessage(stored.History, *msg)
+	}
+	stored.CreatedAt = current.CreatedAt
+	stored.UpdatedAt = time.Now().UTC()
+	stored.Version = current.Version + 1
+
+	s.tasks[task.ID] = stored
+	s.reindexLabels(task.ID, current.Labels, stored.Labels)
+
+	return cloneTask(stored), nil
+}
+
+// List implements [TaskStore].
+func (s *InMemoryTaskStore) List(ctx context.Context, filter TaskFilter) ([]*a2a.Task, error) {
+	if filter.SessionID != "" {
+		sessionID, err := a2a.NormalizeSessionID(filter.SessionID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid session ID: %w", err)
+		}
+		filter.SessionID = sessionID
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// Start from the smallest indexed candidate set and filter the rest.
+	var candidates map[string]struct{}
+	for key, value := range filter.Labels {
+		ids := s.labelIndex[labelIndexKey(key, value)]
+		if candidates == nil || len(ids) < len(candidates) {
+			candidates = ids
+		}
+		if len(candidates) == 0 {
+			return nil, nil
+		}
+	}
+
+	match := func(task *a2a.Task) bool {
+		return (filter.SessionID == "" || task.SessionID == filter.SessionID) &&
+			(filter.State == "" || task.Status.State == filter.State) &&
+			task.MatchLabels(filter.Labels)
+	}
+
+	var tasks []*a2a.Task
+	if len(filter.Labels) == 0 {
+		for _, task := range s.tasks {
+			if match(task) {
+				tasks = append(tasks, cloneTask(task))
+			}
+		}
+	} else {
+		for id := range candidates {
+			if task, ok := s.tasks[id]; ok && match(task) {
+				tasks = append(tasks, cloneTask(task))
+			}
+		}
+	}
+	slices.SortFunc(tasks, func(a, b *a2a.Task) int { return strings.Compare(a.ID, b.ID) })
+
+	return tasks, nil
+}
+
+// Delete implements [TaskStore].
+func (s *InMemoryTaskStore) Delete(ctx context.Context, taskID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	task, ok := s.tasks[taskID]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrTaskNotFound, taskID)
+	}
+	s.reindexLabels(taskID, task.Labels, nil)
+	delete(s.tasks, taskID)
+
+	return nil
+}
+
+// reindexLabels moves taskID in the label index from the old labels to the new ones.
+//
+// The caller must hold mu.
+func (s *InMemoryTaskStore) reindexLabels(taskID string, old, labels map[string]string) {
+	for key, value := range old {
+		ik := labelIndexKey(key, value)
+		delete(s.labelIndex[ik], taskID)
+		if len(s.labelIndex[ik]) == 0 {
+			delete(s.labelIndex, ik)
+		}
+	}
+	for key, value := range labels {
+		ik := labelIndexKey(key, value)
+		if s.labelIndex[ik] == nil {
+			s.labelIndex[ik] = make(map[string]struct{})
+		}
+		s.labelIndex[ik][taskID] = struct{}{}
+	}
+}
+
+// labelIndexKey returns the label index key for a label pair.
+func labelIndexKey(key, value string) string {
+	return key + "\x00" + value
+}
+
+// cloneTask returns a copy of task that shares no slices or maps with it.
+//
+// Parts themselves are shared, as they are treated as immutable once sent.
+func cloneTask(task *a2a.Task) *a2a.Task {
+	clone := *task
+	clone.Artifacts = slices.Clone(task.Artifacts)
+	for i := range clone.Artifacts {
+		clone.Artifacts[i].Parts = slices.Clone(clone.Artifacts[i].Parts)
+		clone.Artifacts[i].Metadata = maps.Clone(clone.Artifacts[i].Metadata)
+		clone.Artifacts[i].Labels = maps.Clone(clone.Artifacts[i].Labels)
+	}
+	clone.History = slices.Clone(task.History)
+	for i := range clone.History {
+		clone.History[i].Parts = slices.Clone(clone.History[i].Parts)
+		clone.History[i].Metadata = maps.Clone(clone.History[i].Metadata)
+	}
+	if task.Status.Message != nil {
+		msg := *task.Status.Message
+		msg.Parts = slices.Clone(msg.Parts)
+		msg.Metadata = maps.Clone(msg.Metadata)
+		clone.Status.Message = &msg
+	}
+	clone.Labels = maps.Clone(task.Labels)
+	clone.Metadata = maps.Clone(task.Metadata)
+	return &clone
+}
@@ -0,0 +1,202 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/sonic"
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestInMemoryTaskStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	store := server.NewInMemoryTaskStore()
+
+	created, err := store.Create(ctx, &a2a.Task{
+		ID:     "task-1",
+		Status: a2a.TaskStatus{State: a2a.TaskStateSubmitted},
+		Labels: map[string]string{"team": "search"},
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if created.Version != 1 || created.CreatedAt.IsZero() || !created.UpdatedAt.Equal(created.CreatedAt) {
+		t.Errorf("Create() = version %d, created %v, updated %v, want version 1 and equal stamps",
+			created.Version, created.CreatedAt, created.UpdatedAt)
+	}
+	if _, err := store.Create(ctx, &a2a.Task{ID: "task-1"}); !errors.Is(err, server.ErrTaskExists) {
+		t.Errorf("Create() duplicate error = %v, want %v", err, server.ErrTaskExists)
+	}
+
+	// Updating with a new status message appends it to the history.
+	reply := &a2a.Message{Role: a2a.RoleAgent, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "working on it"}}}
+	next := *created
+	next.Status = a2a.TaskStatus{State: a2a.TaskStateWorking, Message: reply}
+	updated, err := store.Update(ctx, &next, created.Version)
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if updated.Version != 2 || !updated.CreatedAt.Equal(created.CreatedAt) || updated.UpdatedAt.Before(created.UpdatedAt) {
+		t.Errorf("Update() = version %d, created %v, updated %v", updated.Version, updated.CreatedAt, updated.UpdatedAt)
+	}
+	if len(updated.History) != 1 || updated.History[0].Role != a2a.RoleAgent {
+		t.Errorf("Update() history = %+v, want the status message", updated.History)
+	}
+
+	// Re-saving the same status message does not append it again.
+	again, err := store.Update(ctx, updated, updated.Version)
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if len(again.History) != 1 {
+		t.Errorf("Update() history length = %d, want 1", len(again.History))
+	}
+
+	// A status message growing the previous one replaces it in the history.
+	grown := *again
+	grown.Status = a2a.TaskStatus{State: a2a.TaskStateWorking, Message: &a2a.Message{
+		Role:  a2a.RoleAgent,
+		Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "working on it, almost done"}},
+	}}
+	again, err = store.Update(ctx, &grown, again.Version)
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if len(again.History) != 1 || again.History[0].Text() != "working on it, almost done" {
+		t.Errorf("Update() history = %+v, want the grown status message only", again.History)
+	}
+
+	if _, err := store.Update(ctx, &next, created.Version); !errors.Is(err, server.ErrVersionConflict) {
+		t.Errorf("Update() stale error = %v, want %v", err, server.ErrVersionConflict)
+	}
+
+	// Returned tasks are copies.
+	again.Labels["team"] = "ads"
+	got, err := store.Get(ctx, "task-1")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got.Labels["team"] != "search" {
+		t.Errorf("stored label = %q, want %q", got.Labels["team"], "search")
+	}
+
+	if err := store.Delete(ctx, "task-1"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := store.Get(ctx, "task-1"); !errors.Is(err, server.ErrTaskNotFound) {
+		t.Errorf("Get() after Delete() error = %v, want %v", err, server.ErrTaskNotFound)
+	}
+	if err := store.Delete(ctx, "task-1"); !errors.Is(err, server.ErrTaskNotFound) {
+		t.Errorf("Delete() twice error = %v, want %v", err, server.ErrTaskNotFound)
+	}
+	if _, err := store.Update(ctx, &next, 2); !errors.Is(err, server.ErrTaskNotFound) {
+		t.Errorf("Update() after Delete() error = %v, want %v", err, server.ErrTaskNotFound)
+	}
+}
+
+func TestInMemoryTaskStore_List(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	store := server.NewInMemoryTaskStore()
+	for _, task := range []*a2a.Task{
+		{ID: "c", SessionID: "s1", Labels: map[string]string{"env": "prod", "team": "search"}},
+		{ID: "a", SessionID: "s1", Labels: map[string]string{"env": "prod"}},
+		{ID: "b", SessionID: "s2", Labels: map[string]string{"env": "dev"}},
+	} {
+		if _, err := store.Create(ctx, task); err != nil {
+			t.Fatalf("Create(%q) error = %v", task.ID, err)
+		}
+	}
+
+	tests := map[string]struct {
+		filter server.TaskFilter
+		want   []string
+	}{
+		"all": {
+			want: []string{"a", "b", "c"},
+		},
+		"session": {
+			filter: server.TaskFilter{SessionID: "s1"},
+			want:   []string{"a", "c"},
+		},
+		"label": {
+			filter: server.TaskFilter{Labels: map[string]string{"env": "prod"}},
+			want:   []string{"a", "c"},
+		},
+		"labels and session": {
+			filter: server.TaskFilter{SessionID: "s1", Labels: map[string]string{"env": "prod", "team": "search"}},
+			want:   []string{"c"},
+		},
+		"no match": {
+			filter: server.TaskFilter{SessionID: "s2", Labels: map[string]string{"env": "prod"}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tasks, err := store.List(ctx, tt.filter)
+			if err != nil {
+				t.Fatalf("List() error = %v", err)
+			}
+			var got []string
+			for _, task := range tasks {
+				got = append(got, task.ID)
+			}
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("List() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServer_TaskNotFound(t *testing.T) {
+	t.Parallel()
+
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, server.NewInMemoryTaskManager(),
+		server.WithTaskStore(server.NewInMemoryTaskStore())))
+	t.Cleanup(srv.Close)
+
+	tests := map[string]string{
+		"get":    a2a.MethodTasksGet,
+		"cancel": a2a.MethodTasksCancel,
+	}
+	for name, method := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			body := `{"jsonrpc":"2.0","id":1,"method":"` + method + `","params":{"id":"missing"}}`
+			resp, err := http.Post(srv.URL, "application/json", strings.NewReader(body))
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			data, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("read body: %v", err)
+			}
+			var got a2a.JSONRPCResponse
+			if err := sonic.ConfigFastest.Unmarshal(data, &got); err != nil {
+				t.Fatalf("Unmarshal(%q) error = %v", data, err)
+			}
+			if got.Error == nil || got.Error.Code != a2a.TaskNotFoundErrorCode {
+				t.Errorf("response error = %+v, want code %d", got.Error, a2a.TaskNotFoundErrorCode)
+			}
+		})
+	}
+}
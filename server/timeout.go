@@ -0,0 +1,276 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/go-a2a/a2a"
+)
+
+// TaskTimeoutCode is the [a2a.TaskError] code of the tasks failed by [WithTaskTimeout].
+const TaskTimeoutCode = a2a.TaskErrTimeout
+
+// errTaskExpired is returned for a task whose stream was ended because it timed out.
+var errTaskExpired = errors.New("task timed out")
+
+// StatusUpdater is implemented by task managers whose tasks the server can update, letting
+// [WithTaskTimeout] fail the tasks that time out, and [WithRecovery] the tasks whose handler
+// panicked. [InMemoryTaskManager] implements it.
+type StatusUpdater interface {
+	// UpdateTaskStatus sets the status of a task, returning an error if the task cannot move to it.
+	UpdateTaskStatus(ctx context.Context, taskID string, status a2a.TaskStatus, artifacts []a2a.Artifact) error
+}
+
+var _ StatusUpdater = (*InMemoryTaskManager)(nil)
+
+// taskTimers holds the timer of each task with a deadline.
+type taskTimers struct {
+	mu     sync.Mutex
+	timers map[string]*taskTimer
+}
+
+// start arms the timer of tt, expiring it at its deadline unless stopped before. It replaces
+// the timer of a previous send of the same task.
+func (t *taskTimers) start(ctx context.Context, tt *taskTimer) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if old := t.timers[tt.taskID]; old != nil {
+		old.timer.Stop()
+	}
+	if t.timers == nil {
+		t.timers = make(map[string]*taskTimer)
+	}
+	t.timers[tt.taskID] = tt
+	tt.timer = time.AfterFunc(time.Until(tt.deadline), func() {
+		t.stop(tt)
+		tt.expire(ctx)
+	})
+}
+
+// stop disarms the timer of tt, unless it was replaced.
+func (t *taskTimers) stop(tt *taskTimer) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.timers[tt.taskID] == tt {
+		delete(t.timers, tt.taskID)
+		tt.timer.Stop()
+	}
+}
+
+// stopTask disarms the timer of the task taskID, if any.
+func (t *taskTimers) stopTask(taskID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if tt := t.timers[taskID]; tt != nil {
+		delete(t.timers, taskID)
+		tt.timer.Stop()
+	}
+}
+
+// stopAll disarms every timer.
+func (t *taskTimers) stopAll() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for taskID, tt := range t.timers {
+		tt.timer.Stop()
+		delete(t.timers, taskID)
+	}
+}
+
+// taskTimer enforces the deadline of a task sent to the server.
+type taskTimer struct {
+	s        *Server
+	taskID   string
+	deadline time.Time
+	timer    *time.Timer
+
+	// sw is the stream of the task, ended once it expires, or nil for tasks/send.
+	sw *sseWriter
+
+	// once guards expiring the task, and expired is closed once it expired.
+	once    sync.Once
+	expired chan struct{}
+}
+
+// startTaskTimer returns the timer of the task sent with params, or nil if it has no deadline,
+// see [WithTaskTimeout]. It returns an error for an invalid deadline in the metadata.
+func (s *Server) startTaskTimer(ctx context.Context, params a2a.TaskSendParams, sw *sseWriter) (*taskTimer, error) {
+	deadline, ok, err := params.Deadline()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if s.taskTimeout <= 0 {
+			return nil, nil
+		}
+		deadline = time.Now().Add(s.taskTimeout)
+	}
+
+	tt := &taskTimer{
+		s:        s,
+		taskID:   params.ID,
+		deadline: deadline,
+		sw:       sw,
+		expired:  make(chan struct{}),
+	}
+	if sw != nil {
+		sw.expiry = deadline
+	}
+	// The task may outlive the request, as tasks/send often returns before the task is done.
+	s.taskTimers.start(context.WithoutCancel(ctx), tt)
+	return tt, nil
+}
+
+// expire fails the task with a timeout error, and ends its stream with the failed status. It
+// only runs once, and returns once the task expired.
+func (tt *taskTimer) expire(ctx context.Context) {
+	tt.once.Do(func() {
+		tt.fail(ctx)
+		close(tt.expired)
+	})
+}
+
+// fail fails the task with a timeout error, and ends its stream with the failed status.
+func (tt *taskTimer) fail(ctx context.Context) {
+	status := timeoutStatus("task not done by " + tt.deadline.UTC().Format(time.RFC3339))
+	// The stream notifies the failed status along with sending it.
+	tt.s.failTask(ctx, tt.taskID, status, tt.sw == nil)
+	if tt.sw != nil {
+		tt.sw.end(status)
+	}
+}
+
+// timeoutStatus returns the failed status of a task that timed out, with a [TaskTimeoutCode]
+// error saying why.
+func timeoutStatus(message string) a2a.TaskStatus {
+	return a2a.TaskStatus{
+		State: a2a.TaskStateFailed,
+		Message: &a2a.Message{
+			Role:  a2a.RoleAgent,
+			Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "task timed out"}},
+		},
+		Error:     a2a.NewTaskError(TaskTimeoutCode, message),
+		Timestamp: time.Now().UTC(),
+	}
+}
+
+// failTask moves the task taskID to status, failed with a timeout or internal error, if the
+// task manager is a [StatusUpdater], and records it in the journal of the task and posts it to
+// its push notification webhook if notify is set. Streamed tasks leave both to their stream.
+func (s *Server) failTask(ctx context.Context, taskID string, status a2a.TaskStatus, notify bool) {
+	updater, ok := s.taskManager.(StatusUpdater)
+	if !ok {
+		return
+	}
+	// A task that reached a terminal state in the meantime cannot move to failed.
+	if err := updater.UpdateTaskStatus(ctx, taskID, status, nil); err != nil {
+		s.logger.DebugContext(ctx, "task not failed", slog.String("task_id", taskID), slog.Any("error", err))
+		return
+	}
+	s.logger.InfoContext(ctx, "task failed", slog.String("task_id", taskID), slog.String("code", string(status.Error.Code)))
+	if !notify {
+		return
+	}
+	s.journalStatus(ctx, taskID, status)
+	if s.notifier != nil {
+		s.notifier.Notify(ctx, &a2a.TaskStatusUpdateEvent{ID: taskID, Status: status, Final: true})
+	}
+}
+
+// checkDeadline returns the error the handler of the task taskID failed with, wrapping
+// [context.DeadlineExceeded] if the handler gave up because ctx, the context it ran with,
+// expired. The task is then failed with a timeout error, as handlers giving up at their
+// deadline do not always fail their task, and the client is answered with
+// [a2a.DeadlineExceededErrorCode].
+func (s *Server) checkDeadline(ctx context.Context, taskID string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return err
+		}
+		err = fmt.Errorf("%w: %w", context.DeadlineExceeded, err)
+	}
+	s.failTask(context.WithoutCancel(ctx), taskID, timeoutStatus(err.Error()), true)
+	return err
+}
+
+// run runs the task, returning its error, or [errTaskExpired] as soon as the task expires, even if
+// run has not returned yet. The task runs with ctx, bounded by the deadline.
+func (tt *taskTimer) run(ctx context.Context, run func(ctx context.Context) error) error {
+	if tt == nil {
+		return run(ctx)
+	}
+	ctx, cancel := context.WithDeadline(ctx, tt.deadline)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		// A panic is carried over to the goroutine serving the request, which would otherwise
+		// not outlive it.
+		defer func() {
+			if v := recover(); v != nil {
+				done <- &handlerPanic{value: v, stack: debug.Stack()}
+			}
+		}()
+		done <- run(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		if p, ok := err.(*handlerPanic); ok {
+			panic(p)
+		}
+		if tt.overran(ctx) {
+			// The task gave up at its deadline, along with its context.
+			tt.expireNow(ctx)
+			return errTaskExpired
+		}
+		return err
+	case <-tt.expired:
+		return errTaskExpired
+	}
+}
+
+// overran reports whether ctx, bounded by the deadline of the task, expired.
+func (tt *taskTimer) overran(ctx context.Context) bool {
+	return tt != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) && !time.Now().Before(tt.deadline)
+}
+
+// expireNow expires the task at once, unless its timer already did.
+func (tt *taskTimer) expireNow(ctx context.Context) {
+	tt.s.taskTimers.stop(tt)
+	tt.expire(context.WithoutCancel(ctx))
+}
+
+// settle disarms the timer once the task is done, so that it does not outlive the task.
+func (tt *taskTimer) settle(task *a2a.Task) {
+	if tt != nil && task != nil && task.Status.State.IsTerminal() {
+		tt.s.taskTimers.stop(tt)
+	}
+}
+
+// end ends the stream with a final status, unless a final status was already sent, and closes it.
+func (sw *sseWriter) end(status a2a.TaskStatus) {
+	sw.mu.Lock()
+	ended := sw.ended || sw.closed
+	sw.mu.Unlock()
+
+	if !ended {
+		sw.SendStatus(status)
+	}
+	sw.Close()
+}
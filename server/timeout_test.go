@@ -0,0 +1,255 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+// slowTaskManager stores the tasks sent to it, then runs them with work.
+type slowTaskManager struct {
+	*server.InMemoryTaskManager
+
+	work func(ctx context.Context, tm *server.InMemoryTaskManager, taskID string) error
+}
+
+func (tm *slowTaskManager) OnSendTask(ctx context.Context, req *a2a.SendTaskRequest) (*a2a.SendTaskResponse, error) {
+	if _, err := tm.InMemoryTaskManager.OnSendTask(ctx, req); err != nil {
+		return nil, err
+	}
+	if err := tm.work(ctx, tm.InMemoryTaskManager, req.Params.ID); err != nil {
+		return nil, err
+	}
+	resp, err := tm.OnGetTask(ctx, &a2a.GetTaskRequest{Params: a2a.TaskQueryParams{TaskIDParams: req.Params.TaskIDParams}})
+	if err != nil {
+		return nil, err
+	}
+	return &a2a.SendTaskResponse{Result: resp.Result}, nil
+}
+
+func TestServer_TaskTimeout(t *testing.T) {
+	t.Parallel()
+
+	// hang ignores its context, as a stuck handler would.
+	hang := func(t *testing.T) func(ctx context.Context) {
+		release := make(chan struct{})
+		t.Cleanup(func() { close(release) })
+		return func(context.Context) { <-release }
+	}
+	complete := func(ctx context.Context, tm *server.InMemoryTaskManager, taskID string) error {
+		if err := tm.UpdateTaskStatus(ctx, taskID, a2a.TaskStatus{State: a2a.TaskStateWorking}, nil); err != nil {
+			return err
+		}
+		return tm.UpdateTaskStatus(ctx, taskID, a2a.TaskStatus{State: a2a.TaskStateCompleted}, nil)
+	}
+
+	tests := map[string]struct {
+		opts      []server.Option
+		deadline  time.Duration
+		work      func(t *testing.T) func(ctx context.Context, tm *server.InMemoryTaskManager, taskID string) error
+		wantState a2a.TaskState
+	}{
+		"stuck handler": {
+			opts: []server.Option{server.WithTaskTimeout(100 * time.Millisecond)},
+			work: func(t *testing.T) func(context.Context, *server.InMemoryTaskManager, string) error {
+				wait := hang(t)
+				return func(ctx context.Context, _ *server.InMemoryTaskManager, _ string) error {
+					wait(ctx)
+					return nil
+				}
+			},
+			wantState: a2a.TaskStateFailed,
+		},
+		"handler giving up at the deadline": {
+			opts: []server.Option{server.WithTaskTimeout(100 * time.Millisecond)},
+			work: func(*testing.T) func(context.Context, *server.InMemoryTaskManager, string) error {
+				return func(ctx context.Context, _ *server.InMemoryTaskManager, _ string) error {
+					<-ctx.Done()
+					return ctx.Err()
+				}
+			},
+			wantState: a2a.TaskStateFailed,
+		},
+		"deadline in metadata": {
+			deadline: 100 * time.Millisecond,
+			work: func(*testing.T) func(context.Context, *server.InMemoryTaskManager, string) error {
+				return func(ctx context.Context, _ *server.InMemoryTaskManager, _ string) error {
+					<-ctx.Done()
+					return ctx.Err()
+				}
+			},
+			wantState: a2a.TaskStateFailed,
+		},
+		"done in time": {
+			opts: []server.Option{server.WithTaskTimeout(100 * time.Millisecond)},
+			work: func(*testing.T) func(context.Context, *server.InMemoryTaskManager, string) error {
+				return complete
+			},
+			wantState: a2a.TaskStateCompleted,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tm := &slowTaskManager{InMemoryTaskManager: server.NewInMemoryTaskManager(), work: tt.work(t)}
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			srv := httptest.NewServer(server.NewServer("", "", card, tm, tt.opts...))
+			t.Cleanup(srv.Close)
+
+			post := func(body string) *a2a.Task {
+				t.Helper()
+
+				resp, err := http.Post(srv.URL, "application/json", strings.NewReader(body))
+				if err != nil {
+					t.Fatalf("Post() error = %v", err)
+				}
+				defer resp.Body.Close()
+				var rpcResp a2a.SendTaskResponse
+				if err := sonic.ConfigFastest.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+					t.Fatalf("decode response: %v", err)
+				}
+				if rpcResp.Error != nil {
+					t.Fatalf("response error = %v", rpcResp.Error)
+				}
+				return rpcResp.Result
+			}
+
+			var metadata string
+			if tt.deadline > 0 {
+				metadata = `,"metadata":{"deadline":"` + time.Now().Add(tt.deadline).Format(time.RFC3339Nano) + `"}`
+			}
+			start := time.Now()
+			task := post(`{"jsonrpc":"2.0","id":1,"method":"tasks/send","params":{"id":"task-1",` +
+				`"message":{"role":"user","parts":[{"type":"text","text":"hi"}]}` + metadata + `}}`)
+			if elapsed := time.Since(start); elapsed > 5*time.Second {
+				t.Errorf("tasks/send took %v, want it bounded by the timeout", elapsed)
+			}
+			checkTimedOut(t, task.Status, tt.wantState)
+
+			// The task stays as it was answered, past its deadline.
+			time.Sleep(200 * time.Millisecond)
+			task = post(`{"jsonrpc":"2.0","id":2,"method":"tasks/get","params":{"id":"task-1"}}`)
+			checkTimedOut(t, task.Status, tt.wantState)
+		})
+	}
+}
+
+func TestServer_HandlerDeadlineExceeded(t *testing.T) {
+	t.Parallel()
+
+	tm := &slowTaskManager{
+		InMemoryTaskManager: server.NewInMemoryTaskManager(),
+		// work gives up at a deadline of its own, as a handler calling a slow backend would.
+		work: func(ctx context.Context, _ *server.InMemoryTaskManager, _ string) error {
+			ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+			defer cancel()
+			<-ctx.Done()
+			return fmt.Errorf("call backend: %w", ctx.Err())
+		},
+	}
+	card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+	srv := httptest.NewServer(server.NewServer("", "", card, tm))
+	t.Cleanup(srv.Close)
+
+	resp, err := http.Post(srv.URL, "application/json", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"tasks/send",`+
+		`"params":{"id":"task-1","message":{"role":"user","parts":[{"type":"text","text":"hi"}]}}}`))
+	if err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	defer resp.Body.Close()
+	var rpcResp a2a.SendTaskResponse
+	if err := sonic.ConfigFastest.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if rpcResp.Error == nil || rpcResp.Error.Code != a2a.DeadlineExceededErrorCode {
+		t.Fatalf("response error = %v, want code %d", rpcResp.Error, a2a.DeadlineExceededErrorCode)
+	}
+	if !errors.Is(rpcResp.Error.AsError(), context.DeadlineExceeded) {
+		t.Errorf("response error = %v, want it to be context.DeadlineExceeded", rpcResp.Error)
+	}
+
+	got, err := tm.OnGetTask(t.Context(), &a2a.GetTaskRequest{Params: a2a.TaskQueryParams{TaskIDParams: a2a.TaskIDParams{ID: "task-1"}}})
+	if err != nil {
+		t.Fatalf("OnGetTask() error = %v", err)
+	}
+	if status := got.Result.Status; status.State != a2a.TaskStateFailed || status.Error == nil || status.Error.Code != server.TaskTimeoutCode {
+		t.Errorf("task status = %s with error %v, want %s with code %q", status.State, status.Error, a2a.TaskStateFailed, server.TaskTimeoutCode)
+	}
+}
+
+func TestServer_TaskTimeoutStream(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+	srv := newStreamingServer(t, func(ctx context.Context, w server.StreamWriter) error {
+		if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking}); err != nil {
+			return err
+		}
+		<-release
+		return nil
+	}, server.WithTaskTimeout(100*time.Millisecond))
+
+	resp := postSendSubscribe(t.Context(), t, srv.URL)
+	defer resp.Body.Close()
+
+	var events []a2a.TaskStatusUpdateEvent
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+		if !ok {
+			continue
+		}
+		var frame struct {
+			Result a2a.TaskStatusUpdateEvent `json:"result"`
+		}
+		if err := sonic.ConfigFastest.Unmarshal([]byte(data), &frame); err != nil {
+			t.Fatalf("Unmarshal(%q) error = %v", data, err)
+		}
+		events = append(events, frame.Result)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want 2", len(events))
+	}
+	if events[0].Status.Expiry == nil {
+		t.Error("working status has no expiry")
+	}
+	if !events[1].Final {
+		t.Error("timeout status is not final")
+	}
+	checkTimedOut(t, events[1].Status, a2a.TaskStateFailed)
+}
+
+// checkTimedOut checks that status is in state, with a timeout error if it failed.
+func checkTimedOut(t *testing.T, status a2a.TaskStatus, state a2a.TaskState) {
+	t.Helper()
+
+	if status.State != state {
+		t.Fatalf("state = %q, want %q", status.State, state)
+	}
+	if state != a2a.TaskStateFailed {
+		if status.Error != nil {
+			t.Errorf("error = %v, want nil", status.Error)
+		}
+		return
+	}
+	if status.Error == nil || status.Error.Code != server.TaskTimeoutCode {
+		t.Errorf("error = %v, want code %q", status.Error, server.TaskTimeoutCode)
+	}
+}
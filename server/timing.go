@@ -0,0 +1,154 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-a2a/a2a"
+)
+
+// Server-Timing metric names recorded by the [Server].
+const (
+	// TimingDecode is the time spent decoding the request.
+	TimingDecode = "decode"
+	// TimingHandler is the time spent in the task manager.
+	TimingHandler = "handler"
+	// TimingEncode is the time spent encoding the response.
+	TimingEncode = "encode"
+	// TimingStore is the time spent in task storage, recorded by [InMemoryTaskManager].
+	TimingStore = "store"
+)
+
+type serverTimingKey struct{}
+
+// serverTimings accumulates Server-Timing metrics for a single request.
+type serverTimings struct {
+	mu      sync.Mutex
+	names   []string
+	metrics map[string]time.Duration
+	start   time.Time
+}
+
+func newServerTimings() *serverTimings {
+	return &serverTimings{
+		metrics: make(map[string]time.Duration),
+		start:   time.Now(),
+	}
+}
+
+// add adds d to the metric name, keeping metrics in first-recorded order.
+func (t *serverTimings) add(name string, d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.metrics[name]; !ok {
+		t.names = append(t.names, name)
+	}
+	t.metrics[name] += d
+}
+
+// restart resets the reference point used to measure the handler.
+func (t *serverTimings) restart() {
+	t.mu.Lock()
+	t.start = time.Now()
+	t.mu.Unlock()
+}
+
+// sinceStart returns the time elapsed since the last restart.
+func (t *serverTimings) sinceStart() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return time.Since(t.start)
+}
+
+// header formats the metrics as a Server-Timing header value, with durations in milliseconds.
+func (t *serverTimings) header() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var sb strings.Builder
+	for i, name := range t.names {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(name)
+		sb.WriteString(";dur=")
+		sb.WriteString(strconv.FormatFloat(float64(t.metrics[name].Microseconds())/1000, 'f', -1, 64))
+	}
+	return sb.String()
+}
+
+// serverTimingsFromContext returns the timings recorder for the request, if Server-Timing is enabled.
+func serverTimingsFromContext(ctx context.Context) *serverTimings {
+	t, _ := ctx.Value(serverTimingKey{}).(*serverTimings)
+	return t
+}
+
+// RecordServerTiming adds d to the Server-Timing metric name for the current request.
+//
+// Task managers and stores can use it to report their own breakdown. [InMemoryTaskManager]
+// records its calls to its [TaskStore] as [TimingStore].
+// It is a no-op unless the server was created with [WithServerTiming].
+func RecordServerTiming(ctx context.Context, name string, d time.Duration) {
+	if t := serverTimingsFromContext(ctx); t != nil {
+		t.add(name, d)
+	}
+}
+
+// timedStore is a [TaskStore] adding the time spent in each call of store to the [TimingStore]
+// metric of the request, see [RecordServerTiming].
+type timedStore struct {
+	store TaskStore
+}
+
+var _ TaskStore = timedStore{}
+
+// Create implements [TaskStore].
+func (s timedStore) Create(ctx context.Context, task *a2a.Task) (*a2a.Task, error) {
+	defer recordStoreTiming(ctx, time.Now())
+	return s.store.Create(ctx, task)
+}
+
+// Get implements [TaskStore].
+func (s timedStore) Get(ctx context.Context, taskID string) (*a2a.Task, error) {
+	defer recordStoreTiming(ctx, time.Now())
+	return s.store.Get(ctx, taskID)
+}
+
+// Update implements [TaskStore].
+func (s timedStore) Update(ctx context.Context, task *a2a.Task, expectedVersion int) (*a2a.Task, error) {
+	defer recordStoreTiming(ctx, time.Now())
+	return s.store.Update(ctx, task, expectedVersion)
+}
+
+// List implements [TaskStore].
+func (s timedStore) List(ctx context.Context, filter TaskFilter) ([]*a2a.Task, error) {
+	defer recordStoreTiming(ctx, time.Now())
+	return s.store.List(ctx, filter)
+}
+
+// Delete implements [TaskStore].
+func (s timedStore) Delete(ctx context.Context, taskID string) error {
+	defer recordStoreTiming(ctx, time.Now())
+	return s.store.Delete(ctx, taskID)
+}
+
+// recordStoreTiming records the time since start as [TimingStore].
+func recordStoreTiming(ctx context.Context, start time.Time) {
+	RecordServerTiming(ctx, TimingStore, time.Since(start))
+}
+
+// setServerTimingHeader records the handler time and writes the Server-Timing header.
+func setServerTimingHeader(w http.ResponseWriter, t *serverTimings) {
+	if t == nil {
+		return
+	}
+	w.Header().Set("Server-Timing", t.header())
+}
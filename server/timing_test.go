@@ -0,0 +1,72 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+func TestWithServerTiming(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		opts      []server.Option
+		wantNames []string
+	}{
+		"enabled": {
+			opts:      []server.Option{server.WithServerTiming(true)},
+			wantNames: []string{server.TimingDecode, server.TimingStore, server.TimingHandler, server.TimingEncode},
+		},
+		"disabled": {},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tm := server.NewInMemoryTaskManager()
+			if _, err := tm.TaskStore().Create(t.Context(), &a2a.Task{ID: "task-1", Status: a2a.TaskStatus{State: a2a.TaskStateCompleted}}); err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			card := &a2a.AgentCard{Name: "test", URL: "http://example.com", Version: "1.0.0"}
+			srv := httptest.NewServer(server.NewServer("", "", card, tm, tt.opts...))
+			t.Cleanup(srv.Close)
+
+			resp, err := http.Post(srv.URL, "application/json",
+				strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"tasks/get","params":{"id":"task-1"}}`))
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+			resp.Body.Close()
+
+			// The header reads like `decode;dur=0.012, store;dur=0.003, ...`.
+			header := resp.Header.Get("Server-Timing")
+			var names []string
+			for metric := range strings.SplitSeq(header, ", ") {
+				if metric == "" {
+					continue
+				}
+				name, dur, ok := strings.Cut(metric, ";dur=")
+				if !ok {
+					t.Fatalf("Server-Timing metric %q has no duration", metric)
+				}
+				if ms, err := strconv.ParseFloat(dur, 64); err != nil || ms < 0 {
+					t.Errorf("Server-Timing metric %q duration is not a duration in milliseconds", metric)
+				}
+				names = append(names, name)
+			}
+			if diff := gocmp.Diff(tt.wantNames, names); diff != "" {
+				t.Errorf("Server-Timing %q metrics mismatch (-want +got):\n%s", header, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/go-a2a/a2a"
+)
+
+// spanPrefix prefixes the method name in the name of the span covering each JSON-RPC call,
+// such as "a2a.tasks/send".
+const spanPrefix = "a2a."
+
+type rpcSpanKey struct{}
+
+// startRPCSpan starts the span covering the JSON-RPC call req, named after its method, and
+// returns r with a context carrying it.
+//
+// The task and session IDs are read from the params without decoding them, since the
+// handler decodes and validates the params itself.
+func (s *Server) startRPCSpan(r *http.Request, req *a2a.JSONRPCRequest) (*http.Request, trace.Span) {
+	attrs := []attribute.KeyValue{
+		attribute.String("a2a.method", req.Method),
+		attribute.Stringer("a2a.request_id", req.ID),
+	}
+	if id := paramString(req.Params, "id"); id != "" {
+		attrs = append(attrs, attribute.String("a2a.task_id", id))
+	}
+	if id := paramString(req.Params, "sessionId"); id != "" {
+		attrs = append(attrs, attribute.String("a2a.session_id", id))
+	}
+
+	ctx, span := s.tracer.Start(r.Context(), spanPrefix+req.Method,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attrs...),
+	)
+	ctx = context.WithValue(ctx, rpcSpanKey{}, span)
+	return r.WithContext(ctx), span
+}
+
+// rpcSpan returns the span of the JSON-RPC call being served, or a no-op span outside of one.
+func rpcSpan(ctx context.Context) trace.Span {
+	if span, ok := ctx.Value(rpcSpanKey{}).(trace.Span); ok {
+		return span
+	}
+	return trace.SpanFromContext(context.Background())
+}
+
+// recordResult sets the state and session of a task returned by the call on its span.
+func recordResult(ctx context.Context, result any) {
+	task, ok := result.(*a2a.Task)
+	if !ok || task == nil {
+		return
+	}
+	span := rpcSpan(ctx)
+	span.SetAttributes(attribute.String("a2a.task_state", string(task.Status.State)))
+	if task.SessionID != "" {
+		span.SetAttributes(attribute.String("a2a.session_id", task.SessionID))
+	}
+}
+
+// recordRPCError records jerr, the error the call responds with, on its span and for the request log.
+func recordRPCError(ctx context.Context, jerr *a2a.JSONRPCError) {
+	span := rpcSpan(ctx)
+	span.RecordError(jerr)
+	span.SetAttributes(semconv.RPCJsonrpcErrorCode(jerr.Code))
+	span.SetStatus(codes.Error, jerr.Message)
+	recordOutcome(ctx, jerr)
+}
+
+// recordEvent adds a span event for a streamed task update to the span of the call.
+func recordEvent(ctx context.Context, event a2a.TaskEvent) {
+	span := rpcSpan(ctx)
+	switch event := event.(type) {
+	case *a2a.TaskStatusUpdateEvent:
+		span.AddEvent("a2a.status_update", trace.WithAttributes(
+			attribute.String("a2a.task_state", string(event.Status.State)),
+			attribute.Bool("a2a.final", event.Final),
+		))
+		if event.Final {
+			span.SetAttributes(attribute.String("a2a.task_state", string(event.Status.State)))
+		}
+	case *a2a.TaskArtifactUpdateEvent:
+		span.AddEvent("a2a.artifact_update", trace.WithAttributes(
+			attribute.Int("a2a.artifact_index", event.Artifact.Index),
+			attribute.Int("a2a.part_count", len(event.Artifact.Parts)),
+		))
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	gocmp "github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/embedded"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/go-a2a/a2a"
+	"github.com/go-a2a/a2a/server"
+)
+
+// recordingTracer keeps the spans it starts, so tests can inspect them.
+type recordingTracer struct {
+	embedded.Tracer
+
+	mu    sync.Mutex
+	spans []*recordingSpan
+}
+
+func (t *recordingTracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	cfg := trace.NewSpanStartConfig(opts...)
+	span := &recordingSpan{name: name, attrs: make(map[string]string)}
+	span.SetAttributes(cfg.Attributes()...)
+
+	t.mu.Lock()
+	t.spans = append(t.spans, span)
+	t.mu.Unlock()
+	return trace.ContextWithSpan(ctx, span), span
+}
+
+// span returns the span named name.
+func (t *recordingTracer) span(name string) *recordingSpan {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, span := range t.spans {
+		if span.name == name {
+			return span
+		}
+	}
+	return nil
+}
+
+type recordingSpan struct {
+	noop.Span
+
+	name string
+
+	mu     sync.Mutex
+	attrs  map[string]string
+	events []string
+	status codes.Code
+	errs   int
+}
+
+func (s *recordingSpan) SetAttributes(kv ...attribute.KeyValue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, attr := range kv {
+		s.attrs[string(attr.Key)] = attr.Value.Emit()
+	}
+}
+
+func (s *recordingSpan) AddEvent(name string, opts ...trace.EventOption) {
+	cfg := trace.NewEventConfig(opts...)
+	var attrs []string
+	for _, attr := range cfg.Attributes() {
+		attrs = append(attrs, string(attr.Key)+"="+attr.Value.Emit())
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, name+" "+strings.Join(attrs, " "))
+}
+
+func (s *recordingSpan) SetStatus(code codes.Code, _ string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = code
+}
+
+func (s *recordingSpan) RecordError(error, ...trace.EventOption) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errs++
+}
+
+// streamThreeUpdates streams a working update, an artifact and the final status.
+func streamThreeUpdates(ctx context.Context, w server.StreamWriter) error {
+	if err := w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateWorking}); err != nil {
+		return err
+	}
+	if err := w.SendArtifact(a2a.Artifact{Index: 0, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "done"}}}); err != nil {
+		return err
+	}
+	return w.SendStatus(a2a.TaskStatus{State: a2a.TaskStateCompleted})
+}
+
+func TestServer_RPCSpans(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body       string
+		span       string
+		wantAttrs  map[string]string
+		wantEvents []string
+		wantStatus codes.Code
+	}{
+		"send": {
+			body: `{"jsonrpc":"2.0","id":"req-1","method":"tasks/send","params":{"id":"task-1",` +
+				`"sessionId":"3f2504e0-4f89-11d3-9a0c-0305e82c3301","message":{"role":"user","parts":[{"type":"text","text":"hi"}]}}}`,
+			span: "a2a.tasks/send",
+			wantAttrs: map[string]string{
+				"a2a.method":     a2a.MethodTasksSend,
+				"a2a.request_id": "req-1",
+				"a2a.task_id":    "task-1",
+				"a2a.session_id": "3f2504e0-4f89-11d3-9a0c-0305e82c3301",
+				"a2a.task_state": string(a2a.TaskStateSubmitted),
+			},
+		},
+		"handler error": {
+			body: `{"jsonrpc":"2.0","id":"req-2","method":"tasks/get","params":{"id":"missing"}}`,
+			span: "a2a.tasks/get",
+			wantAttrs: map[string]string{
+				"a2a.method":             a2a.MethodTasksGet,
+				"a2a.request_id":         "req-2",
+				"a2a.task_id":            "missing",
+				"rpc.jsonrpc.error_code": "-32001",
+			},
+			wantStatus: codes.Error,
+		},
+		"stream": {
+			body: `{"jsonrpc":"2.0","id":"req-3","method":"tasks/sendSubscribe","params":{"id":"task-3",` +
+				`"message":{"role":"user","parts":[{"type":"text","text":"hi"}]}}}`,
+			span: "a2a.tasks/sendSubscribe",
+			wantAttrs: map[string]string{
+				"a2a.method":     a2a.MethodTasksSendSubscribe,
+				"a2a.request_id": "req-3",
+				"a2a.task_id":    "task-3",
+				"a2a.task_state": string(a2a.TaskStateCompleted),
+			},
+			wantEvents: []string{
+				"a2a.status_update a2a.task_state=working a2a.final=false",
+				"a2a.artifact_update a2a.artifact_index=0 a2a.part_count=1",
+				"a2a.status_update a2a.task_state=completed a2a.final=true",
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tracer := &recordingTracer{}
+			srv := newStreamingServer(t, streamThreeUpdates, server.WithTracer(tracer))
+
+			resp, err := http.Post(srv.URL, "application/json", strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("Post() error = %v", err)
+			}
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+
+			span := tracer.span(tt.span)
+			if span == nil {
+				t.Fatalf("no span named %q", tt.span)
+			}
+			span.mu.Lock()
+			defer span.mu.Unlock()
+
+			if diff := gocmp.Diff(tt.wantAttrs, span.attrs); diff != "" {
+				t.Errorf("span attributes mismatch (-want +got):\n%s", diff)
+			}
+			if diff := gocmp.Diff(tt.wantEvents, span.events); diff != "" {
+				t.Errorf("span events mismatch (-want +got):\n%s", diff)
+			}
+			if span.status != tt.wantStatus {
+				t.Errorf("span status = %v, want %v", span.status, tt.wantStatus)
+			}
+			if wantErrs := tt.wantStatus == codes.Error; (span.errs > 0) != wantErrs {
+				t.Errorf("span recorded %d errors, want errors: %t", span.errs, wantErrs)
+			}
+		})
+	}
+}
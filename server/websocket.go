@@ -0,0 +1,243 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	"golang.org/x/net/websocket"
+)
+
+// WebSocketPath is the path the WebSocket transport is served at, see [WithWebSocket].
+const WebSocketPath = "/ws"
+
+// webSocketPingInterval is how often a ping frame is sent on idle and busy WebSocket
+// connections alike, so that proxies keep them open and dead peers are noticed.
+const webSocketPingInterval = 30 * time.Second
+
+// webSocketHandler authenticates and rate limits a WebSocket upgrade request like any other
+// request to the A2A endpoint, then serves the connection.
+func (s *Server) webSocketHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, span := s.tracer.Start(r.Context(), "server.webSocketHandler")
+	defer span.End()
+
+	ctx, done, ok := s.inFlight.enter(ctx)
+	if !ok {
+		s.rejectShuttingDown(w, r)
+		return
+	}
+	defer done()
+	r = r.WithContext(ctx)
+
+	r, ok = s.authenticate(w, r)
+	if !ok {
+		return
+	}
+	if !s.allowRequest(w, r) {
+		return
+	}
+
+	websocket.Server{
+		Handler: func(ws *websocket.Conn) {
+			if err := s.serveWebSocket(r, ws); err != nil {
+				span.SetStatus(codes.Error, err.Error())
+				s.logger.InfoContext(ctx, "websocket closed", slog.Any("error", err))
+			}
+		},
+	}.ServeHTTP(w, r)
+}
+
+// serveWebSocket serves the JSON-RPC requests received on ws until the client closes it, the
+// connection fails or the server shuts down.
+//
+// Each text message carries a request or a batch, served concurrently with the others as if
+// it had been posted to the A2A endpoint. Responses are sent back as text messages in the order
+// they are ready, and a streaming method sends each of its events as a message of its own, so
+// the client tells them apart by their request ID. A request reusing the ID of a request still
+// in flight on the connection is rejected with the invalid request error.
+func (s *Server) serveWebSocket(r *http.Request, ws *websocket.Conn) error {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	// A request reusing the ID of one still in flight is rejected: their responses would mix.
+	ctx = withConnRequests(ctx)
+
+	if s.maxRequestBytes > 0 {
+		ws.MaxPayloadBytes = int(s.maxRequestBytes)
+	}
+
+	// Messages are sent with [websocket.Message], which sets their own payload type, so the
+	// payload type of the connection only applies to the pings written with ws.Write. All
+	// writes, including the pongs and the close frame, hold the write lock of the connection.
+	ws.PayloadType = websocket.PingFrame
+	send := func(data []byte) error {
+		return websocket.Message.Send(ws, string(data))
+	}
+	// closeConn sends the close frame and closes the connection, which also unblocks the read loop.
+	closeConn := sync.OnceValue(ws.Close)
+
+	var pinger sync.WaitGroup
+	pinger.Add(1)
+	go func() {
+		defer pinger.Done()
+		s.pingWebSocket(ctx, ws)
+		closeConn()
+	}()
+
+	var (
+		handlers sync.WaitGroup
+		err      error
+	)
+	for {
+		var body []byte
+		if err = websocket.Message.Receive(ws, &body); err != nil {
+			break
+		}
+
+		handlers.Add(1)
+		go func() {
+			defer handlers.Done()
+
+			rw := &wsResponseWriter{send: send}
+			req := r.Clone(ctx)
+			if isBatch(body) {
+				s.serveBatch(rw, req, body)
+			} else {
+				s.serveRequest(rw, req, body, false)
+			}
+			if err := rw.finish(); err != nil {
+				s.logger.DebugContext(ctx, "write websocket message", slog.Any("error", err))
+			}
+		}()
+	}
+
+	// Stop the handlers still running, such as streams, along with the keepalive.
+	cancel()
+	handlers.Wait()
+	pinger.Wait()
+
+	closeConn()
+	if errors.Is(err, io.EOF) || r.Context().Err() != nil {
+		return nil
+	}
+	return err
+}
+
+// pingWebSocket sends a ping frame on ws every [webSocketPingInterval] until ctx is done or a
+// ping fails. The client answers with a pong frame.
+func (s *Server) pingWebSocket(ctx context.Context, ws *websocket.Conn) {
+	ticker := time.NewTicker(webSocketPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if _, err := ws.Write(nil); err != nil {
+			s.logger.DebugContext(ctx, "websocket ping", slog.Any("error", err))
+			return
+		}
+	}
+}
+
+// wsResponseWriter is the [http.ResponseWriter] serving one request received as a message, on a
+// WebSocket or the stdio transport, see [Server.ServeStdio].
+//
+// A plain JSON-RPC response is sent as a single message once the request is served. The events
+// of a server-sent event stream are each sent as a message of their own, as they are flushed.
+type wsResponseWriter struct {
+	header http.Header
+	buf    bytes.Buffer
+	send   func(data []byte) error
+	err    error
+}
+
+var (
+	_ http.ResponseWriter = (*wsResponseWriter)(nil)
+	_ http.Flusher        = (*wsResponseWriter)(nil)
+)
+
+// Header implements [http.ResponseWriter].
+func (w *wsResponseWriter) Header() http.Header {
+	if w.header == nil {
+		w.header = make(http.Header)
+	}
+	return w.header
+}
+
+// Write implements [http.ResponseWriter].
+func (w *wsResponseWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	return w.buf.Write(p)
+}
+
+// WriteHeader implements [http.ResponseWriter]. The status code has no WebSocket equivalent.
+func (w *wsResponseWriter) WriteHeader(int) {}
+
+// Flush implements [http.Flusher], sending the complete server-sent events written so far.
+func (w *wsResponseWriter) Flush() {
+	if w.err != nil || !w.streaming() {
+		return
+	}
+	for {
+		event, rest, ok := bytes.Cut(w.buf.Bytes(), []byte("\n\n"))
+		if !ok {
+			return
+		}
+		data := eventData(event)
+		w.buf.Next(len(w.buf.Bytes()) - len(rest))
+		if len(data) == 0 {
+			continue
+		}
+		if err := w.send(data); err != nil {
+			w.err = err
+			return
+		}
+	}
+}
+
+// finish sends what is left of the response once the request is served.
+func (w *wsResponseWriter) finish() error {
+	if w.streaming() {
+		w.Flush()
+		return w.err
+	}
+	if w.err != nil {
+		return w.err
+	}
+	if data := bytes.TrimSpace(w.buf.Bytes()); len(data) > 0 {
+		return w.send(data)
+	}
+	return nil
+}
+
+// streaming reports whether the response is a server-sent event stream.
+func (w *wsResponseWriter) streaming() bool {
+	mediaType, _, _ := mime.ParseMediaType(w.Header().Get("Content-Type"))
+	return mediaType == "text/event-stream"
+}
+
+// eventData returns the data of a server-sent event, joining its data lines.
+func eventData(event []byte) []byte {
+	var data [][]byte
+	for line := range bytes.SplitSeq(event, []byte("\n")) {
+		if value, ok := bytes.CutPrefix(line, []byte("data:")); ok {
+			data = append(data, bytes.TrimPrefix(value, []byte(" ")))
+		}
+	}
+	return bytes.Join(data, []byte("\n"))
+}
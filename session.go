@@ -0,0 +1,52 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// MaxSessionIDLength is the maximum length of a session ID accepted by [NormalizeSessionID].
+const MaxSessionIDLength = 128
+
+// NormalizeSessionID validates a session ID and returns it in canonical form.
+//
+// Surrounding whitespace is removed and UUIDs are rewritten in their lower-case hyphenated
+// form, so the same session is always grouped under the same ID. Other IDs must be at most
+// [MaxSessionIDLength] bytes of ASCII letters, digits, '-', '_', '.' and ':'.
+func NormalizeSessionID(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "", errors.New("session ID cannot be empty")
+	}
+	if len(s) > MaxSessionIDLength {
+		return "", fmt.Errorf("session ID is %d bytes long, exceeds %d", len(s), MaxSessionIDLength)
+	}
+	if id, err := uuid.Parse(s); err == nil {
+		return id.String(), nil
+	}
+
+	for i := 0; i < len(s); i++ {
+		if !isSessionIDChar(s[i]) {
+			return "", fmt.Errorf("session ID contains invalid character %q at offset %d", s[i], i)
+		}
+	}
+	return s, nil
+}
+
+// isSessionIDChar reports whether c may appear in a session ID.
+func isSessionIDChar(c byte) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		return true
+	case c == '-', c == '_', c == '.', c == ':':
+		return true
+	default:
+		return false
+	}
+}
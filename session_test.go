@@ -0,0 +1,67 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestNormalizeSessionID(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		"uuid": {
+			in:   "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+			want: "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		},
+		"uuid upper case and braces": {
+			in:   " {6BA7B810-9DAD-11D1-80B4-00C04FD430C8} ",
+			want: "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		},
+		"opaque ID": {
+			in:   "chat:user-42_thread.7",
+			want: "chat:user-42_thread.7",
+		},
+		"trimmed": {
+			in:   "  session-1\n",
+			want: "session-1",
+		},
+		"empty": {
+			in:      "   ",
+			wantErr: true,
+		},
+		"too long": {
+			in:      strings.Repeat("a", a2a.MaxSessionIDLength+1),
+			wantErr: true,
+		},
+		"invalid character": {
+			in:      "session 1",
+			wantErr: true,
+		},
+		"non-ASCII": {
+			in:      "sessión",
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := a2a.NormalizeSessionID(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeSessionID(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeSessionID(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import "slices"
+
+// taskTransitions lists the states each non-terminal state may move to.
+var taskTransitions = map[TaskState][]TaskState{
+	TaskStateSubmitted:     {TaskStateWorking, TaskStateInputRequired, TaskStateCanceled, TaskStateFailed},
+	TaskStateWorking:       {TaskStateInputRequired, TaskStateCompleted, TaskStateFailed, TaskStateCanceled},
+	TaskStateInputRequired: {TaskStateWorking, TaskStateCanceled},
+}
+
+// IsTerminal reports whether s is a final state that a task can no longer leave.
+func (s TaskState) IsTerminal() bool {
+	switch s {
+	case TaskStateCompleted, TaskStateFailed, TaskStateCanceled:
+		return true
+	default:
+		return false
+	}
+}
+
+// CanTransitionTo reports whether a task in state s may move to next.
+//
+// Staying in the same non-terminal state is allowed, so agents can post progress
+// updates; terminal states and unknown states allow no transition at all.
+func (s TaskState) CanTransitionTo(next TaskState) bool {
+	allowed, ok := taskTransitions[s]
+	if !ok {
+		return false
+	}
+	return next == s || slices.Contains(allowed, next)
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"testing"
+
+	"github.com/go-a2a/a2a"
+)
+
+var allTaskStates = []a2a.TaskState{
+	a2a.TaskStateSubmitted,
+	a2a.TaskStateWorking,
+	a2a.TaskStateInputRequired,
+	a2a.TaskStateCompleted,
+	a2a.TaskStateFailed,
+	a2a.TaskStateCanceled,
+}
+
+func TestTaskState_CanTransitionTo(t *testing.T) {
+	t.Parallel()
+
+	// legal lists every allowed change of state; every other pair must be rejected.
+	legal := map[a2a.TaskState][]a2a.TaskState{
+		a2a.TaskStateSubmitted:     {a2a.TaskStateWorking, a2a.TaskStateInputRequired, a2a.TaskStateCanceled, a2a.TaskStateFailed},
+		a2a.TaskStateWorking:       {a2a.TaskStateInputRequired, a2a.TaskStateCompleted, a2a.TaskStateFailed, a2a.TaskStateCanceled},
+		a2a.TaskStateInputRequired: {a2a.TaskStateWorking, a2a.TaskStateCanceled},
+	}
+
+	for _, from := range allTaskStates {
+		for _, to := range allTaskStates {
+			want := from == to && !from.IsTerminal()
+			for _, state := range legal[from] {
+				want = want || state == to
+			}
+			t.Run(string(from)+"->"+string(to), func(t *testing.T) {
+				t.Parallel()
+
+				if got := from.CanTransitionTo(to); got != want {
+					t.Errorf("%q.CanTransitionTo(%q) = %v, want %v", from, to, got, want)
+				}
+			})
+		}
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		t.Parallel()
+
+		if a2a.TaskState("paused").CanTransitionTo(a2a.TaskStateWorking) {
+			t.Error("unknown state may transition, want none")
+		}
+		if a2a.TaskStateWorking.CanTransitionTo("paused") {
+			t.Error("transition to unknown state allowed, want rejected")
+		}
+	})
+}
+
+func TestTaskState_IsTerminal(t *testing.T) {
+	t.Parallel()
+
+	tests := map[a2a.TaskState]bool{
+		a2a.TaskStateSubmitted:     false,
+		a2a.TaskStateWorking:       false,
+		a2a.TaskStateInputRequired: false,
+		a2a.TaskStateCompleted:     true,
+		a2a.TaskStateFailed:        true,
+		a2a.TaskStateCanceled:      true,
+	}
+	for state, want := range tests {
+		t.Run(string(state), func(t *testing.T) {
+			t.Parallel()
+
+			if got := state.IsTerminal(); got != want {
+				t.Errorf("%q.IsTerminal() = %v, want %v", state, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DeadlineKey is the [TaskSendParams.Metadata] key of the time by which the task must be done,
+// as an RFC 3339 timestamp. Servers enforcing task timeouts fail the task once it passes.
+const DeadlineKey = "deadline"
+
+// CancelReasonKey is the [TaskIDParams.Metadata] key of the reason a tasks/cancel request gives
+// for canceling the task, as a string. See [TaskIDParams.CancelReason].
+const CancelReasonKey = "reason"
+
+// CancelReason returns the reason given under [CancelReasonKey] in the metadata of a
+// tasks/cancel request, or an empty string if there is none.
+func (p TaskIDParams) CancelReason() string {
+	reason, _ := p.Metadata[CancelReasonKey].(string)
+	return reason
+}
+
+// TaskErrorCode identifies the kind of failure of a task, see [TaskError.Code].
+//
+// Agents should use the standard codes below, which clients can branch on, but may use
+// codes of their own for failures none of them describes.
+type TaskErrorCode string
+
+// Standard task error codes.
+const (
+	// TaskErrTimeout is the code of a task that did not finish in time.
+	TaskErrTimeout TaskErrorCode = "timeout"
+
+	// TaskErrCanceled is the code of a task that was canceled before it finished.
+	TaskErrCanceled TaskErrorCode = "canceled"
+
+	// TaskErrHandlerError is the code of a task whose handler returned an error.
+	TaskErrHandlerError TaskErrorCode = "handler_error"
+
+	// TaskErrUnsupportedInput is the code of a task sent input the agent cannot process, such
+	// as a file of an unsupported MIME type.
+	TaskErrUnsupportedInput TaskErrorCode = "unsupported_input"
+
+	// TaskErrInternal is the code of a task that failed on an internal error of the agent,
+	// such as a panic of its handler.
+	TaskErrInternal TaskErrorCode = "internal"
+
+	// TaskErrRateLimited is the code of a task refused or stopped by a rate limit.
+	TaskErrRateLimited TaskErrorCode = "rate_limited"
+
+	// TaskErrUnavailable is the code of a task that failed because the agent, or a service it
+	// depends on, was temporarily unavailable.
+	TaskErrUnavailable TaskErrorCode = "unavailable"
+)
+
+// IsRetryable reports whether c is the code of a transient failure, after which sending the
+// task again may succeed: [TaskErrTimeout], [TaskErrRateLimited] and [TaskErrUnavailable].
+// Custom codes are not retryable.
+func (c TaskErrorCode) IsRetryable() bool {
+	switch c {
+	case TaskErrTimeout, TaskErrRateLimited, TaskErrUnavailable:
+		return true
+	default:
+		return false
+	}
+}
+
+// TaskError describes why a task failed, see [TaskStatus.Error].
+type TaskError struct {
+	// Code identifies the kind of failure, preferably one of the standard codes such as
+	// [TaskErrTimeout].
+	Code TaskErrorCode `json:"code"`
+
+	// Message describes the failure.
+	Message string `json:"message,omitempty"`
+}
+
+// NewTaskError returns a [TaskError] with the given code and message.
+func NewTaskError(code TaskErrorCode, msg string) *TaskError {
+	return &TaskError{Code: code, Message: msg}
+}
+
+// Error implements error.
+func (e *TaskError) Error() string {
+	if e.Message == "" {
+		return "task failed: " + string(e.Code)
+	}
+	return fmt.Sprintf("task failed: %s: %s", e.Code, e.Message)
+}
+
+type taskKey struct{}
+
+// ContextWithTask returns a copy of ctx carrying task, see [TaskFromContext].
+func ContextWithTask(ctx context.Context, task *Task) context.Context {
+	return context.WithValue(ctx, taskKey{}, task)
+}
+
+// TaskFromContext returns the task carried by ctx, if any. Servers set it to the task a call
+// operates on before invoking its handler, sparing the handler a lookup. The task is a
+// snapshot taken when the call started, which handlers must not modify.
+func TaskFromContext(ctx context.Context) (*Task, bool) {
+	task, ok := ctx.Value(taskKey{}).(*Task)
+	return task, ok && task != nil
+}
+
+// FileLocation identifies where a file part lives within a [Task].
+type FileLocation string
+
+const (
+	// FileLocationHistory indicates the file part belongs to a message in the task history.
+	FileLocationHistory FileLocation = "history"
+	// FileLocationArtifact indicates the file part belongs to a task artifact.
+	FileLocationArtifact FileLocation = "artifact"
+)
+
+// UnresolvedFile describes a file part that references its content by URI only.
+type UnresolvedFile struct {
+	// Location is where the part was found.
+	Location FileLocation
+
+	// Index is the index of the history message or artifact that holds the part.
+	Index int
+
+	// PartIndex is the index of the part within its message or artifact.
+	PartIndex int
+
+	// Part is the file part itself.
+	Part *FilePart
+}
+
+// IsPartial reports whether the task failed after producing some artifacts, so its
+// output is usable but incomplete. The failure reason is in the status message.
+func (t Task) IsPartial() bool {
+	return t.Status.State == TaskStateFailed && len(t.Artifacts) > 0
+}
+
+// Expired reports whether the status carries an [TaskStatus.Expiry] that is not after now,
+// while the task has not reached a terminal state.
+func (s TaskStatus) Expired(now time.Time) bool {
+	return s.Expiry != nil && !s.Expiry.After(now) && !s.State.IsTerminal()
+}
+
+// IsRetryable reports whether the status is that of a task that failed on a transient error,
+// so that sending it again may succeed, see [TaskErrorCode.IsRetryable].
+func (s TaskStatus) IsRetryable() bool {
+	return s.State == TaskStateFailed && s.Error != nil && s.Error.Code.IsRetryable()
+}
+
+// Deadline returns the time by which the task must be done, set under [DeadlineKey] in the
+// metadata as an RFC 3339 timestamp or a [time.Time]. It returns an error if the value is
+// neither.
+func (p TaskSendParams) Deadline() (time.Time, bool, error) {
+	switch v := p.Metadata[DeadlineKey].(type) {
+	case nil:
+		return time.Time{}, false, nil
+	case time.Time:
+		return v, true, nil
+	case string:
+		deadline, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid %s metadata: %w", DeadlineKey, err)
+		}
+		return deadline, true, nil
+	default:
+		return time.Time{}, false, fmt.Errorf("invalid %s metadata: %T is not a timestamp", DeadlineKey, v)
+	}
+}
+
+// Trim returns the view of task asked for by p, with the history limited to
+// [TaskQueryParams.HistoryLength] messages and the artifacts left out unless
+// [TaskQueryParams.IncludeArtifacts] allows them. The task itself is not modified.
+func (p TaskQueryParams) Trim(task *Task) *Task {
+	if task == nil {
+		return nil
+	}
+	view := *task
+	if n := p.HistoryLength; n != nil && *n < len(view.History) {
+		view.History = view.History[len(view.History)-max(*n, 0):]
+		if len(view.History) == 0 {
+			view.History = nil
+		}
+	}
+	if p.IncludeArtifacts != nil && !*p.IncludeArtifacts {
+		view.Artifacts = nil
+	}
+	return &view
+}
+
+// UnresolvedFiles returns every file part in the task history and artifacts that has a URI but no inline bytes.
+//
+// Callers can use it to decide which files need fetching before processing the task offline.
+func (t Task) UnresolvedFiles() []UnresolvedFile {
+	var files []UnresolvedFile
+
+	for i, msg := range t.History {
+		for j, part := range msg.Parts {
+			if fp, ok := unresolvedFilePart(part); ok {
+				files = append(files, UnresolvedFile{
+					Location:  FileLocationHistory,
+					Index:     i,
+					PartIndex: j,
+					Part:      fp,
+				})
+			}
+		}
+	}
+
+	for i, artifact := range t.Artifacts {
+		for j, part := range artifact.Parts {
+			if fp, ok := unresolvedFilePart(part); ok {
+				files = append(files, UnresolvedFile{
+					Location:  FileLocationArtifact,
+					Index:     i,
+					PartIndex: j,
+					Part:      fp,
+				})
+			}
+		}
+	}
+
+	return files
+}
+
+// unresolvedFilePart reports whether part is a file part referencing its content only by URI.
+func unresolvedFilePart(part Part) (*FilePart, bool) {
+	fp, ok := part.(*FilePart)
+	if !ok || fp == nil {
+		return nil, false
+	}
+	return fp, fp.File.URI != "" && fp.File.Bytes == ""
+}
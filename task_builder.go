@@ -0,0 +1,124 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"fmt"
+	"maps"
+	"time"
+)
+
+// TaskBuilder assembles a [Task] through chained calls, for handlers and tests.
+//
+// The zero value is not usable; create builders with [NewTaskBuilder].
+type TaskBuilder struct {
+	task      Task
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewTaskBuilder returns a builder for a task with the given ID, in the submitted state.
+func NewTaskBuilder(id string) *TaskBuilder {
+	return &TaskBuilder{
+		task: Task{
+			ID:     id,
+			Status: TaskStatus{State: TaskStateSubmitted},
+		},
+	}
+}
+
+// WithState sets the state of the task.
+func (b *TaskBuilder) WithState(state TaskState) *TaskBuilder {
+	b.task.Status.State = state
+	return b
+}
+
+// WithStatusMessage sets the message attached to the task status.
+func (b *TaskBuilder) WithStatusMessage(msg Message) *TaskBuilder {
+	b.task.Status.Message = &msg
+	return b
+}
+
+// WithArtifact appends an artifact to the task.
+func (b *TaskBuilder) WithArtifact(artifact Artifact) *TaskBuilder {
+	b.task.Artifacts = append(b.task.Artifacts, artifact)
+	return b
+}
+
+// WithHistoryMessage appends a message to the task history.
+func (b *TaskBuilder) WithHistoryMessage(msg Message) *TaskBuilder {
+	b.task.History = append(b.task.History, msg)
+	return b
+}
+
+// WithSession sets the session the task belongs to.
+func (b *TaskBuilder) WithSession(sessionID string) *TaskBuilder {
+	b.task.SessionID = sessionID
+	return b
+}
+
+// WithLabel sets a task label.
+func (b *TaskBuilder) WithLabel(key, value string) *TaskBuilder {
+	if b.task.Labels == nil {
+		b.task.Labels = make(map[string]string)
+	}
+	b.task.Labels[key] = value
+	return b
+}
+
+// WithMetadata sets a task metadata entry.
+func (b *TaskBuilder) WithMetadata(key string, value any) *TaskBuilder {
+	if b.task.Metadata == nil {
+		b.task.Metadata = make(map[string]any)
+	}
+	b.task.Metadata[key] = value
+	return b
+}
+
+// WithCreatedAt sets when the task was created, instead of the time [TaskBuilder.Build] is called.
+func (b *TaskBuilder) WithCreatedAt(t time.Time) *TaskBuilder {
+	b.createdAt = t
+	return b
+}
+
+// WithUpdatedAt sets when the task and its status were last updated, instead of the time
+// [TaskBuilder.Build] is called.
+func (b *TaskBuilder) WithUpdatedAt(t time.Time) *TaskBuilder {
+	b.updatedAt = t
+	return b
+}
+
+// Build returns the task, or an error if its state is not one of the known task states.
+//
+// Timestamps not set with [TaskBuilder.WithCreatedAt] or [TaskBuilder.WithUpdatedAt] are set
+// to the current time, and the status timestamp matches UpdatedAt. The returned task does
+// not share its lists and maps with the builder, which can be reused to build variants.
+func (b *TaskBuilder) Build() (Task, error) {
+	if state := b.task.Status.State; !state.IsTerminal() && taskTransitions[state] == nil {
+		return Task{}, fmt.Errorf("task %s: unknown state %q", b.task.ID, state)
+	}
+
+	now := time.Now()
+	task := b.task
+	task.CreatedAt = cmpOr(b.createdAt, now)
+	task.UpdatedAt = cmpOr(b.updatedAt, now)
+	task.Status.Timestamp = task.UpdatedAt
+	if msg := task.Status.Message; msg != nil {
+		clone := *msg
+		task.Status.Message = &clone
+	}
+	task.Artifacts = append([]Artifact(nil), task.Artifacts...)
+	task.History = append([]Message(nil), task.History...)
+	task.Labels = maps.Clone(task.Labels)
+	task.Metadata = maps.Clone(task.Metadata)
+	return task, nil
+}
+
+// cmpOr returns t, or fallback if t is zero.
+func cmpOr(t, fallback time.Time) time.Time {
+	if t.IsZero() {
+		return fallback
+	}
+	return t
+}
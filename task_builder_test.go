@@ -0,0 +1,106 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestTaskBuilder(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	updated := created.Add(time.Minute)
+	question := a2a.Message{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "weather?"}}}
+	answer := a2a.Artifact{Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "sunny"}}}
+
+	tests := map[string]struct {
+		build   func() *a2a.TaskBuilder
+		want    a2a.Task
+		wantErr string
+	}{
+		"full task": {
+			build: func() *a2a.TaskBuilder {
+				return a2a.NewTaskBuilder("task-1").
+					WithSession("session-1").
+					WithState(a2a.TaskStateCompleted).
+					WithHistoryMessage(question).
+					WithArtifact(answer).
+					WithLabel("team", "weather").
+					WithMetadata("source", "test").
+					WithCreatedAt(created).
+					WithUpdatedAt(updated)
+			},
+			want: a2a.Task{
+				ID:        "task-1",
+				SessionID: "session-1",
+				Status:    a2a.TaskStatus{State: a2a.TaskStateCompleted, Timestamp: updated},
+				Artifacts: []a2a.Artifact{answer},
+				History:   []a2a.Message{question},
+				Labels:    map[string]string{"team": "weather"},
+				Metadata:  map[string]any{"source": "test"},
+				CreatedAt: created,
+				UpdatedAt: updated,
+			},
+		},
+		"defaults": {
+			build: func() *a2a.TaskBuilder {
+				return a2a.NewTaskBuilder("task-1").WithCreatedAt(created).WithUpdatedAt(created)
+			},
+			want: a2a.Task{
+				ID:        "task-1",
+				Status:    a2a.TaskStatus{State: a2a.TaskStateSubmitted, Timestamp: created},
+				CreatedAt: created,
+				UpdatedAt: created,
+			},
+		},
+		"unknown state": {
+			build: func() *a2a.TaskBuilder {
+				return a2a.NewTaskBuilder("task-1").WithState("paused")
+			},
+			wantErr: `unknown state "paused"`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.build().Build()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Build() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Build() error = %v", err)
+			}
+			if diff := gocmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Build() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTaskBuilder_Now(t *testing.T) {
+	t.Parallel()
+
+	before := time.Now()
+	task, err := a2a.NewTaskBuilder("task-1").WithState(a2a.TaskStateWorking).Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	if task.CreatedAt.Before(before) || task.UpdatedAt.Before(before) {
+		t.Errorf("timestamps %v, %v predate the call at %v", task.CreatedAt, task.UpdatedAt, before)
+	}
+	if !task.Status.Timestamp.Equal(task.UpdatedAt) {
+		t.Errorf("Status.Timestamp = %v, want UpdatedAt %v", task.Status.Timestamp, task.UpdatedAt)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+)
+
+// ErrTaskEncoding is returned by [Task.UnmarshalBinary] for data that is not an encoded task,
+// or is encoded with a version it does not know.
+var ErrTaskEncoding = errors.New("unsupported task encoding")
+
+// taskEncodingV1 is the version byte of tasks encoded as their JSON.
+const taskEncodingV1 byte = 1
+
+var (
+	_ encoding.BinaryMarshaler   = Task{}
+	_ encoding.BinaryUnmarshaler = (*Task)(nil)
+)
+
+// MarshalBinary implements [encoding.BinaryMarshaler], for task stores keeping tasks as bytes,
+// such as Redis or a database column.
+//
+// The encoding is a version byte followed by the JSON of the task, with object keys sorted so
+// that the same task always encodes to the same bytes. The metadata and data of the task are
+// kept as the JSON they encode to, so a task decoded from JSON, as servers receive them,
+// survives a round trip through [Task.UnmarshalBinary] unchanged.
+func (t Task) MarshalBinary() ([]byte, error) {
+	// encoding/json sorts map keys, which keeps the encoding stable.
+	data, err := StdCodec{}.Marshal(t)
+	if err != nil {
+		return nil, fmt.Errorf("marshal task: %w", err)
+	}
+	return append([]byte{taskEncodingV1}, data...), nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler], decoding a task encoded by
+// [Task.MarshalBinary]. It returns an error wrapping [ErrTaskEncoding] for data of an
+// unknown encoding version.
+func (t *Task) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("%w: no data", ErrTaskEncoding)
+	}
+	switch version := data[0]; version {
+	case taskEncodingV1:
+		var task Task
+		if err := DefaultCodec.Unmarshal(data[1:], &task); err != nil {
+			return fmt.Errorf("unmarshal task: %w", err)
+		}
+		*t = task
+		return nil
+	default:
+		return fmt.Errorf("%w: version %d", ErrTaskEncoding, version)
+	}
+}
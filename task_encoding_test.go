@@ -0,0 +1,114 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestTask_MarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := map[string]a2a.Task{
+		"empty": {ID: "task-1"},
+		"full": {
+			ID:        "task-2",
+			SessionID: "session-1",
+			Status: a2a.TaskStatus{
+				State:     a2a.TaskStateCanceled,
+				Reason:    "user aborted",
+				Timestamp: now,
+				Message: &a2a.Message{
+					Role:     a2a.RoleAgent,
+					Parts:    []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "stopped"}},
+					Metadata: map[string]any{"step": 3.0},
+				},
+			},
+			Artifacts: []a2a.Artifact{{
+				Name:  "report",
+				Index: 1,
+				Parts: []a2a.Part{
+					&a2a.DataPart{Type: a2a.PartTypeData, Data: map[string]any{"total": 42.5, "items": []any{"a", true, nil}}},
+					&a2a.FilePart{Type: a2a.PartTypeFile, File: a2a.FileContent{Name: "r.txt", MIMEType: "text/plain", Bytes: "aGk="}},
+				},
+				Metadata: map[string]any{a2a.ArtifactOutputModeKey: "application/json"},
+			}},
+			History: []a2a.Message{{
+				Role:  a2a.RoleUser,
+				Parts: []a2a.Part{&a2a.TextPart{Type: a2a.PartTypeText, Text: "hi", Metadata: map[string]any{"lang": "en"}}},
+			}},
+			Labels:    map[string]string{"team": "search"},
+			Version:   4,
+			CreatedAt: now,
+			UpdatedAt: now.Add(time.Minute),
+			Metadata: map[string]any{
+				"nested": map[string]any{"list": []any{1.0, "two", map[string]any{"three": 3.0}}},
+				"flag":   false,
+			},
+		},
+	}
+	for name, task := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := task.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary() error = %v", err)
+			}
+			again, err := task.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary() error = %v", err)
+			}
+			if !bytes.Equal(data, again) {
+				t.Errorf("MarshalBinary() is not stable:\n%s\n%s", data, again)
+			}
+
+			var got a2a.Task
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("UnmarshalBinary() error = %v", err)
+			}
+			if diff := gocmp.Diff(task, got); diff != "" {
+				t.Errorf("round trip mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTask_UnmarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		data    []byte
+		wantErr error
+	}{
+		"empty":           {data: nil, wantErr: a2a.ErrTaskEncoding},
+		"unknown version": {data: append([]byte{99}, `{"id":"task-1"}`...), wantErr: a2a.ErrTaskEncoding},
+		"invalid JSON":    {data: append([]byte{1}, `{"id":`...)},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			task := a2a.Task{ID: "unchanged"}
+			err := task.UnmarshalBinary(tt.data)
+			if err == nil {
+				t.Fatal("UnmarshalBinary() error = nil, want an error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("UnmarshalBinary() error = %v, want %v", err, tt.wantErr)
+			}
+			if task.ID != "unchanged" {
+				t.Errorf("UnmarshalBinary() changed the task to %+v on error", task)
+			}
+		})
+	}
+}
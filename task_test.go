@@ -0,0 +1,333 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gocmp "github.com/google/go-cmp/cmp"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestTask_UnresolvedFiles(t *testing.T) {
+	t.Parallel()
+
+	remote := &a2a.FilePart{File: a2a.FileContent{Name: "remote.pdf", URI: "https://example.com/remote.pdf"}}
+	inline := &a2a.FilePart{File: a2a.FileContent{Name: "inline.txt", Bytes: "ZGF0YQ=="}}
+	output := &a2a.FilePart{File: a2a.FileContent{Name: "output.png", URI: "https://example.com/output.png"}}
+
+	task := a2a.Task{
+		ID: "task-1",
+		History: []a2a.Message{
+			{
+				Role:  a2a.RoleUser,
+				Parts: []a2a.Part{&a2a.TextPart{Text: "summarize"}, remote, inline},
+			},
+		},
+		Artifacts: []a2a.Artifact{
+			{Parts: []a2a.Part{&a2a.TextPart{Text: "summary"}}},
+			{Parts: []a2a.Part{output}},
+		},
+	}
+
+	want := []a2a.UnresolvedFile{
+		{Location: a2a.FileLocationHistory, Index: 0, PartIndex: 1, Part: remote},
+		{Location: a2a.FileLocationArtifact, Index: 1, PartIndex: 0, Part: output},
+	}
+	if diff := gocmp.Diff(want, task.UnresolvedFiles()); diff != "" {
+		t.Errorf("Task.UnresolvedFiles() mismatch (-want +got):\n%s", diff)
+	}
+
+	if got := (a2a.Task{}).UnresolvedFiles(); got != nil {
+		t.Errorf("Task.UnresolvedFiles() = %v, want nil", got)
+	}
+}
+
+func TestTask_IsPartial(t *testing.T) {
+	t.Parallel()
+
+	artifacts := []a2a.Artifact{{Parts: []a2a.Part{&a2a.TextPart{Text: "step 1"}}}}
+
+	tests := map[string]struct {
+		task a2a.Task
+		want bool
+	}{
+		"failed with artifacts": {
+			task: a2a.Task{Status: a2a.TaskStatus{State: a2a.TaskStateFailed}, Artifacts: artifacts},
+			want: true,
+		},
+		"failed without artifacts": {
+			task: a2a.Task{Status: a2a.TaskStatus{State: a2a.TaskStateFailed}},
+			want: false,
+		},
+		"completed": {
+			task: a2a.Task{Status: a2a.TaskStatus{State: a2a.TaskStateCompleted}, Artifacts: artifacts},
+			want: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.task.IsPartial(); got != tt.want {
+				t.Errorf("Task.IsPartial() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTaskStatus_Expired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	past, future := now.Add(-time.Minute), now.Add(time.Minute)
+
+	tests := map[string]struct {
+		status a2a.TaskStatus
+		want   bool
+	}{
+		"no expiry": {
+			status: a2a.TaskStatus{State: a2a.TaskStateWorking},
+		},
+		"before expiry": {
+			status: a2a.TaskStatus{State: a2a.TaskStateWorking, Expiry: &future},
+		},
+		"past expiry": {
+			status: a2a.TaskStatus{State: a2a.TaskStateWorking, Expiry: &past},
+			want:   true,
+		},
+		"terminal past expiry": {
+			status: a2a.TaskStatus{State: a2a.TaskStateCompleted, Expiry: &past},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.status.Expired(now); got != tt.want {
+				t.Errorf("Expired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTaskStatus_IsRetryable(t *testing.T) {
+	t.Parallel()
+
+	failed := func(code a2a.TaskErrorCode) a2a.TaskStatus {
+		return a2a.TaskStatus{State: a2a.TaskStateFailed, Error: a2a.NewTaskError(code, "failed")}
+	}
+
+	tests := map[string]struct {
+		status a2a.TaskStatus
+		want   bool
+	}{
+		"timeout": {
+			status: failed(a2a.TaskErrTimeout),
+			want:   true,
+		},
+		"rate limited": {
+			status: failed(a2a.TaskErrRateLimited),
+			want:   true,
+		},
+		"unavailable": {
+			status: failed(a2a.TaskErrUnavailable),
+			want:   true,
+		},
+		"canceled": {
+			status: failed(a2a.TaskErrCanceled),
+		},
+		"handler error": {
+			status: failed(a2a.TaskErrHandlerError),
+		},
+		"unsupported input": {
+			status: failed(a2a.TaskErrUnsupportedInput),
+		},
+		"internal": {
+			status: failed(a2a.TaskErrInternal),
+		},
+		"custom code": {
+			status: failed("quota_exceeded"),
+		},
+		"failed without error": {
+			status: a2a.TaskStatus{State: a2a.TaskStateFailed},
+		},
+		"not failed": {
+			status: a2a.TaskStatus{State: a2a.TaskStateWorking, Error: a2a.NewTaskError(a2a.TaskErrTimeout, "")},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.status.IsRetryable(); got != tt.want {
+				t.Errorf("IsRetryable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTaskSendParams_Deadline(t *testing.T) {
+	t.Parallel()
+
+	deadline := time.Date(2025, 4, 1, 12, 30, 0, 500, time.UTC)
+
+	tests := map[string]struct {
+		metadata map[string]any
+		want     time.Time
+		wantOK   bool
+		wantErr  bool
+	}{
+		"no metadata": {},
+		"timestamp": {
+			metadata: map[string]any{a2a.DeadlineKey: deadline.Format(time.RFC3339Nano)},
+			want:     deadline,
+			wantOK:   true,
+		},
+		"time": {
+			metadata: map[string]any{a2a.DeadlineKey: deadline},
+			want:     deadline,
+			wantOK:   true,
+		},
+		"invalid timestamp": {
+			metadata: map[string]any{a2a.DeadlineKey: "tomorrow"},
+			wantErr:  true,
+		},
+		"not a timestamp": {
+			metadata: map[string]any{a2a.DeadlineKey: 60},
+			wantErr:  true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok, err := a2a.TaskSendParams{Metadata: tt.metadata}.Deadline()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Deadline() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if ok != tt.wantOK || !got.Equal(tt.want) {
+				t.Errorf("Deadline() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestTaskIDParams_CancelReason(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		metadata map[string]any
+		want     string
+	}{
+		"reason":       {metadata: map[string]any{a2a.CancelReasonKey: "user aborted"}, want: "user aborted"},
+		"no metadata":  {},
+		"no reason":    {metadata: map[string]any{"other": "value"}},
+		"not a string": {metadata: map[string]any{a2a.CancelReasonKey: 42}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			params := a2a.TaskIDParams{ID: "task-1", Metadata: tt.metadata}
+			if got := params.CancelReason(); got != tt.want {
+				t.Errorf("CancelReason() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTaskFromContext(t *testing.T) {
+	t.Parallel()
+
+	task := &a2a.Task{ID: "task-1", Status: a2a.TaskStatus{State: a2a.TaskStateWorking}}
+
+	tests := map[string]struct {
+		ctx    context.Context
+		want   *a2a.Task
+		wantOK bool
+	}{
+		"no task": {
+			ctx: context.Background(),
+		},
+		"task": {
+			ctx:    a2a.ContextWithTask(context.Background(), task),
+			want:   task,
+			wantOK: true,
+		},
+		"nil task": {
+			ctx: a2a.ContextWithTask(context.Background(), nil),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := a2a.TaskFromContext(tt.ctx)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("TaskFromContext() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestTaskQueryParams_Trim(t *testing.T) {
+	t.Parallel()
+
+	history := []a2a.Message{
+		{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Text: "one"}}},
+		{Role: a2a.RoleAgent, Parts: []a2a.Part{&a2a.TextPart{Text: "two"}}},
+		{Role: a2a.RoleUser, Parts: []a2a.Part{&a2a.TextPart{Text: "three"}}},
+	}
+	artifacts := []a2a.Artifact{{Parts: []a2a.Part{&a2a.TextPart{Text: "out"}}}}
+	task := &a2a.Task{ID: "task-1", History: history, Artifacts: artifacts}
+
+	tests := map[string]struct {
+		params a2a.TaskQueryParams
+		want   *a2a.Task
+	}{
+		"whole task": {
+			want: task,
+		},
+		"last messages": {
+			params: a2a.TaskQueryParams{HistoryLength: ptr(2)},
+			want:   &a2a.Task{ID: "task-1", History: history[1:], Artifacts: artifacts},
+		},
+		"no history": {
+			params: a2a.TaskQueryParams{HistoryLength: ptr(0)},
+			want:   &a2a.Task{ID: "task-1", Artifacts: artifacts},
+		},
+		"history within limit": {
+			params: a2a.TaskQueryParams{HistoryLength: ptr(5)},
+			want:   task,
+		},
+		"without artifacts": {
+			params: a2a.TaskQueryParams{IncludeArtifacts: ptr(false)},
+			want:   &a2a.Task{ID: "task-1", History: history},
+		},
+		"with artifacts": {
+			params: a2a.TaskQueryParams{IncludeArtifacts: ptr(true)},
+			want:   task,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := gocmp.Diff(tt.want, tt.params.Trim(task)); diff != "" {
+				t.Errorf("TaskQueryParams.Trim() mismatch (-want +got):\n%s", diff)
+			}
+			if len(task.History) != 3 || len(task.Artifacts) != 1 {
+				t.Error("TaskQueryParams.Trim() modified the task")
+			}
+		})
+	}
+
+	if got := (a2a.TaskQueryParams{}).Trim(nil); got != nil {
+		t.Errorf("TaskQueryParams.Trim(nil) = %v, want nil", got)
+	}
+}
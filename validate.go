@@ -0,0 +1,242 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// DefaultMaxDataDepth is the default maximum nesting depth allowed for the structured data of a [DataPart].
+const DefaultMaxDataDepth = 64
+
+// ValidateDataDepth walks data iteratively and reports an error if any value is nested deeper than maxDepth.
+//
+// Each map, slice, array or struct level counts as one level of depth, so a flat map has a depth of 1.
+// Because the walk is bounded by maxDepth, cyclic structures are rejected instead of looping forever.
+// A non-positive maxDepth disables the check.
+func ValidateDataDepth(data any, maxDepth int) error {
+	if maxDepth <= 0 || data == nil {
+		return nil
+	}
+
+	type frame struct {
+		v     reflect.Value
+		depth int
+	}
+	stack := []frame{{v: reflect.ValueOf(data), depth: 0}}
+
+	for len(stack) > 0 {
+		f := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		v := f.v
+		for v.IsValid() && (v.Kind() == reflect.Interface || v.Kind() == reflect.Pointer) {
+			if v.IsNil() {
+				break
+			}
+			v = v.Elem()
+		}
+		if !v.IsValid() {
+			continue
+		}
+
+		switch v.Kind() {
+		case reflect.Map, reflect.Slice, reflect.Array, reflect.Struct:
+		default:
+			continue
+		}
+
+		depth := f.depth + 1
+		if depth > maxDepth {
+			return fmt.Errorf("data exceeds maximum nesting depth of %d", maxDepth)
+		}
+
+		switch v.Kind() {
+		case reflect.Map:
+			iter := v.MapRange()
+			for iter.Next() {
+				stack = append(stack, frame{v: iter.Value(), depth: depth})
+			}
+		case reflect.Slice, reflect.Array:
+			for i := range v.Len() {
+				stack = append(stack, frame{v: v.Index(i), depth: depth})
+			}
+		case reflect.Struct:
+			for i := range v.NumField() {
+				if !v.Type().Field(i).IsExported() {
+					continue
+				}
+				stack = append(stack, frame{v: v.Field(i), depth: depth})
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateMessageDataDepth applies [ValidateDataDepth] to every [DataPart] in the message.
+func ValidateMessageDataDepth(msg Message, maxDepth int) error {
+	for i, part := range msg.Parts {
+		dp, ok := part.(*DataPart)
+		if !ok || dp == nil {
+			continue
+		}
+		if err := ValidateDataDepth(dp.Data, maxDepth); err != nil {
+			return fmt.Errorf("part %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// ErrFileTooLarge is returned by [ValidateMessageFileSize] for a file part whose content exceeds the limit.
+var ErrFileTooLarge = errors.New("file too large")
+
+// ValidateMessageFileSize reports an error wrapping [ErrFileTooLarge] if the decoded content of
+// any [FilePart] in the message is larger than maxBytes.
+//
+// The size is computed from the length of the base64 content, without decoding it.
+// A non-positive maxBytes disables the check.
+func ValidateMessageFileSize(msg Message, maxBytes int64) error {
+	if maxBytes <= 0 {
+		return nil
+	}
+	for i, part := range msg.Parts {
+		fp, ok := part.(*FilePart)
+		if !ok || fp == nil {
+			continue
+		}
+		if size := int64(base64.StdEncoding.DecodedLen(len(fp.File.Bytes))); size > maxBytes {
+			return fmt.Errorf("part %d: %w: about %d bytes, limit is %d", i, ErrFileTooLarge, size, maxBytes)
+		}
+	}
+	return nil
+}
+
+// ErrMessageTooLarge is returned by [ValidateMessageSize] for a message with too many parts or
+// too much content.
+var ErrMessageTooLarge = errors.New("message too large")
+
+// ValidateMessageSize reports an error wrapping [ErrMessageTooLarge] if the message has more
+// than maxParts parts, or more than maxBytes bytes of content: the length of its texts, the
+// decoded size of its inline files and the JSON-encoded size of its data.
+//
+// A non-positive limit disables its check.
+func ValidateMessageSize(msg Message, maxParts int, maxBytes int64) error {
+	if maxParts > 0 && len(msg.Parts) > maxParts {
+		return fmt.Errorf("%w: %d parts, limit is %d", ErrMessageTooLarge, len(msg.Parts), maxParts)
+	}
+	if maxBytes <= 0 {
+		return nil
+	}
+
+	var total int64
+	for i, part := range msg.Parts {
+		switch p := part.(type) {
+		case *TextPart:
+			if p != nil {
+				total += int64(len(p.Text))
+			}
+		case *FilePart:
+			if p != nil {
+				total += int64(base64.StdEncoding.DecodedLen(len(p.File.Bytes)))
+			}
+		case *DataPart:
+			if p != nil {
+				data, err := DefaultCodec.Marshal(p.Data)
+				if err != nil {
+					return fmt.Errorf("part %d: encode data: %w", i, err)
+				}
+				total += int64(len(data))
+			}
+		}
+		if total > maxBytes {
+			return fmt.Errorf("%w: more than %d bytes of content by part %d, limit is %d", ErrMessageTooLarge, total, i, maxBytes)
+		}
+	}
+	return nil
+}
+
+// Validate reports an error if the part type does not match the part.
+func (p *TextPart) Validate() error {
+	return checkPartType(p.Type, PartTypeText)
+}
+
+// Validate reports an error if the part type does not match the part or the file has
+// neither or both of its bytes and URI set.
+func (p *FilePart) Validate() error {
+	if err := checkPartType(p.Type, PartTypeFile); err != nil {
+		return err
+	}
+	if err := p.File.CheckContent(); err != nil {
+		return fmt.Errorf("file part: field %q: %w", "file", err)
+	}
+	return nil
+}
+
+// Validate reports an error if the part type does not match the part or the data is nil.
+func (p *DataPart) Validate() error {
+	if err := checkPartType(p.Type, PartTypeData); err != nil {
+		return err
+	}
+	if p.Data == nil {
+		return fmt.Errorf("data part: field %q: must not be nil", "data")
+	}
+	return nil
+}
+
+// ValidatePart checks the invariants of a [TextPart], [FilePart] or [DataPart].
+//
+// Parts of other types are accepted as long as they are not nil.
+func ValidatePart(p Part) error {
+	switch p := p.(type) {
+	case nil:
+		return errors.New("part must not be nil")
+	case *TextPart:
+		if p == nil {
+			return errors.New("text part must not be nil")
+		}
+		return p.Validate()
+	case *FilePart:
+		if p == nil {
+			return errors.New("file part must not be nil")
+		}
+		return p.Validate()
+	case *DataPart:
+		if p == nil {
+			return errors.New("data part must not be nil")
+		}
+		return p.Validate()
+	default:
+		return nil
+	}
+}
+
+// Validate reports an error if the message role is unknown or any of its parts is invalid.
+//
+// Part errors are prefixed with the index of the offending part.
+func (m Message) Validate() error {
+	switch m.Role {
+	case RoleUser, RoleAgent:
+	default:
+		return fmt.Errorf("message: field %q: unknown role %q", "role", m.Role)
+	}
+
+	for i, part := range m.Parts {
+		if err := ValidatePart(part); err != nil {
+			return fmt.Errorf("part %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// checkPartType reports an error if typ is set and differs from want.
+func checkPartType(typ, want PartType) error {
+	if typ != "" && typ != want {
+		return fmt.Errorf("%s part: field %q: got %q", want, "type", typ)
+	}
+	return nil
+}
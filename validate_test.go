@@ -0,0 +1,280 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-a2a/a2a"
+)
+
+func nestedData(depth int) map[string]any {
+	root := map[string]any{}
+	cur := root
+	for range depth - 1 {
+		next := map[string]any{}
+		cur["child"] = next
+		cur = next
+	}
+	return root
+}
+
+func TestValidateDataDepth(t *testing.T) {
+	t.Parallel()
+
+	cyclic := map[string]any{}
+	cyclic["self"] = cyclic
+
+	tests := map[string]struct {
+		data     any
+		maxDepth int
+		wantErr  bool
+	}{
+		"nil": {
+			data:     nil,
+			maxDepth: 1,
+			wantErr:  false,
+		},
+		"scalar": {
+			data:     "value",
+			maxDepth: 1,
+			wantErr:  false,
+		},
+		"flat_map": {
+			data:     map[string]any{"key": "value"},
+			maxDepth: 1,
+			wantErr:  false,
+		},
+		"at_limit": {
+			data:     nestedData(10),
+			maxDepth: 10,
+			wantErr:  false,
+		},
+		"over_limit": {
+			data:     nestedData(11),
+			maxDepth: 10,
+			wantErr:  true,
+		},
+		"nested_slices": {
+			data:     []any{[]any{[]any{"deep"}}},
+			maxDepth: 2,
+			wantErr:  true,
+		},
+		"cyclic": {
+			data:     cyclic,
+			maxDepth: a2a.DefaultMaxDataDepth,
+			wantErr:  true,
+		},
+		"disabled": {
+			data:     nestedData(100),
+			maxDepth: 0,
+			wantErr:  false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := a2a.ValidateDataDepth(tt.data, tt.maxDepth)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateDataDepth() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateMessageDataDepth(t *testing.T) {
+	t.Parallel()
+
+	msg := a2a.Message{
+		Role: a2a.RoleUser,
+		Parts: []a2a.Part{
+			&a2a.TextPart{Text: "hello"},
+			&a2a.DataPart{Data: nestedData(5)},
+		},
+	}
+
+	if err := a2a.ValidateMessageDataDepth(msg, 5); err != nil {
+		t.Errorf("ValidateMessageDataDepth() error = %v, want nil", err)
+	}
+	if err := a2a.ValidateMessageDataDepth(msg, 4); err == nil {
+		t.Error("ValidateMessageDataDepth() error = nil, want error")
+	}
+}
+
+func TestValidateMessageFileSize(t *testing.T) {
+	t.Parallel()
+
+	// 12 bytes of content.
+	msg := a2a.Message{
+		Role: a2a.RoleUser,
+		Parts: []a2a.Part{
+			&a2a.TextPart{Text: "hello"},
+			&a2a.FilePart{File: a2a.FileContent{URI: "https://example.com/large.bin"}},
+			&a2a.FilePart{File: a2a.FileContent{Bytes: "aGVsbG8gd29ybGQh"}},
+		},
+	}
+
+	tests := map[string]struct {
+		maxBytes int64
+		wantErr  bool
+	}{
+		"within limit": {maxBytes: 12},
+		"over limit":   {maxBytes: 11, wantErr: true},
+		"disabled":     {maxBytes: 0},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := a2a.ValidateMessageFileSize(msg, tt.maxBytes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateMessageFileSize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, a2a.ErrFileTooLarge) {
+				t.Errorf("ValidateMessageFileSize() error = %v, want %v", err, a2a.ErrFileTooLarge)
+			}
+		})
+	}
+}
+
+func TestValidateMessageSize(t *testing.T) {
+	t.Parallel()
+
+	// 5 bytes of text, 12 of file and 7 of data.
+	msg := a2a.Message{
+		Role: a2a.RoleUser,
+		Parts: []a2a.Part{
+			&a2a.TextPart{Text: "hello"},
+			&a2a.FilePart{File: a2a.FileContent{Bytes: "aGVsbG8gd29ybGQh"}},
+			&a2a.FilePart{File: a2a.FileContent{URI: "https://example.com/large.bin"}},
+			&a2a.DataPart{Data: map[string]any{"n": 1}},
+		},
+	}
+
+	tests := map[string]struct {
+		maxParts int
+		maxBytes int64
+		wantErr  bool
+	}{
+		"within limits":  {maxParts: 4, maxBytes: 24},
+		"too many parts": {maxParts: 3, maxBytes: 24, wantErr: true},
+		"too much":       {maxParts: 4, maxBytes: 23, wantErr: true},
+		"disabled":       {},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := a2a.ValidateMessageSize(msg, tt.maxParts, tt.maxBytes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateMessageSize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, a2a.ErrMessageTooLarge) {
+				t.Errorf("ValidateMessageSize() error = %v, want %v", err, a2a.ErrMessageTooLarge)
+			}
+		})
+	}
+}
+
+func TestValidatePart(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		part    a2a.Part
+		wantErr bool
+	}{
+		"text": {
+			part: &a2a.TextPart{Type: a2a.PartTypeText, Text: "hello"},
+		},
+		"text without type": {
+			part: &a2a.TextPart{Text: "hello"},
+		},
+		"text with mismatched type": {
+			part:    &a2a.TextPart{Type: a2a.PartTypeFile, Text: "hello"},
+			wantErr: true,
+		},
+		"file with bytes": {
+			part: &a2a.FilePart{File: a2a.FileContent{MIMEType: "text/plain", Bytes: "ZGF0YQ=="}},
+		},
+		"file with uri": {
+			part: &a2a.FilePart{File: a2a.FileContent{URI: "https://example.com/a.txt"}},
+		},
+		"file without content": {
+			part:    &a2a.FilePart{File: a2a.FileContent{Name: "a.txt"}},
+			wantErr: true,
+		},
+		"file with bytes and uri": {
+			part:    &a2a.FilePart{File: a2a.FileContent{Bytes: "ZGF0YQ==", URI: "https://example.com/a.txt"}},
+			wantErr: true,
+		},
+		"data": {
+			part: &a2a.DataPart{Data: map[string]any{}},
+		},
+		"data nil": {
+			part:    &a2a.DataPart{},
+			wantErr: true,
+		},
+		"nil interface": {
+			part:    nil,
+			wantErr: true,
+		},
+		"nil pointer": {
+			part:    (*a2a.FilePart)(nil),
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := a2a.ValidatePart(tt.part)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidatePart() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMessage_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		msg     a2a.Message
+		wantErr string
+	}{
+		"valid": {
+			msg: a2a.Message{
+				Role:  a2a.RoleUser,
+				Parts: []a2a.Part{&a2a.TextPart{Text: "hello"}, &a2a.DataPart{Data: map[string]any{"k": 1}}},
+			},
+		},
+		"unknown role": {
+			msg:     a2a.Message{Role: "system"},
+			wantErr: `message: field "role": unknown role "system"`,
+		},
+		"invalid part reports index": {
+			msg: a2a.Message{
+				Role:  a2a.RoleAgent,
+				Parts: []a2a.Part{&a2a.TextPart{Text: "hello"}, &a2a.FilePart{}},
+			},
+			wantErr: `part 1: file part: field "file": either 'Bytes' or 'URI' fields must be present in the file data`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.msg.Validate()
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("Message.Validate() error = %v, want nil", err)
+			case tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr):
+				t.Fatalf("Message.Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// WebhookSignatureHeader is the header of the signature of push notifications sent to a
+	// webhook whose [PushNotificationConfig] has a secret.
+	//
+	// Its value is "t=<timestamp>,v1=<signature>", where the timestamp is in Unix seconds and the
+	// signature is the hex-encoded HMAC-SHA256, keyed by the secret, of the timestamp, a dot and
+	// the body. Servers send the body as canonical JSON, see [CanonicalJSON].
+	WebhookSignatureHeader = "X-A2A-Signature"
+
+	// DefaultWebhookTolerance is how far the timestamp of a signature may be from the current
+	// time for [VerifyWebhookSignature], unless it is given another tolerance.
+	DefaultWebhookTolerance = 5 * time.Minute
+)
+
+var (
+	// ErrInvalidWebhookSignature is returned by [VerifyWebhookSignature] for a notification
+	// without a valid signature.
+	ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+
+	// ErrStaleWebhookSignature is returned by [VerifyWebhookSignature] for a notification signed
+	// too long ago, or too far in the future, which may be replayed.
+	ErrStaleWebhookSignature = errors.New("stale webhook signature")
+)
+
+// SignWebhookPayload returns the value of the [WebhookSignatureHeader] signing body with secret
+// at time t.
+func SignWebhookPayload(secret string, body []byte, t time.Time) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	return "t=" + ts + ",v1=" + hex.EncodeToString(webhookMAC(secret, ts, body))
+}
+
+// VerifyWebhookSignature verifies the [WebhookSignatureHeader] in header of a push notification
+// whose body is body, signed with secret.
+//
+// It returns an error wrapping [ErrInvalidWebhookSignature] if the signature is missing,
+// malformed or does not match, and [ErrStaleWebhookSignature] if its timestamp is more than
+// tolerance away from now, or [DefaultWebhookTolerance] if tolerance is not positive.
+// Receivers should read the body whole, as sent, before verifying it.
+func VerifyWebhookSignature(secret string, body []byte, header http.Header, tolerance time.Duration) error {
+	value := header.Get(WebhookSignatureHeader)
+	if value == "" {
+		return fmt.Errorf("%w: missing %s header", ErrInvalidWebhookSignature, WebhookSignatureHeader)
+	}
+
+	var (
+		ts         string
+		signatures [][]byte
+	)
+	for field := range strings.SplitSeq(value, ",") {
+		key, val, ok := strings.Cut(strings.TrimSpace(field), "=")
+		if !ok {
+			return fmt.Errorf("%w: malformed field %q", ErrInvalidWebhookSignature, field)
+		}
+		switch key {
+		case "t":
+			ts = val
+		case "v1":
+			sig, err := hex.DecodeString(val)
+			if err != nil {
+				return fmt.Errorf("%w: malformed signature: %w", ErrInvalidWebhookSignature, err)
+			}
+			signatures = append(signatures, sig)
+		}
+	}
+	if ts == "" || len(signatures) == 0 {
+		return fmt.Errorf("%w: missing timestamp or signature", ErrInvalidWebhookSignature)
+	}
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: malformed timestamp: %w", ErrInvalidWebhookSignature, err)
+	}
+
+	want := webhookMAC(secret, ts, body)
+	valid := false
+	for _, sig := range signatures {
+		valid = valid || hmac.Equal(sig, want)
+	}
+	if !valid {
+		return fmt.Errorf("%w: signature mismatch", ErrInvalidWebhookSignature)
+	}
+
+	if tolerance <= 0 {
+		tolerance = DefaultWebhookTolerance
+	}
+	if age := time.Since(time.Unix(unix, 0)); age > tolerance || age < -tolerance {
+		return fmt.Errorf("%w: signed %s ago, exceeds %s", ErrStaleWebhookSignature, age.Round(time.Second), tolerance)
+	}
+	return nil
+}
+
+// webhookMAC returns the HMAC-SHA256 of the timestamp ts and body, keyed by secret.
+func webhookMAC(secret, ts string, body []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return mac.Sum(nil)
+}
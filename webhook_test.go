@@ -0,0 +1,88 @@
+// Copyright 2025 The Go A2A Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package a2a_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-a2a/a2a"
+)
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	t.Parallel()
+
+	const secret = "signing-secret"
+	body := []byte(`{"jsonrpc":"2.0","method":"tasks/pushNotification","params":{"id":"task-1"}}`)
+	header := func(signature string) http.Header {
+		h := make(http.Header)
+		h.Set(a2a.WebhookSignatureHeader, signature)
+		return h
+	}
+	signed := func(secret string, body []byte, t time.Time) http.Header {
+		return header(a2a.SignWebhookPayload(secret, body, t))
+	}
+
+	tests := map[string]struct {
+		body    []byte
+		header  http.Header
+		wantErr error
+	}{
+		"valid": {
+			body:   body,
+			header: signed(secret, body, time.Now()),
+		},
+		"within tolerance": {
+			body:   body,
+			header: signed(secret, body, time.Now().Add(-30*time.Second)),
+		},
+		"tampered body": {
+			body:    []byte(`{"jsonrpc":"2.0","method":"tasks/pushNotification","params":{"id":"task-2"}}`),
+			header:  signed(secret, body, time.Now()),
+			wantErr: a2a.ErrInvalidWebhookSignature,
+		},
+		"wrong secret": {
+			body:    body,
+			header:  signed("other-secret", body, time.Now()),
+			wantErr: a2a.ErrInvalidWebhookSignature,
+		},
+		"stale timestamp": {
+			body:    body,
+			header:  signed(secret, body, time.Now().Add(-2*time.Minute)),
+			wantErr: a2a.ErrStaleWebhookSignature,
+		},
+		"future timestamp": {
+			body:    body,
+			header:  signed(secret, body, time.Now().Add(2*time.Minute)),
+			wantErr: a2a.ErrStaleWebhookSignature,
+		},
+		"missing header": {
+			body:    body,
+			header:  http.Header{},
+			wantErr: a2a.ErrInvalidWebhookSignature,
+		},
+		"malformed header": {
+			body:    body,
+			header:  header("t=now,v1=zz"),
+			wantErr: a2a.ErrInvalidWebhookSignature,
+		},
+		"missing signature": {
+			body:    body,
+			header:  header("t=1700000000"),
+			wantErr: a2a.ErrInvalidWebhookSignature,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := a2a.VerifyWebhookSignature(secret, tt.body, tt.header, time.Minute)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("VerifyWebhookSignature() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}